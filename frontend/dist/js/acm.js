@@ -0,0 +1,156 @@
+import * as state from './state.js';
+import { detailSidebar } from './detailSidebar.js';
+
+// expiryColor highlights certificates expiring within 30 days, and expired ones
+function expiryColor(cert) {
+    if (!cert.NotAfter) return 'inherit';
+    if (cert.DaysToExpiry < 0) return 'var(--brand-danger)';
+    if (cert.DaysToExpiry <= 30) return 'var(--brand-warning)';
+    return 'inherit';
+}
+
+function expiryText(cert) {
+    if (!cert.NotAfter) return '-';
+    if (cert.DaysToExpiry < 0) return `Expired ${-cert.DaysToExpiry} day(s) ago`;
+    return `${cert.DaysToExpiry} day(s)`;
+}
+
+export function createACMCard(cert) {
+    const sans = (cert.SubjectAlternativeNames || []).filter(n => n !== cert.DomainName);
+    const inUse = cert.InUseBy || [];
+
+    return `
+        <div class="vpc-card" data-id="${cert.ARN}" style="cursor: pointer;">
+            <div class="vpc-card-header">
+                <div class="vpc-card-title-row">
+                    <div class="vpc-card-title">🔒 ${cert.DomainName}</div>
+                    <span class="badge">${cert.Status}</span>
+                </div>
+                ${inUse.length === 0 ? `<div style="margin-top: 4px;"><span class="badge">Not in use</span></div>` : ''}
+            </div>
+
+            <div class="vpc-card-divider"></div>
+
+            <div class="vpc-card-body">
+                ${sans.length ? `
+                <div class="vpc-card-row">
+                    <span class="label">Also covers:</span>
+                    <span class="value font-mono text-xs">${sans.join(', ')}</span>
+                </div>` : ''}
+
+                <div class="vpc-card-row">
+                    <span class="label">Type:</span>
+                    <span class="value">${cert.Type} · ${cert.KeyAlgorithm}</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">Expires:</span>
+                    <span class="value font-mono" style="color: ${expiryColor(cert)};">${cert.NotAfter || '-'} (${expiryText(cert)})</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">Renewal:</span>
+                    <span class="value">${cert.RenewalEligibility === 'ELIGIBLE' ? (cert.RenewalStatus || 'Managed') : 'Manual'}</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">In use by:</span>
+                    <span class="value font-mono text-xs">${inUse.map(arn => arn.split(':').slice(5).join(':')).join('<br>') || '-'}</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">Region:</span>
+                    <span class="value font-mono">${cert.Region}</span>
+                </div>
+            </div>
+        </div>
+    `;
+}
+
+export function createACMTableRow(cert) {
+    return `
+        <tr>
+            <td><strong>${cert.DomainName}</strong></td>
+            <td>${cert.Status}</td>
+            <td>${cert.Type}</td>
+            <td class="font-mono">${cert.NotAfter || '-'}</td>
+            <td class="font-mono" style="color: ${expiryColor(cert)};">${expiryText(cert)}</td>
+            <td class="font-mono">${(cert.InUseBy || []).length}</td>
+            <td class="font-mono">${cert.Region}</td>
+        </tr>
+    `;
+}
+
+export async function fetchACMCertificates() {
+    try {
+        state.setCurrentPage('acm-list');
+        state.loadingBar.classList.remove('hidden');
+        state.statusText.textContent = 'Fetching ACM Certificates...';
+        state.vpcGrid.innerHTML = '';
+        state.acmTableBody.innerHTML = '';
+
+        const certificates = await window.go.core.App.GetACMCertificates();
+        state.loadingBar.classList.add('hidden');
+
+        state.setAllACMCertificates(certificates || []);
+        state.setFilteredACMCertificates([...state.allACMCertificates]);
+
+        renderACMCertificates();
+    } catch (error) {
+        state.loadingBar.classList.add('hidden');
+        state.statusText.textContent = 'Error fetching ACM certificates';
+        console.error(error);
+    }
+}
+
+export function renderACMCertificates() {
+    if (state.filteredACMCertificates.length === 0) {
+        state.statusText.textContent = 'No ACM certificates found';
+        const emptyCard = `
+            <div class="vpc-card" data-empty="true" style="cursor: pointer;">
+                <div class="vpc-card-title">No ACM Certificates</div>
+                <div class="vpc-card-info">No ACM certificates found in your AWS account</div>
+            </div>
+        `;
+        state.vpcGrid.innerHTML = emptyCard;
+        state.acmTableBody.innerHTML = `
+            <tr>
+                <td colspan="7" style="text-align: center; padding: 32px; color: var(--text-secondary);">
+                    No ACM certificates found
+                </td>
+            </tr>
+        `;
+        return;
+    }
+
+    const expiring = state.filteredACMCertificates.filter(c => c.NotAfter && c.DaysToExpiry <= 30).length;
+    state.statusText.textContent = `${state.filteredACMCertificates.length} ACM certificate(s) found` +
+        (expiring ? `, ${expiring} expiring within 30 days` : '');
+
+    if (state.currentView === 'cards') {
+        state.vpcGrid.innerHTML = state.filteredACMCertificates.map(c => createACMCard(c)).join('');
+    } else {
+        state.acmTableBody.innerHTML = state.filteredACMCertificates.map(c => createACMTableRow(c)).join('');
+    }
+}
+
+export function initACMListeners() {
+    if (!state.vpcGrid) return;
+
+    state.vpcGrid.addEventListener('click', (e) => {
+        if (state.currentPage !== 'acm-list') return;
+
+        const card = e.target.closest('.vpc-card');
+        if (card) {
+            if (card.dataset.empty === 'true') {
+                detailSidebar.open({ message: "Not found yet" });
+                return;
+            }
+            const id = card.dataset.id;
+            const cert = state.allACMCertificates.find(c => c.ARN === id);
+            if (cert) {
+                detailSidebar.open(cert);
+            }
+        }
+    });
+}
@@ -0,0 +1,142 @@
+import * as state from './state.js';
+import { detailSidebar } from './detailSidebar.js';
+
+// customDomains lists the custom domain names mapped to an API
+function customDomains(api) {
+    return (state.apiGatewayDomains || [])
+        .filter(d => (d.Mappings || []).some(m => m.APIID === api.ID))
+        .map(d => d.DomainName);
+}
+
+export function createAPIGatewayCard(api) {
+    const domains = customDomains(api);
+
+    return `
+        <div class="vpc-card" data-id="${api.ID}" style="cursor: pointer;">
+            <div class="vpc-card-header">
+                <div class="vpc-card-title-row">
+                    <div class="vpc-card-title">🔌 ${api.Name}</div>
+                    <span class="badge">${api.Protocol}</span>
+                </div>
+                <div class="vpc-card-subtitle font-mono">${api.ID}</div>
+            </div>
+
+            <div class="vpc-card-divider"></div>
+
+            <div class="vpc-card-body">
+                <div class="vpc-card-row">
+                    <span class="label">Endpoint type:</span>
+                    <span class="value">${api.EndpointType || '-'}</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">Endpoint:</span>
+                    <span class="value font-mono text-xs">${api.DefaultEndpointDisabled ? 'Disabled' : (api.Endpoint || '-')}</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">Custom domains:</span>
+                    <span class="value font-mono text-xs">${domains.join('<br>') || '-'}</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">Created:</span>
+                    <span class="value font-mono">${api.CreatedAt || '-'}</span>
+                </div>
+            </div>
+        </div>
+    `;
+}
+
+export function createAPIGatewayTableRow(api) {
+    return `
+        <tr>
+            <td><strong>${api.Name}</strong></td>
+            <td class="font-mono">${api.ID}</td>
+            <td>${api.Protocol}</td>
+            <td>${api.EndpointType || '-'}</td>
+            <td class="font-mono">${customDomains(api).join(', ') || '-'}</td>
+            <td class="font-mono">${api.CreatedAt || '-'}</td>
+        </tr>
+    `;
+}
+
+export async function fetchAPIGatewayAPIs() {
+    try {
+        state.setCurrentPage('apigateway-list');
+        state.loadingBar.classList.remove('hidden');
+        state.statusText.textContent = 'Fetching APIs...';
+        state.vpcGrid.innerHTML = '';
+        state.apigatewayTableBody.innerHTML = '';
+
+        // Custom domains are only decoration, the APIs are listed without them
+        const [apis, domains] = await Promise.all([
+            window.go.core.App.GetAPIGatewayAPIs(),
+            window.go.core.App.GetAPIGatewayDomains().catch(error => {
+                console.error(error);
+                return null;
+            })
+        ]);
+        state.loadingBar.classList.add('hidden');
+
+        state.setAPIGatewayDomains(domains || []);
+        state.setAllAPIGatewayAPIs(apis || []);
+        state.setFilteredAPIGatewayAPIs([...state.allAPIGatewayAPIs]);
+
+        renderAPIGatewayAPIs();
+    } catch (error) {
+        state.loadingBar.classList.add('hidden');
+        state.statusText.textContent = 'Error fetching APIs';
+        console.error(error);
+    }
+}
+
+export function renderAPIGatewayAPIs() {
+    if (state.filteredAPIGatewayAPIs.length === 0) {
+        state.statusText.textContent = 'No APIs found';
+        const emptyCard = `
+            <div class="vpc-card" data-empty="true" style="cursor: pointer;">
+                <div class="vpc-card-title">No APIs</div>
+                <div class="vpc-card-info">No API Gateway APIs found in this region</div>
+            </div>
+        `;
+        state.vpcGrid.innerHTML = emptyCard;
+        state.apigatewayTableBody.innerHTML = `
+            <tr>
+                <td colspan="6" style="text-align: center; padding: 32px; color: var(--text-secondary);">
+                    No APIs found
+                </td>
+            </tr>
+        `;
+        return;
+    }
+
+    state.statusText.textContent = `${state.filteredAPIGatewayAPIs.length} API(s) found`;
+
+    if (state.currentView === 'cards') {
+        state.vpcGrid.innerHTML = state.filteredAPIGatewayAPIs.map(a => createAPIGatewayCard(a)).join('');
+    } else {
+        state.apigatewayTableBody.innerHTML = state.filteredAPIGatewayAPIs.map(a => createAPIGatewayTableRow(a)).join('');
+    }
+}
+
+export function initAPIGatewayListeners() {
+    if (!state.vpcGrid) return;
+
+    state.vpcGrid.addEventListener('click', (e) => {
+        if (state.currentPage !== 'apigateway-list') return;
+
+        const card = e.target.closest('.vpc-card');
+        if (card) {
+            if (card.dataset.empty === 'true') {
+                detailSidebar.open({ message: "Not found yet" });
+                return;
+            }
+            const id = card.dataset.id;
+            const api = state.allAPIGatewayAPIs.find(a => a.ID === id);
+            if (api) {
+                detailSidebar.open(api);
+            }
+        }
+    });
+}
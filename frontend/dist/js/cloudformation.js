@@ -0,0 +1,198 @@
+import * as state from './state.js';
+import { detailSidebar } from './detailSidebar.js';
+
+// Identifier fields of the inventory items, matched against the physical IDs of the
+// stack resources. Fields like VPCID are left out: they point at another resource.
+const IDENTIFIER_FIELDS = [
+    'ID', 'ARN', 'QueueURL', 'FunctionName', 'DBInstanceIdentifier', 'DBClusterIdentifier',
+    'TableName', 'DomainName', 'ClusterName', 'LoadBalancerName', 'ServiceName', 'Name',
+];
+
+let ownershipPromise = null;
+
+export function driftColor(status) {
+    switch (status) {
+        case 'DRIFTED':
+        case 'MODIFIED':
+        case 'DELETED':
+            return 'var(--brand-warning)';
+        case 'IN_SYNC':
+            return 'var(--brand-success)';
+        default:
+            return 'var(--text-secondary)';
+    }
+}
+
+function statusColor(status) {
+    if (status.endsWith('_FAILED') || status.includes('ROLLBACK')) return 'var(--brand-danger)';
+    if (status.endsWith('_IN_PROGRESS')) return 'var(--brand-warning)';
+    return 'inherit';
+}
+
+// loadStackOwnership fetches the ownership index once and shares it between items
+function loadStackOwnership() {
+    if (!ownershipPromise) {
+        ownershipPromise = window.go.core.App.GetStackOwnership().catch(err => {
+            ownershipPromise = null;
+            throw err;
+        });
+    }
+    return ownershipPromise;
+}
+
+// findStackOwner returns the stack that created an inventory item, from its
+// aws:cloudformation:stack-name tag or else from its identifiers
+export async function findStackOwner(item) {
+    if (!item || typeof item !== 'object' || item.StackID !== undefined) return null;
+
+    const index = await loadStackOwnership();
+    if (!index) return null;
+
+    const tags = item.Tags || {};
+    const stackName = tags['aws:cloudformation:stack-name'];
+    if (stackName) {
+        return {
+            stack_name: stackName,
+            stack_id: tags['aws:cloudformation:stack-id'] || index.stack_ids[stackName] || '',
+            logical_id: tags['aws:cloudformation:logical-id'] || '',
+            resource_type: '',
+        };
+    }
+
+    for (const field of IDENTIFIER_FIELDS) {
+        const value = item[field];
+        if (typeof value === 'string' && value && index.by_physical_id[value]) {
+            return index.by_physical_id[value];
+        }
+    }
+    return null;
+}
+
+export function createCloudFormationCard(stack) {
+    return `
+        <div class="vpc-card" data-id="${stack.StackID}" style="cursor: pointer;">
+            <div class="vpc-card-header">
+                <div class="vpc-card-title-row">
+                    <div class="vpc-card-title">🧱 ${stack.StackName}</div>
+                    <span class="badge" style="color: ${statusColor(stack.Status)};">${stack.Status}</span>
+                </div>
+                <div class="vpc-card-subtitle">${stack.Description || (stack.ParentID ? 'Nested stack' : 'Stack')}</div>
+            </div>
+
+            <div class="vpc-card-divider"></div>
+
+            <div class="vpc-card-body">
+                <div class="vpc-card-row">
+                    <span class="label">Drift:</span>
+                    <span class="value" style="color: ${driftColor(stack.DriftStatus)};">${stack.DriftStatus}${stack.LastDriftCheck ? ` (${stack.LastDriftCheck})` : ''}</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">Parent:</span>
+                    <span class="value">${stack.ParentID ? stack.ParentID.split('/')[1] : '-'}</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">Nested stacks:</span>
+                    <span class="value">${(stack.NestedStacks || []).join(', ') || '-'}</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">Outputs:</span>
+                    <span class="value">${(stack.Outputs || []).length}, ${Object.keys(stack.Parameters || {}).length} parameter(s)</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">Updated:</span>
+                    <span class="value">${stack.UpdatedAt || stack.CreatedAt}</span>
+                </div>
+            </div>
+        </div>
+    `;
+}
+
+export function createCloudFormationTableRow(stack) {
+    return `
+        <tr>
+            <td><strong>${stack.StackName}</strong></td>
+            <td style="color: ${statusColor(stack.Status)};">${stack.Status}</td>
+            <td style="color: ${driftColor(stack.DriftStatus)};">${stack.DriftStatus}</td>
+            <td>${stack.ParentID ? stack.ParentID.split('/')[1] : '-'}</td>
+            <td class="font-mono">${(stack.NestedStacks || []).length}</td>
+            <td class="font-mono">${(stack.Outputs || []).length}</td>
+            <td>${stack.UpdatedAt || stack.CreatedAt}</td>
+        </tr>
+    `;
+}
+
+export async function fetchCloudFormationStacks() {
+    try {
+        state.setCurrentPage('cloudformation-list');
+        state.loadingBar.classList.remove('hidden');
+        state.statusText.textContent = 'Fetching CloudFormation Stacks...';
+        state.vpcGrid.innerHTML = '';
+        state.cloudformationTableBody.innerHTML = '';
+
+        const stacks = await window.go.core.App.GetCloudFormationStacks();
+        state.loadingBar.classList.add('hidden');
+
+        state.setAllCloudFormationStacks(stacks || []);
+        state.setFilteredCloudFormationStacks([...state.allCloudFormationStacks]);
+
+        renderCloudFormationStacks();
+    } catch (error) {
+        state.loadingBar.classList.add('hidden');
+        state.statusText.textContent = 'Error fetching CloudFormation stacks';
+        console.error(error);
+    }
+}
+
+export function renderCloudFormationStacks() {
+    if (state.filteredCloudFormationStacks.length === 0) {
+        state.statusText.textContent = 'No CloudFormation stacks found';
+        const emptyCard = `
+            <div class="vpc-card" data-empty="true" style="cursor: pointer;">
+                <div class="vpc-card-title">No CloudFormation Stacks</div>
+                <div class="vpc-card-info">No CloudFormation stacks found in this region</div>
+            </div>
+        `;
+        state.vpcGrid.innerHTML = emptyCard;
+        state.cloudformationTableBody.innerHTML = `
+            <tr>
+                <td colspan="7" style="text-align: center; padding: 32px; color: var(--text-secondary);">
+                    No CloudFormation stacks found
+                </td>
+            </tr>
+        `;
+        return;
+    }
+
+    state.statusText.textContent = `${state.filteredCloudFormationStacks.length} CloudFormation stack(s) found`;
+
+    if (state.currentView === 'cards') {
+        state.vpcGrid.innerHTML = state.filteredCloudFormationStacks.map(s => createCloudFormationCard(s)).join('');
+    } else {
+        state.cloudformationTableBody.innerHTML = state.filteredCloudFormationStacks.map(s => createCloudFormationTableRow(s)).join('');
+    }
+}
+
+export function initCloudFormationListeners() {
+    if (!state.vpcGrid) return;
+
+    state.vpcGrid.addEventListener('click', (e) => {
+        if (state.currentPage !== 'cloudformation-list') return;
+
+        const card = e.target.closest('.vpc-card');
+        if (card) {
+            if (card.dataset.empty === 'true') {
+                detailSidebar.open({ message: "Not found yet" });
+                return;
+            }
+            const id = card.dataset.id;
+            const stack = state.allCloudFormationStacks.find(s => s.StackID === id);
+            if (stack) {
+                detailSidebar.open(stack);
+            }
+        }
+    });
+}
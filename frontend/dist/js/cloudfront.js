@@ -0,0 +1,153 @@
+import * as state from './state.js';
+import { detailSidebar } from './detailSidebar.js';
+
+const PRICE_CLASS_LABELS = {
+    'PriceClass_All': 'All locations',
+    'PriceClass_200': 'NA, EU, Asia, ME, Africa',
+    'PriceClass_100': 'NA, EU',
+    'None': 'All locations',
+};
+
+function originSummary(dist) {
+    return (dist.Origins || []).map(o => `${o.DomainName}${o.Path || ''} (${o.Type}${o.Type === 's3' && !o.AccessControl ? ', public' : ''})`);
+}
+
+export function createCloudFrontCard(dist) {
+    const aliases = dist.Aliases || [];
+    const openS3 = (dist.Origins || []).filter(o => o.Type === 's3' && !o.AccessControl);
+
+    return `
+        <div class="vpc-card" data-id="${dist.ID}" style="cursor: pointer;">
+            <div class="vpc-card-header">
+                <div class="vpc-card-title-row">
+                    <div class="vpc-card-title">🌐 ${aliases[0] || dist.DomainName}</div>
+                    <span class="badge">${dist.Enabled ? dist.Status : 'Disabled'}</span>
+                </div>
+                ${!dist.WebACLID ? `<div style="margin-top: 4px;"><span class="badge" style="color: var(--brand-warning);">No WAF web ACL</span></div>` : ''}
+                ${openS3.length ? `<div style="margin-top: 4px;"><span class="badge" style="color: var(--brand-warning);">${openS3.length} S3 origin(s) without access control</span></div>` : ''}
+            </div>
+
+            <div class="vpc-card-divider"></div>
+
+            <div class="vpc-card-body">
+                <div class="vpc-card-row">
+                    <span class="label">ID:</span>
+                    <span class="value font-mono">${dist.ID}</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">Domain:</span>
+                    <span class="value font-mono text-xs">${dist.DomainName}</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">Aliases:</span>
+                    <span class="value font-mono text-xs">${aliases.join(', ') || '-'}</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">Origins:</span>
+                    <span class="value font-mono text-xs">${originSummary(dist).join('<br>') || '-'}</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">Price class:</span>
+                    <span class="value" title="${dist.PriceClass}">${PRICE_CLASS_LABELS[dist.PriceClass] || dist.PriceClass}</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">TLS:</span>
+                    <span class="value font-mono text-xs">${dist.CertificateARN ? `ACM, ${dist.MinimumProtocolVersion}` : 'Default certificate'}</span>
+                </div>
+            </div>
+        </div>
+    `;
+}
+
+export function createCloudFrontTableRow(dist) {
+    return `
+        <tr>
+            <td><strong>${dist.ID}</strong></td>
+            <td class="font-mono">${dist.DomainName}</td>
+            <td class="font-mono">${(dist.Aliases || []).join(', ') || '-'}</td>
+            <td class="font-mono">${(dist.Origins || []).length}</td>
+            <td>${dist.PriceClass}</td>
+            <td style="color: ${dist.WebACLID ? 'inherit' : 'var(--brand-warning)'};">${dist.WebACLID ? 'Yes' : 'No'}</td>
+            <td>${dist.Enabled ? dist.Status : 'Disabled'}</td>
+        </tr>
+    `;
+}
+
+export async function fetchCloudFrontDistributions() {
+    try {
+        state.setCurrentPage('cloudfront-list');
+        state.loadingBar.classList.remove('hidden');
+        state.statusText.textContent = 'Fetching CloudFront Distributions...';
+        state.vpcGrid.innerHTML = '';
+        state.cloudfrontTableBody.innerHTML = '';
+
+        const distributions = await window.go.core.App.GetCloudFrontDistributions();
+        state.loadingBar.classList.add('hidden');
+
+        state.setAllCloudFrontDistributions(distributions || []);
+        state.setFilteredCloudFrontDistributions([...state.allCloudFrontDistributions]);
+
+        renderCloudFrontDistributions();
+    } catch (error) {
+        state.loadingBar.classList.add('hidden');
+        state.statusText.textContent = 'Error fetching CloudFront distributions';
+        console.error(error);
+    }
+}
+
+export function renderCloudFrontDistributions() {
+    if (state.filteredCloudFrontDistributions.length === 0) {
+        state.statusText.textContent = 'No CloudFront distributions found';
+        const emptyCard = `
+            <div class="vpc-card" data-empty="true" style="cursor: pointer;">
+                <div class="vpc-card-title">No CloudFront Distributions</div>
+                <div class="vpc-card-info">No CloudFront distributions found in your AWS account</div>
+            </div>
+        `;
+        state.vpcGrid.innerHTML = emptyCard;
+        state.cloudfrontTableBody.innerHTML = `
+            <tr>
+                <td colspan="7" style="text-align: center; padding: 32px; color: var(--text-secondary);">
+                    No CloudFront distributions found
+                </td>
+            </tr>
+        `;
+        return;
+    }
+
+    const unprotected = state.filteredCloudFrontDistributions.filter(d => !d.WebACLID).length;
+    state.statusText.textContent = `${state.filteredCloudFrontDistributions.length} CloudFront distribution(s) found` +
+        (unprotected ? `, ${unprotected} without WAF` : '');
+
+    if (state.currentView === 'cards') {
+        state.vpcGrid.innerHTML = state.filteredCloudFrontDistributions.map(d => createCloudFrontCard(d)).join('');
+    } else {
+        state.cloudfrontTableBody.innerHTML = state.filteredCloudFrontDistributions.map(d => createCloudFrontTableRow(d)).join('');
+    }
+}
+
+export function initCloudFrontListeners() {
+    if (!state.vpcGrid) return;
+
+    state.vpcGrid.addEventListener('click', (e) => {
+        if (state.currentPage !== 'cloudfront-list') return;
+
+        const card = e.target.closest('.vpc-card');
+        if (card) {
+            if (card.dataset.empty === 'true') {
+                detailSidebar.open({ message: "Not found yet" });
+                return;
+            }
+            const id = card.dataset.id;
+            const dist = state.allCloudFrontDistributions.find(d => d.ID === id);
+            if (dist) {
+                detailSidebar.open(dist);
+            }
+        }
+    });
+}
@@ -7,8 +7,15 @@ export const detailSidebar = {
         tabs: [],
         panels: [],
         metricsContent: null,
+        healthContent: null,
+        alarmsContent: null,
+        logsContent: null,
+        stackOwner: null,
     },
     currentData: null,
+    currentTailId: null,
+    stopTailListener: null,
+    stopDriftListener: null,
     currentTab: 'details',
 
     init() {
@@ -18,6 +25,10 @@ export const detailSidebar = {
         this.elements.tabs = document.querySelectorAll('.detail-tab');
         this.elements.panels = document.querySelectorAll('.tab-panel');
         this.elements.metricsContent = document.getElementById('metricsContent');
+        this.elements.healthContent = document.getElementById('healthContent');
+        this.elements.alarmsContent = document.getElementById('alarmsContent');
+        this.elements.logsContent = document.getElementById('logsContent');
+        this.elements.stackOwner = document.getElementById('stackOwner');
 
         if (!this.elements.sidebar || !this.elements.content || !this.elements.closeBtn) {
             console.error('Detail Sidebar elements not found');
@@ -47,6 +58,8 @@ export const detailSidebar = {
     open(data) {
         if (!this.elements.sidebar) return;
 
+        this.stopLogTail();
+        this.stopDriftWatch();
         this.currentData = data;
 
         // Reset to details tab
@@ -55,6 +68,7 @@ export const detailSidebar = {
         // Format JSON
         const jsonString = JSON.stringify(data, null, 2);
         this.elements.content.textContent = jsonString;
+        this.loadStackOwner(data);
 
         this.elements.sidebar.classList.add('open');
     },
@@ -74,44 +88,127 @@ export const detailSidebar = {
 
         if (tabId === 'metrics') {
             this.loadMetrics();
+        } else if (tabId === 'health') {
+            this.loadHealth();
+        } else if (tabId === 'alarms') {
+            this.loadAlarms();
+        } else if (tabId === 'logs') {
+            this.loadLogs();
         }
     },
 
+    // Shows the CloudFormation stack that created the selected resource, if any
+    async loadStackOwner(data) {
+        const banner = this.elements.stackOwner;
+        if (!banner) return;
+        banner.classList.add('hidden');
+
+        let owner;
+        try {
+            const { findStackOwner } = await import('./cloudformation.js');
+            owner = await findStackOwner(data);
+        } catch (err) {
+            console.error('Failed to load stack ownership:', err);
+            return;
+        }
+        if (this.currentData !== data || !owner) return;
+
+        banner.innerHTML = `
+            <div class="metric-header">
+                <span class="metric-title">🧱 Managed by stack ${owner.stack_name}</span>
+                <span class="metric-value">${owner.logical_id}</span>
+            </div>
+            ${owner.resource_type ? `<div class="alarm-condition">${owner.resource_type}</div>` : ''}
+        `;
+        banner.classList.remove('hidden');
+    },
+
+    // Maps the selected resource to a metrics catalog type and identifier
+    resolveMetricTarget(data) {
+        if (data.ServiceName && data.ClusterArn) {
+            const cluster = data.ClusterArn.split('/').pop();
+            return { type: 'ecs-service', id: `${cluster}/${data.ServiceName}` };
+        }
+        if (data.ClusterName) return { type: 'ecs-cluster', id: data.ClusterName };
+        if (data.FunctionName) return { type: 'lambda', id: data.FunctionName };
+        if (data.DBInstanceIdentifier) return { type: 'rds', id: data.DBInstanceIdentifier };
+        if (data.DNSName && data.ARN) {
+            if (data.Type === 'application') return { type: 'alb', id: data.ARN };
+            if (data.Type === 'network') return { type: 'nlb', id: data.ARN };
+            return null;
+        }
+        if (data.InstanceType && data.ID) return { type: 'ec2', id: data.ID };
+        if (data.ConnectivityType && data.ID) return { type: 'nat', id: data.ID };
+        if (data.TableName && data.BillingMode) return { type: 'dynamodb', id: data.TableName };
+        if (data.QueueURL) return { type: 'sqs', id: data.QueueName };
+        if (data.TopicName && data.SubscriptionsByProtocol) return { type: 'sns', id: data.ARN };
+        if (data.MetricClusterID) return { type: 'elasticache', id: data.MetricClusterID };
+        if (data.DomainName && data.DedicatedMasterEnabled !== undefined) return { type: 'opensearch', id: data.ARN };
+        if (data.KafkaVersion !== undefined && data.BrokerCount !== undefined) return { type: 'msk', id: data.Name };
+        return null;
+    },
+
     async loadMetrics() {
         if (!this.currentData || !this.elements.metricsContent) return;
 
-        // Add period selector if not present
-        if (!document.getElementById('metricPeriod')) {
-            const periodContainer = document.createElement('div');
-            periodContainer.className = 'metrics-toolbar';
-            periodContainer.innerHTML = `
+        // Add range selector if not present
+        if (!document.getElementById('metricRange')) {
+            const rangeContainer = document.createElement('div');
+            rangeContainer.className = 'metrics-toolbar';
+            rangeContainer.innerHTML = `
                 <div class="period-selector">
-                    <label for="metricPeriod">Interval:</label>
-                    <select id="metricPeriod" class="custom-select-sm">
-                        <option value="60">1 Minute</option>
-                        <option value="300">5 Minutes</option>
-                        <option value="3600" selected>1 Hour</option>
-                        <option value="21600">6 Hours</option>
-                        <option value="86400">1 Day</option>
+                    <label for="metricRange">Range:</label>
+                    <select id="metricRange" class="custom-select-sm">
+                        <option value="1h">Last Hour</option>
+                        <option value="6h">Last 6 Hours</option>
+                        <option value="24h" selected>Last 24 Hours</option>
+                        <option value="7d">Last 7 Days</option>
+                    </select>
+                    <label for="metricStat">Statistic:</label>
+                    <select id="metricStat" class="custom-select-sm">
+                        <option value="" selected>Default</option>
+                        <option value="p50,p90,p99">Percentiles</option>
+                        <option value="Maximum">Maximum</option>
+                        <option value="Sum">Sum</option>
                     </select>
                 </div>
             `;
-            this.elements.metricsContent.parentNode.insertBefore(periodContainer, this.elements.metricsContent);
+            this.elements.metricsContent.parentNode.insertBefore(rangeContainer, this.elements.metricsContent);
 
-            document.getElementById('metricPeriod').addEventListener('change', () => this.loadMetrics());
+            document.getElementById('metricRange').addEventListener('change', () => this.loadMetrics());
+            document.getElementById('metricStat').addEventListener('change', () => this.loadMetrics());
+        }
+
+        const range = document.getElementById('metricRange').value;
+        const stats = document.getElementById('metricStat').value;
+        const target = this.resolveMetricTarget(this.currentData);
+
+        if (!target) {
+            this.elements.metricsContent.innerHTML = '<div class="metrics-loading">No CloudWatch metrics available for this resource type.</div>';
+            return;
         }
 
-        const period = parseInt(document.getElementById('metricPeriod').value);
         this.elements.metricsContent.innerHTML = '<div class="metrics-loading">Fetching CloudWatch metrics...</div>';
 
         try {
-            // Check if it's an ECS Cluster
-            if (this.currentData.ClusterName) {
-                const results = await window.go.core.App.GetECSMetrics(this.currentData.ClusterName, period);
-                this.renderMetrics(results);
+            let results;
+            if (stats) {
+                const hours = { '1h': 1, '6h': 6, '24h': 24, '7d': 168 }[range];
+                const end = new Date();
+                const start = new Date(end.getTime() - hours * 3600 * 1000);
+                results = await window.go.core.App.QueryResourceMetrics({
+                    resource_type: target.type,
+                    resource_id: target.id,
+                    start: start.toISOString(),
+                    end: end.toISOString(),
+                    period: 0,
+                    stats: stats.split(','),
+                    expressions: [],
+                });
             } else {
-                this.elements.metricsContent.innerHTML = '<div class="metrics-loading">Metrics only available for ECS Clusters and Lambdas.</div>';
+                results = await window.go.core.App.GetResourceMetrics(target.type, target.id, range);
             }
+            this.renderMetrics(results);
         } catch (err) {
             console.error('Failed to load metrics:', err);
             this.elements.metricsContent.innerHTML = `<div class="metrics-loading" style="color: var(--status-error)">Error: ${err.message || err}</div>`;
@@ -135,7 +232,7 @@ export const detailSidebar = {
                 if (metric.label.includes('Utilization')) {
                     formattedValue = latestValue.toFixed(2);
                     unit = '%';
-                } else if (metric.label.includes('Network')) {
+                } else if (metric.label.includes('Network') || metric.label.includes('Bytes') || metric.label.includes('FreeStorage') || metric.label.includes('FreeableMemory')) {
                     // Convert bytes to KB/MB
                     if (latestValue > 1024 * 1024) {
                         formattedValue = (latestValue / (1024 * 1024)).toFixed(2);
@@ -168,6 +265,719 @@ export const detailSidebar = {
         this.elements.metricsContent.innerHTML = html;
     },
 
+    async loadHealth() {
+        if (!this.currentData || !this.elements.healthContent) return;
+
+        const target = this.resolveMetricTarget(this.currentData);
+        if (target && target.type === 'rds') {
+            this.loadRDSEvents(this.currentData);
+            return;
+        }
+        if (target && target.type === 'lambda') {
+            this.loadLambdaDetail(this.currentData);
+            return;
+        }
+        if (this.currentData.RegistryID && this.currentData.ImageCount !== undefined) {
+            this.loadECRImages(this.currentData);
+            return;
+        }
+        if (this.currentData.PlatformVersion !== undefined && this.currentData.SupportStatus !== undefined) {
+            this.loadEKSDetail(this.currentData);
+            return;
+        }
+        if (this.currentData.RecordCount !== undefined && this.currentData.Private !== undefined) {
+            this.loadRoute53Records(this.currentData);
+            return;
+        }
+        if (this.currentData.Protocol !== undefined && this.currentData.EndpointType !== undefined) {
+            this.loadAPIGatewayDetail(this.currentData);
+            return;
+        }
+        if (this.currentData.StackID !== undefined && this.currentData.DriftStatus !== undefined) {
+            this.loadCloudFormationDetail(this.currentData);
+            return;
+        }
+        if (!target || target.type !== 'ec2') {
+            this.elements.healthContent.innerHTML = '<div class="metrics-loading">Health checks are only available for EC2, RDS and Lambda.</div>';
+            return;
+        }
+
+        const instance = this.currentData;
+        this.elements.healthContent.innerHTML = '<div class="metrics-loading">Fetching instance status...</div>';
+
+        let status = null;
+        let statusError = null;
+        try {
+            status = await window.go.core.App.GetInstanceStatus(instance.ID);
+        } catch (err) {
+            console.error('Failed to load instance status:', err);
+            statusError = err.message || err;
+        }
+
+        // The instance may have been closed while the status was loading
+        if (this.currentData !== instance) return;
+        this.elements.healthContent.innerHTML =
+            this.renderInstanceStatus(status, statusError) + this.renderInstanceDetail(instance);
+    },
+
+    async loadRDSEvents(db) {
+        this.elements.healthContent.innerHTML = '<div class="metrics-loading">Fetching RDS events...</div>';
+
+        try {
+            const requests = [window.go.core.App.GetRDSEvents('db-instance', db.DBInstanceIdentifier)];
+            if (db.DBClusterIdentifier) {
+                requests.push(window.go.core.App.GetRDSEvents('db-cluster', db.DBClusterIdentifier));
+            }
+            const events = (await Promise.all(requests))
+                .flatMap(list => list || [])
+                .sort((a, b) => b.date.localeCompare(a.date));
+
+            if (this.currentData !== db) return;
+            this.elements.healthContent.innerHTML = events.length === 0
+                ? '<div class="metrics-loading">No RDS events in the last 24 hours.</div>'
+                : events.map(e => `
+                    <div class="metric-card">
+                        <div class="metric-header">
+                            <span class="metric-title">${e.source_identifier}</span>
+                            <span class="metric-value">${e.date}</span>
+                        </div>
+                        <div class="alarm-reason">${e.message}</div>
+                        <div class="alarm-condition">${(e.categories || []).join(', ')}</div>
+                    </div>
+                `).join('');
+        } catch (err) {
+            console.error('Failed to load RDS events:', err);
+            this.elements.healthContent.innerHTML = `<div class="metrics-loading" style="color: var(--brand-danger)">Error: ${err.message || err}</div>`;
+        }
+    },
+
+    async loadLambdaDetail(fn) {
+        this.elements.healthContent.innerHTML = '<div class="metrics-loading">Fetching function configuration...</div>';
+
+        let detail, invokers;
+        try {
+            // The invokers come from every API and state machine, a failure there keeps the configuration
+            [detail, invokers] = await Promise.all([
+                window.go.core.App.GetLambdaFunctionDetail(fn.FunctionName),
+                window.go.core.App.GetLambdaInvokers(fn.Arn).catch(err => {
+                    console.error('Failed to load function invokers:', err);
+                    return null;
+                })
+            ]);
+        } catch (err) {
+            console.error('Failed to load function detail:', err);
+            this.elements.healthContent.innerHTML = `<div class="metrics-loading" style="color: var(--brand-danger)">Error: ${err.message || err}</div>`;
+            return;
+        }
+        if (this.currentData !== fn || !detail) return;
+
+        const line = text => `<div class="alarm-condition">${text}</div>`;
+        const card = (title, value, body) => `
+            <div class="metric-card">
+                <div class="metric-header">
+                    <span class="metric-title">${title}</span>
+                    <span class="metric-value">${value}</span>
+                </div>
+                ${body}
+            </div>
+        `;
+
+        const runtime = fn.DeprecatedRuntime
+            ? `<span style="color: var(--brand-danger)">${fn.Runtime} (deprecated ${fn.RuntimeDeprecationDate})</span>`
+            : `${fn.Runtime || fn.PackageType}${fn.RuntimeDeprecationDate ? ` (deprecated on ${fn.RuntimeDeprecationDate})` : ''}`;
+
+        this.elements.healthContent.innerHTML = [
+            (detail.errors || []).map(e => `<div class="metrics-loading" style="color: var(--brand-warning)">${e}</div>`).join(''),
+            card('Configuration', '', [
+                line(`Runtime: ${runtime}`),
+                line(`Timeout: ${fn.Timeout}s, Memory: ${fn.MemorySize} MB, ${fn.Architecture}`),
+                line(`Package: ${fn.PackageType}, ${(fn.CodeSize / 1048576).toFixed(1)} MB`),
+                line(`Role: ${fn.Role || '-'}`),
+                fn.VPCID ? line(`VPC: ${fn.VPCID}, subnets ${(fn.SubnetIDs || []).join(', ')}, SGs ${(fn.SecurityGroupIDs || []).join(', ')}`) : '',
+                ...(fn.Layers || []).map(l => line(`Layer: ${l}`)),
+                line(`Environment: ${(fn.EnvVarNames || []).join(', ') || 'none'}`),
+            ].join('')),
+            card('Concurrency', detail.reserved_concurrency === null ? 'unreserved' : `${detail.reserved_concurrency} reserved`,
+                (detail.provisioned_concurrency || []).map(p =>
+                    line(`${p.qualifier}: ${p.allocated}/${p.requested} provisioned (${p.status})${p.reason ? ` ${p.reason}` : ''}`)).join('')),
+            card('Aliases', detail.aliases.length, detail.aliases.map(a =>
+                line(`${a.name} → ${a.version}${Object.entries(a.additional_version_weights || {}).map(([v, w]) => `, ${v} at ${Math.round(w * 100)}%`).join('')}`)).join('')),
+            card('Versions', detail.versions.length, detail.versions.slice(-5).reverse().map(v =>
+                line(`${v.version}: ${v.last_modified}${v.description ? ` ${v.description}` : ''}`)).join('')),
+            card('Event Sources', detail.event_sources.length, detail.event_sources.map(e =>
+                line(`${e.source_type} ${e.source_arn.split(':').pop()}: ${e.state}, batch ${e.batch_size}${e.last_result ? `, ${e.last_result}` : ''}`)).join('')),
+            card('Triggers', detail.triggers.length, detail.triggers.map(t =>
+                line(`${t.principal}${t.source_arn ? ` from ${t.source_arn}` : ''}${t.account ? ` (account ${t.account})` : ''}`)).join('')),
+            invokers ? card('Invoked by', invokers.length, invokers.map(i =>
+                line(`${i.source_type === 'apigateway' ? 'API' : 'State machine'} ${i.source_name}${i.detail ? ` ${i.detail}` : ''}${i.qualifier ? ` → ${i.qualifier}` : ''}`)).join('')) : '',
+        ].join('');
+    },
+
+    async loadAPIGatewayDetail(api) {
+        this.elements.healthContent.innerHTML = '<div class="metrics-loading">Fetching stages and routes...</div>';
+
+        let detail;
+        try {
+            detail = await window.go.core.App.GetAPIGatewayAPIDetail(api.ID, api.Protocol);
+        } catch (err) {
+            console.error('Failed to load API detail:', err);
+            this.elements.healthContent.innerHTML = `<div class="metrics-loading" style="color: var(--brand-danger)">Error: ${err.message || err}</div>`;
+            return;
+        }
+        if (this.currentData !== api || !detail) return;
+
+        const line = text => `<div class="alarm-condition">${text}</div>`;
+        const card = (title, value, body) => `
+            <div class="metric-card">
+                <div class="metric-header">
+                    <span class="metric-title">${title}</span>
+                    <span class="metric-value">${value}</span>
+                </div>
+                ${body}
+            </div>
+        `;
+        const target = r => {
+            if (r.lambda_arn) return `Lambda ${r.lambda_arn.split(':').slice(6).join(':')}`;
+            if (r.state_machine_arn) return `State machine ${r.state_machine_arn.split(':').pop()}`;
+            return `${r.integration_type || 'none'}${r.integration_target ? ` ${r.integration_target}` : ''}`;
+        };
+
+        this.elements.healthContent.innerHTML = [
+            (detail.errors || []).map(e => `<div class="metrics-loading" style="color: var(--brand-warning)">${e}</div>`).join(''),
+            ...detail.stages.map(st => card(`Stage ${st.name}`, st.last_updated || '', [
+                line(st.invoke_url),
+                st.throttle_rate ? line(`Throttling: ${st.throttle_rate} req/s, burst ${st.throttle_burst}`) : '',
+                line(`Access logs: ${st.access_log_target || 'off'}${st.tracing_enabled ? ', X-Ray tracing' : ''}${st.auto_deploy ? ', auto deploy' : ''}`),
+                st.web_acl_arn ? line(`WAF: ${st.web_acl_arn.split('/').slice(-2, -1)[0]}`) : '',
+            ].join(''))),
+            card('Routes', detail.routes.length, detail.routes.map(r =>
+                line(`${r.route} → ${target(r)}${r.authorization && r.authorization !== 'NONE' ? ` (${r.authorization})` : ''}`)).join('')),
+        ].join('');
+    },
+
+    async loadCloudFormationDetail(stack) {
+        this.elements.healthContent.innerHTML = '<div class="metrics-loading">Fetching stack resources...</div>';
+
+        let resources;
+        try {
+            resources = await window.go.core.App.GetCloudFormationStackResources(stack.StackName);
+        } catch (err) {
+            console.error('Failed to load stack resources:', err);
+            this.elements.healthContent.innerHTML = `<div class="metrics-loading" style="color: var(--brand-danger)">Error: ${err.message || err}</div>`;
+            return;
+        }
+        if (this.currentData !== stack) return;
+
+        const { driftColor } = await import('./cloudformation.js');
+        const line = text => `<div class="alarm-condition">${text}</div>`;
+        const card = (title, value, body) => `
+            <div class="metric-card">
+                <div class="metric-header">
+                    <span class="metric-title">${title}</span>
+                    <span class="metric-value">${value}</span>
+                </div>
+                ${body}
+            </div>
+        `;
+
+        this.elements.healthContent.innerHTML = [
+            card('Drift', `<span style="color: ${driftColor(stack.DriftStatus)};">${stack.DriftStatus}</span>`, `
+                <div id="stackDriftResult">${line(stack.LastDriftCheck ? `Last checked ${stack.LastDriftCheck}` : 'Never checked')}</div>
+                <button id="stackDriftBtn" class="btn-secondary">Detect drift</button>
+            `),
+            stack.Outputs.length ? card('Outputs', stack.Outputs.length, stack.Outputs.map(o =>
+                line(`${o.Key} = ${o.Value}${o.ExportName ? ` (export ${o.ExportName})` : ''}`)).join('')) : '',
+            card('Resources', resources.length, resources.map(r => line(
+                `${r.logical_id} <span class="font-mono">${r.physical_id || '-'}</span> ${r.type} · ${r.status}` +
+                (r.drift_status !== 'NOT_CHECKED' ? ` · <span style="color: ${driftColor(r.drift_status)};">${r.drift_status}</span>` : ''))).join('')),
+        ].join('');
+
+        document.getElementById('stackDriftBtn').addEventListener('click', () => this.detectStackDrift(stack));
+    },
+
+    // Starts a drift detection; its result arrives as a 'cloudformation:drift' event
+    async detectStackDrift(stack) {
+        const button = document.getElementById('stackDriftBtn');
+        const output = document.getElementById('stackDriftResult');
+        button.disabled = true;
+        output.innerHTML = '<div class="alarm-condition">Detecting drift...</div>';
+
+        // Matched by stack name: the result may be emitted before DetectStackDrift returns
+        this.stopDriftWatch();
+        this.stopDriftListener = window.runtime.EventsOn('cloudformation:drift', (result) => {
+            if (result.stack_name !== stack.StackName) return;
+            this.stopDriftWatch();
+            if (this.currentData !== stack) return;
+
+            button.disabled = false;
+            if (result.error) {
+                output.innerHTML = `<div class="alarm-condition text-danger">Error: ${result.error}</div>`;
+                return;
+            }
+            stack.DriftStatus = result.drift_status;
+            stack.LastDriftCheck = result.checked_at;
+            output.innerHTML = [
+                `<div class="alarm-condition">${result.drift_status}, ${result.drifted_count} drifted resource(s) at ${result.checked_at}</div>`,
+                result.status_reason ? `<div class="alarm-condition">${result.status_reason}</div>` : '',
+                ...result.drifts.map(d => `
+                    <div class="alarm-condition">${d.logical_id} (${d.type}) <span style="color: var(--brand-warning);">${d.drift_status}</span></div>
+                    ${d.differences.map(diff => `<div class="alarm-condition font-mono text-xs">${diff}</div>`).join('')}
+                `),
+            ].join('');
+        });
+
+        try {
+            await window.go.core.App.DetectStackDrift(stack.StackName);
+        } catch (err) {
+            this.stopDriftWatch();
+            button.disabled = false;
+            output.innerHTML = `<div class="alarm-condition text-danger">Error: ${err.message || err}</div>`;
+        }
+    },
+
+    stopDriftWatch() {
+        if (this.stopDriftListener) {
+            this.stopDriftListener();
+            this.stopDriftListener = null;
+        }
+    },
+
+    async loadECRImages(repo) {
+        this.elements.healthContent.innerHTML = '<div class="metrics-loading">Fetching images...</div>';
+
+        let images;
+        try {
+            images = await window.go.core.App.GetECRImages(repo.Name);
+        } catch (err) {
+            console.error('Failed to load images:', err);
+            this.elements.healthContent.innerHTML = `<div class="metrics-loading" style="color: var(--brand-danger)">Error: ${err.message || err}</div>`;
+            return;
+        }
+        if (this.currentData !== repo) return;
+
+        const { servicesUsing, severityBadges, formatSize } = await import('./ecr.js');
+        const usages = servicesUsing(repo);
+        const line = text => `<div class="alarm-condition">${text}</div>`;
+
+        const servicesCard = `
+            <div class="metric-card">
+                <div class="metric-header">
+                    <span class="metric-title">ECS Services</span>
+                    <span class="metric-value">${usages.length}</span>
+                </div>
+                ${usages.map(u => line(`${u.cluster}/${u.service} (${u.container}): ${u.tag || u.digest.slice(0, 19)} ` +
+                    (u.not_found ? '<span style="color: var(--brand-warning)">image not in repository</span>' : (severityBadges(u.severity_counts) || u.scan_status || 'not scanned')))).join('')}
+            </div>
+        `;
+        const imageCards = (images || []).slice(0, 20).map(img => `
+            <div class="metric-card ecr-image" data-digest="${img.digest}" style="cursor: pointer;">
+                <div class="metric-header">
+                    <span class="metric-title">${img.tags.length ? img.tags.join(', ') : '&lt;untagged&gt;'}</span>
+                    <span class="metric-value">${formatSize(img.size_bytes)}</span>
+                </div>
+                ${line(`${img.digest.slice(0, 19)} pushed ${img.pushed_at}${img.last_pulled_at ? `, pulled ${img.last_pulled_at}` : ''}`)}
+                ${line(severityBadges(img.severity_counts) || img.scan_status || 'Not scanned')}
+                <div class="ecr-findings"></div>
+            </div>
+        `).join('');
+
+        this.elements.healthContent.innerHTML = servicesCard + imageCards;
+
+        // Expand the findings of an image on click
+        this.elements.healthContent.querySelectorAll('.ecr-image').forEach(el => {
+            el.addEventListener('click', async () => {
+                const target = el.querySelector('.ecr-findings');
+                if (target.innerHTML) {
+                    target.innerHTML = '';
+                    return;
+                }
+                target.innerHTML = line('Fetching findings...');
+                try {
+                    const findings = await window.go.core.App.GetECRImageScanFindings(repo.Name, el.dataset.digest);
+                    target.innerHTML = (findings || []).slice(0, 50).map(f =>
+                        line(`${f.severity} ${f.uri ? `<a href="${f.uri}" target="_blank">${f.name}</a>` : f.name}${f.package ? ` in ${f.package}` : ''}${f.fixed_in ? `, fixed in ${f.fixed_in}` : ''}`)).join('') || line('No findings');
+                } catch (err) {
+                    target.innerHTML = line(`<span style="color: var(--brand-danger)">Error: ${err.message || err}</span>`);
+                }
+            });
+        });
+    },
+
+    async loadEKSDetail(cluster) {
+        this.elements.healthContent.innerHTML = '<div class="metrics-loading">Fetching cluster compute and network footprint...</div>';
+
+        let detail;
+        try {
+            detail = await window.go.core.App.GetEKSClusterDetail(cluster.Name);
+        } catch (err) {
+            console.error('Failed to load cluster detail:', err);
+            this.elements.healthContent.innerHTML = `<div class="metrics-loading" style="color: var(--brand-danger)">Error: ${err.message || err}</div>`;
+            return;
+        }
+        if (this.currentData !== cluster || !detail) return;
+
+        const line = text => `<div class="alarm-condition">${text}</div>`;
+        const card = (title, value, body) => `
+            <div class="metric-card">
+                <div class="metric-header">
+                    <span class="metric-title">${title}</span>
+                    <span class="metric-value">${value}</span>
+                </div>
+                ${body}
+            </div>
+        `;
+        const supportColor = {
+            'standard': 'var(--brand-success)',
+            'extended': 'var(--brand-warning)',
+            'unsupported': 'var(--brand-danger)',
+        };
+        const issues = list => (list || []).map(i => line(`<span style="color: var(--brand-danger)">${i}</span>`)).join('');
+
+        this.elements.healthContent.innerHTML = [
+            (detail.errors || []).map(e => `<div class="metrics-loading" style="color: var(--brand-warning)">${e}</div>`).join(''),
+            card('Kubernetes', `<span style="color: ${supportColor[cluster.SupportStatus] || 'inherit'}">${cluster.Version} ${cluster.SupportStatus || 'not in calendar'}</span>`, [
+                line(`Platform: ${cluster.PlatformVersion}`),
+                cluster.StandardSupportEnd ? line(`Standard support ends ${cluster.StandardSupportEnd}`) : '',
+                cluster.ExtendedSupportEnd ? line(`Extended support ends ${cluster.ExtendedSupportEnd}`) : '',
+                cluster.SupportType ? line(`Upgrade policy: ${cluster.SupportType}`) : '',
+            ].join('')),
+            card('Endpoint', cluster.EndpointPublicAccess ? 'public' : 'private', [
+                line(`Public: ${cluster.EndpointPublicAccess ? (cluster.PublicAccessCIDRs || []).join(', ') : 'disabled'}`),
+                line(`Private: ${cluster.EndpointPrivateAccess ? 'enabled' : 'disabled'}`),
+                line(`Logging: ${(cluster.EnabledLogTypes || []).join(', ') || 'none'}`),
+                line(`Secrets encryption: ${cluster.SecretsEncryption ? 'KMS' : 'none'}`),
+            ].join('')),
+            card('Node Groups', detail.node_groups.length, detail.node_groups.map(g =>
+                line(`${g.name}: ${g.desired_size} (${g.min_size}-${g.max_size}) ${g.capacity_type} ${(g.instance_types || []).join(', ')}, ${g.version} ${g.status}`) + issues(g.health_issues)).join('')),
+            card('Fargate Profiles', detail.fargate_profiles.length, detail.fargate_profiles.map(p =>
+                line(`${p.name}: ${p.selectors.join('; ')} (${p.status})`)).join('')),
+            card('Add-ons', detail.add_ons.length, detail.add_ons.map(a =>
+                line(`${a.name} ${a.version}: ${a.status}`) + issues(a.health_issues)).join('')),
+            card('Network Footprint', `${detail.eni_count} ENIs`, detail.footprint.map(f =>
+                line(`${f.subnet_id} (${f.availability_zone}): ${f.cluster_enis}/${f.total_enis} ENIs, <span style="color: ${f.available_ips < 16 ? 'var(--brand-danger)' : 'inherit'}">${f.available_ips} IPs free</span>, ${f.used_by.join(', ')}`)).join('')),
+        ].join('');
+    },
+
+    async loadRoute53Records(zone) {
+        this.elements.healthContent.innerHTML = '<div class="metrics-loading">Fetching records...</div>';
+
+        let records;
+        try {
+            records = await window.go.core.App.GetRoute53Records(zone.ID);
+        } catch (err) {
+            console.error('Failed to load records:', err);
+            this.elements.healthContent.innerHTML = `<div class="metrics-loading" style="color: var(--brand-danger)">Error: ${err.message || err}</div>`;
+            return;
+        }
+        if (this.currentData !== zone) return;
+
+        const line = text => `<div class="alarm-condition">${text}</div>`;
+        this.elements.healthContent.innerHTML = (records || []).map(r => `
+            <div class="metric-card">
+                <div class="metric-header">
+                    <span class="metric-title">${r.name}</span>
+                    <span class="metric-value">${r.type}</span>
+                </div>
+                ${r.alias_target ? line(`Alias to ${r.alias_target}`) : r.values.map(v => line(v)).join('')}
+                ${r.alias_target ? '' : line(`TTL ${r.ttl}s`)}
+                ${r.routing !== 'simple' ? line(`${r.routing} routing${r.set_identifier ? ` (${r.set_identifier})` : ''}`) : ''}
+                ${r.health_check_id ? line(`Health check ${r.health_check_id}`) : ''}
+            </div>
+        `).join('') || '<div class="metrics-loading">No records.</div>';
+    },
+
+    renderInstanceStatus(status, error) {
+        if (error) {
+            return `<div class="metrics-loading" style="color: var(--brand-danger)">Error: ${error}</div>`;
+        }
+        if (!status) return '';
+
+        const statusColor = {
+            'ok': 'var(--brand-success)',
+            'impaired': 'var(--brand-danger)',
+            'insufficient-data': 'var(--brand-warning)',
+            'initializing': 'var(--brand-warning)',
+        };
+        const summary = (label, value, checks) => `
+            <div class="metric-card">
+                <div class="metric-header">
+                    <span class="metric-title">${label}</span>
+                    <span class="metric-value" style="color: ${statusColor[value] || 'inherit'}">${value || 'n/a'}</span>
+                </div>
+                ${(checks || []).map(c => `
+                    <div class="alarm-condition">${c.name}: ${c.status}${c.impaired_since ? ` since ${c.impaired_since}` : ''}</div>
+                `).join('')}
+            </div>
+        `;
+
+        const events = (status.scheduled_events || []).map(e => `
+            <div class="metric-card">
+                <div class="metric-header">
+                    <span class="metric-title">${e.code}</span>
+                    <span class="metric-value" style="color: var(--brand-warning)">${e.not_before}</span>
+                </div>
+                <div class="alarm-reason">${e.description || ''}</div>
+                ${e.deadline ? `<div class="alarm-condition">Can be rescheduled until ${e.deadline}</div>` : ''}
+            </div>
+        `).join('');
+
+        return summary('System status', status.system_status, status.system_checks)
+            + summary('Instance status', status.instance_status, status.instance_checks)
+            + (status.ebs_status ? summary('Attached EBS status', status.ebs_status) : '')
+            + (events || '<div class="metrics-loading">No scheduled events.</div>');
+    },
+
+    renderInstanceDetail(instance) {
+        const row = (label, value, color) => `
+            <div class="alarm-condition"><strong>${label}:</strong>
+                <span${color ? ` style="color: ${color}"` : ''}>${value === undefined || value === '' ? '-' : value}</span>
+            </div>
+        `;
+
+        const imds = !instance.IMDSEnabled
+            ? row('IMDS', 'disabled')
+            : row('IMDS', instance.IMDSv2Required ? `IMDSv2 required (hop limit ${instance.IMDSHopLimit})` : 'IMDSv1 allowed',
+                instance.IMDSv2Required ? 'var(--brand-success)' : 'var(--brand-warning)');
+
+        const tags = Object.entries(instance.Tags || {})
+            .sort(([a], [b]) => a.localeCompare(b))
+            .map(([key, value]) => row(key, value))
+            .join('');
+
+        const volumes = (instance.BlockDevices || []).map(bd =>
+            row(bd.DeviceName, `${bd.VolumeID} (${bd.Status}${bd.DeleteOnTermination ? ', deleted on termination' : ''})`)
+        ).join('');
+
+        return `
+            <div class="metric-card">
+                <div class="metric-header"><span class="metric-title">Instance</span></div>
+                ${row('State', instance.State + (instance.StateReason ? ` (${instance.StateReason})` : ''))}
+                ${row('AMI', instance.ImageID)}
+                ${row('Lifecycle', instance.Lifecycle, instance.Lifecycle === 'spot' ? 'var(--brand-warning)' : '')}
+                ${row('Availability Zone', instance.AvailabilityZone)}
+                ${row('Tenancy', instance.Tenancy)}
+                ${instance.PlacementGroup ? row('Placement Group', instance.PlacementGroup) : ''}
+                ${row('Auto Scaling Group', instance.AutoScalingGroup)}
+                ${row('IAM Instance Profile', instance.IAMInstanceProfile)}
+                ${imds}
+                ${row('Detailed Monitoring', instance.Monitoring)}
+                ${row('Security Groups', (instance.SecurityGroupIDs || []).join(', '))}
+            </div>
+            <div class="metric-card">
+                <div class="metric-header"><span class="metric-title">Volumes</span></div>
+                ${volumes || row('Root device', instance.RootDeviceName)}
+            </div>
+            <div class="metric-card">
+                <div class="metric-header"><span class="metric-title">Tags</span></div>
+                ${tags || '<div class="alarm-condition">No tags</div>'}
+            </div>
+        `;
+    },
+
+    async loadAlarms() {
+        if (!this.currentData || !this.elements.alarmsContent) return;
+
+        const target = this.resolveMetricTarget(this.currentData);
+        if (!target) {
+            this.elements.alarmsContent.innerHTML = '<div class="metrics-loading">No CloudWatch alarms available for this resource type.</div>';
+            return;
+        }
+
+        this.elements.alarmsContent.innerHTML = '<div class="metrics-loading">Fetching CloudWatch alarms...</div>';
+
+        try {
+            const alarms = await window.go.core.App.GetResourceAlarms(target.type, target.id);
+            this.renderAlarms(alarms);
+        } catch (err) {
+            console.error('Failed to load alarms:', err);
+            this.elements.alarmsContent.innerHTML = `<div class="metrics-loading" style="color: var(--status-error)">Error: ${err.message || err}</div>`;
+        }
+    },
+
+    renderAlarms(alarms) {
+        if (!alarms || alarms.length === 0) {
+            this.elements.alarmsContent.innerHTML = '<div class="metrics-loading">No alarms watch this resource.</div>';
+            return;
+        }
+
+        const stateColor = {
+            'ALARM': 'var(--brand-danger)',
+            'INSUFFICIENT_DATA': 'var(--brand-warning)',
+            'OK': 'var(--brand-success)',
+        };
+
+        this.elements.alarmsContent.innerHTML = alarms.map(alarm => `
+            <div class="metric-card alarm-card" data-alarm="${alarm.name}">
+                <div class="metric-header">
+                    <span class="metric-title">${alarm.name}</span>
+                    <span class="metric-value" style="color: ${stateColor[alarm.state] || 'inherit'}">${alarm.state}</span>
+                </div>
+                <div class="alarm-condition">
+                    ${alarm.metric_name ? `${alarm.metric_name} (${alarm.statistic}) ${alarm.comparison_operator} ${alarm.threshold} for ${alarm.evaluation_periods} x ${alarm.period}s` : 'Metric math alarm'}
+                </div>
+                <div class="alarm-reason">${alarm.state_reason || ''}</div>
+                <div class="alarm-history"></div>
+            </div>
+        `).join('');
+
+        // Load the history of an alarm on click
+        this.elements.alarmsContent.querySelectorAll('.alarm-card').forEach(card => {
+            card.addEventListener('click', () => this.loadAlarmHistory(card));
+        });
+    },
+
+    async loadAlarmHistory(card) {
+        const container = card.querySelector('.alarm-history');
+        if (container.dataset.loaded) return;
+        container.dataset.loaded = 'true';
+        container.textContent = 'Loading history...';
+
+        try {
+            const history = await window.go.core.App.GetAlarmHistory(card.dataset.alarm);
+            container.innerHTML = (history || []).length === 0
+                ? 'No recent history.'
+                : history.slice(0, 10).map(item => `<div class="alarm-history-item">${item.timestamp} - ${item.summary}</div>`).join('');
+        } catch (err) {
+            container.textContent = `Error: ${err.message || err}`;
+        }
+    },
+
+    // Collects the CloudWatch log groups of a Lambda function or the awslogs
+    // configuration of ECS task definitions
+    async resolveLogGroups(data) {
+        if (data.LogGroup) return [data.LogGroup];
+
+        let containers = data.Containers || [];
+        let taskDefinitions = [];
+        if (data.TaskDefinition) {
+            taskDefinitions = [data.TaskDefinition];
+        } else if (data.Services) {
+            taskDefinitions = [...new Set(data.Services.map(s => s.TaskDefinition).filter(Boolean))].slice(0, 5);
+        }
+
+        for (const td of taskDefinitions) {
+            try {
+                const def = await window.go.core.App.GetECSTaskDefinition(td);
+                containers = containers.concat((def && def.Containers) || []);
+            } catch (err) {
+                console.error('Failed to load task definition:', err);
+            }
+        }
+
+        return [...new Set(containers.map(c => c.LogGroup).filter(Boolean))];
+    },
+
+    async loadLogs() {
+        if (!this.currentData || !this.elements.logsContent) return;
+
+        this.elements.logsContent.innerHTML = '<div class="metrics-loading">Resolving log groups...</div>';
+        const data = this.currentData;
+        const groups = await this.resolveLogGroups(data);
+        if (data !== this.currentData) return;
+
+        if (groups.length === 0) {
+            this.elements.logsContent.innerHTML = '<div class="metrics-loading">No CloudWatch Logs configured for this resource.</div>';
+            return;
+        }
+
+        this.elements.logsContent.innerHTML = `
+            <div class="logs-toolbar">
+                <select id="logGroupSelect" class="custom-select-sm">
+                    ${groups.map(g => `<option value="${g}">${g}</option>`).join('')}
+                </select>
+                <input id="logFilterPattern" class="custom-select-sm" type="text" placeholder="Filter pattern, e.g. ERROR">
+                <button id="logTailBtn" class="btn-secondary">Start Tail</button>
+            </div>
+            <div class="logs-toolbar">
+                <input id="logInsightsQuery" class="custom-select-sm" type="text"
+                    value="fields @timestamp, @message | sort @timestamp desc | limit 50">
+                <button id="logInsightsBtn" class="btn-secondary">Run Query</button>
+            </div>
+            <div id="logOutput" class="log-output"></div>
+        `;
+
+        document.getElementById('logTailBtn').addEventListener('click', () => this.toggleLogTail());
+        document.getElementById('logInsightsBtn').addEventListener('click', () => this.runLogsInsights());
+    },
+
+    async toggleLogTail() {
+        const button = document.getElementById('logTailBtn');
+        if (this.currentTailId) {
+            this.stopLogTail();
+            button.textContent = 'Start Tail';
+            return;
+        }
+
+        const output = document.getElementById('logOutput');
+        output.innerHTML = '';
+
+        try {
+            const tailId = await window.go.core.App.StartLogTail({
+                log_group: document.getElementById('logGroupSelect').value,
+                log_stream: '',
+                filter_pattern: document.getElementById('logFilterPattern').value,
+            });
+            this.currentTailId = tailId;
+            button.textContent = 'Stop Tail';
+
+            this.stopTailListener = window.runtime.EventsOn('logs:tail', (batch) => {
+                if (batch.tail_id !== this.currentTailId) return;
+                if (batch.error) {
+                    output.insertAdjacentHTML('beforeend', `<div class="log-line text-danger">${batch.error}</div>`);
+                    return;
+                }
+                (batch.events || []).forEach(e => {
+                    const line = document.createElement('div');
+                    line.className = 'log-line';
+                    line.textContent = `${e.time}  ${e.message}`;
+                    output.appendChild(line);
+                });
+                output.scrollTop = output.scrollHeight;
+            });
+        } catch (err) {
+            output.innerHTML = `<div class="log-line text-danger">Error: ${err.message || err}</div>`;
+        }
+    },
+
+    stopLogTail() {
+        if (this.currentTailId) {
+            window.go.core.App.StopLogTail(this.currentTailId);
+            this.currentTailId = null;
+        }
+        if (this.stopTailListener) {
+            this.stopTailListener();
+            this.stopTailListener = null;
+        }
+    },
+
+    async runLogsInsights() {
+        const output = document.getElementById('logOutput');
+        output.innerHTML = '<div class="log-line">Running query...</div>';
+
+        try {
+            const result = await window.go.core.App.RunLogsInsightsQuery({
+                log_groups: [document.getElementById('logGroupSelect').value],
+                query: document.getElementById('logInsightsQuery').value,
+                start: '',
+                end: '',
+                limit: 0,
+            });
+            const rows = (result && result.rows) || [];
+            output.innerHTML = rows.length === 0 ? '<div class="log-line">No results.</div>' : '';
+            rows.forEach(row => {
+                const line = document.createElement('div');
+                line.className = 'log-line';
+                line.textContent = Object.entries(row)
+                    .filter(([field]) => field !== '@ptr')
+                    .map(([field, value]) => `${field}=${value}`)
+                    .join('  ');
+                output.appendChild(line);
+            });
+        } catch (err) {
+            output.innerHTML = `<div class="log-line text-danger">Error: ${err.message || err}</div>`;
+        }
+    },
+
     generateMetricBars(values) {
         if (!values || values.length === 0) return '';
 
@@ -182,6 +992,8 @@ export const detailSidebar = {
     },
 
     close() {
+        this.stopLogTail();
+        this.stopDriftWatch();
         if (this.elements.sidebar) {
             this.elements.sidebar.classList.remove('open');
         }
@@ -0,0 +1,157 @@
+import * as state from './state.js';
+import { detailSidebar } from './detailSidebar.js';
+
+function formatBytes(bytes) {
+    if (!bytes) return '0 B';
+    const units = ['B', 'KB', 'MB', 'GB', 'TB'];
+    const i = Math.min(Math.floor(Math.log(bytes) / Math.log(1024)), units.length - 1);
+    return `${(bytes / Math.pow(1024, i)).toFixed(i === 0 ? 0 : 1)} ${units[i]}`;
+}
+
+function capacityLabel(table) {
+    if (table.BillingMode === 'PAY_PER_REQUEST') return 'On-demand';
+    return `Provisioned · ${table.ReadCapacity} RCU / ${table.WriteCapacity} WCU`;
+}
+
+export function createDynamoDBCard(table) {
+    const isActive = table.Status === 'ACTIVE';
+    const statusClass = isActive ? 'status-available' : 'status-pending';
+    const keys = table.SortKey ? `${table.PartitionKey} / ${table.SortKey}` : table.PartitionKey;
+
+    return `
+        <div class="vpc-card" data-id="${table.TableName}" style="cursor: pointer;">
+            <div class="vpc-card-header">
+                <div class="vpc-card-title-row">
+                    <div class="vpc-card-title">🗄️ ${table.TableName}</div>
+                    <span class="badge ${statusClass}">${table.Status}</span>
+                </div>
+                ${!table.PITREnabled ? `<div style="margin-top: 4px;"><span class="badge" style="color: var(--brand-warning);">PITR disabled</span></div>` : ''}
+            </div>
+
+            <div class="vpc-card-divider"></div>
+
+            <div class="vpc-card-body">
+                <div class="vpc-card-row">
+                    <span class="label">Capacity:</span>
+                    <span class="value font-mono">${capacityLabel(table)}</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">Items:</span>
+                    <span class="value font-mono">${table.ItemCount.toLocaleString()} · ${formatBytes(table.SizeBytes)}</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">Keys:</span>
+                    <span class="value font-mono text-xs">${keys || '-'}</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">Indexes:</span>
+                    <span class="value font-mono">${(table.GlobalIndexes || []).length} GSI · ${table.LocalIndexCount} LSI</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">TTL:</span>
+                    <span class="value font-mono text-xs">${table.TTLAttribute ? `${table.TTLAttribute} (${table.TTLStatus})` : (table.TTLStatus || '-')}</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">Stream:</span>
+                    <span class="value font-mono text-xs">${table.StreamEnabled ? table.StreamViewType : 'Disabled'}</span>
+                </div>
+            </div>
+        </div>
+    `;
+}
+
+export function createDynamoDBTableRow(table) {
+    const isActive = table.Status === 'ACTIVE';
+    const statusClass = isActive ? 'available' : 'pending';
+
+    return `
+        <tr>
+            <td><strong>${table.TableName}</strong></td>
+            <td>${table.BillingMode === 'PAY_PER_REQUEST' ? 'On-demand' : 'Provisioned'}</td>
+            <td class="font-mono">${table.ItemCount.toLocaleString()}</td>
+            <td class="font-mono">${formatBytes(table.SizeBytes)}</td>
+            <td>${table.PITREnabled ? 'Enabled' : 'Disabled'}</td>
+            <td class="vpc-status">
+                <span class="status-dot ${statusClass}"></span>
+                ${table.Status}
+            </td>
+        </tr>
+    `;
+}
+
+export async function fetchDynamoDBTables() {
+    try {
+        state.setCurrentPage('dynamodb-list');
+        state.loadingBar.classList.remove('hidden');
+        state.statusText.textContent = 'Fetching DynamoDB Tables...';
+        state.vpcGrid.innerHTML = '';
+        state.dynamodbTableBody.innerHTML = '';
+
+        const tables = await window.go.core.App.GetDynamoDBTables();
+        state.loadingBar.classList.add('hidden');
+
+        state.setAllDynamoDBTables(tables || []);
+        state.setFilteredDynamoDBTables([...state.allDynamoDBTables]);
+
+        renderDynamoDBTables();
+    } catch (error) {
+        state.loadingBar.classList.add('hidden');
+        state.statusText.textContent = 'Error fetching DynamoDB tables';
+        console.error(error);
+    }
+}
+
+export function renderDynamoDBTables() {
+    if (state.filteredDynamoDBTables.length === 0) {
+        state.statusText.textContent = 'No DynamoDB tables found';
+        const emptyCard = `
+            <div class="vpc-card" data-empty="true" style="cursor: pointer;">
+                <div class="vpc-card-title">No DynamoDB Tables</div>
+                <div class="vpc-card-info">No DynamoDB tables found in your AWS account</div>
+            </div>
+        `;
+        state.vpcGrid.innerHTML = emptyCard;
+        state.dynamodbTableBody.innerHTML = `
+            <tr>
+                <td colspan="6" style="text-align: center; padding: 32px; color: var(--text-secondary);">
+                    No DynamoDB tables found
+                </td>
+            </tr>
+        `;
+        return;
+    }
+
+    state.statusText.textContent = `${state.filteredDynamoDBTables.length} DynamoDB table(s) found`;
+
+    if (state.currentView === 'cards') {
+        state.vpcGrid.innerHTML = state.filteredDynamoDBTables.map(t => createDynamoDBCard(t)).join('');
+    } else {
+        state.dynamodbTableBody.innerHTML = state.filteredDynamoDBTables.map(t => createDynamoDBTableRow(t)).join('');
+    }
+}
+
+export function initDynamoDBListeners() {
+    if (!state.vpcGrid) return;
+
+    state.vpcGrid.addEventListener('click', (e) => {
+        if (state.currentPage !== 'dynamodb-list') return;
+
+        const card = e.target.closest('.vpc-card');
+        if (card) {
+            if (card.dataset.empty === 'true') {
+                detailSidebar.open({ message: "Not found yet" });
+                return;
+            }
+            const id = card.dataset.id;
+            const table = state.allDynamoDBTables.find(t => t.TableName === id);
+            if (table) {
+                detailSidebar.open(table);
+            }
+        }
+    });
+}
@@ -45,6 +45,13 @@ export function createEC2Card(instance) {
                     <span class="value font-mono">${instance.PrivateIPAddress || '-'}</span>
                 </div>
 
+                ${instance.AutoScalingGroup ? `
+                <div class="vpc-card-row">
+                    <span class="label">ASG:</span> 
+                    <span class="value font-mono text-xs">${instance.AutoScalingGroup}</span>
+                </div>
+                ` : ''}
+
                 <div class="vpc-card-row">
                     <span class="label">Key Pair:</span> 
                     <span class="value font-mono text-xs">${instance.KeyName || '-'}</span>
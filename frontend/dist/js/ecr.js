@@ -0,0 +1,174 @@
+import * as state from './state.js';
+import { detailSidebar } from './detailSidebar.js';
+
+const SEVERITIES = ['CRITICAL', 'HIGH', 'MEDIUM', 'LOW'];
+
+export function formatSize(bytes) {
+    if (!bytes) return '0 B';
+    const units = ['B', 'KB', 'MB', 'GB', 'TB'];
+    const i = Math.min(Math.floor(Math.log(bytes) / Math.log(1024)), units.length - 1);
+    return `${(bytes / Math.pow(1024, i)).toFixed(i === 0 ? 0 : 1)} ${units[i]}`;
+}
+
+export function severityBadges(counts) {
+    const color = { CRITICAL: 'var(--brand-danger)', HIGH: 'var(--brand-warning)' };
+    return SEVERITIES
+        .filter(s => (counts || {})[s] > 0)
+        .map(s => `<span class="badge" style="color: ${color[s] || 'inherit'};">${counts[s]} ${s.toLowerCase()}</span>`)
+        .join(' ');
+}
+
+// servicesUsing returns the ECS services running an image of the repository
+export function servicesUsing(repo) {
+    return state.ecrImageUsage.filter(u => u.repository === repo.Name && u.registry_id === repo.RegistryID);
+}
+
+export function createECRCard(repo) {
+    const usages = servicesUsing(repo);
+    const vulnerable = usages.filter(u => (u.severity_counts || {}).CRITICAL > 0);
+
+    return `
+        <div class="vpc-card" data-id="${repo.Name}" style="cursor: pointer;">
+            <div class="vpc-card-header">
+                <div class="vpc-card-title-row">
+                    <div class="vpc-card-title">📦 ${repo.Name}</div>
+                    <span class="badge">${repo.TagImmutability ? 'Immutable' : 'Mutable'}</span>
+                </div>
+                ${vulnerable.length ? `<div style="margin-top: 4px;"><span class="badge" style="color: var(--brand-danger);" title="${vulnerable.map(u => `${u.cluster}/${u.service}`).join(', ')}">${vulnerable.length} service(s) run critical CVEs</span></div>` : ''}
+            </div>
+
+            <div class="vpc-card-divider"></div>
+
+            <div class="vpc-card-body">
+                <div class="vpc-card-row">
+                    <span class="label">Images:</span>
+                    <span class="value font-mono">${repo.ImageCount} · ${formatSize(repo.TotalSizeBytes)}</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">Last push:</span>
+                    <span class="value font-mono">${repo.LastPushedAt || '-'}</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">Scanning:</span>
+                    <span class="value font-mono">${repo.ScanOnPush ? 'On push' : 'Manual'}</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">Lifecycle:</span>
+                    <span class="value font-mono" style="color: ${repo.LifecyclePolicy ? 'inherit' : 'var(--brand-warning)'};">${repo.LifecyclePolicy ? 'Policy set' : 'No policy'}</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">Findings:</span>
+                    <span class="value">${severityBadges(repo.SeverityCounts) || 'None'}</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">Used by:</span>
+                    <span class="value font-mono text-xs">${usages.length ? [...new Set(usages.map(u => u.service))].join(', ') : '-'}</span>
+                </div>
+            </div>
+        </div>
+    `;
+}
+
+export function createECRTableRow(repo) {
+    const counts = repo.SeverityCounts || {};
+    return `
+        <tr>
+            <td><strong>${repo.Name}</strong></td>
+            <td class="font-mono">${repo.ImageCount}</td>
+            <td class="font-mono">${repo.LastPushedAt || '-'}</td>
+            <td>${repo.ScanOnPush ? 'On push' : 'Manual'}</td>
+            <td>${repo.LifecyclePolicy ? 'Yes' : 'No'}</td>
+            <td class="font-mono" style="color: ${counts.CRITICAL ? 'var(--brand-danger)' : 'inherit'};">${counts.CRITICAL || 0} / ${counts.HIGH || 0}</td>
+            <td class="font-mono">${servicesUsing(repo).length}</td>
+        </tr>
+    `;
+}
+
+export async function fetchECRRepositories() {
+    try {
+        state.setCurrentPage('ecr-list');
+        state.loadingBar.classList.remove('hidden');
+        state.statusText.textContent = 'Fetching ECR Repositories...';
+        state.vpcGrid.innerHTML = '';
+        state.ecrTableBody.innerHTML = '';
+
+        // The cross-reference with ECS is optional, the repositories are shown without it
+        const [repos, usage] = await Promise.all([
+            window.go.core.App.GetECRRepositories(),
+            window.go.core.App.GetECRImageUsage().catch(err => {
+                console.error('Failed to cross-reference ECS images:', err);
+                return null;
+            }),
+        ]);
+        state.loadingBar.classList.add('hidden');
+
+        state.setECRImageUsage((usage && usage.usages) || []);
+        state.setAllECRRepositories(repos || []);
+        state.setFilteredECRRepositories([...state.allECRRepositories]);
+
+        renderECRRepositories();
+    } catch (error) {
+        state.loadingBar.classList.add('hidden');
+        state.statusText.textContent = 'Error fetching ECR repositories';
+        console.error(error);
+    }
+}
+
+export function renderECRRepositories() {
+    if (state.filteredECRRepositories.length === 0) {
+        state.statusText.textContent = 'No ECR repositories found';
+        const emptyCard = `
+            <div class="vpc-card" data-empty="true" style="cursor: pointer;">
+                <div class="vpc-card-title">No ECR Repositories</div>
+                <div class="vpc-card-info">No ECR repositories found in your AWS account</div>
+            </div>
+        `;
+        state.vpcGrid.innerHTML = emptyCard;
+        state.ecrTableBody.innerHTML = `
+            <tr>
+                <td colspan="7" style="text-align: center; padding: 32px; color: var(--text-secondary);">
+                    No ECR repositories found
+                </td>
+            </tr>
+        `;
+        return;
+    }
+
+    const critical = new Set(state.ecrImageUsage
+        .filter(u => (u.severity_counts || {}).CRITICAL > 0)
+        .map(u => `${u.cluster}/${u.service}`));
+    state.statusText.textContent = `${state.filteredECRRepositories.length} ECR repositor${state.filteredECRRepositories.length === 1 ? 'y' : 'ies'} found` +
+        (critical.size ? `, ${critical.size} ECS service(s) running images with critical CVEs` : '');
+
+    if (state.currentView === 'cards') {
+        state.vpcGrid.innerHTML = state.filteredECRRepositories.map(r => createECRCard(r)).join('');
+    } else {
+        state.ecrTableBody.innerHTML = state.filteredECRRepositories.map(r => createECRTableRow(r)).join('');
+    }
+}
+
+export function initECRListeners() {
+    if (!state.vpcGrid) return;
+
+    state.vpcGrid.addEventListener('click', (e) => {
+        if (state.currentPage !== 'ecr-list') return;
+
+        const card = e.target.closest('.vpc-card');
+        if (card) {
+            if (card.dataset.empty === 'true') {
+                detailSidebar.open({ message: "Not found yet" });
+                return;
+            }
+            const id = card.dataset.id;
+            const repo = state.allECRRepositories.find(r => r.Name === id);
+            if (repo) {
+                detailSidebar.open(repo);
+            }
+        }
+    });
+}
@@ -52,12 +52,26 @@ export function initECSListeners() {
             const id = card.dataset.id;
             const cluster = state.allVPCs.find(v => v.ClusterArn === id);
             if (cluster) {
-                detailSidebar.open(cluster);
+                openClusterDetails(cluster);
             }
         }
     });
 }
 
+async function openClusterDetails(cluster) {
+    detailSidebar.open(cluster);
+
+    try {
+        const services = await window.go.core.App.GetECSServices(cluster.ClusterArn);
+        // Only refresh if the user is still looking at this cluster
+        if (detailSidebar.currentData === cluster) {
+            detailSidebar.open({ ...cluster, Services: services || [] });
+        }
+    } catch (error) {
+        console.error('Error fetching ECS services:', error);
+    }
+}
+
 export function createECSTableRow(cluster) {
     const name = cluster.ClusterName || '-';
     const statusClass = cluster.Status === 'ACTIVE' ? 'available' : 'pending';
@@ -0,0 +1,161 @@
+import { truncateID } from './utils.js';
+import * as state from './state.js';
+import { detailSidebar } from './detailSidebar.js';
+
+// supportBadge warns when the Kubernetes version has left standard support, or does within 90 days
+function supportBadge(cluster) {
+    if (cluster.SupportStatus === 'unsupported') {
+        return `<div style="margin-top: 4px;"><span class="badge" style="color: var(--brand-danger);" title="Extended support ended ${cluster.ExtendedSupportEnd || cluster.StandardSupportEnd}">End of support</span></div>`;
+    }
+    if (cluster.SupportStatus === 'extended') {
+        return `<div style="margin-top: 4px;"><span class="badge" style="color: var(--brand-warning);" title="Standard support ended ${cluster.StandardSupportEnd}">Extended support until ${cluster.ExtendedSupportEnd}</span></div>`;
+    }
+    if (!cluster.StandardSupportEnd) return '';
+    const daysLeft = (new Date(cluster.StandardSupportEnd) - new Date()) / 86400000;
+    if (daysLeft > 90) return '';
+    return `<div style="margin-top: 4px;"><span class="badge" style="color: var(--brand-warning);">Standard support ends ${cluster.StandardSupportEnd}</span></div>`;
+}
+
+function endpointLabel(cluster) {
+    if (cluster.EndpointPublicAccess && cluster.EndpointPrivateAccess) return 'Public & private';
+    return cluster.EndpointPublicAccess ? 'Public' : 'Private';
+}
+
+export function createEKSCard(cluster) {
+    const isActive = cluster.Status === 'ACTIVE';
+    const statusClass = isActive ? 'status-available' : 'status-pending';
+
+    return `
+        <div class="vpc-card" data-id="${cluster.Name}" style="cursor: pointer;">
+            <div class="vpc-card-header">
+                <div class="vpc-card-title-row">
+                    <div class="vpc-card-title">☸️ ${cluster.Name}</div>
+                    <span class="badge ${statusClass}">${cluster.Status}</span>
+                </div>
+                ${supportBadge(cluster)}
+            </div>
+
+            <div class="vpc-card-divider"></div>
+
+            <div class="vpc-card-body">
+                <div class="vpc-card-row">
+                    <span class="label">Version:</span>
+                    <span class="value font-mono">${cluster.Version} · ${cluster.PlatformVersion}</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">Endpoint:</span>
+                    <span class="value font-mono">${endpointLabel(cluster)}</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">VPC:</span>
+                    <span class="value font-mono text-xs">${truncateID(cluster.VPCID)} · ${(cluster.SubnetIDs || []).length} subnets</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">Logging:</span>
+                    <span class="value font-mono text-xs">${(cluster.EnabledLogTypes || []).join(', ') || 'Disabled'}</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">Created:</span>
+                    <span class="value font-mono">${(cluster.CreatedAt || '').split(' ')[0] || '-'}</span>
+                </div>
+            </div>
+        </div>
+    `;
+}
+
+export function createEKSTableRow(cluster) {
+    const isActive = cluster.Status === 'ACTIVE';
+    const statusClass = isActive ? 'available' : 'pending';
+
+    return `
+        <tr>
+            <td><strong>${cluster.Name}</strong></td>
+            <td class="font-mono">${cluster.Version}</td>
+            <td>${cluster.SupportStatus || '-'}</td>
+            <td>${endpointLabel(cluster)}</td>
+            <td class="font-mono">${cluster.VPCID}</td>
+            <td class="vpc-status">
+                <span class="status-dot ${statusClass}"></span>
+                ${cluster.Status}
+            </td>
+        </tr>
+    `;
+}
+
+export async function fetchEKSClusters() {
+    try {
+        state.setCurrentPage('eks-list');
+        state.loadingBar.classList.remove('hidden');
+        state.statusText.textContent = 'Fetching EKS Clusters...';
+        state.vpcGrid.innerHTML = '';
+        state.eksTableBody.innerHTML = '';
+
+        const clusters = await window.go.core.App.GetEKSClusters();
+        state.loadingBar.classList.add('hidden');
+
+        state.setAllEKSClusters(clusters || []);
+        state.setFilteredEKSClusters([...state.allEKSClusters]);
+
+        renderEKSClusters();
+    } catch (error) {
+        state.loadingBar.classList.add('hidden');
+        state.statusText.textContent = 'Error fetching EKS clusters';
+        console.error(error);
+    }
+}
+
+export function renderEKSClusters() {
+    if (state.filteredEKSClusters.length === 0) {
+        state.statusText.textContent = 'No EKS clusters found';
+        const emptyCard = `
+            <div class="vpc-card" data-empty="true" style="cursor: pointer;">
+                <div class="vpc-card-title">No EKS Clusters</div>
+                <div class="vpc-card-info">No EKS clusters found in your AWS account</div>
+            </div>
+        `;
+        state.vpcGrid.innerHTML = emptyCard;
+        state.eksTableBody.innerHTML = `
+            <tr>
+                <td colspan="6" style="text-align: center; padding: 32px; color: var(--text-secondary);">
+                    No EKS clusters found
+                </td>
+            </tr>
+        `;
+        return;
+    }
+
+    const outOfSupport = state.filteredEKSClusters.filter(c => c.SupportStatus === 'extended' || c.SupportStatus === 'unsupported').length;
+    state.statusText.textContent = `${state.filteredEKSClusters.length} EKS cluster(s) found` +
+        (outOfSupport ? `, ${outOfSupport} past standard support` : '');
+
+    if (state.currentView === 'cards') {
+        state.vpcGrid.innerHTML = state.filteredEKSClusters.map(c => createEKSCard(c)).join('');
+    } else {
+        state.eksTableBody.innerHTML = state.filteredEKSClusters.map(c => createEKSTableRow(c)).join('');
+    }
+}
+
+export function initEKSListeners() {
+    if (!state.vpcGrid) return;
+
+    state.vpcGrid.addEventListener('click', (e) => {
+        if (state.currentPage !== 'eks-list') return;
+
+        const card = e.target.closest('.vpc-card');
+        if (card) {
+            if (card.dataset.empty === 'true') {
+                detailSidebar.open({ message: "Not found yet" });
+                return;
+            }
+            const id = card.dataset.id;
+            const cluster = state.allEKSClusters.find(c => c.Name === id);
+            if (cluster) {
+                detailSidebar.open(cluster);
+            }
+        }
+    });
+}
@@ -0,0 +1,144 @@
+import * as state from './state.js';
+import { detailSidebar } from './detailSidebar.js';
+
+function encryptionText(cluster) {
+    const parts = [];
+    if (cluster.TransitEncryption) parts.push('in transit');
+    if (cluster.AtRestEncryption) parts.push('at rest');
+    return parts.length ? parts.join(', ') : '<span style="color: var(--brand-warning);">None</span>';
+}
+
+function topologyText(cluster) {
+    if (!cluster.ReplicationGroup) return `${cluster.NodeCount} node(s)`;
+    const shards = cluster.ClusterMode === 'enabled' ? `${cluster.ShardCount} shard(s), ` : '';
+    return `${shards}${cluster.NodeCount} node(s)${cluster.MultiAZ ? ', Multi-AZ' : ''}`;
+}
+
+export function createElastiCacheCard(cluster) {
+    return `
+        <div class="vpc-card" data-id="${cluster.ID}" style="cursor: pointer;">
+            <div class="vpc-card-header">
+                <div class="vpc-card-title-row">
+                    <div class="vpc-card-title">⚡ ${cluster.ID}</div>
+                    <span class="badge">${cluster.Status}</span>
+                </div>
+                <div class="vpc-card-subtitle">${cluster.Engine} ${cluster.EngineVersion || ''}</div>
+            </div>
+
+            <div class="vpc-card-divider"></div>
+
+            <div class="vpc-card-body">
+                <div class="vpc-card-row">
+                    <span class="label">Nodes:</span>
+                    <span class="value">${cluster.NodeType} · ${topologyText(cluster)}</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">Encryption:</span>
+                    <span class="value">${encryptionText(cluster)}${cluster.AuthTokenEnabled ? ' · AUTH' : ''}</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">Endpoint:</span>
+                    <span class="value font-mono text-xs">${cluster.Endpoint ? `${cluster.Endpoint}:${cluster.Port}` : '-'}</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">VPC:</span>
+                    <span class="value font-mono">${cluster.VPCID || '-'}${cluster.SubnetGroup ? ` (${cluster.SubnetGroup})` : ''}</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">Security groups:</span>
+                    <span class="value font-mono text-xs">${(cluster.SecurityGroupIDs || []).join(', ') || '-'}</span>
+                </div>
+            </div>
+        </div>
+    `;
+}
+
+export function createElastiCacheTableRow(cluster) {
+    return `
+        <tr>
+            <td><strong>${cluster.ID}</strong></td>
+            <td>${cluster.Engine} ${cluster.EngineVersion || ''}</td>
+            <td>${cluster.Status}</td>
+            <td class="font-mono">${cluster.NodeType}</td>
+            <td class="font-mono">${cluster.NodeCount}</td>
+            <td>${encryptionText(cluster)}</td>
+            <td class="font-mono">${cluster.VPCID || '-'}</td>
+        </tr>
+    `;
+}
+
+export async function fetchElastiCacheClusters() {
+    try {
+        state.setCurrentPage('elasticache-list');
+        state.loadingBar.classList.remove('hidden');
+        state.statusText.textContent = 'Fetching ElastiCache Clusters...';
+        state.vpcGrid.innerHTML = '';
+        state.elasticacheTableBody.innerHTML = '';
+
+        const clusters = await window.go.core.App.GetElastiCacheClusters();
+        state.loadingBar.classList.add('hidden');
+
+        state.setAllElastiCacheClusters(clusters || []);
+        state.setFilteredElastiCacheClusters([...state.allElastiCacheClusters]);
+
+        renderElastiCacheClusters();
+    } catch (error) {
+        state.loadingBar.classList.add('hidden');
+        state.statusText.textContent = 'Error fetching ElastiCache clusters';
+        console.error(error);
+    }
+}
+
+export function renderElastiCacheClusters() {
+    if (state.filteredElastiCacheClusters.length === 0) {
+        state.statusText.textContent = 'No ElastiCache clusters found';
+        const emptyCard = `
+            <div class="vpc-card" data-empty="true" style="cursor: pointer;">
+                <div class="vpc-card-title">No ElastiCache Clusters</div>
+                <div class="vpc-card-info">No ElastiCache clusters found in this region</div>
+            </div>
+        `;
+        state.vpcGrid.innerHTML = emptyCard;
+        state.elasticacheTableBody.innerHTML = `
+            <tr>
+                <td colspan="7" style="text-align: center; padding: 32px; color: var(--text-secondary);">
+                    No ElastiCache clusters found
+                </td>
+            </tr>
+        `;
+        return;
+    }
+
+    state.statusText.textContent = `${state.filteredElastiCacheClusters.length} ElastiCache cluster(s) found`;
+
+    if (state.currentView === 'cards') {
+        state.vpcGrid.innerHTML = state.filteredElastiCacheClusters.map(c => createElastiCacheCard(c)).join('');
+    } else {
+        state.elasticacheTableBody.innerHTML = state.filteredElastiCacheClusters.map(c => createElastiCacheTableRow(c)).join('');
+    }
+}
+
+export function initElastiCacheListeners() {
+    if (!state.vpcGrid) return;
+
+    state.vpcGrid.addEventListener('click', (e) => {
+        if (state.currentPage !== 'elasticache-list') return;
+
+        const card = e.target.closest('.vpc-card');
+        if (card) {
+            if (card.dataset.empty === 'true') {
+                detailSidebar.open({ message: "Not found yet" });
+                return;
+            }
+            const id = card.dataset.id;
+            const cluster = state.allElastiCacheClusters.find(c => c.ID === id);
+            if (cluster) {
+                detailSidebar.open(cluster);
+            }
+        }
+    });
+}
@@ -88,21 +88,28 @@ function renderHome(info) {
                         <span class="lag-notice" title="AWS Cost Explorer data usually has a 24h delay">24h Lag</span>
                     </div>
                     <div class="card-content">
-                        <div class="cost-item">
-                            <span class="label">Yesterday</span>
-                            <span class="value highlighted">$${info.cost_yesterday.toFixed(2)}</span>
-                        </div>
-                        <div class="cost-divider"></div>
-                        <div class="cost-row">
-                            <div class="cost-sub-item">
-                                <span class="label">MTD</span>
-                                <span class="value">$${info.cost_month_to_date.toFixed(2)}</span>
+                        ${!info.costs_enabled ? `
+                            <div class="service-action-required">
+                                <span class="label">Cost Explorer</span>
+                                <span class="value text-warning">Access Required</span>
                             </div>
-                            <div class="cost-sub-item">
-                                <span class="label">Last Month</span>
-                                <span class="value">$${info.cost_last_month.toFixed(2)}</span>
+                        ` : `
+                            <div class="cost-item">
+                                <span class="label">Yesterday</span>
+                                <span class="value highlighted">$${info.cost_yesterday.toFixed(2)}</span>
                             </div>
-                        </div>
+                            <div class="cost-divider"></div>
+                            <div class="cost-row">
+                                <div class="cost-sub-item">
+                                    <span class="label">MTD</span>
+                                    <span class="value">$${info.cost_month_to_date.toFixed(2)}</span>
+                                </div>
+                                <div class="cost-sub-item">
+                                    <span class="label">Last Month</span>
+                                    <span class="value">$${info.cost_last_month.toFixed(2)}</span>
+                                </div>
+                            </div>
+                        `}
                         ${info.potential_savings > 0 ? `
                         <div class="cost-divider"></div>
                         <div class="cost-savings-summary">
@@ -119,6 +126,17 @@ function renderHome(info) {
                     </div>
                 </div>
 
+                <!-- Alarms Card -->
+                <div class="home-card alarms-card">
+                    <div class="card-header">
+                        <span class="card-icon"></span>
+                        <h3>Alarms</h3>
+                    </div>
+                    <div class="card-content">
+                        ${renderAlarms(info)}
+                    </div>
+                </div>
+
                 <!-- Limits Card -->
                 <div class="home-card limits-card">
                     <div class="card-header">
@@ -139,6 +157,41 @@ function renderHome(info) {
     `;
 }
 
+function renderAlarms(info) {
+    if (!info.alarms_enabled) {
+        return `
+            <div class="service-action-required">
+                <span class="label">CloudWatch Alarms</span>
+                <span class="value text-warning">Access Required</span>
+            </div>
+        `;
+    }
+
+    const alarms = info.alarms_in_alarm || [];
+    if (alarms.length === 0) {
+        return `
+            <div class="info-row">
+                <span class="label">In ALARM</span>
+                <span class="status-pill success">None</span>
+            </div>
+        `;
+    }
+
+    return `
+        <div class="info-row">
+            <span class="label">In ALARM</span>
+            <span class="value text-danger">${alarms.length}</span>
+        </div>
+        <div class="info-divider"></div>
+        ${alarms.slice(0, 5).map(alarm => `
+            <div class="info-row" title="${alarm.state_reason || ''}">
+                <span class="label">${alarm.name}</span>
+                <span class="value">${alarm.state_updated_at || ''}</span>
+            </div>
+        `).join('')}
+    `;
+}
+
 function renderLimit(label, usage, limit) {
     const percentage = limit > 0 ? (usage / limit) * 100 : 0;
     const barClass = percentage > 90 ? 'danger' : percentage > 75 ? 'warning' : '';
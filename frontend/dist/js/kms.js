@@ -0,0 +1,166 @@
+import * as state from './state.js';
+import { detailSidebar } from './detailSidebar.js';
+
+function keyName(key) {
+    return key.Aliases && key.Aliases.length > 0 ? key.Aliases[0] : key.KeyID;
+}
+
+// rotationText tells why a key does not rotate: disabled, or not supported by its spec
+function rotationText(key) {
+    if (!key.RotationSupported) return `<span style="color: var(--text-muted);">Not supported</span>`;
+    if (key.PendingDeletion) return '-';
+    if (key.RotationUnknown) return `<span style="color: var(--text-muted);">Unknown</span>`;
+    if (!key.RotationEnabled) return `<span style="color: var(--brand-warning);">Disabled</span>`;
+    return `Every ${key.RotationPeriodDays} days${key.NextRotation ? `, next ${key.NextRotation}` : ''}`;
+}
+
+function stateText(key) {
+    const color = key.PendingDeletion ? 'var(--brand-danger)' : key.State === 'Enabled' ? 'inherit' : 'var(--brand-warning)';
+    return `<span style="color: ${color};">${key.State}</span>`;
+}
+
+function issuesText(key) {
+    const issues = key.Issues || [];
+    if (issues.length === 0) return '-';
+    return `<span style="color: var(--brand-warning);">${issues.join('<br>')}</span>`;
+}
+
+export function createKMSCard(key) {
+    const principals = key.PolicyPrincipals || [];
+    return `
+        <div class="vpc-card" data-id="${key.KeyID}" style="cursor: pointer;">
+            <div class="vpc-card-header">
+                <div class="vpc-card-title-row">
+                    <div class="vpc-card-title">🔑 ${keyName(key)}</div>
+                    <span class="badge">${key.State}</span>
+                </div>
+                <div class="vpc-card-subtitle">${key.Description || key.KeyID}</div>
+            </div>
+
+            <div class="vpc-card-divider"></div>
+
+            <div class="vpc-card-body">
+                <div class="vpc-card-row">
+                    <span class="label">Spec:</span>
+                    <span class="value">${key.KeySpec} (${key.KeyUsage})${key.MultiRegion ? ', multi-region' : ''}</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">Rotation:</span>
+                    <span class="value">${rotationText(key)}</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">Aliases:</span>
+                    <span class="value">${(key.Aliases || []).join(', ') || '-'}</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">Principals:</span>
+                    <span class="value font-mono text-xs">${principals.join(', ') || '-'}</span>
+                </div>
+
+                ${key.PendingDeletion ? `
+                <div class="vpc-card-row">
+                    <span class="label">Deletion:</span>
+                    <span class="value" style="color: var(--brand-danger);">${key.DeletionDate}</span>
+                </div>
+                ` : ''}
+
+                ${(key.Issues || []).length > 0 ? `
+                <div class="vpc-card-row">
+                    <span class="label">Issues:</span>
+                    <span class="value">${issuesText(key)}</span>
+                </div>
+                ` : ''}
+            </div>
+        </div>
+    `;
+}
+
+export function createKMSTableRow(key) {
+    return `
+        <tr>
+            <td><strong>${keyName(key)}</strong></td>
+            <td class="font-mono text-xs">${key.KeyID}</td>
+            <td>${stateText(key)}</td>
+            <td>${key.KeySpec}</td>
+            <td>${rotationText(key)}</td>
+            <td class="font-mono">${(key.PolicyPrincipals || []).length}</td>
+            <td>${issuesText(key)}</td>
+        </tr>
+    `;
+}
+
+export async function fetchKMSKeys() {
+    try {
+        state.setCurrentPage('kms-list');
+        state.loadingBar.classList.remove('hidden');
+        state.statusText.textContent = 'Fetching KMS Keys...';
+        state.vpcGrid.innerHTML = '';
+        state.kmsTableBody.innerHTML = '';
+
+        const keys = await window.go.core.App.GetKMSKeys();
+        state.loadingBar.classList.add('hidden');
+
+        state.setAllKMSKeys(keys || []);
+        state.setFilteredKMSKeys([...state.allKMSKeys]);
+
+        renderKMSKeys();
+    } catch (error) {
+        state.loadingBar.classList.add('hidden');
+        state.statusText.textContent = 'Error fetching KMS keys';
+        console.error(error);
+    }
+}
+
+export function renderKMSKeys() {
+    if (state.filteredKMSKeys.length === 0) {
+        state.statusText.textContent = 'No customer-managed KMS keys found';
+        const emptyCard = `
+            <div class="vpc-card" data-empty="true" style="cursor: pointer;">
+                <div class="vpc-card-title">No KMS Keys</div>
+                <div class="vpc-card-info">No customer-managed KMS keys found in this region</div>
+            </div>
+        `;
+        state.vpcGrid.innerHTML = emptyCard;
+        state.kmsTableBody.innerHTML = `
+            <tr>
+                <td colspan="7" style="text-align: center; padding: 32px; color: var(--text-secondary);">
+                    No customer-managed KMS keys found
+                </td>
+            </tr>
+        `;
+        return;
+    }
+
+    const flagged = state.filteredKMSKeys.filter(k => (k.Issues || []).length > 0).length;
+    state.statusText.textContent = `${state.filteredKMSKeys.length} KMS key(s) found${flagged ? `, ${flagged} flagged` : ''}`;
+
+    if (state.currentView === 'cards') {
+        state.vpcGrid.innerHTML = state.filteredKMSKeys.map(k => createKMSCard(k)).join('');
+    } else {
+        state.kmsTableBody.innerHTML = state.filteredKMSKeys.map(k => createKMSTableRow(k)).join('');
+    }
+}
+
+export function initKMSListeners() {
+    if (!state.vpcGrid) return;
+
+    state.vpcGrid.addEventListener('click', (e) => {
+        if (state.currentPage !== 'kms-list') return;
+
+        const card = e.target.closest('.vpc-card');
+        if (card) {
+            if (card.dataset.empty === 'true') {
+                detailSidebar.open({ message: "Not found yet" });
+                return;
+            }
+            const id = card.dataset.id;
+            const key = state.allKMSKeys.find(k => k.KeyID === id);
+            if (key) {
+                detailSidebar.open(key);
+            }
+        }
+    });
+}
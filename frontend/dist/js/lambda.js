@@ -2,6 +2,17 @@ import { truncateID } from './utils.js';
 import * as state from './state.js';
 import { detailSidebar } from './detailSidebar.js';
 
+// deprecationBadge flags runtimes past or within 180 days of their end of support
+function deprecationBadge(fn) {
+    if (!fn.RuntimeDeprecationDate) return '';
+    if (fn.DeprecatedRuntime) {
+        return `<div style="margin-top: 4px;"><span class="badge" style="color: var(--brand-danger);" title="Deprecated since ${fn.RuntimeDeprecationDate}">Deprecated runtime</span></div>`;
+    }
+    const daysLeft = (new Date(fn.RuntimeDeprecationDate) - new Date()) / 86400000;
+    if (daysLeft > 180) return '';
+    return `<div style="margin-top: 4px;"><span class="badge" style="color: var(--brand-warning);">Runtime deprecated on ${fn.RuntimeDeprecationDate}</span></div>`;
+}
+
 export function createLambdaCard(fn) {
     const title = fn.FunctionName;
     const isActive = fn.State === 'Active';
@@ -14,6 +25,7 @@ export function createLambdaCard(fn) {
                     <div class="vpc-card-title">⚡ ${title}</div>
                     <span class="badge ${statusClass}">${fn.State}</span>
                 </div>
+                ${deprecationBadge(fn)}
             </div>
 
             <div class="vpc-card-divider"></div>
@@ -29,6 +41,17 @@ export function createLambdaCard(fn) {
                     <span class="value font-mono">${fn.MemorySize} MB</span>
                 </div>
 
+                <div class="vpc-card-row">
+                    <span class="label">Timeout:</span>
+                    <span class="value font-mono">${fn.Timeout}s · ${fn.Architecture || '-'} · ${fn.PackageType}</span>
+                </div>
+
+                ${fn.VPCID ? `
+                <div class="vpc-card-row">
+                    <span class="label">VPC:</span>
+                    <span class="value font-mono text-xs">${truncateID(fn.VPCID)}</span>
+                </div>` : ''}
+
                 <div class="vpc-card-row">
                     <span class="label">Handler:</span> 
                     <span class="value font-mono text-xs" title="${fn.Handler}">${fn.Handler}</span>
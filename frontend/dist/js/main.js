@@ -15,6 +15,22 @@ import { fetchLoadBalancers, initLoadBalancerListeners } from './loadbalancer.js
 import { fetchElasticIPs, initElasticIPListeners } from './elasticip.js';
 import { fetchLambdaFunctions, initLambdaListeners } from './lambda.js';
 import { fetchRDSInstances, initRDSListeners } from './rds.js';
+import { fetchECRRepositories, initECRListeners } from './ecr.js';
+import { fetchEKSClusters, initEKSListeners } from './eks.js';
+import { fetchDynamoDBTables, initDynamoDBListeners } from './dynamodb.js';
+import { fetchSQSQueues, initSQSListeners } from './sqs.js';
+import { fetchSNSTopics, initSNSListeners } from './sns.js';
+import { fetchCloudFrontDistributions, initCloudFrontListeners } from './cloudfront.js';
+import { fetchRoute53HostedZones, initRoute53Listeners } from './route53.js';
+import { fetchACMCertificates, initACMListeners } from './acm.js';
+import { fetchAPIGatewayAPIs, initAPIGatewayListeners } from './apigateway.js';
+import { fetchStateMachines, initStepFunctionsListeners } from './stepfunctions.js';
+import { fetchElastiCacheClusters, initElastiCacheListeners } from './elasticache.js';
+import { fetchOpenSearchDomains, initOpenSearchListeners } from './opensearch.js';
+import { fetchMSKClusters, initMSKListeners } from './msk.js';
+import { fetchCloudFormationStacks, initCloudFormationListeners } from './cloudformation.js';
+import { fetchKMSKeys, initKMSListeners } from './kms.js';
+import { fetchSecrets, initSecretsListeners } from './secretsmanager.js';
 import { initSettings } from './settings.js';
 import { detailSidebar } from './detailSidebar.js';
 import { WindowManager } from './windowManager.js';
@@ -56,8 +72,25 @@ document.addEventListener('DOMContentLoaded', async () => {
     initElasticIPListeners();
     initLambdaListeners();
     initRDSListeners();
+    initECRListeners();
+    initEKSListeners();
+    initDynamoDBListeners();
+    initSQSListeners();
+    initSNSListeners();
+    initCloudFrontListeners();
+    initRoute53Listeners();
+    initACMListeners();
+    initAPIGatewayListeners();
+    initStepFunctionsListeners();
+    initElastiCacheListeners();
+    initOpenSearchListeners();
+    initMSKListeners();
+    initCloudFormationListeners();
+    initKMSListeners();
+    initSecretsListeners();
 
     checkAdminStatus();
+    checkFeatureAvailability();
     WindowManager.init();
 });
 
@@ -75,6 +108,28 @@ async function checkAdminStatus() {
     }
 }
 
+// Marks the views the credentials cannot use, or only partly, so that users know why
+async function checkFeatureAvailability() {
+    try {
+        const features = await window.go.core.App.GetFeatureAvailability();
+        if (!features) return;
+
+        const byView = Object.fromEntries(features.map(feature => [feature.feature, feature]));
+        document.querySelectorAll('.sidebar-nav .nav-item').forEach(item => {
+            const feature = byView[item.getAttribute('data-view')];
+            if (feature && !feature.available) {
+                item.classList.add('nav-unavailable');
+                item.title = `${feature.label} unavailable: ${feature.reason}`;
+            } else if (feature && feature.degraded && feature.degraded.length > 0) {
+                item.classList.add('nav-degraded');
+                item.title = `${feature.label}: ${feature.reason}`;
+            }
+        });
+    } catch (err) {
+        console.error("Failed to check feature availability:", err);
+    }
+}
+
 // View toggle buttons
 // View toggle buttons
 state.cardViewBtn.addEventListener('click', () => {
@@ -94,6 +149,22 @@ state.cardViewBtn.addEventListener('click', () => {
     else if (state.currentPage === 'elasticip-list') fetchElasticIPs();
     else if (state.currentPage === 'lambda-list') fetchLambdaFunctions();
     else if (state.currentPage === 'rds-list') fetchRDSInstances();
+    else if (state.currentPage === 'ecr-list') fetchECRRepositories();
+    else if (state.currentPage === 'eks-list') fetchEKSClusters();
+    else if (state.currentPage === 'dynamodb-list') fetchDynamoDBTables();
+    else if (state.currentPage === 'sqs-list') fetchSQSQueues();
+    else if (state.currentPage === 'sns-list') fetchSNSTopics();
+    else if (state.currentPage === 'cloudfront-list') fetchCloudFrontDistributions();
+    else if (state.currentPage === 'route53-list') fetchRoute53HostedZones();
+    else if (state.currentPage === 'acm-list') fetchACMCertificates();
+    else if (state.currentPage === 'apigateway-list') fetchAPIGatewayAPIs();
+    else if (state.currentPage === 'stepfunctions-list') fetchStateMachines();
+    else if (state.currentPage === 'elasticache-list') fetchElastiCacheClusters();
+    else if (state.currentPage === 'opensearch-list') fetchOpenSearchDomains();
+    else if (state.currentPage === 'msk-list') fetchMSKClusters();
+    else if (state.currentPage === 'cloudformation-list') fetchCloudFormationStacks();
+    else if (state.currentPage === 'kms-list') fetchKMSKeys();
+    else if (state.currentPage === 'secretsmanager-list') fetchSecrets();
 });
 
 state.tableViewBtn.addEventListener('click', () => {
@@ -114,6 +185,22 @@ state.tableViewBtn.addEventListener('click', () => {
     else if (state.currentPage === 'lambda-list') fetchLambdaFunctions();
     else if (state.currentPage === 'rds-list') fetchRDSInstances();
     else if (state.currentPage === 'rds-list') fetchRDSInstances();
+    else if (state.currentPage === 'ecr-list') fetchECRRepositories();
+    else if (state.currentPage === 'eks-list') fetchEKSClusters();
+    else if (state.currentPage === 'dynamodb-list') fetchDynamoDBTables();
+    else if (state.currentPage === 'sqs-list') fetchSQSQueues();
+    else if (state.currentPage === 'sns-list') fetchSNSTopics();
+    else if (state.currentPage === 'cloudfront-list') fetchCloudFrontDistributions();
+    else if (state.currentPage === 'route53-list') fetchRoute53HostedZones();
+    else if (state.currentPage === 'acm-list') fetchACMCertificates();
+    else if (state.currentPage === 'apigateway-list') fetchAPIGatewayAPIs();
+    else if (state.currentPage === 'stepfunctions-list') fetchStateMachines();
+    else if (state.currentPage === 'elasticache-list') fetchElastiCacheClusters();
+    else if (state.currentPage === 'opensearch-list') fetchOpenSearchDomains();
+    else if (state.currentPage === 'msk-list') fetchMSKClusters();
+    else if (state.currentPage === 'cloudformation-list') fetchCloudFormationStacks();
+    else if (state.currentPage === 'kms-list') fetchKMSKeys();
+    else if (state.currentPage === 'secretsmanager-list') fetchSecrets();
 });
 
 // Group By Dropdown
@@ -136,6 +223,22 @@ if (groupBySelect) {
         else if (state.currentPage === 'elasticip-list') fetchElasticIPs();
         else if (state.currentPage === 'lambda-list') fetchLambdaFunctions();
         else if (state.currentPage === 'rds-list') fetchRDSInstances();
+        else if (state.currentPage === 'ecr-list') fetchECRRepositories();
+        else if (state.currentPage === 'eks-list') fetchEKSClusters();
+        else if (state.currentPage === 'dynamodb-list') fetchDynamoDBTables();
+        else if (state.currentPage === 'sqs-list') fetchSQSQueues();
+        else if (state.currentPage === 'sns-list') fetchSNSTopics();
+        else if (state.currentPage === 'cloudfront-list') fetchCloudFrontDistributions();
+        else if (state.currentPage === 'route53-list') fetchRoute53HostedZones();
+        else if (state.currentPage === 'acm-list') fetchACMCertificates();
+        else if (state.currentPage === 'apigateway-list') fetchAPIGatewayAPIs();
+        else if (state.currentPage === 'stepfunctions-list') fetchStateMachines();
+        else if (state.currentPage === 'elasticache-list') fetchElastiCacheClusters();
+        else if (state.currentPage === 'opensearch-list') fetchOpenSearchDomains();
+        else if (state.currentPage === 'msk-list') fetchMSKClusters();
+        else if (state.currentPage === 'cloudformation-list') fetchCloudFormationStacks();
+        else if (state.currentPage === 'kms-list') fetchKMSKeys();
+        else if (state.currentPage === 'secretsmanager-list') fetchSecrets();
     });
 }
 
@@ -186,6 +289,38 @@ state.refreshBtn.addEventListener('click', () => {
         fetchLambdaFunctions();
     } else if (state.currentPage === 'rds-list') {
         fetchRDSInstances();
+    } else if (state.currentPage === 'ecr-list') {
+        fetchECRRepositories();
+    } else if (state.currentPage === 'eks-list') {
+        fetchEKSClusters();
+    } else if (state.currentPage === 'dynamodb-list') {
+        fetchDynamoDBTables();
+    } else if (state.currentPage === 'sqs-list') {
+        fetchSQSQueues();
+    } else if (state.currentPage === 'sns-list') {
+        fetchSNSTopics();
+    } else if (state.currentPage === 'cloudfront-list') {
+        fetchCloudFrontDistributions();
+    } else if (state.currentPage === 'route53-list') {
+        fetchRoute53HostedZones();
+    } else if (state.currentPage === 'acm-list') {
+        fetchACMCertificates();
+    } else if (state.currentPage === 'apigateway-list') {
+        fetchAPIGatewayAPIs();
+    } else if (state.currentPage === 'stepfunctions-list') {
+        fetchStateMachines();
+    } else if (state.currentPage === 'elasticache-list') {
+        fetchElastiCacheClusters();
+    } else if (state.currentPage === 'opensearch-list') {
+        fetchOpenSearchDomains();
+    } else if (state.currentPage === 'msk-list') {
+        fetchMSKClusters();
+    } else if (state.currentPage === 'cloudformation-list') {
+        fetchCloudFormationStacks();
+    } else if (state.currentPage === 'kms-list') {
+        fetchKMSKeys();
+    } else if (state.currentPage === 'secretsmanager-list') {
+        fetchSecrets();
     }
 });
 
@@ -0,0 +1,142 @@
+import * as state from './state.js';
+import { detailSidebar } from './detailSidebar.js';
+
+// encryptionText shows in-transit encryption from the clients, at rest is always on
+function encryptionText(cluster) {
+    const color = cluster.TransitEncryption ? 'inherit' : 'var(--brand-warning)';
+    return `<span style="color: ${color};">${cluster.ClientBrokerEncryption}</span> in transit, at rest${cluster.KMSKeyID ? ' (KMS)' : ''}`;
+}
+
+function brokersText(cluster) {
+    if (cluster.Type === 'SERVERLESS') return 'Serverless';
+    return `${cluster.BrokerCount} × ${cluster.InstanceType}${cluster.VolumeSize ? `, ${cluster.VolumeSize} GiB each` : ''}`;
+}
+
+export function createMSKCard(cluster) {
+    return `
+        <div class="vpc-card" data-id="${cluster.ARN}" style="cursor: pointer;">
+            <div class="vpc-card-header">
+                <div class="vpc-card-title-row">
+                    <div class="vpc-card-title">📨 ${cluster.Name}</div>
+                    <span class="badge">${cluster.State}</span>
+                </div>
+                <div class="vpc-card-subtitle">${cluster.KafkaVersion ? `Kafka ${cluster.KafkaVersion}` : cluster.Type}</div>
+            </div>
+
+            <div class="vpc-card-divider"></div>
+
+            <div class="vpc-card-body">
+                <div class="vpc-card-row">
+                    <span class="label">Brokers:</span>
+                    <span class="value">${brokersText(cluster)}</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">Encryption:</span>
+                    <span class="value">${encryptionText(cluster)}</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">Monitoring:</span>
+                    <span class="value">${cluster.EnhancedMonitoring || '-'}</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">VPC:</span>
+                    <span class="value font-mono">${cluster.VPCID || '-'}</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">Security groups:</span>
+                    <span class="value font-mono text-xs">${(cluster.SecurityGroupIDs || []).join(', ') || '-'}</span>
+                </div>
+            </div>
+        </div>
+    `;
+}
+
+export function createMSKTableRow(cluster) {
+    return `
+        <tr>
+            <td><strong>${cluster.Name}</strong></td>
+            <td>${cluster.Type}</td>
+            <td>${cluster.State}</td>
+            <td>${cluster.KafkaVersion || '-'}</td>
+            <td class="font-mono">${cluster.Type === 'SERVERLESS' ? '-' : cluster.BrokerCount}</td>
+            <td>${encryptionText(cluster)}</td>
+            <td class="font-mono">${cluster.VPCID || '-'}</td>
+        </tr>
+    `;
+}
+
+export async function fetchMSKClusters() {
+    try {
+        state.setCurrentPage('msk-list');
+        state.loadingBar.classList.remove('hidden');
+        state.statusText.textContent = 'Fetching MSK Clusters...';
+        state.vpcGrid.innerHTML = '';
+        state.mskTableBody.innerHTML = '';
+
+        const clusters = await window.go.core.App.GetMSKClusters();
+        state.loadingBar.classList.add('hidden');
+
+        state.setAllMSKClusters(clusters || []);
+        state.setFilteredMSKClusters([...state.allMSKClusters]);
+
+        renderMSKClusters();
+    } catch (error) {
+        state.loadingBar.classList.add('hidden');
+        state.statusText.textContent = 'Error fetching MSK clusters';
+        console.error(error);
+    }
+}
+
+export function renderMSKClusters() {
+    if (state.filteredMSKClusters.length === 0) {
+        state.statusText.textContent = 'No MSK clusters found';
+        const emptyCard = `
+            <div class="vpc-card" data-empty="true" style="cursor: pointer;">
+                <div class="vpc-card-title">No MSK Clusters</div>
+                <div class="vpc-card-info">No MSK clusters found in this region</div>
+            </div>
+        `;
+        state.vpcGrid.innerHTML = emptyCard;
+        state.mskTableBody.innerHTML = `
+            <tr>
+                <td colspan="7" style="text-align: center; padding: 32px; color: var(--text-secondary);">
+                    No MSK clusters found
+                </td>
+            </tr>
+        `;
+        return;
+    }
+
+    state.statusText.textContent = `${state.filteredMSKClusters.length} MSK cluster(s) found`;
+
+    if (state.currentView === 'cards') {
+        state.vpcGrid.innerHTML = state.filteredMSKClusters.map(c => createMSKCard(c)).join('');
+    } else {
+        state.mskTableBody.innerHTML = state.filteredMSKClusters.map(c => createMSKTableRow(c)).join('');
+    }
+}
+
+export function initMSKListeners() {
+    if (!state.vpcGrid) return;
+
+    state.vpcGrid.addEventListener('click', (e) => {
+        if (state.currentPage !== 'msk-list') return;
+
+        const card = e.target.closest('.vpc-card');
+        if (card) {
+            if (card.dataset.empty === 'true') {
+                detailSidebar.open({ message: "Not found yet" });
+                return;
+            }
+            const id = card.dataset.id;
+            const cluster = state.allMSKClusters.find(c => c.ARN === id);
+            if (cluster) {
+                detailSidebar.open(cluster);
+            }
+        }
+    });
+}
@@ -0,0 +1,152 @@
+import * as state from './state.js';
+import { detailSidebar } from './detailSidebar.js';
+
+function encryptionText(domain) {
+    const parts = [];
+    if (domain.TransitEncryption) parts.push('node-to-node');
+    if (domain.AtRestEncryption) parts.push('at rest');
+    if (domain.EnforceHTTPS) parts.push('HTTPS only');
+    return parts.length ? parts.join(', ') : '<span style="color: var(--brand-warning);">None</span>';
+}
+
+function nodesText(domain) {
+    const parts = [`${domain.InstanceCount} × ${domain.InstanceType}`];
+    if (domain.DedicatedMasterEnabled) parts.push(`${domain.DedicatedMasterCount} × ${domain.DedicatedMasterType} masters`);
+    if (domain.WarmCount) parts.push(`${domain.WarmCount} warm`);
+    return parts.join(', ');
+}
+
+export function createOpenSearchCard(domain) {
+    return `
+        <div class="vpc-card" data-id="${domain.ARN}" style="cursor: pointer;">
+            <div class="vpc-card-header">
+                <div class="vpc-card-title-row">
+                    <div class="vpc-card-title">🔎 ${domain.DomainName}</div>
+                    <span class="badge">${domain.Status}</span>
+                </div>
+                <div class="vpc-card-subtitle">${domain.EngineVersion}</div>
+            </div>
+
+            <div class="vpc-card-divider"></div>
+
+            <div class="vpc-card-body">
+                <div class="vpc-card-row">
+                    <span class="label">Nodes:</span>
+                    <span class="value">${nodesText(domain)}${domain.ZoneAwareness ? ' · Multi-AZ' : ''}</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">Storage:</span>
+                    <span class="value">${domain.VolumeSize ? `${domain.VolumeSize} GiB ${domain.VolumeType} per node` : 'Instance store'}</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">Encryption:</span>
+                    <span class="value">${encryptionText(domain)}</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">Endpoint:</span>
+                    <span class="value font-mono text-xs">${domain.Endpoint || '-'}</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">VPC:</span>
+                    <span class="value font-mono">${domain.VPCID || 'Public'}</span>
+                </div>
+
+                ${domain.VPCID ? `
+                <div class="vpc-card-row">
+                    <span class="label">Security groups:</span>
+                    <span class="value font-mono text-xs">${(domain.SecurityGroupIDs || []).join(', ') || '-'}</span>
+                </div>` : ''}
+            </div>
+        </div>
+    `;
+}
+
+export function createOpenSearchTableRow(domain) {
+    return `
+        <tr>
+            <td><strong>${domain.DomainName}</strong></td>
+            <td>${domain.EngineVersion}</td>
+            <td>${domain.Status}</td>
+            <td class="font-mono">${domain.InstanceType}</td>
+            <td class="font-mono">${domain.InstanceCount}</td>
+            <td>${encryptionText(domain)}</td>
+            <td class="font-mono">${domain.VPCID || 'Public'}</td>
+        </tr>
+    `;
+}
+
+export async function fetchOpenSearchDomains() {
+    try {
+        state.setCurrentPage('opensearch-list');
+        state.loadingBar.classList.remove('hidden');
+        state.statusText.textContent = 'Fetching OpenSearch Domains...';
+        state.vpcGrid.innerHTML = '';
+        state.opensearchTableBody.innerHTML = '';
+
+        const domains = await window.go.core.App.GetOpenSearchDomains();
+        state.loadingBar.classList.add('hidden');
+
+        state.setAllOpenSearchDomains(domains || []);
+        state.setFilteredOpenSearchDomains([...state.allOpenSearchDomains]);
+
+        renderOpenSearchDomains();
+    } catch (error) {
+        state.loadingBar.classList.add('hidden');
+        state.statusText.textContent = 'Error fetching OpenSearch domains';
+        console.error(error);
+    }
+}
+
+export function renderOpenSearchDomains() {
+    if (state.filteredOpenSearchDomains.length === 0) {
+        state.statusText.textContent = 'No OpenSearch domains found';
+        const emptyCard = `
+            <div class="vpc-card" data-empty="true" style="cursor: pointer;">
+                <div class="vpc-card-title">No OpenSearch Domains</div>
+                <div class="vpc-card-info">No OpenSearch domains found in this region</div>
+            </div>
+        `;
+        state.vpcGrid.innerHTML = emptyCard;
+        state.opensearchTableBody.innerHTML = `
+            <tr>
+                <td colspan="7" style="text-align: center; padding: 32px; color: var(--text-secondary);">
+                    No OpenSearch domains found
+                </td>
+            </tr>
+        `;
+        return;
+    }
+
+    state.statusText.textContent = `${state.filteredOpenSearchDomains.length} OpenSearch domain(s) found`;
+
+    if (state.currentView === 'cards') {
+        state.vpcGrid.innerHTML = state.filteredOpenSearchDomains.map(d => createOpenSearchCard(d)).join('');
+    } else {
+        state.opensearchTableBody.innerHTML = state.filteredOpenSearchDomains.map(d => createOpenSearchTableRow(d)).join('');
+    }
+}
+
+export function initOpenSearchListeners() {
+    if (!state.vpcGrid) return;
+
+    state.vpcGrid.addEventListener('click', (e) => {
+        if (state.currentPage !== 'opensearch-list') return;
+
+        const card = e.target.closest('.vpc-card');
+        if (card) {
+            if (card.dataset.empty === 'true') {
+                detailSidebar.open({ message: "Not found yet" });
+                return;
+            }
+            const id = card.dataset.id;
+            const domain = state.allOpenSearchDomains.find(d => d.ARN === id);
+            if (domain) {
+                detailSidebar.open(domain);
+            }
+        }
+    });
+}
@@ -1,12 +1,35 @@
+import { truncateID } from './utils.js';
 import * as state from './state.js';
 import { detailSidebar } from './detailSidebar.js';
 
+// clusterRole describes the place of an instance in its cluster, e.g. "orders (writer)"
+function clusterRole(db) {
+    if (!db.DBClusterIdentifier) return '';
+    const cluster = state.rdsClusters.find(c => c.DBClusterIdentifier === db.DBClusterIdentifier);
+    const member = cluster && (cluster.Members || []).find(m => m.DBInstanceIdentifier === db.DBInstanceIdentifier);
+    if (!member) return db.DBClusterIdentifier;
+    const drift = member.ParameterGroupStatus && member.ParameterGroupStatus !== 'in-sync' ? `, ${member.ParameterGroupStatus}` : '';
+    return `${db.DBClusterIdentifier} (${member.IsWriter ? 'writer' : 'reader'}${drift})`;
+}
+
+// pendingMaintenance lists the maintenance actions pending on an instance or its cluster
+function pendingMaintenance(db) {
+    const cluster = state.rdsClusters.find(c => c.DBClusterIdentifier === db.DBClusterIdentifier);
+    return state.rdsPendingMaintenance.filter(a =>
+        a.resource_arn.endsWith(`:db:${db.DBInstanceIdentifier}`) ||
+        (cluster && a.resource_arn === cluster.ARN));
+}
+
 export function createRDSCard(db) {
     const title = db.DBInstanceIdentifier;
     const isAvailable = db.DBInstanceStatus === 'available';
     const statusClass = isAvailable ? 'status-available' : 'status-pending';
 
     const multiAZBadge = db.MultiAZ ? '<span class="badge badge-blue">Multi-AZ</span>' : '';
+    const maintenance = pendingMaintenance(db);
+    const maintenanceBadge = maintenance.length > 0
+        ? `<span class="badge" style="color: var(--brand-warning);" title="${maintenance.map(a => `${a.action}: ${a.description}`).join('\n')}">Maintenance</span>`
+        : '';
     const publicBadge = db.PubliclyAccessible ? '<span class="badge badge-primary" style="background: rgba(210, 153, 34, 0.15); color: var(--brand-warning); border: 1px solid rgba(210, 153, 34, 0.2);">Public</span>' : '';
 
     return `
@@ -19,6 +42,7 @@ export function createRDSCard(db) {
                 <div style="display: flex; gap: 4px; margin-top: 4px;">
                     ${multiAZBadge}
                     ${publicBadge}
+                    ${maintenanceBadge}
                 </div>
             </div>
 
@@ -30,6 +54,12 @@ export function createRDSCard(db) {
                     <span class="value">${db.Engine} ${db.EngineVersion}</span>
                 </div>
                 
+                ${db.DBClusterIdentifier ? `
+                <div class="vpc-card-row">
+                    <span class="label">Cluster:</span>
+                    <span class="value">${clusterRole(db)}</span>
+                </div>` : ''}
+
                 <div class="vpc-card-row">
                     <span class="label">Class:</span> 
                     <span class="value font-mono">${db.DBInstanceClass}</span>
@@ -86,6 +116,14 @@ export async function fetchRDSInstances() {
         state.rdsTableBody.innerHTML = '';
 
         const dbs = await window.go.core.App.GetRDSInstances();
+
+        // Cluster roles and pending maintenance only annotate the cards
+        const [clusters, maintenance] = await Promise.all([
+            window.go.core.App.GetRDSClusters().catch(err => { console.error(err); return []; }),
+            window.go.core.App.GetRDSPendingMaintenance().catch(err => { console.error(err); return []; }),
+        ]);
+        state.setRDSClusters(clusters || []);
+        state.setRDSPendingMaintenance(maintenance || []);
         state.loadingBar.classList.add('hidden');
 
         state.setAllRDSInstances(dbs || []);
@@ -0,0 +1,119 @@
+import * as state from './state.js';
+import { detailSidebar } from './detailSidebar.js';
+
+export function createRoute53Card(zone) {
+    return `
+        <div class="vpc-card" data-id="${zone.ID}" style="cursor: pointer;">
+            <div class="vpc-card-header">
+                <div class="vpc-card-title-row">
+                    <div class="vpc-card-title">🧭 ${zone.Name}</div>
+                    <span class="badge">${zone.Private ? 'Private' : 'Public'}</span>
+                </div>
+            </div>
+
+            <div class="vpc-card-divider"></div>
+
+            <div class="vpc-card-body">
+                <div class="vpc-card-row">
+                    <span class="label">Zone ID:</span>
+                    <span class="value font-mono">${zone.ID}</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">Records:</span>
+                    <span class="value font-mono">${zone.RecordCount}</span>
+                </div>
+
+                ${zone.Comment ? `
+                <div class="vpc-card-row">
+                    <span class="label">Comment:</span>
+                    <span class="value">${zone.Comment}</span>
+                </div>` : ''}
+            </div>
+        </div>
+    `;
+}
+
+export function createRoute53TableRow(zone) {
+    return `
+        <tr>
+            <td><strong>${zone.Name}</strong></td>
+            <td class="font-mono">${zone.ID}</td>
+            <td>${zone.Private ? 'Private' : 'Public'}</td>
+            <td class="font-mono">${zone.RecordCount}</td>
+            <td>${zone.Comment || '-'}</td>
+        </tr>
+    `;
+}
+
+export async function fetchRoute53HostedZones() {
+    try {
+        state.setCurrentPage('route53-list');
+        state.loadingBar.classList.remove('hidden');
+        state.statusText.textContent = 'Fetching Route 53 Hosted Zones...';
+        state.vpcGrid.innerHTML = '';
+        state.route53TableBody.innerHTML = '';
+
+        const zones = await window.go.core.App.GetRoute53HostedZones();
+        state.loadingBar.classList.add('hidden');
+
+        state.setAllRoute53HostedZones(zones || []);
+        state.setFilteredRoute53HostedZones([...state.allRoute53HostedZones]);
+
+        renderRoute53HostedZones();
+    } catch (error) {
+        state.loadingBar.classList.add('hidden');
+        state.statusText.textContent = 'Error fetching hosted zones';
+        console.error(error);
+    }
+}
+
+export function renderRoute53HostedZones() {
+    if (state.filteredRoute53HostedZones.length === 0) {
+        state.statusText.textContent = 'No hosted zones found';
+        const emptyCard = `
+            <div class="vpc-card" data-empty="true" style="cursor: pointer;">
+                <div class="vpc-card-title">No Hosted Zones</div>
+                <div class="vpc-card-info">No Route 53 hosted zones found in your AWS account</div>
+            </div>
+        `;
+        state.vpcGrid.innerHTML = emptyCard;
+        state.route53TableBody.innerHTML = `
+            <tr>
+                <td colspan="5" style="text-align: center; padding: 32px; color: var(--text-secondary);">
+                    No hosted zones found
+                </td>
+            </tr>
+        `;
+        return;
+    }
+
+    state.statusText.textContent = `${state.filteredRoute53HostedZones.length} hosted zone(s) found`;
+
+    if (state.currentView === 'cards') {
+        state.vpcGrid.innerHTML = state.filteredRoute53HostedZones.map(z => createRoute53Card(z)).join('');
+    } else {
+        state.route53TableBody.innerHTML = state.filteredRoute53HostedZones.map(z => createRoute53TableRow(z)).join('');
+    }
+}
+
+export function initRoute53Listeners() {
+    if (!state.vpcGrid) return;
+
+    state.vpcGrid.addEventListener('click', (e) => {
+        if (state.currentPage !== 'route53-list') return;
+
+        const card = e.target.closest('.vpc-card');
+        if (card) {
+            if (card.dataset.empty === 'true') {
+                detailSidebar.open({ message: "Not found yet" });
+                return;
+            }
+            const id = card.dataset.id;
+            const zone = state.allRoute53HostedZones.find(z => z.ID === id);
+            if (zone) {
+                detailSidebar.open(zone);
+            }
+        }
+    });
+}
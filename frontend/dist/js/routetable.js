@@ -3,6 +3,14 @@ import * as state from './state.js';
 
 import { detailSidebar } from './detailSidebar.js';
 
+// formatRouteTarget renders a route as "destination → target", flagging blackholes
+function formatRouteTarget(route) {
+    const target = route.TargetType === 'local' ? 'local' : truncateID(route.TargetID || route.TargetType);
+    const color = route.State === 'blackhole' ? 'var(--brand-danger)' : 'inherit';
+    const title = `${route.TargetType}${route.State === 'blackhole' ? ' (blackhole)' : ''}`;
+    return `<div style="color: ${color};" title="${title}">${route.Destination} → ${target}</div>`;
+}
+
 export function createRouteTableCard(rt) {
     const title = rt.Name || rt.ID;
     const showID = rt.Name ? `<div class="vpc-card-id">ID: ${truncateID(rt.ID)}</div>` : '';
@@ -29,6 +37,10 @@ export function createRouteTableCard(rt) {
                 <strong>Associations:</strong>
                 <span>${rt.Subnets}</span>
             </div>
+            <div class="vpc-card-divider"></div>
+            <div class="vpc-card-info" style="font-family: monospace; font-size: 11px;">
+                ${(rt.RouteEntries || []).map(formatRouteTarget).join('')}
+            </div>
         </div>
     `;
 }
@@ -63,7 +75,7 @@ export function createRouteTableTableRow(rt) {
             <td class="vpc-id">${rt.ID}</td>
             <td class="vpc-id">${rt.VPCID}</td>
             <td>${rt.IsMain ? 'Yes' : 'No'}</td>
-            <td>${rt.Routes}</td>
+            <td title="${(rt.RouteEntries || []).map(r => `${r.Destination} → ${r.TargetID || r.TargetType}`).join('\n')}">${rt.Routes}</td>
             <td>${rt.Subnets}</td>
         </tr>
     `;
@@ -0,0 +1,168 @@
+import * as state from './state.js';
+import { detailSidebar } from './detailSidebar.js';
+
+// Only the metadata of the secrets is fetched, their values are never read
+
+function rotationText(secret) {
+    if (!secret.RotationEnabled) return `<span style="color: var(--brand-warning);">Disabled</span>`;
+    return secret.RotationSchedule || 'Enabled';
+}
+
+function accessText(secret) {
+    if (!secret.LastAccessed) return `<span style="color: ${secret.Stale ? 'var(--brand-warning)' : 'inherit'};">Never</span>`;
+    const color = secret.Stale ? 'var(--brand-warning)' : 'inherit';
+    return `<span style="color: ${color};">${secret.LastAccessed}</span>`;
+}
+
+function replicasText(secret) {
+    const replicas = secret.Replicas || [];
+    if (replicas.length === 0) return '-';
+    return replicas.map(r => {
+        const color = r.Status === 'Failed' ? 'var(--brand-danger)' : 'inherit';
+        return `<span style="color: ${color};" title="${r.StatusMessage || r.Status}">${r.Region}</span>`;
+    }).join(', ');
+}
+
+function issuesText(secret) {
+    const issues = secret.Issues || [];
+    if (issues.length === 0) return '-';
+    return `<span style="color: var(--brand-warning);">${issues.join('<br>')}</span>`;
+}
+
+export function createSecretCard(secret) {
+    return `
+        <div class="vpc-card" data-id="${secret.ARN}" style="cursor: pointer;">
+            <div class="vpc-card-header">
+                <div class="vpc-card-title-row">
+                    <div class="vpc-card-title">🔐 ${secret.Name}</div>
+                    ${secret.Stale ? `<span class="badge">Stale</span>` : ''}
+                </div>
+                <div class="vpc-card-subtitle">${secret.Description || (secret.OwningService ? `Managed by ${secret.OwningService}` : 'Secret')}</div>
+            </div>
+
+            <div class="vpc-card-divider"></div>
+
+            <div class="vpc-card-body">
+                <div class="vpc-card-row">
+                    <span class="label">Rotation:</span>
+                    <span class="value">${rotationText(secret)}</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">Last rotated:</span>
+                    <span class="value">${secret.LastRotated || '-'}</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">Last accessed:</span>
+                    <span class="value">${accessText(secret)}</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">KMS key:</span>
+                    <span class="value font-mono text-xs">${secret.KMSKeyID}</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">Replicas:</span>
+                    <span class="value">${replicasText(secret)}</span>
+                </div>
+
+                ${(secret.Issues || []).length > 0 ? `
+                <div class="vpc-card-row">
+                    <span class="label">Issues:</span>
+                    <span class="value">${issuesText(secret)}</span>
+                </div>
+                ` : ''}
+            </div>
+        </div>
+    `;
+}
+
+export function createSecretTableRow(secret) {
+    return `
+        <tr>
+            <td><strong>${secret.Name}</strong></td>
+            <td>${rotationText(secret)}</td>
+            <td>${secret.LastRotated || '-'}</td>
+            <td>${accessText(secret)}</td>
+            <td class="font-mono text-xs">${secret.KMSKeyID}</td>
+            <td>${replicasText(secret)}</td>
+            <td>${issuesText(secret)}</td>
+        </tr>
+    `;
+}
+
+export async function fetchSecrets() {
+    try {
+        state.setCurrentPage('secretsmanager-list');
+        state.loadingBar.classList.remove('hidden');
+        state.statusText.textContent = 'Fetching Secrets...';
+        state.vpcGrid.innerHTML = '';
+        state.secretsmanagerTableBody.innerHTML = '';
+
+        const secrets = await window.go.core.App.GetSecrets();
+        state.loadingBar.classList.add('hidden');
+
+        state.setAllSecrets(secrets || []);
+        state.setFilteredSecrets([...state.allSecrets]);
+
+        renderSecrets();
+    } catch (error) {
+        state.loadingBar.classList.add('hidden');
+        state.statusText.textContent = 'Error fetching secrets';
+        console.error(error);
+    }
+}
+
+export function renderSecrets() {
+    if (state.filteredSecrets.length === 0) {
+        state.statusText.textContent = 'No secrets found';
+        const emptyCard = `
+            <div class="vpc-card" data-empty="true" style="cursor: pointer;">
+                <div class="vpc-card-title">No Secrets</div>
+                <div class="vpc-card-info">No Secrets Manager secrets found in this region</div>
+            </div>
+        `;
+        state.vpcGrid.innerHTML = emptyCard;
+        state.secretsmanagerTableBody.innerHTML = `
+            <tr>
+                <td colspan="7" style="text-align: center; padding: 32px; color: var(--text-secondary);">
+                    No secrets found
+                </td>
+            </tr>
+        `;
+        return;
+    }
+
+    const noRotation = state.filteredSecrets.filter(s => !s.RotationEnabled).length;
+    const stale = state.filteredSecrets.filter(s => s.Stale).length;
+    state.statusText.textContent = `${state.filteredSecrets.length} secret(s) found, ${noRotation} without rotation, ${stale} stale`;
+
+    if (state.currentView === 'cards') {
+        state.vpcGrid.innerHTML = state.filteredSecrets.map(s => createSecretCard(s)).join('');
+    } else {
+        state.secretsmanagerTableBody.innerHTML = state.filteredSecrets.map(s => createSecretTableRow(s)).join('');
+    }
+}
+
+export function initSecretsListeners() {
+    if (!state.vpcGrid) return;
+
+    state.vpcGrid.addEventListener('click', (e) => {
+        if (state.currentPage !== 'secretsmanager-list') return;
+
+        const card = e.target.closest('.vpc-card');
+        if (card) {
+            if (card.dataset.empty === 'true') {
+                detailSidebar.open({ message: "Not found yet" });
+                return;
+            }
+            const id = card.dataset.id;
+            const secret = state.allSecrets.find(s => s.ARN === id);
+            if (secret) {
+                detailSidebar.open(secret);
+            }
+        }
+    });
+}
@@ -1,21 +1,124 @@
 import * as state from './state.js';
 import { ErrorHandler } from './errorHandler.js';
 
+// Certificates expiring within this many days are reported
+const CERTIFICATE_EXPIRY_DAYS = 30;
+
 export async function fetchSecurityInfo() {
     if (!state.securityContainer) return;
 
     state.securityContainer.innerHTML = '<div class="loading">Fetching security and compliance data...</div>';
 
     try {
-        const info = await window.go.core.App.GetAccountHomeInfo();
-        renderSecurityView(info);
+        const [info, flowLogFindings, certificateFindings, dnsFindings, iamHygiene, policyAnalysis] = await Promise.all([
+            window.go.core.App.GetAccountHomeInfo(),
+            window.go.core.App.GetFlowLogFindings().catch(e => { console.error('Flow log findings error:', e); return null; }),
+            window.go.core.App.GetExpiringCertificates(CERTIFICATE_EXPIRY_DAYS).catch(e => { console.error('Certificate findings error:', e); return null; }),
+            window.go.core.App.GetDanglingDNSRecords().catch(e => { console.error('Dangling DNS findings error:', e); return null; }),
+            // Zero thresholds use the backend defaults
+            window.go.core.App.GetIAMHygiene({}).catch(e => { console.error('IAM hygiene error:', e); return null; }),
+            window.go.core.App.GetPolicyAnalysis().catch(e => { console.error('Policy analysis error:', e); return null; })
+        ]);
+        renderSecurityView(info, { flowLogFindings, certificateFindings, dnsFindings, iamHygiene, policyAnalysis });
     } catch (error) {
         console.error('Error fetching security info:', error);
         state.securityContainer.innerHTML = `<div class="error-container">Failed to load security info: ${error}</div>`;
     }
 }
 
-function renderSecurityView(info) {
+// checkSection renders the findings of a check, or why it could not run when findings is null
+function checkSection(title, notice, findings, unavailable, empty) {
+    return `
+                <section class="security-section findings-section">
+                    <div class="section-header">
+                        <h2>${title}</h2>
+                        <span class="freshness-notice">${notice}</span>
+                    </div>
+
+                    ${findings === null ? `
+                        <div class="empty-state">
+                            <p>${unavailable[0]}</p>
+                            <p class="small">${unavailable[1]}</p>
+                        </div>
+                    ` : findings.length > 0 ? `
+                        <div class="findings-list">
+                            ${findings.map(f => `
+                                <div class="finding-card ${f.severity.toLowerCase()}">
+                                    <div class="finding-main">
+                                        <span class="severity-tag">${f.severity}</span>
+                                        <h3 class="finding-title">${f.title}</h3>
+                                    </div>
+                                    <div class="finding-details">
+                                        <p class="finding-category">${f.category}</p>
+                                        <span class="finding-resource">Resource: <code>${f.resource_id}</code></span>
+                                    </div>
+                                </div>
+                            `).join('')}
+                        </div>
+                    ` : `
+                        <div class="empty-state">
+                            <p>${empty}</p>
+                        </div>
+                    `}
+                </section>
+    `;
+}
+
+// iamHygieneNotice summarizes the IAM hygiene report and its thresholds
+function iamHygieneNotice(report) {
+    if (!report) return 'Credential report, roles and password policy';
+    const t = report.thresholds;
+    const notice = `${report.users.length} user(s), ${report.roles.length} role(s) from the credential report of ${report.generated_at}. ` +
+        `Access keys older than ${t.access_key_max_age_days} days or unused for ${t.access_key_unused_days}, ` +
+        `no console sign-in for ${t.console_inactive_days} days, roles unused for ${t.role_unused_days} days`;
+    return report.errors.length ? `${notice}. Incomplete: ${report.errors.join('; ')}` : notice;
+}
+
+// policySection lists the principals with risky policies and the statements at fault
+function policySection(analysis) {
+    if (!analysis) {
+        return checkSection('IAM Policies', 'Wildcards and privilege escalation in identity policies', null,
+            ['Could not analyze IAM policies.', 'iam:GetAccountAuthorizationDetails may be missing from your permissions.'], '');
+    }
+
+    // Combinations carry one statement per line
+    const statements = text => text.split('\n').map(s => JSON.stringify(JSON.parse(s), null, 2)).join('\n');
+    const notice = `${analysis.principals_analyzed} principal(s), ${analysis.policies_analyzed} policies analyzed. Deny statements and conditions are not evaluated` +
+        (analysis.errors.length ? `. Incomplete: ${analysis.errors.join('; ')}` : '');
+
+    return `
+                <section class="security-section findings-section">
+                    <div class="section-header">
+                        <h2>IAM Policies</h2>
+                        <span class="freshness-notice">${notice}</span>
+                    </div>
+
+                    ${analysis.principals.length > 0 ? `
+                        <div class="findings-list">
+                            ${analysis.principals.flatMap(p => p.risks.map(r => `
+                                <div class="finding-card ${r.severity.toLowerCase()}">
+                                    <div class="finding-main">
+                                        <span class="severity-tag">${r.severity}</span>
+                                        <h3 class="finding-title">${r.risk}</h3>
+                                    </div>
+                                    <div class="finding-details">
+                                        <p class="finding-category">${r.policy} (${r.via})${r.conditional ? ', conditional' : ''}</p>
+                                        <span class="finding-resource">${p.type}: <code>${p.name}</code></span>
+                                        <pre class="finding-statement">${statements(r.statement)}</pre>
+                                    </div>
+                                </div>
+                            `)).join('')}
+                        </div>
+                    ` : `
+                        <div class="empty-state">
+                            <p>No risky policy found.</p>
+                        </div>
+                    `}
+                </section>
+    `;
+}
+
+function renderSecurityView(info, checks) {
     if (!info) return;
 
     const findings = info.top_findings || [];
@@ -111,6 +214,29 @@ function renderSecurityView(info) {
                         </div>
                     `}
                 </section>
+
+                <!-- VPC Flow Logs coverage -->
+                ${checkSection('VPC Flow Logs', 'VPCs without an active VPC-level flow log', checks.flowLogFindings,
+                    ['Could not check flow logs.', 'ec2:DescribeFlowLogs may be missing from your permissions.'],
+                    'Every VPC has flow logs enabled.')}
+
+                <!-- ACM certificate expiry -->
+                ${checkSection('Certificates', `ACM certificates expiring within ${CERTIFICATE_EXPIRY_DAYS} days or expired while in use`, checks.certificateFindings,
+                    ['Could not check certificates.', 'acm:ListCertificates and acm:DescribeCertificate may be missing from your permissions.'],
+                    'No certificate is about to expire.')}
+
+                <!-- Dangling DNS records -->
+                ${checkSection('Dangling DNS', 'Public records pointing at deleted load balancers, distributions, buckets or IPs', checks.dnsFindings,
+                    ['Could not check DNS records.', 'route53:ListHostedZones and route53:ListResourceRecordSets may be missing from your permissions.'],
+                    'No dangling record found.')}
+
+                <!-- IAM users, roles and password policy -->
+                ${checkSection('IAM Hygiene', iamHygieneNotice(checks.iamHygiene), checks.iamHygiene ? checks.iamHygiene.findings : null,
+                    ['Could not build the IAM hygiene report.', 'iam:GenerateCredentialReport, iam:GetCredentialReport, iam:ListRoles and iam:GetRole may be missing from your permissions.'],
+                    'No IAM hygiene issue found.')}
+
+                <!-- IAM policy analysis -->
+                ${policySection(checks.policyAnalysis)}
             </div>
             
             <footer class="security-footer">
@@ -0,0 +1,132 @@
+import * as state from './state.js';
+import { detailSidebar } from './detailSidebar.js';
+
+function protocolSummary(topic) {
+    const byProtocol = topic.SubscriptionsByProtocol || {};
+    const protocols = Object.keys(byProtocol).sort();
+    if (protocols.length === 0) return 'None';
+    return protocols.map(p => `${p} × ${byProtocol[p]}`).join(', ');
+}
+
+export function createSNSCard(topic) {
+    return `
+        <div class="vpc-card" data-id="${topic.ARN}" style="cursor: pointer;">
+            <div class="vpc-card-header">
+                <div class="vpc-card-title-row">
+                    <div class="vpc-card-title">📣 ${topic.TopicName}</div>
+                    <span class="badge">${topic.FIFO ? 'FIFO' : 'Standard'}</span>
+                </div>
+                ${topic.SubscriptionsConfirmed === 0 ? `<div style="margin-top: 4px;"><span class="badge" style="color: var(--brand-warning);">No confirmed subscribers</span></div>` : ''}
+            </div>
+
+            <div class="vpc-card-divider"></div>
+
+            <div class="vpc-card-body">
+                ${topic.DisplayName ? `
+                <div class="vpc-card-row">
+                    <span class="label">Display:</span>
+                    <span class="value">${topic.DisplayName}</span>
+                </div>` : ''}
+
+                <div class="vpc-card-row">
+                    <span class="label">Subscriptions:</span>
+                    <span class="value font-mono">${topic.SubscriptionsConfirmed} confirmed · ${topic.SubscriptionsPending} pending</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">Protocols:</span>
+                    <span class="value font-mono text-xs">${protocolSummary(topic)}</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">Encryption:</span>
+                    <span class="value font-mono text-xs">${topic.KMSKeyID || 'None'}</span>
+                </div>
+            </div>
+        </div>
+    `;
+}
+
+export function createSNSTableRow(topic) {
+    return `
+        <tr>
+            <td><strong>${topic.TopicName}</strong></td>
+            <td class="font-mono">${topic.SubscriptionsConfirmed}</td>
+            <td class="font-mono">${topic.SubscriptionsPending}</td>
+            <td class="font-mono">${protocolSummary(topic)}</td>
+            <td>${topic.KMSKeyID ? 'SSE-KMS' : 'None'}</td>
+        </tr>
+    `;
+}
+
+export async function fetchSNSTopics() {
+    try {
+        state.setCurrentPage('sns-list');
+        state.loadingBar.classList.remove('hidden');
+        state.statusText.textContent = 'Fetching SNS Topics...';
+        state.vpcGrid.innerHTML = '';
+        state.snsTableBody.innerHTML = '';
+
+        const topics = await window.go.core.App.GetSNSTopics();
+        state.loadingBar.classList.add('hidden');
+
+        state.setAllSNSTopics(topics || []);
+        state.setFilteredSNSTopics([...state.allSNSTopics]);
+
+        renderSNSTopics();
+    } catch (error) {
+        state.loadingBar.classList.add('hidden');
+        state.statusText.textContent = 'Error fetching SNS topics';
+        console.error(error);
+    }
+}
+
+export function renderSNSTopics() {
+    if (state.filteredSNSTopics.length === 0) {
+        state.statusText.textContent = 'No SNS topics found';
+        const emptyCard = `
+            <div class="vpc-card" data-empty="true" style="cursor: pointer;">
+                <div class="vpc-card-title">No SNS Topics</div>
+                <div class="vpc-card-info">No SNS topics found in your AWS account</div>
+            </div>
+        `;
+        state.vpcGrid.innerHTML = emptyCard;
+        state.snsTableBody.innerHTML = `
+            <tr>
+                <td colspan="5" style="text-align: center; padding: 32px; color: var(--text-secondary);">
+                    No SNS topics found
+                </td>
+            </tr>
+        `;
+        return;
+    }
+
+    state.statusText.textContent = `${state.filteredSNSTopics.length} SNS topic(s) found`;
+
+    if (state.currentView === 'cards') {
+        state.vpcGrid.innerHTML = state.filteredSNSTopics.map(t => createSNSCard(t)).join('');
+    } else {
+        state.snsTableBody.innerHTML = state.filteredSNSTopics.map(t => createSNSTableRow(t)).join('');
+    }
+}
+
+export function initSNSListeners() {
+    if (!state.vpcGrid) return;
+
+    state.vpcGrid.addEventListener('click', (e) => {
+        if (state.currentPage !== 'sns-list') return;
+
+        const card = e.target.closest('.vpc-card');
+        if (card) {
+            if (card.dataset.empty === 'true') {
+                detailSidebar.open({ message: "Not found yet" });
+                return;
+            }
+            const id = card.dataset.id;
+            const topic = state.allSNSTopics.find(t => t.ARN === id);
+            if (topic) {
+                detailSidebar.open(topic);
+            }
+        }
+    });
+}
@@ -0,0 +1,148 @@
+import * as state from './state.js';
+import { detailSidebar } from './detailSidebar.js';
+
+// formatAge renders the oldest message age in seconds; -1 means CloudWatch had no datapoint
+function formatAge(seconds) {
+    if (seconds < 0) return '-';
+    if (seconds < 60) return `${seconds}s`;
+    if (seconds < 3600) return `${Math.floor(seconds / 60)}m`;
+    if (seconds < 86400) return `${Math.floor(seconds / 3600)}h ${Math.floor((seconds % 3600) / 60)}m`;
+    return `${Math.floor(seconds / 86400)}d ${Math.floor((seconds % 86400) / 3600)}h`;
+}
+
+// ageColor warns once the oldest message has waited over 5 minutes, danger past an hour
+function ageColor(seconds) {
+    if (seconds >= 3600) return 'var(--brand-danger)';
+    if (seconds >= 300) return 'var(--brand-warning)';
+    return 'inherit';
+}
+
+export function createSQSCard(queue) {
+    const isDLQ = (queue.SourceQueues || []).length > 0;
+
+    return `
+        <div class="vpc-card" data-id="${queue.QueueURL}" style="cursor: pointer;">
+            <div class="vpc-card-header">
+                <div class="vpc-card-title-row">
+                    <div class="vpc-card-title">📨 ${queue.QueueName}</div>
+                    <span class="badge">${queue.FIFO ? 'FIFO' : 'Standard'}</span>
+                </div>
+                ${isDLQ ? `<div style="margin-top: 4px;"><span class="badge" style="color: ${queue.Messages > 0 ? 'var(--brand-danger)' : 'inherit'};" title="Dead-letter queue for ${queue.SourceQueues.join(', ')}">Dead-letter queue</span></div>` : ''}
+            </div>
+
+            <div class="vpc-card-divider"></div>
+
+            <div class="vpc-card-body">
+                <div class="vpc-card-row">
+                    <span class="label">Messages:</span>
+                    <span class="value font-mono">${queue.Messages} visible · ${queue.MessagesInFlight} in flight · ${queue.MessagesDelayed} delayed</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">Oldest:</span>
+                    <span class="value font-mono" style="color: ${ageColor(queue.OldestMessageAge)};">${formatAge(queue.OldestMessageAge)}</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">DLQ:</span>
+                    <span class="value font-mono text-xs">${queue.DeadLetterQueue ? `${queue.DeadLetterQueue} (max ${queue.MaxReceiveCount})` : 'None'}</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">Encryption:</span>
+                    <span class="value font-mono text-xs" title="${queue.KMSKeyID || ''}">${queue.Encryption || 'None'}</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">Visibility:</span>
+                    <span class="value font-mono">${queue.VisibilityTimeout}s · retention ${formatAge(queue.RetentionPeriod)}</span>
+                </div>
+            </div>
+        </div>
+    `;
+}
+
+export function createSQSTableRow(queue) {
+    return `
+        <tr>
+            <td><strong>${queue.QueueName}</strong></td>
+            <td class="font-mono">${queue.Messages}</td>
+            <td class="font-mono">${queue.MessagesInFlight}</td>
+            <td class="font-mono" style="color: ${ageColor(queue.OldestMessageAge)};">${formatAge(queue.OldestMessageAge)}</td>
+            <td class="font-mono">${queue.DeadLetterQueue || '-'}</td>
+            <td>${queue.Encryption || 'None'}</td>
+        </tr>
+    `;
+}
+
+export async function fetchSQSQueues() {
+    try {
+        state.setCurrentPage('sqs-list');
+        state.loadingBar.classList.remove('hidden');
+        state.statusText.textContent = 'Fetching SQS Queues...';
+        state.vpcGrid.innerHTML = '';
+        state.sqsTableBody.innerHTML = '';
+
+        const queues = await window.go.core.App.GetSQSQueues();
+        state.loadingBar.classList.add('hidden');
+
+        state.setAllSQSQueues(queues || []);
+        state.setFilteredSQSQueues([...state.allSQSQueues]);
+
+        renderSQSQueues();
+    } catch (error) {
+        state.loadingBar.classList.add('hidden');
+        state.statusText.textContent = 'Error fetching SQS queues';
+        console.error(error);
+    }
+}
+
+export function renderSQSQueues() {
+    if (state.filteredSQSQueues.length === 0) {
+        state.statusText.textContent = 'No SQS queues found';
+        const emptyCard = `
+            <div class="vpc-card" data-empty="true" style="cursor: pointer;">
+                <div class="vpc-card-title">No SQS Queues</div>
+                <div class="vpc-card-info">No SQS queues found in your AWS account</div>
+            </div>
+        `;
+        state.vpcGrid.innerHTML = emptyCard;
+        state.sqsTableBody.innerHTML = `
+            <tr>
+                <td colspan="6" style="text-align: center; padding: 32px; color: var(--text-secondary);">
+                    No SQS queues found
+                </td>
+            </tr>
+        `;
+        return;
+    }
+
+    state.statusText.textContent = `${state.filteredSQSQueues.length} SQS queue(s) found`;
+
+    if (state.currentView === 'cards') {
+        state.vpcGrid.innerHTML = state.filteredSQSQueues.map(q => createSQSCard(q)).join('');
+    } else {
+        state.sqsTableBody.innerHTML = state.filteredSQSQueues.map(q => createSQSTableRow(q)).join('');
+    }
+}
+
+export function initSQSListeners() {
+    if (!state.vpcGrid) return;
+
+    state.vpcGrid.addEventListener('click', (e) => {
+        if (state.currentPage !== 'sqs-list') return;
+
+        const card = e.target.closest('.vpc-card');
+        if (card) {
+            if (card.dataset.empty === 'true') {
+                detailSidebar.open({ message: "Not found yet" });
+                return;
+            }
+            const id = card.dataset.id;
+            const queue = state.allSQSQueues.find(q => q.QueueURL === id);
+            if (queue) {
+                detailSidebar.open(queue);
+            }
+        }
+    });
+}
@@ -21,6 +21,38 @@ export const lambdaTableContainer = document.getElementById('lambdaTable');
 export const lambdaTableBody = document.getElementById('lambdaTableBody');
 export const rdsTableContainer = document.getElementById('rdsTable');
 export const rdsTableBody = document.getElementById('rdsTableBody');
+export const ecrTableContainer = document.getElementById('ecrTable');
+export const ecrTableBody = document.getElementById('ecrTableBody');
+export const eksTableContainer = document.getElementById('eksTable');
+export const eksTableBody = document.getElementById('eksTableBody');
+export const dynamodbTableContainer = document.getElementById('dynamodbTable');
+export const dynamodbTableBody = document.getElementById('dynamodbTableBody');
+export const sqsTableContainer = document.getElementById('sqsTable');
+export const sqsTableBody = document.getElementById('sqsTableBody');
+export const snsTableContainer = document.getElementById('snsTable');
+export const snsTableBody = document.getElementById('snsTableBody');
+export const cloudfrontTableContainer = document.getElementById('cloudfrontTable');
+export const cloudfrontTableBody = document.getElementById('cloudfrontTableBody');
+export const route53TableContainer = document.getElementById('route53Table');
+export const route53TableBody = document.getElementById('route53TableBody');
+export const acmTableContainer = document.getElementById('acmTable');
+export const acmTableBody = document.getElementById('acmTableBody');
+export const apigatewayTableContainer = document.getElementById('apigatewayTable');
+export const apigatewayTableBody = document.getElementById('apigatewayTableBody');
+export const stepfunctionsTableContainer = document.getElementById('stepfunctionsTable');
+export const stepfunctionsTableBody = document.getElementById('stepfunctionsTableBody');
+export const elasticacheTableContainer = document.getElementById('elasticacheTable');
+export const elasticacheTableBody = document.getElementById('elasticacheTableBody');
+export const opensearchTableContainer = document.getElementById('opensearchTable');
+export const opensearchTableBody = document.getElementById('opensearchTableBody');
+export const mskTableContainer = document.getElementById('mskTable');
+export const mskTableBody = document.getElementById('mskTableBody');
+export const cloudformationTableContainer = document.getElementById('cloudformationTable');
+export const cloudformationTableBody = document.getElementById('cloudformationTableBody');
+export const kmsTableContainer = document.getElementById('kmsTable');
+export const kmsTableBody = document.getElementById('kmsTableBody');
+export const secretsmanagerTableContainer = document.getElementById('secretsmanagerTable');
+export const secretsmanagerTableBody = document.getElementById('secretsmanagerTableBody');
 export const homeContainer = document.getElementById('homeContainer');
 export const securityContainer = document.getElementById('securityContainer');
 
@@ -59,6 +91,43 @@ export let allLambdaFunctions = [];
 export let filteredLambdaFunctions = [];
 export let allRDSInstances = [];
 export let filteredRDSInstances = [];
+export let rdsClusters = []; // Aurora and Multi-AZ DB clusters, linked to instances by DBClusterIdentifier
+export let rdsPendingMaintenance = [];
+export let allECRRepositories = [];
+export let filteredECRRepositories = [];
+export let ecrImageUsage = []; // ECS service containers running ECR images, see GetECRImageUsage
+export let allEKSClusters = [];
+export let filteredEKSClusters = [];
+export let allDynamoDBTables = [];
+export let filteredDynamoDBTables = [];
+export let allSQSQueues = [];
+export let filteredSQSQueues = [];
+export let allSNSTopics = [];
+export let filteredSNSTopics = [];
+export let allCloudFrontDistributions = [];
+export let filteredCloudFrontDistributions = [];
+export let allRoute53HostedZones = [];
+export let filteredRoute53HostedZones = [];
+export let allACMCertificates = [];
+export let filteredACMCertificates = [];
+export let allAPIGatewayAPIs = [];
+export let filteredAPIGatewayAPIs = [];
+export let apiGatewayDomains = []; // Custom domains shown on the API cards
+export let allStateMachines = [];
+export let filteredStateMachines = [];
+export let allElastiCacheClusters = [];
+export let filteredElastiCacheClusters = [];
+export let allOpenSearchDomains = [];
+export let filteredOpenSearchDomains = [];
+export let allMSKClusters = [];
+export let filteredMSKClusters = [];
+export let allCloudFormationStacks = [];
+export let filteredCloudFormationStacks = [];
+export let allKMSKeys = [];
+export let filteredKMSKeys = [];
+export let allSecrets = [];
+export let filteredSecrets = [];
+export let vpcConnectivity = null; // IGWs, endpoints, peerings, TGW and VPN links between VPCs
 
 
 
@@ -152,6 +221,10 @@ export function setFilteredNATGateways(gateways) {
     filteredNATGateways = gateways;
 }
 
+export function setVPCConnectivity(connectivity) {
+    vpcConnectivity = connectivity;
+}
+
 export function setAllRouteTables(tables) {
     allRouteTables = tables;
 }
@@ -207,3 +280,147 @@ export function setAllRDSInstances(instances) {
 export function setFilteredRDSInstances(instances) {
     filteredRDSInstances = instances;
 }
+
+export function setRDSClusters(clusters) {
+    rdsClusters = clusters;
+}
+
+export function setRDSPendingMaintenance(actions) {
+    rdsPendingMaintenance = actions;
+}
+
+export function setAllECRRepositories(repos) {
+    allECRRepositories = repos;
+}
+
+export function setFilteredECRRepositories(repos) {
+    filteredECRRepositories = repos;
+}
+
+export function setECRImageUsage(usages) {
+    ecrImageUsage = usages;
+}
+
+export function setAllEKSClusters(clusters) {
+    allEKSClusters = clusters;
+}
+
+export function setFilteredEKSClusters(clusters) {
+    filteredEKSClusters = clusters;
+}
+
+export function setAllDynamoDBTables(tables) {
+    allDynamoDBTables = tables;
+}
+
+export function setFilteredDynamoDBTables(tables) {
+    filteredDynamoDBTables = tables;
+}
+
+export function setAllSQSQueues(queues) {
+    allSQSQueues = queues;
+}
+
+export function setFilteredSQSQueues(queues) {
+    filteredSQSQueues = queues;
+}
+
+export function setAllSNSTopics(topics) {
+    allSNSTopics = topics;
+}
+
+export function setFilteredSNSTopics(topics) {
+    filteredSNSTopics = topics;
+}
+
+export function setAllCloudFrontDistributions(distributions) {
+    allCloudFrontDistributions = distributions;
+}
+
+export function setFilteredCloudFrontDistributions(distributions) {
+    filteredCloudFrontDistributions = distributions;
+}
+
+export function setAllRoute53HostedZones(zones) {
+    allRoute53HostedZones = zones;
+}
+
+export function setFilteredRoute53HostedZones(zones) {
+    filteredRoute53HostedZones = zones;
+}
+
+export function setAllACMCertificates(certificates) {
+    allACMCertificates = certificates;
+}
+
+export function setFilteredACMCertificates(certificates) {
+    filteredACMCertificates = certificates;
+}
+
+export function setAllAPIGatewayAPIs(apis) {
+    allAPIGatewayAPIs = apis;
+}
+
+export function setFilteredAPIGatewayAPIs(apis) {
+    filteredAPIGatewayAPIs = apis;
+}
+
+export function setAPIGatewayDomains(domains) {
+    apiGatewayDomains = domains;
+}
+
+export function setAllStateMachines(stateMachines) {
+    allStateMachines = stateMachines;
+}
+
+export function setFilteredStateMachines(stateMachines) {
+    filteredStateMachines = stateMachines;
+}
+
+export function setAllElastiCacheClusters(clusters) {
+    allElastiCacheClusters = clusters;
+}
+
+export function setFilteredElastiCacheClusters(clusters) {
+    filteredElastiCacheClusters = clusters;
+}
+
+export function setAllOpenSearchDomains(domains) {
+    allOpenSearchDomains = domains;
+}
+
+export function setFilteredOpenSearchDomains(domains) {
+    filteredOpenSearchDomains = domains;
+}
+
+export function setAllMSKClusters(clusters) {
+    allMSKClusters = clusters;
+}
+
+export function setFilteredMSKClusters(clusters) {
+    filteredMSKClusters = clusters;
+}
+
+export function setAllCloudFormationStacks(stacks) {
+    allCloudFormationStacks = stacks;
+}
+
+export function setFilteredCloudFormationStacks(stacks) {
+    filteredCloudFormationStacks = stacks;
+}
+
+export function setAllKMSKeys(keys) {
+    allKMSKeys = keys;
+}
+
+export function setFilteredKMSKeys(keys) {
+    filteredKMSKeys = keys;
+}
+
+export function setAllSecrets(secrets) {
+    allSecrets = secrets;
+}
+
+export function setFilteredSecrets(secrets) {
+    filteredSecrets = secrets;
+}
@@ -0,0 +1,151 @@
+import * as state from './state.js';
+import { detailSidebar } from './detailSidebar.js';
+
+const EXECUTION_STATUSES = [
+    ['SUCCEEDED', 'var(--brand-success)'],
+    ['RUNNING', 'inherit'],
+    ['FAILED', 'var(--brand-danger)'],
+    ['TIMED_OUT', 'var(--brand-danger)'],
+    ['ABORTED', 'var(--brand-warning)']
+];
+
+// executionSummary renders the status counts of the recent executions
+function executionSummary(sm) {
+    if (sm.Type === 'EXPRESS') return 'Not listed for express';
+    if (!sm.SampledExecutions) return 'No executions';
+
+    const counts = sm.ExecutionCounts || {};
+    return EXECUTION_STATUSES
+        .filter(([status]) => counts[status])
+        .map(([status, color]) => `<span style="color: ${color};">${counts[status]} ${status.toLowerCase().replace('_', ' ')}</span>`)
+        .join(' · ');
+}
+
+export function createStateMachineCard(sm) {
+    const functions = (sm.LambdaARNs || []).map(arn => arn.split(':').slice(6).join(':'));
+
+    return `
+        <div class="vpc-card" data-id="${sm.ARN}" style="cursor: pointer;">
+            <div class="vpc-card-header">
+                <div class="vpc-card-title-row">
+                    <div class="vpc-card-title">🔀 ${sm.Name}</div>
+                    <span class="badge">${sm.Type}</span>
+                </div>
+                ${sm.Status && sm.Status !== 'ACTIVE' ? `<div style="margin-top: 4px;"><span class="badge">${sm.Status}</span></div>` : ''}
+            </div>
+
+            <div class="vpc-card-divider"></div>
+
+            <div class="vpc-card-body">
+                <div class="vpc-card-row">
+                    <span class="label">Last ${sm.SampledExecutions || ''} runs:</span>
+                    <span class="value">${executionSummary(sm)}</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">Last execution:</span>
+                    <span class="value font-mono">${sm.LastExecution || '-'}</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">Functions:</span>
+                    <span class="value font-mono text-xs">${functions.join('<br>') || '-'}</span>
+                </div>
+
+                <div class="vpc-card-row">
+                    <span class="label">Logging:</span>
+                    <span class="value">${sm.LoggingLevel || 'OFF'}${sm.TracingEnabled ? ' · X-Ray' : ''}</span>
+                </div>
+            </div>
+        </div>
+    `;
+}
+
+export function createStateMachineTableRow(sm) {
+    const counts = sm.ExecutionCounts || {};
+    const failed = (counts.FAILED || 0) + (counts.TIMED_OUT || 0);
+
+    return `
+        <tr>
+            <td><strong>${sm.Name}</strong></td>
+            <td>${sm.Type}</td>
+            <td>${sm.Status || '-'}</td>
+            <td class="font-mono">${sm.SampledExecutions || 0}</td>
+            <td class="font-mono">${counts.SUCCEEDED || 0}</td>
+            <td class="font-mono" style="color: ${failed ? 'var(--brand-danger)' : 'inherit'};">${failed}</td>
+            <td class="font-mono">${sm.LastExecution || '-'}</td>
+        </tr>
+    `;
+}
+
+export async function fetchStateMachines() {
+    try {
+        state.setCurrentPage('stepfunctions-list');
+        state.loadingBar.classList.remove('hidden');
+        state.statusText.textContent = 'Fetching State Machines...';
+        state.vpcGrid.innerHTML = '';
+        state.stepfunctionsTableBody.innerHTML = '';
+
+        const stateMachines = await window.go.core.App.GetStateMachines();
+        state.loadingBar.classList.add('hidden');
+
+        state.setAllStateMachines(stateMachines || []);
+        state.setFilteredStateMachines([...state.allStateMachines]);
+
+        renderStateMachines();
+    } catch (error) {
+        state.loadingBar.classList.add('hidden');
+        state.statusText.textContent = 'Error fetching state machines';
+        console.error(error);
+    }
+}
+
+export function renderStateMachines() {
+    if (state.filteredStateMachines.length === 0) {
+        state.statusText.textContent = 'No state machines found';
+        const emptyCard = `
+            <div class="vpc-card" data-empty="true" style="cursor: pointer;">
+                <div class="vpc-card-title">No State Machines</div>
+                <div class="vpc-card-info">No Step Functions state machines found in this region</div>
+            </div>
+        `;
+        state.vpcGrid.innerHTML = emptyCard;
+        state.stepfunctionsTableBody.innerHTML = `
+            <tr>
+                <td colspan="7" style="text-align: center; padding: 32px; color: var(--text-secondary);">
+                    No state machines found
+                </td>
+            </tr>
+        `;
+        return;
+    }
+
+    state.statusText.textContent = `${state.filteredStateMachines.length} state machine(s) found`;
+
+    if (state.currentView === 'cards') {
+        state.vpcGrid.innerHTML = state.filteredStateMachines.map(sm => createStateMachineCard(sm)).join('');
+    } else {
+        state.stepfunctionsTableBody.innerHTML = state.filteredStateMachines.map(sm => createStateMachineTableRow(sm)).join('');
+    }
+}
+
+export function initStepFunctionsListeners() {
+    if (!state.vpcGrid) return;
+
+    state.vpcGrid.addEventListener('click', (e) => {
+        if (state.currentPage !== 'stepfunctions-list') return;
+
+        const card = e.target.closest('.vpc-card');
+        if (card) {
+            if (card.dataset.empty === 'true') {
+                detailSidebar.open({ message: "Not found yet" });
+                return;
+            }
+            const id = card.dataset.id;
+            const sm = state.allStateMachines.find(s => s.ARN === id);
+            if (sm) {
+                detailSidebar.open(sm);
+            }
+        }
+    });
+}
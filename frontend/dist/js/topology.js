@@ -84,13 +84,26 @@ export function initTopology() {
             { selector: 'node[type="ec2"]', style: { 'background-color': 'data(bgColor)', 'shape': 'ellipse', 'border-width': 1, 'border-color': '#fff', 'width': '40px', 'height': '40px' } },
             { selector: 'node[type="rds"]', style: { 'background-color': 'data(bgColor)', 'shape': 'round-rectangle', 'width': '40px', 'height': '40px' } },
             { selector: 'node[type="lambda"]', style: { 'background-color': '#f85149', 'shape': 'triangle', 'width': '40px', 'height': '40px' } },
+            { selector: 'node[type="elasticache"]', style: { 'background-color': '#c93c37', 'shape': 'round-rectangle', 'width': '35px', 'height': '35px' } },
+            { selector: 'node[type="opensearch"]', style: { 'background-color': '#005eb8', 'shape': 'round-rectangle', 'width': '35px', 'height': '35px' } },
+            { selector: 'node[type="msk"]', style: { 'background-color': '#8957e5', 'shape': 'round-rectangle', 'width': '35px', 'height': '35px' } },
             { selector: 'node[type="loadbalancer"]', style: { 'background-color': '#58a6ff', 'shape': 'diamond', 'width': '40px', 'height': '40px' } },
             // New Network Components
             { selector: 'node[type="nat"]', style: { 'background-color': '#a371f7', 'shape': 'pentagon', 'width': '35px', 'height': '35px', 'label': 'NAT' } },
             { selector: 'node[type="rtb"]', style: { 'background-color': '#d29922', 'shape': 'round-tag', 'width': '30px', 'height': '30px', 'font-size': '9px' } },
             { selector: 'node[type="sg"]', style: { 'background-color': '#6e7681', 'shape': 'shield', 'width': '30px', 'height': '30px', 'font-size': '9px' } },
             { selector: 'node[type="s3"]', style: { 'background-color': '#ff9900', 'shape': 'barrel', 'width': '35px', 'height': '35px', 'label': 'data(label)' } },
+            { selector: 'node[type="igw"]', style: { 'background-color': '#3fb950', 'shape': 'hexagon', 'width': '35px', 'height': '35px', 'font-size': '9px' } },
+            { selector: 'node[type="vpce"]', style: { 'background-color': '#39c5cf', 'shape': 'round-rectangle', 'width': '25px', 'height': '25px', 'font-size': '9px' } },
+            { selector: 'node[type="tgw"]', style: { 'background-color': '#db61a2', 'shape': 'octagon', 'width': '45px', 'height': '45px' } },
+            { selector: 'node[type="vpn"]', style: { 'background-color': '#f0883e', 'shape': 'vee', 'width': '35px', 'height': '35px', 'font-size': '9px' } },
+            { selector: 'node[type="external"]', style: { 'background-color': '#6e7681', 'shape': 'round-rectangle', 'border-style': 'dashed', 'border-width': 1, 'border-color': '#8b949e', 'font-size': '9px' } },
             { selector: 'edge', style: { 'width': 2, 'line-color': '#8b949e', 'target-arrow-color': '#8b949e', 'target-arrow-shape': 'triangle', 'curve-style': 'bezier', 'opacity': 0.7 } },
+            { selector: 'edge[kind="route"]', style: { 'width': 1, 'line-style': 'dashed', 'label': 'data(label)', 'font-size': '8px', 'color': '#8b949e', 'text-rotation': 'autorotate' } },
+            { selector: 'edge[kind="peering"]', style: { 'line-color': '#a371f7', 'target-arrow-shape': 'none', 'width': 3 } },
+            { selector: 'edge[kind="tgw"]', style: { 'line-color': '#db61a2', 'target-arrow-shape': 'none', 'width': 3 } },
+            { selector: 'edge[kind="vpn"]', style: { 'line-color': '#f0883e', 'target-arrow-shape': 'none' } },
+            { selector: 'edge[state="blackhole"]', style: { 'line-color': '#f85149', 'target-arrow-color': '#f85149' } },
             { selector: ':selected', style: { 'border-width': 2, 'border-color': '#e6edf3', 'border-style': 'solid' } }
         ],
         layout: { name: 'preset' }, // ABSOLUTELY NO PHYSICS
@@ -110,9 +123,17 @@ export function initTopology() {
             if (node.data('type') === 'ec2') tooltipContent += `IP: ${data.PrivateIpAddress || data.PrivateIPAddress || 'N/A'}`;
             if (node.data('type') === 'nat') tooltipContent += `Public IP: ${data.PublicIP || 'N/A'}`;
             if (node.data('type') === 'rtb') tooltipContent += `Routes: ${data.Routes || 0}`;
+            if (node.data('type') === 'igw') tooltipContent += `State: ${data.State || 'N/A'}`;
+            if (node.data('type') === 'vpce') tooltipContent += `${data.Type || ''} ${data.ServiceName || ''}`;
+            if (node.data('type') === 'tgw') tooltipContent += `Attachments: ${(data.attachments || []).length}`;
+            if (node.data('type') === 'vpn') tooltipContent += `Tunnels up: ${(data.Tunnels || []).filter(t => t.Status === 'UP').length}/${(data.Tunnels || []).length}`;
+            if (node.data('type') === 'external') tooltipContent += `Owner: ${data.ownerId || 'N/A'}`;
             if (node.data('type') === 'sg') tooltipContent += `Desc: ${data.Description || ''}`;
             if (node.data('type') === 'rds') tooltipContent += `Status: ${data.DBInstanceStatus || 'N/A'}`;
             if (node.data('type') === 'lambda') tooltipContent += `Runtime: ${data.Runtime || 'N/A'}`;
+            if (node.data('type') === 'elasticache') tooltipContent += `${data.Engine} ${data.EngineVersion || ''}, ${data.NodeCount} × ${data.NodeType}`;
+            if (node.data('type') === 'opensearch') tooltipContent += `${data.EngineVersion}, ${data.InstanceCount} × ${data.InstanceType}`;
+            if (node.data('type') === 'msk') tooltipContent += data.Type === 'SERVERLESS' ? 'Serverless' : `Kafka ${data.KafkaVersion}, ${data.BrokerCount} brokers`;
             if (node.data('type') === 'loadbalancer') tooltipContent += `Type: ${data.Type || 'N/A'}`;
         }
 
@@ -435,10 +456,156 @@ export function renderTopology() {
         });
     }
 
-    console.log(`Built ${nodes.length} nodes`);
+    // 11. Data tier: ElastiCache, OpenSearch and MSK, placed in their first subnet drawn
+    const dataTier = [
+        ['elasticache', state.allElastiCacheClusters, c => c.ID],
+        ['opensearch', state.allOpenSearchDomains, d => d.DomainName],
+        ['msk', state.allMSKClusters, c => c.Name]
+    ];
+    dataTier.forEach(([type, resources, label]) => {
+        (resources || []).forEach(resource => {
+            const id = getId(resource.ARN);
+            if (!id || createdIds.has(id)) return;
+
+            const node = { group: 'nodes', data: { id: id, label: label(resource), type: type, fullData: resource } };
+            const subnetId = (resource.SubnetIDs || []).map(getId).find(subId => subId && createdIds.has(subId));
+            const vpcId = getId(resource.VPCID);
+            if (subnetId) {
+                node.data.parent = subnetId;
+            } else if (vpcId && createdIds.has(vpcId)) {
+                node.data.parent = vpcId;
+            }
+            nodes.push(node);
+            createdIds.add(id);
+        });
+    });
+
+    // 12. Connectivity: internet gateways, endpoints, transit gateways, peerings and VPNs
+    const edges = [];
+    const conn = state.vpcConnectivity;
+    // Route targets (pcx-, tgw-...) mapped to the node they lead to
+    const targetNodes = new Map();
+
+    if (conn) {
+        (conn.internet_gateways || []).forEach(igw => {
+            const id = getId(igw.ID);
+            if (!id || createdIds.has(id)) return;
+            const node = { group: 'nodes', data: { id: id, label: igw.Name || 'IGW', type: 'igw', fullData: igw } };
+            const vpcId = getId(igw.VPCID);
+            if (vpcId && createdIds.has(vpcId)) {
+                node.data.parent = vpcId;
+            }
+            nodes.push(node);
+            createdIds.add(id);
+        });
+
+        (conn.endpoints || []).forEach(ep => {
+            const id = getId(ep.ID);
+            if (!id || createdIds.has(id)) return;
+            // com.amazonaws.us-east-1.s3 -> s3
+            const service = (ep.ServiceName || '').split('.').pop();
+            const node = { group: 'nodes', data: { id: id, label: ep.Name || service || 'Endpoint', type: 'vpce', fullData: ep } };
+            const vpcId = getId(ep.VPCID);
+            if (vpcId && createdIds.has(vpcId)) {
+                node.data.parent = vpcId;
+            }
+            nodes.push(node);
+            createdIds.add(id);
+        });
+
+        // Peer VPCs from other accounts or regions are drawn as external nodes
+        const ensureVPCNode = (vpcId, ownerId, region) => {
+            if (!vpcId || createdIds.has(vpcId)) return;
+            nodes.push({ group: 'nodes', data: { id: vpcId, label: `${vpcId}\n${region || ''}`, type: 'external', fullData: { vpcId, ownerId, region } } });
+            createdIds.add(vpcId);
+        };
+
+        (conn.peerings || []).forEach(pcx => {
+            const requester = getId(pcx.RequesterVPCID);
+            const accepter = getId(pcx.AccepterVPCID);
+            if (!requester || !accepter) return;
+            ensureVPCNode(requester, pcx.RequesterOwnerID, pcx.RequesterRegion);
+            ensureVPCNode(accepter, pcx.AccepterOwnerID, pcx.AccepterRegion);
+            edges.push({ group: 'edges', data: { id: pcx.ID, source: requester, target: accepter, kind: 'peering', fullData: pcx } });
+            targetNodes.set(pcx.ID, { requester, accepter });
+        });
+
+        const tgws = new Map();
+        (conn.transit_gateway_attachments || []).forEach(att => {
+            const tgwId = getId(att.TransitGatewayID);
+            if (!tgwId) return;
+            if (!tgws.has(tgwId)) tgws.set(tgwId, []);
+            tgws.get(tgwId).push(att);
+        });
+        tgws.forEach((attachments, tgwId) => {
+            nodes.push({ group: 'nodes', data: { id: tgwId, label: tgwId, type: 'tgw', fullData: { id: tgwId, attachments } } });
+            createdIds.add(tgwId);
+            attachments.forEach(att => {
+                const vpcId = getId(att.VPCID);
+                if (vpcId && createdIds.has(vpcId)) {
+                    edges.push({ group: 'edges', data: { id: att.ID, source: vpcId, target: tgwId, kind: 'tgw', fullData: att } });
+                }
+            });
+        });
+
+        (conn.vpn_connections || []).forEach(vpn => {
+            const id = getId(vpn.ID);
+            if (!id || createdIds.has(id)) return;
+            nodes.push({ group: 'nodes', data: { id: id, label: vpn.Name || 'VPN', type: 'vpn', fullData: vpn } });
+            createdIds.add(id);
+            // A VPN terminates on a transit gateway or on the virtual private gateway of a VPC
+            const peer = getId(vpn.TransitGatewayID) || getId(vpn.VPCID);
+            if (peer && createdIds.has(peer)) {
+                edges.push({ group: 'edges', data: { id: `${id}_${peer}`, source: id, target: peer, kind: 'vpn', fullData: vpn } });
+            }
+            const vgw = getId(vpn.VPNGatewayID);
+            if (vgw && getId(vpn.VPCID)) {
+                targetNodes.set(vgw, id);
+            }
+        });
+    }
+
+    // Route edges: each route table node points to the gateways its routes go through
+    if (state.allRouteTables) {
+        state.allRouteTables.forEach(rtb => {
+            const rawID = getId(rtb.ID);
+            const vpcId = getId(rtb.VPCID);
+            const rtbNodes = (rtb.SubnetIDs || []).map(sid => `${rawID}_${getId(sid)}`).filter(id => createdIds.has(id));
+            if (rtbNodes.length === 0 && createdIds.has(rawID)) rtbNodes.push(rawID);
+
+            (rtb.RouteEntries || []).forEach(route => {
+                if (route.TargetType === 'local') return;
+                let target = getId(route.TargetID);
+                if (route.TargetType === 'vpc-peering') {
+                    const pcx = targetNodes.get(target);
+                    target = pcx ? (pcx.requester === vpcId ? pcx.accepter : pcx.requester) : null;
+                } else if (targetNodes.has(target)) {
+                    target = targetNodes.get(target);
+                }
+                if (!target || !createdIds.has(target)) return;
+
+                rtbNodes.forEach(source => {
+                    edges.push({
+                        group: 'edges',
+                        data: {
+                            id: `${source}_${route.Destination}_${target}`,
+                            source: source,
+                            target: target,
+                            kind: 'route',
+                            label: route.Destination,
+                            state: route.State
+                        }
+                    });
+                });
+            });
+        });
+    }
+
+    console.log(`Built ${nodes.length} nodes and ${edges.length} edges`);
 
     cy.elements().remove();
     cy.add(nodes);
+    cy.add(edges);
 
     // COSE LAYOUT (Calculated physically but rendered statically)
     // This handles compound node sizing and separation better than breadthfirst
@@ -510,14 +677,20 @@ export async function showTopology() {
             throw new Error('Wails backend not available. Make sure the app is running.');
         }
 
-        const [vpcs, subnets, ec2s, rdss, lambdas, lbs, s3s] = await Promise.all([
+        const [vpcs, subnets, ec2s, rdss, lambdas, lbs, s3s, nats, rtbs, connectivity, caches, searchDomains, kafkas] = await Promise.all([
             window.go.core.App.GetVPCs().catch(e => { console.error('VPC fetch error:', e); return []; }),
             window.go.core.App.GetSubnets().catch(e => { console.error('Subnet fetch error:', e); return []; }),
             window.go.core.App.GetEC2Instances().catch(e => { console.error('EC2 fetch error:', e); return []; }),
             window.go.core.App.GetRDSInstances().catch(e => { console.error('RDS fetch error:', e); return []; }),
             window.go.core.App.GetLambdaFunctions().catch(e => { console.error('Lambda fetch error:', e); return []; }),
             window.go.core.App.GetLoadBalancers().catch(e => { console.error('LB fetch error:', e); return []; }),
-            window.go.core.App.GetS3Buckets().catch(e => { console.error('S3 fetch error:', e); return []; })
+            window.go.core.App.GetS3Buckets().catch(e => { console.error('S3 fetch error:', e); return []; }),
+            window.go.core.App.GetNATGateways().catch(e => { console.error('NAT fetch error:', e); return []; }),
+            window.go.core.App.GetRouteTables().catch(e => { console.error('Route table fetch error:', e); return []; }),
+            window.go.core.App.GetVPCConnectivity().catch(e => { console.error('Connectivity fetch error:', e); return null; }),
+            window.go.core.App.GetElastiCacheClusters().catch(e => { console.error('ElastiCache fetch error:', e); return []; }),
+            window.go.core.App.GetOpenSearchDomains().catch(e => { console.error('OpenSearch fetch error:', e); return []; }),
+            window.go.core.App.GetMSKClusters().catch(e => { console.error('MSK fetch error:', e); return []; })
         ]);
 
         console.log('Raw data received:');
@@ -532,6 +705,13 @@ export async function showTopology() {
         state.setAllLambdaFunctions(lambdas || []);
         state.setAllLoadBalancers(lbs || []);
         state.setAllS3Buckets(s3s || []);
+        state.setAllNATGateways(nats || []);
+        state.setAllRouteTables(rtbs || []);
+        state.setVPCConnectivity(connectivity);
+        state.setAllElastiCacheClusters(caches || []);
+        state.setAllOpenSearchDomains(searchDomains || []);
+        state.setAllMSKClusters(kafkas || []);
+        (connectivity?.errors || []).forEach(e => console.warn('Connectivity:', e));
 
         console.log('Data fetched successfully:');
         console.log('VPCs:', state.allVPCs.length);
@@ -548,7 +728,8 @@ export async function showTopology() {
         if (statusText) {
             const totalNodes = state.allVPCs.length + state.allSubnets.length +
                 state.allEC2Instances.length + state.allRDSInstances.length +
-                state.allLambdaFunctions.length + state.allLoadBalancers.length;
+                state.allLambdaFunctions.length + state.allLoadBalancers.length +
+                state.allElastiCacheClusters.length + state.allOpenSearchDomains.length + state.allMSKClusters.length;
             statusText.textContent = `Topology: ${totalNodes} resources`;
         }
     } catch (error) {
@@ -102,6 +102,78 @@ function getGroupByOptionsForPage(page) {
             { value: 'Engine', label: 'Engine' },
             { value: 'DBInstanceStatus', label: 'Status' },
             { value: 'AvailabilityZone', label: 'Availability Zone' }
+        ],
+        'ecr-list': [
+            { value: 'ScanOnPush', label: 'Scan on Push' },
+            { value: 'LifecyclePolicy', label: 'Lifecycle Policy' },
+            { value: 'TagImmutability', label: 'Tag Immutability' }
+        ],
+        'eks-list': [
+            { value: 'Version', label: 'Version' },
+            { value: 'SupportStatus', label: 'Support Status' },
+            { value: 'VPCID', label: 'VPC ID' }
+        ],
+        'dynamodb-list': [
+            { value: 'BillingMode', label: 'Billing Mode' },
+            { value: 'TableClass', label: 'Table Class' },
+            { value: 'Status', label: 'Status' }
+        ],
+        'sqs-list': [
+            { value: 'Encryption', label: 'Encryption' },
+            { value: 'DeadLetterQueue', label: 'Dead-Letter Queue' }
+        ],
+        'sns-list': [
+            { value: 'FIFO', label: 'FIFO' }
+        ],
+        'cloudfront-list': [
+            { value: 'PriceClass', label: 'Price Class' },
+            { value: 'Status', label: 'Status' },
+            { value: 'Enabled', label: 'Enabled' }
+        ],
+        'route53-list': [
+            { value: 'Private', label: 'Private' }
+        ],
+        'acm-list': [
+            { value: 'Status', label: 'Status' },
+            { value: 'Type', label: 'Type' },
+            { value: 'Region', label: 'Region' }
+        ],
+        'apigateway-list': [
+            { value: 'Protocol', label: 'Protocol' },
+            { value: 'EndpointType', label: 'Endpoint Type' }
+        ],
+        'stepfunctions-list': [
+            { value: 'Type', label: 'Type' },
+            { value: 'Status', label: 'Status' }
+        ],
+        'elasticache-list': [
+            { value: 'Engine', label: 'Engine' },
+            { value: 'Status', label: 'Status' },
+            { value: 'VPCID', label: 'VPC' }
+        ],
+        'opensearch-list': [
+            { value: 'EngineVersion', label: 'Engine Version' },
+            { value: 'Status', label: 'Status' },
+            { value: 'VPCID', label: 'VPC' }
+        ],
+        'msk-list': [
+            { value: 'Type', label: 'Type' },
+            { value: 'State', label: 'State' },
+            { value: 'VPCID', label: 'VPC' }
+        ],
+        'cloudformation-list': [
+            { value: 'Status', label: 'Status' },
+            { value: 'DriftStatus', label: 'Drift' }
+        ],
+        'kms-list': [
+            { value: 'State', label: 'State' },
+            { value: 'KeySpec', label: 'Key spec' },
+            { value: 'RotationEnabled', label: 'Rotation' }
+        ],
+        'secretsmanager-list': [
+            { value: 'RotationEnabled', label: 'Rotation' },
+            { value: 'KMSKeyID', label: 'KMS key' },
+            { value: 'Stale', label: 'Stale' }
         ]
     };
     return map[page] || [];
@@ -173,7 +245,23 @@ export function switchView(viewName) {
         'route-list',
         'target-group-list',
         'lb-list',
-        'elasticip-list'
+        'elasticip-list',
+        'ecr-list',
+        'eks-list',
+        'dynamodb-list',
+        'sqs-list',
+        'sns-list',
+        'cloudfront-list',
+        'route53-list',
+        'acm-list',
+        'apigateway-list',
+        'stepfunctions-list',
+        'elasticache-list',
+        'opensearch-list',
+        'msk-list',
+        'cloudformation-list',
+        'kms-list',
+        'secretsmanager-list'
     ];
 
     if (cardViewPages.includes(state.currentPage) && state.currentView === 'cards') {
@@ -222,6 +310,54 @@ export function switchView(viewName) {
         case 'rds-list':
             state.rdsTableContainer.classList.remove('hidden');
             break;
+        case 'ecr-list':
+            state.ecrTableContainer.classList.remove('hidden');
+            break;
+        case 'eks-list':
+            state.eksTableContainer.classList.remove('hidden');
+            break;
+        case 'dynamodb-list':
+            state.dynamodbTableContainer.classList.remove('hidden');
+            break;
+        case 'sqs-list':
+            state.sqsTableContainer.classList.remove('hidden');
+            break;
+        case 'sns-list':
+            state.snsTableContainer.classList.remove('hidden');
+            break;
+        case 'cloudfront-list':
+            state.cloudfrontTableContainer.classList.remove('hidden');
+            break;
+        case 'route53-list':
+            state.route53TableContainer.classList.remove('hidden');
+            break;
+        case 'acm-list':
+            state.acmTableContainer.classList.remove('hidden');
+            break;
+        case 'apigateway-list':
+            state.apigatewayTableContainer.classList.remove('hidden');
+            break;
+        case 'stepfunctions-list':
+            state.stepfunctionsTableContainer.classList.remove('hidden');
+            break;
+        case 'elasticache-list':
+            state.elasticacheTableContainer.classList.remove('hidden');
+            break;
+        case 'opensearch-list':
+            state.opensearchTableContainer.classList.remove('hidden');
+            break;
+        case 'msk-list':
+            state.mskTableContainer.classList.remove('hidden');
+            break;
+        case 'cloudformation-list':
+            state.cloudformationTableContainer.classList.remove('hidden');
+            break;
+        case 'kms-list':
+            state.kmsTableContainer.classList.remove('hidden');
+            break;
+        case 'secretsmanager-list':
+            state.secretsmanagerTableContainer.classList.remove('hidden');
+            break;
         default:
             // Fallback
             console.warn(`Unknown view: ${state.currentPage}`);
@@ -82,6 +82,54 @@ export const WindowManager = {
             case 'rds':
                 setCurrentPage('rds-list');
                 break;
+            case 'ecr':
+                setCurrentPage('ecr-list');
+                break;
+            case 'eks':
+                setCurrentPage('eks-list');
+                break;
+            case 'dynamodb':
+                setCurrentPage('dynamodb-list');
+                break;
+            case 'sqs':
+                setCurrentPage('sqs-list');
+                break;
+            case 'sns':
+                setCurrentPage('sns-list');
+                break;
+            case 'cloudfront':
+                setCurrentPage('cloudfront-list');
+                break;
+            case 'route53':
+                setCurrentPage('route53-list');
+                break;
+            case 'acm':
+                setCurrentPage('acm-list');
+                break;
+            case 'apigateway':
+                setCurrentPage('apigateway-list');
+                break;
+            case 'stepfunctions':
+                setCurrentPage('stepfunctions-list');
+                break;
+            case 'elasticache':
+                setCurrentPage('elasticache-list');
+                break;
+            case 'opensearch':
+                setCurrentPage('opensearch-list');
+                break;
+            case 'msk':
+                setCurrentPage('msk-list');
+                break;
+            case 'cloudformation':
+                setCurrentPage('cloudformation-list');
+                break;
+            case 'kms':
+                setCurrentPage('kms-list');
+                break;
+            case 'secretsmanager':
+                setCurrentPage('secretsmanager-list');
+                break;
             case 'playground':
                 setCurrentPage('playground');
                 break;
@@ -165,6 +213,70 @@ export const WindowManager = {
                     const { fetchRDSInstances } = await import('./rds.js');
                     await fetchRDSInstances();
                     break;
+                case 'ecr':
+                    const { fetchECRRepositories } = await import('./ecr.js');
+                    await fetchECRRepositories();
+                    break;
+                case 'eks':
+                    const { fetchEKSClusters } = await import('./eks.js');
+                    await fetchEKSClusters();
+                    break;
+                case 'dynamodb':
+                    const { fetchDynamoDBTables } = await import('./dynamodb.js');
+                    await fetchDynamoDBTables();
+                    break;
+                case 'sqs':
+                    const { fetchSQSQueues } = await import('./sqs.js');
+                    await fetchSQSQueues();
+                    break;
+                case 'sns':
+                    const { fetchSNSTopics } = await import('./sns.js');
+                    await fetchSNSTopics();
+                    break;
+                case 'cloudfront':
+                    const { fetchCloudFrontDistributions } = await import('./cloudfront.js');
+                    await fetchCloudFrontDistributions();
+                    break;
+                case 'route53':
+                    const { fetchRoute53HostedZones } = await import('./route53.js');
+                    await fetchRoute53HostedZones();
+                    break;
+                case 'acm':
+                    const { fetchACMCertificates } = await import('./acm.js');
+                    await fetchACMCertificates();
+                    break;
+                case 'apigateway':
+                    const { fetchAPIGatewayAPIs } = await import('./apigateway.js');
+                    await fetchAPIGatewayAPIs();
+                    break;
+                case 'stepfunctions':
+                    const { fetchStateMachines } = await import('./stepfunctions.js');
+                    await fetchStateMachines();
+                    break;
+                case 'elasticache':
+                    const { fetchElastiCacheClusters } = await import('./elasticache.js');
+                    await fetchElastiCacheClusters();
+                    break;
+                case 'opensearch':
+                    const { fetchOpenSearchDomains } = await import('./opensearch.js');
+                    await fetchOpenSearchDomains();
+                    break;
+                case 'msk':
+                    const { fetchMSKClusters } = await import('./msk.js');
+                    await fetchMSKClusters();
+                    break;
+                case 'cloudformation':
+                    const { fetchCloudFormationStacks } = await import('./cloudformation.js');
+                    await fetchCloudFormationStacks();
+                    break;
+                case 'kms':
+                    const { fetchKMSKeys } = await import('./kms.js');
+                    await fetchKMSKeys();
+                    break;
+                case 'secretsmanager':
+                    const { fetchSecrets } = await import('./secretsmanager.js');
+                    await fetchSecrets();
+                    break;
                 case 'playground':
                     const { showPlayground } = await import('./playground.js');
                     await showPlayground();
@@ -4,46 +4,182 @@ import {models} from '../models';
 
 export function CheckCredentials():Promise<boolean>;
 
+export function DetectStackDrift(arg1:string):Promise<string>;
+
 export function GenerateTerraform(arg1:string):Promise<string>;
 
+export function GetACMCertificates():Promise<Array<models.ACMCertificateInfo>>;
+
+export function GetAPIGatewayAPIDetail(arg1:string,arg2:string):Promise<models.APIGatewayAPIDetail>;
+
+export function GetAPIGatewayAPIs():Promise<Array<models.APIGatewayAPIInfo>>;
+
+export function GetAPIGatewayDomains():Promise<Array<models.APIGatewayDomainInfo>>;
+
 export function GetAccountHomeInfo():Promise<models.AccountHomeInfo>;
 
+export function GetAlarmHistory(arg1:string):Promise<Array<models.AlarmHistoryItem>>;
+
+export function GetAlarms(arg1:string):Promise<Array<models.AlarmInfo>>;
+
+export function GetAutoScalingGroups():Promise<Array<models.AutoScalingGroupInfo>>;
+
+export function GetCloudFormationStackResources(arg1:string):Promise<Array<models.CloudFormationResourceInfo>>;
+
+export function GetCloudFormationStacks():Promise<Array<models.CloudFormationStackInfo>>;
+
+export function GetCloudFrontDistributions():Promise<Array<models.CloudFrontDistributionInfo>>;
+
 export function GetConfiguration():Promise<models.ConfigurationInfo>;
 
+export function GetDanglingDNSRecords():Promise<Array<models.SecurityFinding>>;
+
+export function GetDynamoDBTables():Promise<Array<models.DynamoDBTableInfo>>;
+
+export function GetEBSSnapshots():Promise<Array<models.EBSSnapshotInfo>>;
+
+export function GetEBSStorageReport():Promise<models.EBSStorageReport>;
+
+export function GetEBSVolumes():Promise<Array<models.EBSVolumeInfo>>;
+
 export function GetEC2Instances():Promise<Array<models.EC2InstanceInfo>>;
 
+export function GetECRImageScanFindings(arg1:string,arg2:string):Promise<Array<models.ECRScanFinding>>;
+
+export function GetECRImageUsage():Promise<models.ECRImageUsageReport>;
+
+export function GetECRImages(arg1:string):Promise<Array<models.ECRImageInfo>>;
+
+export function GetECRRepositories():Promise<Array<models.ECRRepositoryInfo>>;
+
 export function GetECSClusters():Promise<Array<models.ECSClusterInfo>>;
 
 export function GetECSMetrics(arg1:string,arg2:number):Promise<models.ResourceMetrics>;
 
+export function GetECSServiceMetrics(arg1:string,arg2:string,arg3:number):Promise<models.ResourceMetrics>;
+
+export function GetECSServices(arg1:string):Promise<Array<models.ECSServiceInfo>>;
+
+export function GetECSTaskDefinition(arg1:string):Promise<models.ECSTaskDefinitionInfo>;
+
+export function GetECSTasks(arg1:string,arg2:string):Promise<Array<models.ECSTaskInfo>>;
+
+export function GetEKSClusterDetail(arg1:string):Promise<models.EKSClusterDetail>;
+
+export function GetEKSClusters():Promise<Array<models.EKSClusterInfo>>;
+
+export function GetEKSVersionCalendar():Promise<Array<models.EKSVersionSupport>>;
+
+export function GetElastiCacheClusters():Promise<Array<models.ElastiCacheClusterInfo>>;
+
 export function GetElasticIPs():Promise<Array<models.ElasticIPInfo>>;
 
+export function GetExpiringCertificates(arg1:number):Promise<Array<models.SecurityFinding>>;
+
+export function GetFeatureAvailability():Promise<Array<models.FeatureAvailability>>;
+
+export function GetFlowLogFindings():Promise<Array<models.SecurityFinding>>;
+
+export function GetFlowLogSummary(arg1:string,arg2:string):Promise<models.FlowLogSummary>;
+
+export function GetFlowLogs():Promise<Array<models.FlowLogInfo>>;
+
+export function GetIAMHygiene(arg1:models.IAMHygieneRequest):Promise<models.IAMHygieneReport>;
+
+export function GetInstanceStatus(arg1:string):Promise<models.InstanceStatusInfo>;
+
+export function GetInstanceStatuses():Promise<Array<models.InstanceStatusInfo>>;
+
+export function GetKMSKeys():Promise<Array<models.KMSKeyInfo>>;
+
+export function GetLambdaFunctionDetail(arg1:string):Promise<models.LambdaFunctionDetail>;
+
 export function GetLambdaFunctions():Promise<Array<models.LambdaFunctionInfo>>;
 
+export function GetLambdaInvokers(arg1:string):Promise<Array<models.LambdaInvokerInfo>>;
+
+export function GetLaunchTemplateVersions(arg1:string):Promise<Array<models.LaunchTemplateVersionInfo>>;
+
+export function GetLaunchTemplates():Promise<Array<models.LaunchTemplateInfo>>;
+
 export function GetLoadBalancers():Promise<Array<models.LoadBalancerInfo>>;
 
+export function GetLogGroups(arg1:string):Promise<Array<models.LogGroupInfo>>;
+
+export function GetLogStreams(arg1:string):Promise<Array<models.LogStreamInfo>>;
+
+export function GetMSKClusters():Promise<Array<models.MSKClusterInfo>>;
+
 export function GetNATGateways():Promise<Array<models.NATGatewayInfo>>;
 
+export function GetNetworkInterfaces():Promise<Array<models.NetworkInterfaceInfo>>;
+
+export function GetOpenSearchDomains():Promise<Array<models.OpenSearchDomainInfo>>;
+
+export function GetPolicyAnalysis():Promise<models.PolicyAnalysis>;
+
+export function GetRDSClusters():Promise<Array<models.RDSClusterInfo>>;
+
+export function GetRDSEvents(arg1:string,arg2:string):Promise<Array<models.RDSEventInfo>>;
+
 export function GetRDSInstances():Promise<Array<models.RDSInstanceInfo>>;
 
+export function GetRDSParameterGroups():Promise<Array<models.RDSParameterGroupInfo>>;
+
+export function GetRDSPendingMaintenance():Promise<Array<models.RDSPendingMaintenanceInfo>>;
+
+export function GetRDSSnapshots():Promise<Array<models.RDSSnapshotInfo>>;
+
+export function GetResourceAlarms(arg1:string,arg2:string):Promise<Array<models.AlarmInfo>>;
+
+export function GetResourceMetrics(arg1:string,arg2:string,arg3:string):Promise<models.ResourceMetrics>;
+
+export function GetRoute53HostedZones():Promise<Array<models.Route53HostedZoneInfo>>;
+
+export function GetRoute53Records(arg1:string):Promise<Array<models.Route53RecordInfo>>;
+
 export function GetRouteTables():Promise<Array<models.RouteTableInfo>>;
 
 export function GetS3Buckets():Promise<Array<models.S3BucketInfo>>;
 
+export function GetSNSTopics():Promise<Array<models.SNSTopicInfo>>;
+
+export function GetSQSQueues():Promise<Array<models.SQSQueueInfo>>;
+
+export function GetScalingActivities(arg1:string):Promise<Array<models.ScalingActivityInfo>>;
+
+export function GetSecrets():Promise<Array<models.SecretInfo>>;
+
 export function GetSecurityGroups():Promise<Array<models.SecurityGroupInfo>>;
 
+export function GetStackOwnership():Promise<models.StackOwnershipIndex>;
+
+export function GetStateMachines():Promise<Array<models.StateMachineInfo>>;
+
 export function GetSubnets():Promise<Array<models.SubnetInfo>>;
 
 export function GetTargetGroups():Promise<Array<models.TargetGroupInfo>>;
 
+export function GetVPCConnectivity():Promise<models.VPCConnectivity>;
+
 export function GetVPCs():Promise<Array<models.VPCInfo>>;
 
 export function Logout():Promise<void>;
 
+export function LookupIP(arg1:string):Promise<models.IPLookupResult>;
+
+export function QueryResourceMetrics(arg1:models.MetricRequest):Promise<models.ResourceMetrics>;
+
+export function RunLogsInsightsQuery(arg1:models.LogsInsightsRequest):Promise<models.LogsInsightsResult>;
+
 export function SaveAWSCredentials(arg1:string,arg2:string,arg3:string):Promise<void>;
 
 export function SaveTerraformFile(arg1:string):Promise<string>;
 
+export function StartLogTail(arg1:models.LogTailRequest):Promise<string>;
+
+export function StopLogTail(arg1:string):Promise<void>;
+
 export function TestAWSConnection(arg1:string,arg2:string,arg3:string):Promise<void>;
 
-export function VerifyPermissions():Promise<Array<models.PermissionStatus>>;
+export function VerifyPermissions():Promise<Array<models.FeaturePermissions>>;
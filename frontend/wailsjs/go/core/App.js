@@ -6,22 +6,102 @@ export function CheckCredentials() {
   return window['go']['core']['App']['CheckCredentials']();
 }
 
+export function DetectStackDrift(arg1) {
+  return window['go']['core']['App']['DetectStackDrift'](arg1);
+}
+
 export function GenerateTerraform(arg1) {
   return window['go']['core']['App']['GenerateTerraform'](arg1);
 }
 
+export function GetACMCertificates() {
+  return window['go']['core']['App']['GetACMCertificates']();
+}
+
+export function GetAPIGatewayAPIDetail(arg1, arg2) {
+  return window['go']['core']['App']['GetAPIGatewayAPIDetail'](arg1, arg2);
+}
+
+export function GetAPIGatewayAPIs() {
+  return window['go']['core']['App']['GetAPIGatewayAPIs']();
+}
+
+export function GetAPIGatewayDomains() {
+  return window['go']['core']['App']['GetAPIGatewayDomains']();
+}
+
 export function GetAccountHomeInfo() {
   return window['go']['core']['App']['GetAccountHomeInfo']();
 }
 
+export function GetAlarmHistory(arg1) {
+  return window['go']['core']['App']['GetAlarmHistory'](arg1);
+}
+
+export function GetAlarms(arg1) {
+  return window['go']['core']['App']['GetAlarms'](arg1);
+}
+
+export function GetAutoScalingGroups() {
+  return window['go']['core']['App']['GetAutoScalingGroups']();
+}
+
+export function GetCloudFormationStackResources(arg1) {
+  return window['go']['core']['App']['GetCloudFormationStackResources'](arg1);
+}
+
+export function GetCloudFormationStacks() {
+  return window['go']['core']['App']['GetCloudFormationStacks']();
+}
+
+export function GetCloudFrontDistributions() {
+  return window['go']['core']['App']['GetCloudFrontDistributions']();
+}
+
 export function GetConfiguration() {
   return window['go']['core']['App']['GetConfiguration']();
 }
 
+export function GetDanglingDNSRecords() {
+  return window['go']['core']['App']['GetDanglingDNSRecords']();
+}
+
+export function GetDynamoDBTables() {
+  return window['go']['core']['App']['GetDynamoDBTables']();
+}
+
+export function GetEBSSnapshots() {
+  return window['go']['core']['App']['GetEBSSnapshots']();
+}
+
+export function GetEBSStorageReport() {
+  return window['go']['core']['App']['GetEBSStorageReport']();
+}
+
+export function GetEBSVolumes() {
+  return window['go']['core']['App']['GetEBSVolumes']();
+}
+
 export function GetEC2Instances() {
   return window['go']['core']['App']['GetEC2Instances']();
 }
 
+export function GetECRImageScanFindings(arg1, arg2) {
+  return window['go']['core']['App']['GetECRImageScanFindings'](arg1, arg2);
+}
+
+export function GetECRImageUsage() {
+  return window['go']['core']['App']['GetECRImageUsage']();
+}
+
+export function GetECRImages(arg1) {
+  return window['go']['core']['App']['GetECRImages'](arg1);
+}
+
+export function GetECRRepositories() {
+  return window['go']['core']['App']['GetECRRepositories']();
+}
+
 export function GetECSClusters() {
   return window['go']['core']['App']['GetECSClusters']();
 }
@@ -30,26 +110,170 @@ export function GetECSMetrics(arg1, arg2) {
   return window['go']['core']['App']['GetECSMetrics'](arg1, arg2);
 }
 
+export function GetECSServiceMetrics(arg1, arg2, arg3) {
+  return window['go']['core']['App']['GetECSServiceMetrics'](arg1, arg2, arg3);
+}
+
+export function GetECSServices(arg1) {
+  return window['go']['core']['App']['GetECSServices'](arg1);
+}
+
+export function GetECSTaskDefinition(arg1) {
+  return window['go']['core']['App']['GetECSTaskDefinition'](arg1);
+}
+
+export function GetECSTasks(arg1, arg2) {
+  return window['go']['core']['App']['GetECSTasks'](arg1, arg2);
+}
+
+export function GetEKSClusterDetail(arg1) {
+  return window['go']['core']['App']['GetEKSClusterDetail'](arg1);
+}
+
+export function GetEKSClusters() {
+  return window['go']['core']['App']['GetEKSClusters']();
+}
+
+export function GetEKSVersionCalendar() {
+  return window['go']['core']['App']['GetEKSVersionCalendar']();
+}
+
+export function GetElastiCacheClusters() {
+  return window['go']['core']['App']['GetElastiCacheClusters']();
+}
+
 export function GetElasticIPs() {
   return window['go']['core']['App']['GetElasticIPs']();
 }
 
+export function GetExpiringCertificates(arg1) {
+  return window['go']['core']['App']['GetExpiringCertificates'](arg1);
+}
+
+export function GetFeatureAvailability() {
+  return window['go']['core']['App']['GetFeatureAvailability']();
+}
+
+export function GetFlowLogFindings() {
+  return window['go']['core']['App']['GetFlowLogFindings']();
+}
+
+export function GetFlowLogSummary(arg1, arg2) {
+  return window['go']['core']['App']['GetFlowLogSummary'](arg1, arg2);
+}
+
+export function GetFlowLogs() {
+  return window['go']['core']['App']['GetFlowLogs']();
+}
+
+export function GetIAMHygiene(arg1) {
+  return window['go']['core']['App']['GetIAMHygiene'](arg1);
+}
+
+export function GetInstanceStatus(arg1) {
+  return window['go']['core']['App']['GetInstanceStatus'](arg1);
+}
+
+export function GetInstanceStatuses() {
+  return window['go']['core']['App']['GetInstanceStatuses']();
+}
+
+export function GetKMSKeys() {
+  return window['go']['core']['App']['GetKMSKeys']();
+}
+
+export function GetLambdaFunctionDetail(arg1) {
+  return window['go']['core']['App']['GetLambdaFunctionDetail'](arg1);
+}
+
 export function GetLambdaFunctions() {
   return window['go']['core']['App']['GetLambdaFunctions']();
 }
 
+export function GetLambdaInvokers(arg1) {
+  return window['go']['core']['App']['GetLambdaInvokers'](arg1);
+}
+
+export function GetLaunchTemplateVersions(arg1) {
+  return window['go']['core']['App']['GetLaunchTemplateVersions'](arg1);
+}
+
+export function GetLaunchTemplates() {
+  return window['go']['core']['App']['GetLaunchTemplates']();
+}
+
 export function GetLoadBalancers() {
   return window['go']['core']['App']['GetLoadBalancers']();
 }
 
+export function GetLogGroups(arg1) {
+  return window['go']['core']['App']['GetLogGroups'](arg1);
+}
+
+export function GetLogStreams(arg1) {
+  return window['go']['core']['App']['GetLogStreams'](arg1);
+}
+
+export function GetMSKClusters() {
+  return window['go']['core']['App']['GetMSKClusters']();
+}
+
 export function GetNATGateways() {
   return window['go']['core']['App']['GetNATGateways']();
 }
 
+export function GetNetworkInterfaces() {
+  return window['go']['core']['App']['GetNetworkInterfaces']();
+}
+
+export function GetOpenSearchDomains() {
+  return window['go']['core']['App']['GetOpenSearchDomains']();
+}
+
+export function GetPolicyAnalysis() {
+  return window['go']['core']['App']['GetPolicyAnalysis']();
+}
+
+export function GetRDSClusters() {
+  return window['go']['core']['App']['GetRDSClusters']();
+}
+
+export function GetRDSEvents(arg1, arg2) {
+  return window['go']['core']['App']['GetRDSEvents'](arg1, arg2);
+}
+
 export function GetRDSInstances() {
   return window['go']['core']['App']['GetRDSInstances']();
 }
 
+export function GetRDSParameterGroups() {
+  return window['go']['core']['App']['GetRDSParameterGroups']();
+}
+
+export function GetRDSPendingMaintenance() {
+  return window['go']['core']['App']['GetRDSPendingMaintenance']();
+}
+
+export function GetRDSSnapshots() {
+  return window['go']['core']['App']['GetRDSSnapshots']();
+}
+
+export function GetResourceAlarms(arg1, arg2) {
+  return window['go']['core']['App']['GetResourceAlarms'](arg1, arg2);
+}
+
+export function GetResourceMetrics(arg1, arg2, arg3) {
+  return window['go']['core']['App']['GetResourceMetrics'](arg1, arg2, arg3);
+}
+
+export function GetRoute53HostedZones() {
+  return window['go']['core']['App']['GetRoute53HostedZones']();
+}
+
+export function GetRoute53Records(arg1) {
+  return window['go']['core']['App']['GetRoute53Records'](arg1);
+}
+
 export function GetRouteTables() {
   return window['go']['core']['App']['GetRouteTables']();
 }
@@ -58,10 +282,34 @@ export function GetS3Buckets() {
   return window['go']['core']['App']['GetS3Buckets']();
 }
 
+export function GetSNSTopics() {
+  return window['go']['core']['App']['GetSNSTopics']();
+}
+
+export function GetSQSQueues() {
+  return window['go']['core']['App']['GetSQSQueues']();
+}
+
+export function GetScalingActivities(arg1) {
+  return window['go']['core']['App']['GetScalingActivities'](arg1);
+}
+
+export function GetSecrets() {
+  return window['go']['core']['App']['GetSecrets']();
+}
+
 export function GetSecurityGroups() {
   return window['go']['core']['App']['GetSecurityGroups']();
 }
 
+export function GetStackOwnership() {
+  return window['go']['core']['App']['GetStackOwnership']();
+}
+
+export function GetStateMachines() {
+  return window['go']['core']['App']['GetStateMachines']();
+}
+
 export function GetSubnets() {
   return window['go']['core']['App']['GetSubnets']();
 }
@@ -70,6 +318,10 @@ export function GetTargetGroups() {
   return window['go']['core']['App']['GetTargetGroups']();
 }
 
+export function GetVPCConnectivity() {
+  return window['go']['core']['App']['GetVPCConnectivity']();
+}
+
 export function GetVPCs() {
   return window['go']['core']['App']['GetVPCs']();
 }
@@ -78,6 +330,18 @@ export function Logout() {
   return window['go']['core']['App']['Logout']();
 }
 
+export function LookupIP(arg1) {
+  return window['go']['core']['App']['LookupIP'](arg1);
+}
+
+export function QueryResourceMetrics(arg1) {
+  return window['go']['core']['App']['QueryResourceMetrics'](arg1);
+}
+
+export function RunLogsInsightsQuery(arg1) {
+  return window['go']['core']['App']['RunLogsInsightsQuery'](arg1);
+}
+
 export function SaveAWSCredentials(arg1, arg2, arg3) {
   return window['go']['core']['App']['SaveAWSCredentials'](arg1, arg2, arg3);
 }
@@ -86,6 +350,14 @@ export function SaveTerraformFile(arg1) {
   return window['go']['core']['App']['SaveTerraformFile'](arg1);
 }
 
+export function StartLogTail(arg1) {
+  return window['go']['core']['App']['StartLogTail'](arg1);
+}
+
+export function StopLogTail(arg1) {
+  return window['go']['core']['App']['StopLogTail'](arg1);
+}
+
 export function TestAWSConnection(arg1, arg2, arg3) {
   return window['go']['core']['App']['TestAWSConnection'](arg1, arg2, arg3);
 }
@@ -1,5 +1,282 @@
 export namespace models {
 	
+	export class ACMCertificateInfo {
+	    ARN: string;
+	    DomainName: string;
+	    SubjectAlternativeNames: string[];
+	    Status: string;
+	    Type: string;
+	    KeyAlgorithm: string;
+	    Issuer: string;
+	    NotBefore: string;
+	    NotAfter: string;
+	    DaysToExpiry: number;
+	    RenewalEligibility: string;
+	    RenewalStatus: string;
+	    InUseBy: string[];
+	    Region: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ACMCertificateInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.ARN = source["ARN"];
+	        this.DomainName = source["DomainName"];
+	        this.SubjectAlternativeNames = source["SubjectAlternativeNames"];
+	        this.Status = source["Status"];
+	        this.Type = source["Type"];
+	        this.KeyAlgorithm = source["KeyAlgorithm"];
+	        this.Issuer = source["Issuer"];
+	        this.NotBefore = source["NotBefore"];
+	        this.NotAfter = source["NotAfter"];
+	        this.DaysToExpiry = source["DaysToExpiry"];
+	        this.RenewalEligibility = source["RenewalEligibility"];
+	        this.RenewalStatus = source["RenewalStatus"];
+	        this.InUseBy = source["InUseBy"];
+	        this.Region = source["Region"];
+	    }
+	}
+	export class APIGatewayRouteInfo {
+	    route: string;
+	    authorization: string;
+	    api_key_required: boolean;
+	    integration_type: string;
+	    integration_target: string;
+	    lambda_arn: string;
+	    state_machine_arn: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new APIGatewayRouteInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.route = source["route"];
+	        this.authorization = source["authorization"];
+	        this.api_key_required = source["api_key_required"];
+	        this.integration_type = source["integration_type"];
+	        this.integration_target = source["integration_target"];
+	        this.lambda_arn = source["lambda_arn"];
+	        this.state_machine_arn = source["state_machine_arn"];
+	    }
+	}
+	export class APIGatewayStageInfo {
+	    name: string;
+	    invoke_url: string;
+	    deployment_id: string;
+	    description: string;
+	    last_updated: string;
+	    auto_deploy: boolean;
+	    throttle_rate: number;
+	    throttle_burst: number;
+	    tracing_enabled: boolean;
+	    access_log_target: string;
+	    web_acl_arn: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new APIGatewayStageInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.invoke_url = source["invoke_url"];
+	        this.deployment_id = source["deployment_id"];
+	        this.description = source["description"];
+	        this.last_updated = source["last_updated"];
+	        this.auto_deploy = source["auto_deploy"];
+	        this.throttle_rate = source["throttle_rate"];
+	        this.throttle_burst = source["throttle_burst"];
+	        this.tracing_enabled = source["tracing_enabled"];
+	        this.access_log_target = source["access_log_target"];
+	        this.web_acl_arn = source["web_acl_arn"];
+	    }
+	}
+	export class APIGatewayAPIDetail {
+	    api_id: string;
+	    protocol: string;
+	    stages: APIGatewayStageInfo[];
+	    routes: APIGatewayRouteInfo[];
+	    errors: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new APIGatewayAPIDetail(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.api_id = source["api_id"];
+	        this.protocol = source["protocol"];
+	        this.stages = this.convertValues(source["stages"], APIGatewayStageInfo);
+	        this.routes = this.convertValues(source["routes"], APIGatewayRouteInfo);
+	        this.errors = source["errors"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class APIGatewayAPIInfo {
+	    ID: string;
+	    Name: string;
+	    Protocol: string;
+	    EndpointType: string;
+	    Endpoint: string;
+	    DefaultEndpointDisabled: boolean;
+	    Description: string;
+	    Version: string;
+	    CreatedAt: string;
+	    Tags: Record<string, string>;
+	
+	    static createFrom(source: any = {}) {
+	        return new APIGatewayAPIInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.ID = source["ID"];
+	        this.Name = source["Name"];
+	        this.Protocol = source["Protocol"];
+	        this.EndpointType = source["EndpointType"];
+	        this.Endpoint = source["Endpoint"];
+	        this.DefaultEndpointDisabled = source["DefaultEndpointDisabled"];
+	        this.Description = source["Description"];
+	        this.Version = source["Version"];
+	        this.CreatedAt = source["CreatedAt"];
+	        this.Tags = source["Tags"];
+	    }
+	}
+	export class APIGatewayMappingInfo {
+	    APIID: string;
+	    Stage: string;
+	    BasePath: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new APIGatewayMappingInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.APIID = source["APIID"];
+	        this.Stage = source["Stage"];
+	        this.BasePath = source["BasePath"];
+	    }
+	}
+	export class APIGatewayDomainInfo {
+	    DomainName: string;
+	    EndpointType: string;
+	    CertificateARN: string;
+	    TargetDomainName: string;
+	    SecurityPolicy: string;
+	    Mappings: APIGatewayMappingInfo[];
+	
+	    static createFrom(source: any = {}) {
+	        return new APIGatewayDomainInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.DomainName = source["DomainName"];
+	        this.EndpointType = source["EndpointType"];
+	        this.CertificateARN = source["CertificateARN"];
+	        this.TargetDomainName = source["TargetDomainName"];
+	        this.SecurityPolicy = source["SecurityPolicy"];
+	        this.Mappings = this.convertValues(source["Mappings"], APIGatewayMappingInfo);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	
+	
+	export class AlarmInfo {
+	    name: string;
+	    arn: string;
+	    type: string;
+	    description: string;
+	    state: string;
+	    state_reason: string;
+	    state_updated_at: string;
+	    namespace: string;
+	    metric_name: string;
+	    statistic: string;
+	    dimensions: Record<string, string>;
+	    comparison_operator: string;
+	    threshold: number;
+	    period: number;
+	    evaluation_periods: number;
+	    datapoints_to_alarm: number;
+	    treat_missing_data: string;
+	    metric_dimensions: any[];
+	    alarm_rule: string;
+	    actions_enabled: boolean;
+	    alarm_actions: string[];
+	    ok_actions: string[];
+	    insufficient_data_actions: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new AlarmInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.arn = source["arn"];
+	        this.type = source["type"];
+	        this.description = source["description"];
+	        this.state = source["state"];
+	        this.state_reason = source["state_reason"];
+	        this.state_updated_at = source["state_updated_at"];
+	        this.namespace = source["namespace"];
+	        this.metric_name = source["metric_name"];
+	        this.statistic = source["statistic"];
+	        this.dimensions = source["dimensions"];
+	        this.comparison_operator = source["comparison_operator"];
+	        this.threshold = source["threshold"];
+	        this.period = source["period"];
+	        this.evaluation_periods = source["evaluation_periods"];
+	        this.datapoints_to_alarm = source["datapoints_to_alarm"];
+	        this.treat_missing_data = source["treat_missing_data"];
+	        this.metric_dimensions = source["metric_dimensions"];
+	        this.alarm_rule = source["alarm_rule"];
+	        this.actions_enabled = source["actions_enabled"];
+	        this.alarm_actions = source["alarm_actions"];
+	        this.ok_actions = source["ok_actions"];
+	        this.insufficient_data_actions = source["insufficient_data_actions"];
+	    }
+	}
 	export class SecurityFinding {
 	    title: string;
 	    severity: string;
@@ -49,6 +326,7 @@ export namespace models {
 	    cost_month_to_date: number;
 	    cost_last_month: number;
 	    currency: string;
+	    costs_enabled: boolean;
 	    vpc_limit: number;
 	    vpc_usage: number;
 	    instance_limit: number;
@@ -68,6 +346,8 @@ export namespace models {
 	    potential_savings: number;
 	    recommendations: TrustedAdvisorRecommendation[];
 	    top_findings: SecurityFinding[];
+	    alarms_enabled: boolean;
+	    alarms_in_alarm: AlarmInfo[];
 	
 	    static createFrom(source: any = {}) {
 	        return new AccountHomeInfo(source);
@@ -85,6 +365,7 @@ export namespace models {
 	        this.cost_month_to_date = source["cost_month_to_date"];
 	        this.cost_last_month = source["cost_last_month"];
 	        this.currency = source["currency"];
+	        this.costs_enabled = source["costs_enabled"];
 	        this.vpc_limit = source["vpc_limit"];
 	        this.vpc_usage = source["vpc_usage"];
 	        this.instance_limit = source["instance_limit"];
@@ -104,6 +385,8 @@ export namespace models {
 	        this.potential_savings = source["potential_savings"];
 	        this.recommendations = this.convertValues(source["recommendations"], TrustedAdvisorRecommendation);
 	        this.top_findings = this.convertValues(source["top_findings"], SecurityFinding);
+	        this.alarms_enabled = source["alarms_enabled"];
+	        this.alarms_in_alarm = this.convertValues(source["alarms_in_alarm"], AlarmInfo);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -124,145 +407,2381 @@ export namespace models {
 		    return a;
 		}
 	}
-	export class ConfigurationInfo {
-	    Region: string;
-	    AccountID: string;
-	    UserARN: string;
-	    IsAdmin: boolean;
+	export class AlarmHistoryItem {
+	    alarm_name: string;
+	    timestamp: string;
+	    type: string;
+	    summary: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new ConfigurationInfo(source);
+	        return new AlarmHistoryItem(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.Region = source["Region"];
-	        this.AccountID = source["AccountID"];
-	        this.UserARN = source["UserARN"];
-	        this.IsAdmin = source["IsAdmin"];
+	        this.alarm_name = source["alarm_name"];
+	        this.timestamp = source["timestamp"];
+	        this.type = source["type"];
+	        this.summary = source["summary"];
 	    }
 	}
-	export class EC2InstanceInfo {
-	    ID: string;
+	
+	export class ScalingPolicyInfo {
 	    Name: string;
-	    InstanceType: string;
-	    State: string;
-	    PublicIPAddress: string;
-	    PrivateIPAddress: string;
-	    LaunchTime: string;
-	    VPCID: string;
-	    SubnetID: string;
-	    SecurityGroups: string[];
-	    KeyName: string;
-	    Platform: string;
-	    Architecture: string;
+	    ARN: string;
+	    PolicyType: string;
+	    Enabled: boolean;
+	    AdjustmentType: string;
+	    ScalingAdjustment: number;
+	    TargetValue: number;
+	    MetricType: string;
+	    AlarmNames: string[];
 	
 	    static createFrom(source: any = {}) {
-	        return new EC2InstanceInfo(source);
+	        return new ScalingPolicyInfo(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.ID = source["ID"];
 	        this.Name = source["Name"];
-	        this.InstanceType = source["InstanceType"];
-	        this.State = source["State"];
-	        this.PublicIPAddress = source["PublicIPAddress"];
-	        this.PrivateIPAddress = source["PrivateIPAddress"];
-	        this.LaunchTime = source["LaunchTime"];
-	        this.VPCID = source["VPCID"];
-	        this.SubnetID = source["SubnetID"];
-	        this.SecurityGroups = source["SecurityGroups"];
-	        this.KeyName = source["KeyName"];
-	        this.Platform = source["Platform"];
-	        this.Architecture = source["Architecture"];
+	        this.ARN = source["ARN"];
+	        this.PolicyType = source["PolicyType"];
+	        this.Enabled = source["Enabled"];
+	        this.AdjustmentType = source["AdjustmentType"];
+	        this.ScalingAdjustment = source["ScalingAdjustment"];
+	        this.TargetValue = source["TargetValue"];
+	        this.MetricType = source["MetricType"];
+	        this.AlarmNames = source["AlarmNames"];
 	    }
 	}
-	export class ECSClusterInfo {
-	    ClusterName: string;
-	    ClusterArn: string;
-	    Status: string;
-	    RegisteredInstances: number;
-	    RunningTasks: number;
-	    PendingTasks: number;
-	    ActiveServices: number;
+	export class AutoScalingInstanceInfo {
+	    InstanceID: string;
+	    InstanceType: string;
+	    AvailabilityZone: string;
+	    LifecycleState: string;
+	    HealthStatus: string;
+	    LaunchTemplateVersion: string;
+	    ProtectedFromScaleIn: boolean;
 	
 	    static createFrom(source: any = {}) {
-	        return new ECSClusterInfo(source);
+	        return new AutoScalingInstanceInfo(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.ClusterName = source["ClusterName"];
-	        this.ClusterArn = source["ClusterArn"];
-	        this.Status = source["Status"];
-	        this.RegisteredInstances = source["RegisteredInstances"];
-	        this.RunningTasks = source["RunningTasks"];
-	        this.PendingTasks = source["PendingTasks"];
-	        this.ActiveServices = source["ActiveServices"];
+	        this.InstanceID = source["InstanceID"];
+	        this.InstanceType = source["InstanceType"];
+	        this.AvailabilityZone = source["AvailabilityZone"];
+	        this.LifecycleState = source["LifecycleState"];
+	        this.HealthStatus = source["HealthStatus"];
+	        this.LaunchTemplateVersion = source["LaunchTemplateVersion"];
+	        this.ProtectedFromScaleIn = source["ProtectedFromScaleIn"];
 	    }
 	}
-	export class ElasticIPInfo {
-	    PublicIP: string;
-	    AllocationID: string;
-	    AssociationID: string;
-	    InstanceID: string;
-	    PrivateIP: string;
-	    NetworkInterfaceID: string;
-	    NetworkInterfaceOwnerID: string;
+	export class AutoScalingGroupInfo {
+	    Name: string;
+	    ARN: string;
+	    Status: string;
+	    MinSize: number;
+	    MaxSize: number;
+	    DesiredCapacity: number;
+	    HealthCheckType: string;
+	    HealthCheckGracePeriod: number;
+	    AvailabilityZones: string[];
+	    SubnetIDs: string[];
+	    LaunchTemplateID: string;
+	    LaunchTemplateName: string;
+	    LaunchTemplateVersion: string;
+	    LaunchConfigurationName: string;
+	    MixedInstancesPolicy: boolean;
+	    TargetGroupARNs: string[];
+	    LoadBalancerNames: string[];
+	    SuspendedProcesses: string[];
+	    Instances: AutoScalingInstanceInfo[];
+	    Policies: ScalingPolicyInfo[];
+	    CreatedAt: string;
 	    Tags: Record<string, string>;
 	
 	    static createFrom(source: any = {}) {
-	        return new ElasticIPInfo(source);
+	        return new AutoScalingGroupInfo(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.PublicIP = source["PublicIP"];
-	        this.AllocationID = source["AllocationID"];
-	        this.AssociationID = source["AssociationID"];
-	        this.InstanceID = source["InstanceID"];
-	        this.PrivateIP = source["PrivateIP"];
-	        this.NetworkInterfaceID = source["NetworkInterfaceID"];
-	        this.NetworkInterfaceOwnerID = source["NetworkInterfaceOwnerID"];
+	        this.Name = source["Name"];
+	        this.ARN = source["ARN"];
+	        this.Status = source["Status"];
+	        this.MinSize = source["MinSize"];
+	        this.MaxSize = source["MaxSize"];
+	        this.DesiredCapacity = source["DesiredCapacity"];
+	        this.HealthCheckType = source["HealthCheckType"];
+	        this.HealthCheckGracePeriod = source["HealthCheckGracePeriod"];
+	        this.AvailabilityZones = source["AvailabilityZones"];
+	        this.SubnetIDs = source["SubnetIDs"];
+	        this.LaunchTemplateID = source["LaunchTemplateID"];
+	        this.LaunchTemplateName = source["LaunchTemplateName"];
+	        this.LaunchTemplateVersion = source["LaunchTemplateVersion"];
+	        this.LaunchConfigurationName = source["LaunchConfigurationName"];
+	        this.MixedInstancesPolicy = source["MixedInstancesPolicy"];
+	        this.TargetGroupARNs = source["TargetGroupARNs"];
+	        this.LoadBalancerNames = source["LoadBalancerNames"];
+	        this.SuspendedProcesses = source["SuspendedProcesses"];
+	        this.Instances = this.convertValues(source["Instances"], AutoScalingInstanceInfo);
+	        this.Policies = this.convertValues(source["Policies"], ScalingPolicyInfo);
+	        this.CreatedAt = source["CreatedAt"];
 	        this.Tags = source["Tags"];
 	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
 	}
-	export class LambdaFunctionInfo {
-	    FunctionName: string;
-	    Runtime: string;
-	    MemorySize: number;
-	    LastModified: string;
-	    Handler: string;
+	
+	export class CloudFormationOutputInfo {
+	    Key: string;
+	    Value: string;
 	    Description: string;
-	    Arn: string;
-	    State: string;
+	    ExportName: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new LambdaFunctionInfo(source);
+	        return new CloudFormationOutputInfo(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.FunctionName = source["FunctionName"];
-	        this.Runtime = source["Runtime"];
-	        this.MemorySize = source["MemorySize"];
-	        this.LastModified = source["LastModified"];
-	        this.Handler = source["Handler"];
+	        this.Key = source["Key"];
+	        this.Value = source["Value"];
 	        this.Description = source["Description"];
-	        this.Arn = source["Arn"];
-	        this.State = source["State"];
+	        this.ExportName = source["ExportName"];
 	    }
 	}
-	export class LoadBalancerInfo {
-	    Name: string;
-	    ARN: string;
-	    DNSName: string;
-	    Type: string;
-	    Scheme: string;
-	    State: string;
-	    VPCID: string;
-	    AvailabilityZones: string[];
+	export class CloudFormationResourceInfo {
+	    logical_id: string;
+	    physical_id: string;
+	    type: string;
+	    status: string;
+	    status_reason: string;
+	    drift_status: string;
+	    updated_at: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new CloudFormationResourceInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.logical_id = source["logical_id"];
+	        this.physical_id = source["physical_id"];
+	        this.type = source["type"];
+	        this.status = source["status"];
+	        this.status_reason = source["status_reason"];
+	        this.drift_status = source["drift_status"];
+	        this.updated_at = source["updated_at"];
+	    }
+	}
+	export class CloudFormationStackInfo {
+	    StackName: string;
+	    StackID: string;
+	    Status: string;
+	    StatusReason: string;
+	    DriftStatus: string;
+	    LastDriftCheck: string;
+	    Description: string;
+	    TerminationProtection: boolean;
+	    RoleARN: string;
+	    ParentID: string;
+	    RootID: string;
+	    NestedStacks: string[];
+	    Outputs: CloudFormationOutputInfo[];
+	    Parameters: Record<string, string>;
+	    CreatedAt: string;
+	    UpdatedAt: string;
+	    Tags: Record<string, string>;
+	
+	    static createFrom(source: any = {}) {
+	        return new CloudFormationStackInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.StackName = source["StackName"];
+	        this.StackID = source["StackID"];
+	        this.Status = source["Status"];
+	        this.StatusReason = source["StatusReason"];
+	        this.DriftStatus = source["DriftStatus"];
+	        this.LastDriftCheck = source["LastDriftCheck"];
+	        this.Description = source["Description"];
+	        this.TerminationProtection = source["TerminationProtection"];
+	        this.RoleARN = source["RoleARN"];
+	        this.ParentID = source["ParentID"];
+	        this.RootID = source["RootID"];
+	        this.NestedStacks = source["NestedStacks"];
+	        this.Outputs = this.convertValues(source["Outputs"], CloudFormationOutputInfo);
+	        this.Parameters = source["Parameters"];
+	        this.CreatedAt = source["CreatedAt"];
+	        this.UpdatedAt = source["UpdatedAt"];
+	        this.Tags = source["Tags"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class CloudFrontOriginInfo {
+	    ID: string;
+	    DomainName: string;
+	    Path: string;
+	    Type: string;
+	    AccessControl: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new CloudFrontOriginInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.ID = source["ID"];
+	        this.DomainName = source["DomainName"];
+	        this.Path = source["Path"];
+	        this.Type = source["Type"];
+	        this.AccessControl = source["AccessControl"];
+	    }
+	}
+	export class CloudFrontDistributionInfo {
+	    ID: string;
+	    ARN: string;
+	    DomainName: string;
+	    Aliases: string[];
+	    Status: string;
+	    Enabled: boolean;
+	    Origins: CloudFrontOriginInfo[];
+	    PriceClass: string;
+	    WebACLID: string;
+	    CertificateARN: string;
+	    MinimumProtocolVersion: string;
+	    HTTPVersion: string;
+	    IPv6Enabled: boolean;
+	    Comment: string;
+	    LastModified: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new CloudFrontDistributionInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.ID = source["ID"];
+	        this.ARN = source["ARN"];
+	        this.DomainName = source["DomainName"];
+	        this.Aliases = source["Aliases"];
+	        this.Status = source["Status"];
+	        this.Enabled = source["Enabled"];
+	        this.Origins = this.convertValues(source["Origins"], CloudFrontOriginInfo);
+	        this.PriceClass = source["PriceClass"];
+	        this.WebACLID = source["WebACLID"];
+	        this.CertificateARN = source["CertificateARN"];
+	        this.MinimumProtocolVersion = source["MinimumProtocolVersion"];
+	        this.HTTPVersion = source["HTTPVersion"];
+	        this.IPv6Enabled = source["IPv6Enabled"];
+	        this.Comment = source["Comment"];
+	        this.LastModified = source["LastModified"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	export class ConfigurationInfo {
+	    Region: string;
+	    AccountID: string;
+	    UserARN: string;
+	    IsAdmin: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new ConfigurationInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.Region = source["Region"];
+	        this.AccountID = source["AccountID"];
+	        this.UserARN = source["UserARN"];
+	        this.IsAdmin = source["IsAdmin"];
+	    }
+	}
+	export class DynamoDBIndexInfo {
+	    Name: string;
+	    Status: string;
+	    PartitionKey: string;
+	    SortKey: string;
+	    Projection: string;
+	    ItemCount: number;
+	    SizeBytes: number;
+	    ReadCapacity: number;
+	    WriteCapacity: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new DynamoDBIndexInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.Name = source["Name"];
+	        this.Status = source["Status"];
+	        this.PartitionKey = source["PartitionKey"];
+	        this.SortKey = source["SortKey"];
+	        this.Projection = source["Projection"];
+	        this.ItemCount = source["ItemCount"];
+	        this.SizeBytes = source["SizeBytes"];
+	        this.ReadCapacity = source["ReadCapacity"];
+	        this.WriteCapacity = source["WriteCapacity"];
+	    }
+	}
+	export class DynamoDBTableInfo {
+	    TableName: string;
+	    ARN: string;
+	    Status: string;
+	    BillingMode: string;
+	    ReadCapacity: number;
+	    WriteCapacity: number;
+	    ItemCount: number;
+	    SizeBytes: number;
+	    PartitionKey: string;
+	    SortKey: string;
+	    TableClass: string;
+	    GlobalIndexes: DynamoDBIndexInfo[];
+	    LocalIndexCount: number;
+	    PITREnabled: boolean;
+	    TTLStatus: string;
+	    TTLAttribute: string;
+	    StreamEnabled: boolean;
+	    StreamViewType: string;
+	    StreamARN: string;
+	    ReplicaRegions: string[];
+	    Encryption: string;
+	    DeletionProtection: boolean;
+	    CreatedAt: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new DynamoDBTableInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.TableName = source["TableName"];
+	        this.ARN = source["ARN"];
+	        this.Status = source["Status"];
+	        this.BillingMode = source["BillingMode"];
+	        this.ReadCapacity = source["ReadCapacity"];
+	        this.WriteCapacity = source["WriteCapacity"];
+	        this.ItemCount = source["ItemCount"];
+	        this.SizeBytes = source["SizeBytes"];
+	        this.PartitionKey = source["PartitionKey"];
+	        this.SortKey = source["SortKey"];
+	        this.TableClass = source["TableClass"];
+	        this.GlobalIndexes = this.convertValues(source["GlobalIndexes"], DynamoDBIndexInfo);
+	        this.LocalIndexCount = source["LocalIndexCount"];
+	        this.PITREnabled = source["PITREnabled"];
+	        this.TTLStatus = source["TTLStatus"];
+	        this.TTLAttribute = source["TTLAttribute"];
+	        this.StreamEnabled = source["StreamEnabled"];
+	        this.StreamViewType = source["StreamViewType"];
+	        this.StreamARN = source["StreamARN"];
+	        this.ReplicaRegions = source["ReplicaRegions"];
+	        this.Encryption = source["Encryption"];
+	        this.DeletionProtection = source["DeletionProtection"];
+	        this.CreatedAt = source["CreatedAt"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class EBSAttachmentInfo {
+	    InstanceID: string;
+	    Device: string;
+	    State: string;
+	    DeleteOnTermination: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new EBSAttachmentInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.InstanceID = source["InstanceID"];
+	        this.Device = source["Device"];
+	        this.State = source["State"];
+	        this.DeleteOnTermination = source["DeleteOnTermination"];
+	    }
+	}
+	export class EBSSnapshotInfo {
+	    ID: string;
+	    Name: string;
+	    Description: string;
+	    VolumeID: string;
+	    VolumeSizeGiB: number;
+	    State: string;
+	    Progress: string;
+	    Encrypted: boolean;
+	    KMSKeyID: string;
+	    StartTime: string;
+	    StorageTier: string;
+	    Tags: Record<string, string>;
+	    ImageIDs: string[];
+	    Copy: boolean;
+	    Orphaned: boolean;
+	    OrphanReason: string;
+	    MonthlyCost: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new EBSSnapshotInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.ID = source["ID"];
+	        this.Name = source["Name"];
+	        this.Description = source["Description"];
+	        this.VolumeID = source["VolumeID"];
+	        this.VolumeSizeGiB = source["VolumeSizeGiB"];
+	        this.State = source["State"];
+	        this.Progress = source["Progress"];
+	        this.Encrypted = source["Encrypted"];
+	        this.KMSKeyID = source["KMSKeyID"];
+	        this.StartTime = source["StartTime"];
+	        this.StorageTier = source["StorageTier"];
+	        this.Tags = source["Tags"];
+	        this.ImageIDs = source["ImageIDs"];
+	        this.Copy = source["Copy"];
+	        this.Orphaned = source["Orphaned"];
+	        this.OrphanReason = source["OrphanReason"];
+	        this.MonthlyCost = source["MonthlyCost"];
+	    }
+	}
+	export class EBSVolumeInfo {
+	    ID: string;
+	    Name: string;
+	    SizeGiB: number;
+	    VolumeType: string;
+	    IOPS: number;
+	    Throughput: number;
+	    Encrypted: boolean;
+	    KMSKeyID: string;
+	    State: string;
+	    AvailabilityZone: string;
+	    CreateTime: string;
+	    SnapshotID: string;
+	    Attachments: EBSAttachmentInfo[];
+	    Tags: Record<string, string>;
+	    Unattached: boolean;
+	    MonthlyCost: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new EBSVolumeInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.ID = source["ID"];
+	        this.Name = source["Name"];
+	        this.SizeGiB = source["SizeGiB"];
+	        this.VolumeType = source["VolumeType"];
+	        this.IOPS = source["IOPS"];
+	        this.Throughput = source["Throughput"];
+	        this.Encrypted = source["Encrypted"];
+	        this.KMSKeyID = source["KMSKeyID"];
+	        this.State = source["State"];
+	        this.AvailabilityZone = source["AvailabilityZone"];
+	        this.CreateTime = source["CreateTime"];
+	        this.SnapshotID = source["SnapshotID"];
+	        this.Attachments = this.convertValues(source["Attachments"], EBSAttachmentInfo);
+	        this.Tags = source["Tags"];
+	        this.Unattached = source["Unattached"];
+	        this.MonthlyCost = source["MonthlyCost"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class EBSStorageReport {
+	    volumes: EBSVolumeInfo[];
+	    snapshots: EBSSnapshotInfo[];
+	    unattached_volumes: number;
+	    orphaned_snapshots: number;
+	    volume_monthly_cost: number;
+	    snapshot_monthly_cost: number;
+	    unattached_monthly_cost: number;
+	    orphaned_monthly_cost: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new EBSStorageReport(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.volumes = this.convertValues(source["volumes"], EBSVolumeInfo);
+	        this.snapshots = this.convertValues(source["snapshots"], EBSSnapshotInfo);
+	        this.unattached_volumes = source["unattached_volumes"];
+	        this.orphaned_snapshots = source["orphaned_snapshots"];
+	        this.volume_monthly_cost = source["volume_monthly_cost"];
+	        this.snapshot_monthly_cost = source["snapshot_monthly_cost"];
+	        this.unattached_monthly_cost = source["unattached_monthly_cost"];
+	        this.orphaned_monthly_cost = source["orphaned_monthly_cost"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	export class EC2BlockDeviceInfo {
+	    DeviceName: string;
+	    VolumeID: string;
+	    Status: string;
+	    DeleteOnTermination: boolean;
+	    AttachTime: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new EC2BlockDeviceInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.DeviceName = source["DeviceName"];
+	        this.VolumeID = source["VolumeID"];
+	        this.Status = source["Status"];
+	        this.DeleteOnTermination = source["DeleteOnTermination"];
+	        this.AttachTime = source["AttachTime"];
+	    }
+	}
+	export class EC2InstanceInfo {
+	    ID: string;
+	    Name: string;
+	    InstanceType: string;
+	    State: string;
+	    PublicIPAddress: string;
+	    PrivateIPAddress: string;
+	    LaunchTime: string;
+	    VPCID: string;
+	    SubnetID: string;
+	    SecurityGroups: string[];
+	    KeyName: string;
+	    Platform: string;
+	    Architecture: string;
+	    AutoScalingGroup: string;
+	    SecurityGroupIDs: string[];
+	    Tags: Record<string, string>;
+	    ImageID: string;
+	    Lifecycle: string;
+	    IAMInstanceProfile: string;
+	    IMDSv2Required: boolean;
+	    IMDSEnabled: boolean;
+	    IMDSHopLimit: number;
+	    Monitoring: string;
+	    AvailabilityZone: string;
+	    Tenancy: string;
+	    PlacementGroup: string;
+	    EBSOptimized: boolean;
+	    RootDeviceName: string;
+	    BlockDevices: EC2BlockDeviceInfo[];
+	    StateReason: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new EC2InstanceInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.ID = source["ID"];
+	        this.Name = source["Name"];
+	        this.InstanceType = source["InstanceType"];
+	        this.State = source["State"];
+	        this.PublicIPAddress = source["PublicIPAddress"];
+	        this.PrivateIPAddress = source["PrivateIPAddress"];
+	        this.LaunchTime = source["LaunchTime"];
+	        this.VPCID = source["VPCID"];
+	        this.SubnetID = source["SubnetID"];
+	        this.SecurityGroups = source["SecurityGroups"];
+	        this.KeyName = source["KeyName"];
+	        this.Platform = source["Platform"];
+	        this.Architecture = source["Architecture"];
+	        this.AutoScalingGroup = source["AutoScalingGroup"];
+	        this.SecurityGroupIDs = source["SecurityGroupIDs"];
+	        this.Tags = source["Tags"];
+	        this.ImageID = source["ImageID"];
+	        this.Lifecycle = source["Lifecycle"];
+	        this.IAMInstanceProfile = source["IAMInstanceProfile"];
+	        this.IMDSv2Required = source["IMDSv2Required"];
+	        this.IMDSEnabled = source["IMDSEnabled"];
+	        this.IMDSHopLimit = source["IMDSHopLimit"];
+	        this.Monitoring = source["Monitoring"];
+	        this.AvailabilityZone = source["AvailabilityZone"];
+	        this.Tenancy = source["Tenancy"];
+	        this.PlacementGroup = source["PlacementGroup"];
+	        this.EBSOptimized = source["EBSOptimized"];
+	        this.RootDeviceName = source["RootDeviceName"];
+	        this.BlockDevices = this.convertValues(source["BlockDevices"], EC2BlockDeviceInfo);
+	        this.StateReason = source["StateReason"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ECRImageInfo {
+	    repository: string;
+	    digest: string;
+	    tags: string[];
+	    pushed_at: string;
+	    last_pulled_at: string;
+	    size_bytes: number;
+	    scan_status: string;
+	    scan_completed: string;
+	    severity_counts: Record<string, number>;
+	
+	    static createFrom(source: any = {}) {
+	        return new ECRImageInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.repository = source["repository"];
+	        this.digest = source["digest"];
+	        this.tags = source["tags"];
+	        this.pushed_at = source["pushed_at"];
+	        this.last_pulled_at = source["last_pulled_at"];
+	        this.size_bytes = source["size_bytes"];
+	        this.scan_status = source["scan_status"];
+	        this.scan_completed = source["scan_completed"];
+	        this.severity_counts = source["severity_counts"];
+	    }
+	}
+	export class ECRImageUsage {
+	    cluster: string;
+	    service: string;
+	    task_definition: string;
+	    container: string;
+	    image: string;
+	    registry_id: string;
+	    repository: string;
+	    tag: string;
+	    digest: string;
+	    scan_status: string;
+	    severity_counts: Record<string, number>;
+	    not_found: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new ECRImageUsage(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.cluster = source["cluster"];
+	        this.service = source["service"];
+	        this.task_definition = source["task_definition"];
+	        this.container = source["container"];
+	        this.image = source["image"];
+	        this.registry_id = source["registry_id"];
+	        this.repository = source["repository"];
+	        this.tag = source["tag"];
+	        this.digest = source["digest"];
+	        this.scan_status = source["scan_status"];
+	        this.severity_counts = source["severity_counts"];
+	        this.not_found = source["not_found"];
+	    }
+	}
+	export class ECRImageUsageReport {
+	    usages: ECRImageUsage[];
+	    errors: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ECRImageUsageReport(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.usages = this.convertValues(source["usages"], ECRImageUsage);
+	        this.errors = source["errors"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ECRRepositoryInfo {
+	    Name: string;
+	    ARN: string;
+	    URI: string;
+	    RegistryID: string;
+	    TagImmutability: boolean;
+	    ScanOnPush: boolean;
+	    LifecyclePolicy: boolean;
+	    Encryption: string;
+	    ImageCount: number;
+	    TotalSizeBytes: number;
+	    LastPushedAt: string;
+	    SeverityCounts: Record<string, number>;
+	    CreatedAt: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ECRRepositoryInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.Name = source["Name"];
+	        this.ARN = source["ARN"];
+	        this.URI = source["URI"];
+	        this.RegistryID = source["RegistryID"];
+	        this.TagImmutability = source["TagImmutability"];
+	        this.ScanOnPush = source["ScanOnPush"];
+	        this.LifecyclePolicy = source["LifecyclePolicy"];
+	        this.Encryption = source["Encryption"];
+	        this.ImageCount = source["ImageCount"];
+	        this.TotalSizeBytes = source["TotalSizeBytes"];
+	        this.LastPushedAt = source["LastPushedAt"];
+	        this.SeverityCounts = source["SeverityCounts"];
+	        this.CreatedAt = source["CreatedAt"];
+	    }
+	}
+	export class ECRScanFinding {
+	    name: string;
+	    severity: string;
+	    package: string;
+	    fixed_in: string;
+	    description: string;
+	    uri: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ECRScanFinding(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.severity = source["severity"];
+	        this.package = source["package"];
+	        this.fixed_in = source["fixed_in"];
+	        this.description = source["description"];
+	        this.uri = source["uri"];
+	    }
+	}
+	export class ECSClusterInfo {
+	    ClusterName: string;
+	    ClusterArn: string;
+	    Status: string;
+	    RegisteredInstances: number;
+	    RunningTasks: number;
+	    PendingTasks: number;
+	    ActiveServices: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ECSClusterInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.ClusterName = source["ClusterName"];
+	        this.ClusterArn = source["ClusterArn"];
+	        this.Status = source["Status"];
+	        this.RegisteredInstances = source["RegisteredInstances"];
+	        this.RunningTasks = source["RunningTasks"];
+	        this.PendingTasks = source["PendingTasks"];
+	        this.ActiveServices = source["ActiveServices"];
+	    }
+	}
+	export class ECSContainerDefinitionInfo {
+	    Name: string;
+	    Image: string;
+	    CPU: number;
+	    Memory: number;
+	    MemoryReservation: number;
+	    Essential: boolean;
+	    PortMappings: string[];
+	    EnvironmentVars: string[];
+	    Secrets: string[];
+	    LogDriver: string;
+	    LogOptions: Record<string, string>;
+	    LogGroup: string;
+	    LogStreamPrefix: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ECSContainerDefinitionInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.Name = source["Name"];
+	        this.Image = source["Image"];
+	        this.CPU = source["CPU"];
+	        this.Memory = source["Memory"];
+	        this.MemoryReservation = source["MemoryReservation"];
+	        this.Essential = source["Essential"];
+	        this.PortMappings = source["PortMappings"];
+	        this.EnvironmentVars = source["EnvironmentVars"];
+	        this.Secrets = source["Secrets"];
+	        this.LogDriver = source["LogDriver"];
+	        this.LogOptions = source["LogOptions"];
+	        this.LogGroup = source["LogGroup"];
+	        this.LogStreamPrefix = source["LogStreamPrefix"];
+	    }
+	}
+	export class ECSContainerInfo {
+	    Name: string;
+	    Image: string;
+	    ImageDigest: string;
+	    LastStatus: string;
+	    HealthStatus: string;
+	    ExitCode?: number;
+	    Reason: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ECSContainerInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.Name = source["Name"];
+	        this.Image = source["Image"];
+	        this.ImageDigest = source["ImageDigest"];
+	        this.LastStatus = source["LastStatus"];
+	        this.HealthStatus = source["HealthStatus"];
+	        this.ExitCode = source["ExitCode"];
+	        this.Reason = source["Reason"];
+	    }
+	}
+	export class ECSDeploymentInfo {
+	    ID: string;
+	    Status: string;
+	    TaskDefinition: string;
+	    LaunchType: string;
+	    DesiredCount: number;
+	    RunningCount: number;
+	    PendingCount: number;
+	    FailedTasks: number;
+	    RolloutState: string;
+	    RolloutStateReason: string;
+	    CreatedAt: string;
+	    UpdatedAt: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ECSDeploymentInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.ID = source["ID"];
+	        this.Status = source["Status"];
+	        this.TaskDefinition = source["TaskDefinition"];
+	        this.LaunchType = source["LaunchType"];
+	        this.DesiredCount = source["DesiredCount"];
+	        this.RunningCount = source["RunningCount"];
+	        this.PendingCount = source["PendingCount"];
+	        this.FailedTasks = source["FailedTasks"];
+	        this.RolloutState = source["RolloutState"];
+	        this.RolloutStateReason = source["RolloutStateReason"];
+	        this.CreatedAt = source["CreatedAt"];
+	        this.UpdatedAt = source["UpdatedAt"];
+	    }
+	}
+	export class ECSLoadBalancerInfo {
+	    TargetGroupArn: string;
+	    LoadBalancerName: string;
+	    ContainerName: string;
+	    ContainerPort: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ECSLoadBalancerInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.TargetGroupArn = source["TargetGroupArn"];
+	        this.LoadBalancerName = source["LoadBalancerName"];
+	        this.ContainerName = source["ContainerName"];
+	        this.ContainerPort = source["ContainerPort"];
+	    }
+	}
+	export class ECSServiceInfo {
+	    ServiceName: string;
+	    ServiceArn: string;
+	    ClusterArn: string;
+	    Status: string;
+	    LaunchType: string;
+	    TaskDefinition: string;
+	    DesiredCount: number;
+	    RunningCount: number;
+	    PendingCount: number;
+	    RolloutState: string;
+	    CreatedAt: string;
+	    Deployments: ECSDeploymentInfo[];
+	    LoadBalancers: ECSLoadBalancerInfo[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ECSServiceInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.ServiceName = source["ServiceName"];
+	        this.ServiceArn = source["ServiceArn"];
+	        this.ClusterArn = source["ClusterArn"];
+	        this.Status = source["Status"];
+	        this.LaunchType = source["LaunchType"];
+	        this.TaskDefinition = source["TaskDefinition"];
+	        this.DesiredCount = source["DesiredCount"];
+	        this.RunningCount = source["RunningCount"];
+	        this.PendingCount = source["PendingCount"];
+	        this.RolloutState = source["RolloutState"];
+	        this.CreatedAt = source["CreatedAt"];
+	        this.Deployments = this.convertValues(source["Deployments"], ECSDeploymentInfo);
+	        this.LoadBalancers = this.convertValues(source["LoadBalancers"], ECSLoadBalancerInfo);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ECSTaskDefinitionInfo {
+	    Arn: string;
+	    Family: string;
+	    Revision: number;
+	    Status: string;
+	    CPU: string;
+	    Memory: string;
+	    NetworkMode: string;
+	    TaskRoleArn: string;
+	    ExecutionRoleArn: string;
+	    RequiresCompatibilities: string[];
+	    Containers: ECSContainerDefinitionInfo[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ECSTaskDefinitionInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.Arn = source["Arn"];
+	        this.Family = source["Family"];
+	        this.Revision = source["Revision"];
+	        this.Status = source["Status"];
+	        this.CPU = source["CPU"];
+	        this.Memory = source["Memory"];
+	        this.NetworkMode = source["NetworkMode"];
+	        this.TaskRoleArn = source["TaskRoleArn"];
+	        this.ExecutionRoleArn = source["ExecutionRoleArn"];
+	        this.RequiresCompatibilities = source["RequiresCompatibilities"];
+	        this.Containers = this.convertValues(source["Containers"], ECSContainerDefinitionInfo);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ECSTaskInfo {
+	    TaskArn: string;
+	    TaskDefinitionArn: string;
+	    Family: string;
+	    Revision: string;
+	    Group: string;
+	    LastStatus: string;
+	    DesiredStatus: string;
+	    HealthStatus: string;
+	    LaunchType: string;
+	    AvailabilityZone: string;
+	    CPU: string;
+	    Memory: string;
+	    StartedAt: string;
+	    StoppedAt: string;
+	    StopCode: string;
+	    StoppedReason: string;
+	    Containers: ECSContainerInfo[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ECSTaskInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.TaskArn = source["TaskArn"];
+	        this.TaskDefinitionArn = source["TaskDefinitionArn"];
+	        this.Family = source["Family"];
+	        this.Revision = source["Revision"];
+	        this.Group = source["Group"];
+	        this.LastStatus = source["LastStatus"];
+	        this.DesiredStatus = source["DesiredStatus"];
+	        this.HealthStatus = source["HealthStatus"];
+	        this.LaunchType = source["LaunchType"];
+	        this.AvailabilityZone = source["AvailabilityZone"];
+	        this.CPU = source["CPU"];
+	        this.Memory = source["Memory"];
+	        this.StartedAt = source["StartedAt"];
+	        this.StoppedAt = source["StoppedAt"];
+	        this.StopCode = source["StopCode"];
+	        this.StoppedReason = source["StoppedReason"];
+	        this.Containers = this.convertValues(source["Containers"], ECSContainerInfo);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class EKSAddonInfo {
+	    name: string;
+	    version: string;
+	    status: string;
+	    health_issues: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new EKSAddonInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.version = source["version"];
+	        this.status = source["status"];
+	        this.health_issues = source["health_issues"];
+	    }
+	}
+	export class EKSSubnetFootprint {
+	    subnet_id: string;
+	    availability_zone: string;
+	    used_by: string[];
+	    cluster_enis: number;
+	    total_enis: number;
+	    available_ips: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new EKSSubnetFootprint(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.subnet_id = source["subnet_id"];
+	        this.availability_zone = source["availability_zone"];
+	        this.used_by = source["used_by"];
+	        this.cluster_enis = source["cluster_enis"];
+	        this.total_enis = source["total_enis"];
+	        this.available_ips = source["available_ips"];
+	    }
+	}
+	export class EKSFargateProfileInfo {
+	    name: string;
+	    status: string;
+	    pod_execution_role_arn: string;
+	    subnet_ids: string[];
+	    selectors: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new EKSFargateProfileInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.status = source["status"];
+	        this.pod_execution_role_arn = source["pod_execution_role_arn"];
+	        this.subnet_ids = source["subnet_ids"];
+	        this.selectors = source["selectors"];
+	    }
+	}
+	export class EKSNodeGroupInfo {
+	    name: string;
+	    status: string;
+	    version: string;
+	    release_version: string;
+	    ami_type: string;
+	    capacity_type: string;
+	    instance_types: string[];
+	    min_size: number;
+	    max_size: number;
+	    desired_size: number;
+	    subnet_ids: string[];
+	    auto_scaling_groups: string[];
+	    launch_template: string;
+	    health_issues: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new EKSNodeGroupInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.status = source["status"];
+	        this.version = source["version"];
+	        this.release_version = source["release_version"];
+	        this.ami_type = source["ami_type"];
+	        this.capacity_type = source["capacity_type"];
+	        this.instance_types = source["instance_types"];
+	        this.min_size = source["min_size"];
+	        this.max_size = source["max_size"];
+	        this.desired_size = source["desired_size"];
+	        this.subnet_ids = source["subnet_ids"];
+	        this.auto_scaling_groups = source["auto_scaling_groups"];
+	        this.launch_template = source["launch_template"];
+	        this.health_issues = source["health_issues"];
+	    }
+	}
+	export class EKSClusterDetail {
+	    cluster_name: string;
+	    node_groups: EKSNodeGroupInfo[];
+	    fargate_profiles: EKSFargateProfileInfo[];
+	    add_ons: EKSAddonInfo[];
+	    footprint: EKSSubnetFootprint[];
+	    eni_count: number;
+	    errors: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new EKSClusterDetail(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.cluster_name = source["cluster_name"];
+	        this.node_groups = this.convertValues(source["node_groups"], EKSNodeGroupInfo);
+	        this.fargate_profiles = this.convertValues(source["fargate_profiles"], EKSFargateProfileInfo);
+	        this.add_ons = this.convertValues(source["add_ons"], EKSAddonInfo);
+	        this.footprint = this.convertValues(source["footprint"], EKSSubnetFootprint);
+	        this.eni_count = source["eni_count"];
+	        this.errors = source["errors"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class EKSClusterInfo {
+	    Name: string;
+	    ARN: string;
+	    Status: string;
+	    Version: string;
+	    PlatformVersion: string;
+	    Endpoint: string;
+	    EndpointPublicAccess: boolean;
+	    EndpointPrivateAccess: boolean;
+	    PublicAccessCIDRs: string[];
+	    VPCID: string;
+	    SubnetIDs: string[];
+	    SecurityGroupIDs: string[];
+	    ClusterSecurityGroupID: string;
+	    EnabledLogTypes: string[];
+	    SecretsEncryption: boolean;
+	    SupportType: string;
+	    StandardSupportEnd: string;
+	    ExtendedSupportEnd: string;
+	    SupportStatus: string;
+	    CreatedAt: string;
+	    Tags: Record<string, string>;
+	
+	    static createFrom(source: any = {}) {
+	        return new EKSClusterInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.Name = source["Name"];
+	        this.ARN = source["ARN"];
+	        this.Status = source["Status"];
+	        this.Version = source["Version"];
+	        this.PlatformVersion = source["PlatformVersion"];
+	        this.Endpoint = source["Endpoint"];
+	        this.EndpointPublicAccess = source["EndpointPublicAccess"];
+	        this.EndpointPrivateAccess = source["EndpointPrivateAccess"];
+	        this.PublicAccessCIDRs = source["PublicAccessCIDRs"];
+	        this.VPCID = source["VPCID"];
+	        this.SubnetIDs = source["SubnetIDs"];
+	        this.SecurityGroupIDs = source["SecurityGroupIDs"];
+	        this.ClusterSecurityGroupID = source["ClusterSecurityGroupID"];
+	        this.EnabledLogTypes = source["EnabledLogTypes"];
+	        this.SecretsEncryption = source["SecretsEncryption"];
+	        this.SupportType = source["SupportType"];
+	        this.StandardSupportEnd = source["StandardSupportEnd"];
+	        this.ExtendedSupportEnd = source["ExtendedSupportEnd"];
+	        this.SupportStatus = source["SupportStatus"];
+	        this.CreatedAt = source["CreatedAt"];
+	        this.Tags = source["Tags"];
+	    }
+	}
+	
+	
+	
+	export class EKSVersionSupport {
+	    version: string;
+	    standard_support_end: string;
+	    extended_support_end: string;
+	    status: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new EKSVersionSupport(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.version = source["version"];
+	        this.standard_support_end = source["standard_support_end"];
+	        this.extended_support_end = source["extended_support_end"];
+	        this.status = source["status"];
+	    }
+	}
+	export class ElastiCacheClusterInfo {
+	    ID: string;
+	    ARN: string;
+	    Description: string;
+	    Engine: string;
+	    EngineVersion: string;
+	    NodeType: string;
+	    NodeCount: number;
+	    ShardCount: number;
+	    ClusterMode: string;
+	    Status: string;
+	    ReplicationGroup: boolean;
+	    MultiAZ: boolean;
+	    AutomaticFailover: boolean;
+	    TransitEncryption: boolean;
+	    AtRestEncryption: boolean;
+	    AuthTokenEnabled: boolean;
+	    Endpoint: string;
+	    Port: number;
+	    SubnetGroup: string;
+	    VPCID: string;
+	    SubnetIDs: string[];
+	    SecurityGroupIDs: string[];
+	    MetricClusterID: string;
+	    CreatedAt: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ElastiCacheClusterInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.ID = source["ID"];
+	        this.ARN = source["ARN"];
+	        this.Description = source["Description"];
+	        this.Engine = source["Engine"];
+	        this.EngineVersion = source["EngineVersion"];
+	        this.NodeType = source["NodeType"];
+	        this.NodeCount = source["NodeCount"];
+	        this.ShardCount = source["ShardCount"];
+	        this.ClusterMode = source["ClusterMode"];
+	        this.Status = source["Status"];
+	        this.ReplicationGroup = source["ReplicationGroup"];
+	        this.MultiAZ = source["MultiAZ"];
+	        this.AutomaticFailover = source["AutomaticFailover"];
+	        this.TransitEncryption = source["TransitEncryption"];
+	        this.AtRestEncryption = source["AtRestEncryption"];
+	        this.AuthTokenEnabled = source["AuthTokenEnabled"];
+	        this.Endpoint = source["Endpoint"];
+	        this.Port = source["Port"];
+	        this.SubnetGroup = source["SubnetGroup"];
+	        this.VPCID = source["VPCID"];
+	        this.SubnetIDs = source["SubnetIDs"];
+	        this.SecurityGroupIDs = source["SecurityGroupIDs"];
+	        this.MetricClusterID = source["MetricClusterID"];
+	        this.CreatedAt = source["CreatedAt"];
+	    }
+	}
+	export class ElasticIPInfo {
+	    PublicIP: string;
+	    AllocationID: string;
+	    AssociationID: string;
+	    InstanceID: string;
+	    PrivateIP: string;
+	    NetworkInterfaceID: string;
+	    NetworkInterfaceOwnerID: string;
+	    Tags: Record<string, string>;
+	
+	    static createFrom(source: any = {}) {
+	        return new ElasticIPInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.PublicIP = source["PublicIP"];
+	        this.AllocationID = source["AllocationID"];
+	        this.AssociationID = source["AssociationID"];
+	        this.InstanceID = source["InstanceID"];
+	        this.PrivateIP = source["PrivateIP"];
+	        this.NetworkInterfaceID = source["NetworkInterfaceID"];
+	        this.NetworkInterfaceOwnerID = source["NetworkInterfaceOwnerID"];
+	        this.Tags = source["Tags"];
+	    }
+	}
+	export class FeatureAvailability {
+	    feature: string;
+	    label: string;
+	    available: boolean;
+	    missing: string[];
+	    degraded: string[];
+	    reason: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new FeatureAvailability(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.feature = source["feature"];
+	        this.label = source["label"];
+	        this.available = source["available"];
+	        this.missing = source["missing"];
+	        this.degraded = source["degraded"];
+	        this.reason = source["reason"];
+	    }
+	}
+	export class PermissionStatus {
+	    Action: string;
+	    Allowed: boolean;
+	    Unknown: boolean;
+	    Optional: boolean;
+	    Reason: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new PermissionStatus(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.Action = source["Action"];
+	        this.Allowed = source["Allowed"];
+	        this.Unknown = source["Unknown"];
+	        this.Optional = source["Optional"];
+	        this.Reason = source["Reason"];
+	    }
+	}
+	export class FeaturePermissions {
+	    Feature: string;
+	    Label: string;
+	    Allowed: boolean;
+	    Missing: string[];
+	    Degraded: string[];
+	    Permissions: PermissionStatus[];
+	
+	    static createFrom(source: any = {}) {
+	        return new FeaturePermissions(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.Feature = source["Feature"];
+	        this.Label = source["Label"];
+	        this.Allowed = source["Allowed"];
+	        this.Missing = source["Missing"];
+	        this.Degraded = source["Degraded"];
+	        this.Permissions = this.convertValues(source["Permissions"], PermissionStatus);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class FlowLogInfo {
+	    ID: string;
+	    Name: string;
+	    ResourceID: string;
+	    ResourceType: string;
+	    TrafficType: string;
+	    DestinationType: string;
+	    Destination: string;
+	    LogGroup: string;
+	    LogFormat: string;
+	    Status: string;
+	    DeliverStatus: string;
+	    DeliverError: string;
+	    CreatedAt: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new FlowLogInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.ID = source["ID"];
+	        this.Name = source["Name"];
+	        this.ResourceID = source["ResourceID"];
+	        this.ResourceType = source["ResourceType"];
+	        this.TrafficType = source["TrafficType"];
+	        this.DestinationType = source["DestinationType"];
+	        this.Destination = source["Destination"];
+	        this.LogGroup = source["LogGroup"];
+	        this.LogFormat = source["LogFormat"];
+	        this.Status = source["Status"];
+	        this.DeliverStatus = source["DeliverStatus"];
+	        this.DeliverError = source["DeliverError"];
+	        this.CreatedAt = source["CreatedAt"];
+	    }
+	}
+	export class FlowLogPortBytes {
+	    port: string;
+	    protocol: string;
+	    bytes: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new FlowLogPortBytes(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.port = source["port"];
+	        this.protocol = source["protocol"];
+	        this.bytes = source["bytes"];
+	    }
+	}
+	export class FlowLogRejected {
+	    source: string;
+	    destination: string;
+	    port: string;
+	    protocol: string;
+	    flows: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new FlowLogRejected(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.source = source["source"];
+	        this.destination = source["destination"];
+	        this.port = source["port"];
+	        this.protocol = source["protocol"];
+	        this.flows = source["flows"];
+	    }
+	}
+	export class FlowLogTalker {
+	    source: string;
+	    destination: string;
+	    bytes: number;
+	    packets: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new FlowLogTalker(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.source = source["source"];
+	        this.destination = source["destination"];
+	        this.bytes = source["bytes"];
+	        this.packets = source["packets"];
+	    }
+	}
+	export class FlowLogSummary {
+	    vpc_id: string;
+	    flow_log_id: string;
+	    log_group: string;
+	    start: string;
+	    end: string;
+	    top_talkers: FlowLogTalker[];
+	    rejected: FlowLogRejected[];
+	    bytes_by_port: FlowLogPortBytes[];
+	    errors: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new FlowLogSummary(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.vpc_id = source["vpc_id"];
+	        this.flow_log_id = source["flow_log_id"];
+	        this.log_group = source["log_group"];
+	        this.start = source["start"];
+	        this.end = source["end"];
+	        this.top_talkers = this.convertValues(source["top_talkers"], FlowLogTalker);
+	        this.rejected = this.convertValues(source["rejected"], FlowLogRejected);
+	        this.bytes_by_port = this.convertValues(source["bytes_by_port"], FlowLogPortBytes);
+	        this.errors = source["errors"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	export class IAMAccessKeyInfo {
+	    Slot: number;
+	    Active: boolean;
+	    LastRotated: string;
+	    AgeDays: number;
+	    LastUsed: string;
+	    LastUsedService: string;
+	    DaysSinceUse: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new IAMAccessKeyInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.Slot = source["Slot"];
+	        this.Active = source["Active"];
+	        this.LastRotated = source["LastRotated"];
+	        this.AgeDays = source["AgeDays"];
+	        this.LastUsed = source["LastUsed"];
+	        this.LastUsedService = source["LastUsedService"];
+	        this.DaysSinceUse = source["DaysSinceUse"];
+	    }
+	}
+	export class IAMRoleInfo {
+	    RoleName: string;
+	    ARN: string;
+	    Path: string;
+	    Description: string;
+	    CreatedAt: string;
+	    AgeDays: number;
+	    LastUsed: string;
+	    LastUsedRegion: string;
+	    DaysSinceUse: number;
+	    ServiceLinked: boolean;
+	    Issues: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new IAMRoleInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.RoleName = source["RoleName"];
+	        this.ARN = source["ARN"];
+	        this.Path = source["Path"];
+	        this.Description = source["Description"];
+	        this.CreatedAt = source["CreatedAt"];
+	        this.AgeDays = source["AgeDays"];
+	        this.LastUsed = source["LastUsed"];
+	        this.LastUsedRegion = source["LastUsedRegion"];
+	        this.DaysSinceUse = source["DaysSinceUse"];
+	        this.ServiceLinked = source["ServiceLinked"];
+	        this.Issues = source["Issues"];
+	    }
+	}
+	export class IAMUserInfo {
+	    UserName: string;
+	    ARN: string;
+	    Root: boolean;
+	    CreatedAt: string;
+	    AgeDays: number;
+	    PasswordEnabled: boolean;
+	    PasswordLastUsed: string;
+	    PasswordLastChanged: string;
+	    DaysSinceLogin: number;
+	    MFAActive: boolean;
+	    AccessKeys: IAMAccessKeyInfo[];
+	    Issues: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new IAMUserInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.UserName = source["UserName"];
+	        this.ARN = source["ARN"];
+	        this.Root = source["Root"];
+	        this.CreatedAt = source["CreatedAt"];
+	        this.AgeDays = source["AgeDays"];
+	        this.PasswordEnabled = source["PasswordEnabled"];
+	        this.PasswordLastUsed = source["PasswordLastUsed"];
+	        this.PasswordLastChanged = source["PasswordLastChanged"];
+	        this.DaysSinceLogin = source["DaysSinceLogin"];
+	        this.MFAActive = source["MFAActive"];
+	        this.AccessKeys = this.convertValues(source["AccessKeys"], IAMAccessKeyInfo);
+	        this.Issues = source["Issues"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class IAMHygieneRequest {
+	    access_key_max_age_days: number;
+	    access_key_unused_days: number;
+	    console_inactive_days: number;
+	    role_unused_days: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new IAMHygieneRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.access_key_max_age_days = source["access_key_max_age_days"];
+	        this.access_key_unused_days = source["access_key_unused_days"];
+	        this.console_inactive_days = source["console_inactive_days"];
+	        this.role_unused_days = source["role_unused_days"];
+	    }
+	}
+	export class IAMHygieneReport {
+	    generated_at: string;
+	    thresholds: IAMHygieneRequest;
+	    users: IAMUserInfo[];
+	    roles: IAMRoleInfo[];
+	    password_policy_gaps: string[];
+	    users_without_mfa: number;
+	    old_access_keys: number;
+	    unused_access_keys: number;
+	    inactive_console_users: number;
+	    unused_roles: number;
+	    findings: SecurityFinding[];
+	    errors: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new IAMHygieneReport(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.generated_at = source["generated_at"];
+	        this.thresholds = this.convertValues(source["thresholds"], IAMHygieneRequest);
+	        this.users = this.convertValues(source["users"], IAMUserInfo);
+	        this.roles = this.convertValues(source["roles"], IAMRoleInfo);
+	        this.password_policy_gaps = source["password_policy_gaps"];
+	        this.users_without_mfa = source["users_without_mfa"];
+	        this.old_access_keys = source["old_access_keys"];
+	        this.unused_access_keys = source["unused_access_keys"];
+	        this.inactive_console_users = source["inactive_console_users"];
+	        this.unused_roles = source["unused_roles"];
+	        this.findings = this.convertValues(source["findings"], SecurityFinding);
+	        this.errors = source["errors"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	
+	
+	export class IPOwner {
+	    resource_type: string;
+	    resource_id: string;
+	    name: string;
+	    requester: string;
+	    detail: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new IPOwner(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.resource_type = source["resource_type"];
+	        this.resource_id = source["resource_id"];
+	        this.name = source["name"];
+	        this.requester = source["requester"];
+	        this.detail = source["detail"];
+	    }
+	}
+	export class IPLookupResult {
+	    ip: string;
+	    owners: IPOwner[];
+	    errors: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new IPLookupResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.ip = source["ip"];
+	        this.owners = this.convertValues(source["owners"], IPOwner);
+	        this.errors = source["errors"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	export class InstanceEventInfo {
+	    id: string;
+	    code: string;
+	    description: string;
+	    not_before: string;
+	    not_after: string;
+	    deadline: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new InstanceEventInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.code = source["code"];
+	        this.description = source["description"];
+	        this.not_before = source["not_before"];
+	        this.not_after = source["not_after"];
+	        this.deadline = source["deadline"];
+	    }
+	}
+	export class StatusCheckInfo {
+	    name: string;
+	    status: string;
+	    impaired_since: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new StatusCheckInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.status = source["status"];
+	        this.impaired_since = source["impaired_since"];
+	    }
+	}
+	export class InstanceStatusInfo {
+	    instance_id: string;
+	    state: string;
+	    availability_zone: string;
+	    system_status: string;
+	    instance_status: string;
+	    ebs_status: string;
+	    system_checks: StatusCheckInfo[];
+	    instance_checks: StatusCheckInfo[];
+	    scheduled_events: InstanceEventInfo[];
+	
+	    static createFrom(source: any = {}) {
+	        return new InstanceStatusInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.instance_id = source["instance_id"];
+	        this.state = source["state"];
+	        this.availability_zone = source["availability_zone"];
+	        this.system_status = source["system_status"];
+	        this.instance_status = source["instance_status"];
+	        this.ebs_status = source["ebs_status"];
+	        this.system_checks = this.convertValues(source["system_checks"], StatusCheckInfo);
+	        this.instance_checks = this.convertValues(source["instance_checks"], StatusCheckInfo);
+	        this.scheduled_events = this.convertValues(source["scheduled_events"], InstanceEventInfo);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class InternetGatewayInfo {
+	    ID: string;
+	    Name: string;
+	    VPCID: string;
+	    State: string;
+	    OwnerID: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new InternetGatewayInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.ID = source["ID"];
+	        this.Name = source["Name"];
+	        this.VPCID = source["VPCID"];
+	        this.State = source["State"];
+	        this.OwnerID = source["OwnerID"];
+	    }
+	}
+	export class KMSKeyInfo {
+	    KeyID: string;
+	    ARN: string;
+	    Description: string;
+	    Aliases: string[];
+	    State: string;
+	    KeySpec: string;
+	    KeyUsage: string;
+	    Origin: string;
+	    MultiRegion: boolean;
+	    CreatedAt: string;
+	    RotationSupported: boolean;
+	    RotationEnabled: boolean;
+	    RotationUnknown: boolean;
+	    RotationPeriodDays: number;
+	    NextRotation: string;
+	    PendingDeletion: boolean;
+	    DeletionDate: string;
+	    PolicyPrincipals: string[];
+	    Issues: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new KMSKeyInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.KeyID = source["KeyID"];
+	        this.ARN = source["ARN"];
+	        this.Description = source["Description"];
+	        this.Aliases = source["Aliases"];
+	        this.State = source["State"];
+	        this.KeySpec = source["KeySpec"];
+	        this.KeyUsage = source["KeyUsage"];
+	        this.Origin = source["Origin"];
+	        this.MultiRegion = source["MultiRegion"];
+	        this.CreatedAt = source["CreatedAt"];
+	        this.RotationSupported = source["RotationSupported"];
+	        this.RotationEnabled = source["RotationEnabled"];
+	        this.RotationUnknown = source["RotationUnknown"];
+	        this.RotationPeriodDays = source["RotationPeriodDays"];
+	        this.NextRotation = source["NextRotation"];
+	        this.PendingDeletion = source["PendingDeletion"];
+	        this.DeletionDate = source["DeletionDate"];
+	        this.PolicyPrincipals = source["PolicyPrincipals"];
+	        this.Issues = source["Issues"];
+	    }
+	}
+	export class LambdaAliasInfo {
+	    name: string;
+	    version: string;
+	    description: string;
+	    additional_version_weights: Record<string, number>;
+	
+	    static createFrom(source: any = {}) {
+	        return new LambdaAliasInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.version = source["version"];
+	        this.description = source["description"];
+	        this.additional_version_weights = source["additional_version_weights"];
+	    }
+	}
+	export class LambdaEventSourceInfo {
+	    uuid: string;
+	    source_arn: string;
+	    source_type: string;
+	    state: string;
+	    batch_size: number;
+	    last_result: string;
+	    state_reason: string;
+	    last_modified: string;
+	    maximum_retries: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new LambdaEventSourceInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.uuid = source["uuid"];
+	        this.source_arn = source["source_arn"];
+	        this.source_type = source["source_type"];
+	        this.state = source["state"];
+	        this.batch_size = source["batch_size"];
+	        this.last_result = source["last_result"];
+	        this.state_reason = source["state_reason"];
+	        this.last_modified = source["last_modified"];
+	        this.maximum_retries = source["maximum_retries"];
+	    }
+	}
+	export class LambdaTriggerInfo {
+	    sid: string;
+	    principal: string;
+	    action: string;
+	    source_arn: string;
+	    account: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new LambdaTriggerInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.sid = source["sid"];
+	        this.principal = source["principal"];
+	        this.action = source["action"];
+	        this.source_arn = source["source_arn"];
+	        this.account = source["account"];
+	    }
+	}
+	export class LambdaVersionInfo {
+	    version: string;
+	    description: string;
+	    runtime: string;
+	    code_sha256: string;
+	    last_modified: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new LambdaVersionInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.version = source["version"];
+	        this.description = source["description"];
+	        this.runtime = source["runtime"];
+	        this.code_sha256 = source["code_sha256"];
+	        this.last_modified = source["last_modified"];
+	    }
+	}
+	export class LambdaProvisionedConcurrency {
+	    qualifier: string;
+	    requested: number;
+	    allocated: number;
+	    available: number;
+	    status: string;
+	    reason: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new LambdaProvisionedConcurrency(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.qualifier = source["qualifier"];
+	        this.requested = source["requested"];
+	        this.allocated = source["allocated"];
+	        this.available = source["available"];
+	        this.status = source["status"];
+	        this.reason = source["reason"];
+	    }
+	}
+	export class LambdaFunctionDetail {
+	    function_name: string;
+	    reserved_concurrency?: number;
+	    provisioned_concurrency: LambdaProvisionedConcurrency[];
+	    aliases: LambdaAliasInfo[];
+	    versions: LambdaVersionInfo[];
+	    event_sources: LambdaEventSourceInfo[];
+	    triggers: LambdaTriggerInfo[];
+	    errors: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new LambdaFunctionDetail(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.function_name = source["function_name"];
+	        this.reserved_concurrency = source["reserved_concurrency"];
+	        this.provisioned_concurrency = this.convertValues(source["provisioned_concurrency"], LambdaProvisionedConcurrency);
+	        this.aliases = this.convertValues(source["aliases"], LambdaAliasInfo);
+	        this.versions = this.convertValues(source["versions"], LambdaVersionInfo);
+	        this.event_sources = this.convertValues(source["event_sources"], LambdaEventSourceInfo);
+	        this.triggers = this.convertValues(source["triggers"], LambdaTriggerInfo);
+	        this.errors = source["errors"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class LambdaFunctionInfo {
+	    FunctionName: string;
+	    Runtime: string;
+	    MemorySize: number;
+	    LastModified: string;
+	    Handler: string;
+	    Description: string;
+	    Arn: string;
+	    State: string;
+	    LogGroup: string;
+	    Timeout: number;
+	    Architecture: string;
+	    PackageType: string;
+	    CodeSize: number;
+	    Role: string;
+	    Layers: string[];
+	    VPCID: string;
+	    SubnetIDs: string[];
+	    SecurityGroupIDs: string[];
+	    EnvVarNames: string[];
+	    RuntimeDeprecationDate: string;
+	    DeprecatedRuntime: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new LambdaFunctionInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.FunctionName = source["FunctionName"];
+	        this.Runtime = source["Runtime"];
+	        this.MemorySize = source["MemorySize"];
+	        this.LastModified = source["LastModified"];
+	        this.Handler = source["Handler"];
+	        this.Description = source["Description"];
+	        this.Arn = source["Arn"];
+	        this.State = source["State"];
+	        this.LogGroup = source["LogGroup"];
+	        this.Timeout = source["Timeout"];
+	        this.Architecture = source["Architecture"];
+	        this.PackageType = source["PackageType"];
+	        this.CodeSize = source["CodeSize"];
+	        this.Role = source["Role"];
+	        this.Layers = source["Layers"];
+	        this.VPCID = source["VPCID"];
+	        this.SubnetIDs = source["SubnetIDs"];
+	        this.SecurityGroupIDs = source["SecurityGroupIDs"];
+	        this.EnvVarNames = source["EnvVarNames"];
+	        this.RuntimeDeprecationDate = source["RuntimeDeprecationDate"];
+	        this.DeprecatedRuntime = source["DeprecatedRuntime"];
+	    }
+	}
+	export class LambdaInvokerInfo {
+	    source_type: string;
+	    source_id: string;
+	    source_name: string;
+	    detail: string;
+	    qualifier: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new LambdaInvokerInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.source_type = source["source_type"];
+	        this.source_id = source["source_id"];
+	        this.source_name = source["source_name"];
+	        this.detail = source["detail"];
+	        this.qualifier = source["qualifier"];
+	    }
+	}
+	
+	
+	
+	export class LaunchTemplateInfo {
+	    ID: string;
+	    Name: string;
+	    DefaultVersion: number;
+	    LatestVersion: number;
+	    CreatedBy: string;
+	    CreatedAt: string;
+	    Tags: Record<string, string>;
+	
+	    static createFrom(source: any = {}) {
+	        return new LaunchTemplateInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.ID = source["ID"];
+	        this.Name = source["Name"];
+	        this.DefaultVersion = source["DefaultVersion"];
+	        this.LatestVersion = source["LatestVersion"];
+	        this.CreatedBy = source["CreatedBy"];
+	        this.CreatedAt = source["CreatedAt"];
+	        this.Tags = source["Tags"];
+	    }
+	}
+	export class LaunchTemplateVersionInfo {
+	    TemplateID: string;
+	    Number: number;
+	    Description: string;
+	    IsDefault: boolean;
+	    CreatedBy: string;
+	    CreatedAt: string;
+	    ImageID: string;
+	    InstanceType: string;
+	    KeyName: string;
+	    SecurityGroupIDs: string[];
+	    IAMInstanceProfile: string;
+	    HasUserData: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new LaunchTemplateVersionInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.TemplateID = source["TemplateID"];
+	        this.Number = source["Number"];
+	        this.Description = source["Description"];
+	        this.IsDefault = source["IsDefault"];
+	        this.CreatedBy = source["CreatedBy"];
+	        this.CreatedAt = source["CreatedAt"];
+	        this.ImageID = source["ImageID"];
+	        this.InstanceType = source["InstanceType"];
+	        this.KeyName = source["KeyName"];
+	        this.SecurityGroupIDs = source["SecurityGroupIDs"];
+	        this.IAMInstanceProfile = source["IAMInstanceProfile"];
+	        this.HasUserData = source["HasUserData"];
+	    }
+	}
+	export class LoadBalancerInfo {
+	    Name: string;
+	    ARN: string;
+	    DNSName: string;
+	    Type: string;
+	    Scheme: string;
+	    State: string;
+	    VPCID: string;
+	    AvailabilityZones: string[];
+	    Addresses: string[];
 	
 	    static createFrom(source: any = {}) {
 	        return new LoadBalancerInfo(source);
@@ -272,116 +2791,1381 @@ export namespace models {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.Name = source["Name"];
 	        this.ARN = source["ARN"];
-	        this.DNSName = source["DNSName"];
-	        this.Type = source["Type"];
-	        this.Scheme = source["Scheme"];
-	        this.State = source["State"];
+	        this.DNSName = source["DNSName"];
+	        this.Type = source["Type"];
+	        this.Scheme = source["Scheme"];
+	        this.State = source["State"];
+	        this.VPCID = source["VPCID"];
+	        this.AvailabilityZones = source["AvailabilityZones"];
+	        this.Addresses = source["Addresses"];
+	    }
+	}
+	export class LogGroupInfo {
+	    name: string;
+	    arn: string;
+	    created_at: string;
+	    retention_in_days: number;
+	    stored_bytes: number;
+	    kms_key_id: string;
+	    log_group_class: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new LogGroupInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.arn = source["arn"];
+	        this.created_at = source["created_at"];
+	        this.retention_in_days = source["retention_in_days"];
+	        this.stored_bytes = source["stored_bytes"];
+	        this.kms_key_id = source["kms_key_id"];
+	        this.log_group_class = source["log_group_class"];
+	    }
+	}
+	export class LogStreamInfo {
+	    name: string;
+	    created_at: string;
+	    first_event_at: string;
+	    last_event_at: string;
+	    last_ingested_at: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new LogStreamInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.created_at = source["created_at"];
+	        this.first_event_at = source["first_event_at"];
+	        this.last_event_at = source["last_event_at"];
+	        this.last_ingested_at = source["last_ingested_at"];
+	    }
+	}
+	export class LogTailRequest {
+	    log_group: string;
+	    log_stream: string;
+	    filter_pattern: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new LogTailRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.log_group = source["log_group"];
+	        this.log_stream = source["log_stream"];
+	        this.filter_pattern = source["filter_pattern"];
+	    }
+	}
+	export class LogsInsightsRequest {
+	    log_groups: string[];
+	    query: string;
+	    start: string;
+	    end: string;
+	    limit: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new LogsInsightsRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.log_groups = source["log_groups"];
+	        this.query = source["query"];
+	        this.start = source["start"];
+	        this.end = source["end"];
+	        this.limit = source["limit"];
+	    }
+	}
+	export class LogsInsightsResult {
+	    query_id: string;
+	    status: string;
+	    rows: any[];
+	    records_matched: number;
+	    records_scanned: number;
+	    bytes_scanned: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new LogsInsightsResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.query_id = source["query_id"];
+	        this.status = source["status"];
+	        this.rows = source["rows"];
+	        this.records_matched = source["records_matched"];
+	        this.records_scanned = source["records_scanned"];
+	        this.bytes_scanned = source["bytes_scanned"];
+	    }
+	}
+	export class MSKClusterInfo {
+	    Name: string;
+	    ARN: string;
+	    Type: string;
+	    State: string;
+	    KafkaVersion: string;
+	    InstanceType: string;
+	    BrokerCount: number;
+	    VolumeSize: number;
+	    ClientBrokerEncryption: string;
+	    TransitEncryption: boolean;
+	    AtRestEncryption: boolean;
+	    KMSKeyID: string;
+	    EnhancedMonitoring: string;
+	    VPCID: string;
+	    SubnetIDs: string[];
+	    SecurityGroupIDs: string[];
+	    CreatedAt: string;
+	    Tags: Record<string, string>;
+	
+	    static createFrom(source: any = {}) {
+	        return new MSKClusterInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.Name = source["Name"];
+	        this.ARN = source["ARN"];
+	        this.Type = source["Type"];
+	        this.State = source["State"];
+	        this.KafkaVersion = source["KafkaVersion"];
+	        this.InstanceType = source["InstanceType"];
+	        this.BrokerCount = source["BrokerCount"];
+	        this.VolumeSize = source["VolumeSize"];
+	        this.ClientBrokerEncryption = source["ClientBrokerEncryption"];
+	        this.TransitEncryption = source["TransitEncryption"];
+	        this.AtRestEncryption = source["AtRestEncryption"];
+	        this.KMSKeyID = source["KMSKeyID"];
+	        this.EnhancedMonitoring = source["EnhancedMonitoring"];
+	        this.VPCID = source["VPCID"];
+	        this.SubnetIDs = source["SubnetIDs"];
+	        this.SecurityGroupIDs = source["SecurityGroupIDs"];
+	        this.CreatedAt = source["CreatedAt"];
+	        this.Tags = source["Tags"];
+	    }
+	}
+	export class MetricData {
+	    id: string;
+	    label: string;
+	    values: number[];
+	    times: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new MetricData(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.label = source["label"];
+	        this.values = source["values"];
+	        this.times = source["times"];
+	    }
+	}
+	export class MetricExpression {
+	    id: string;
+	    label: string;
+	    expression: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new MetricExpression(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.label = source["label"];
+	        this.expression = source["expression"];
+	    }
+	}
+	export class MetricRequest {
+	    resource_type: string;
+	    resource_id: string;
+	    start: string;
+	    end: string;
+	    period: number;
+	    stats: string[];
+	    expressions: MetricExpression[];
+	
+	    static createFrom(source: any = {}) {
+	        return new MetricRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.resource_type = source["resource_type"];
+	        this.resource_id = source["resource_id"];
+	        this.start = source["start"];
+	        this.end = source["end"];
+	        this.period = source["period"];
+	        this.stats = source["stats"];
+	        this.expressions = this.convertValues(source["expressions"], MetricExpression);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class NATGatewayInfo {
+	    ID: string;
+	    Name: string;
+	    State: string;
+	    SubnetID: string;
+	    VPCID: string;
+	    PublicIP: string;
+	    PrivateIP: string;
+	    ConnectivityType: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new NATGatewayInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.ID = source["ID"];
+	        this.Name = source["Name"];
+	        this.State = source["State"];
+	        this.SubnetID = source["SubnetID"];
+	        this.VPCID = source["VPCID"];
+	        this.PublicIP = source["PublicIP"];
+	        this.PrivateIP = source["PrivateIP"];
+	        this.ConnectivityType = source["ConnectivityType"];
+	    }
+	}
+	export class NetworkInterfaceInfo {
+	    ID: string;
+	    Description: string;
+	    InterfaceType: string;
+	    Status: string;
+	    RequesterID: string;
+	    RequesterManaged: boolean;
+	    Requester: string;
+	    InstanceID: string;
+	    AttachmentStatus: string;
+	    PrivateIPs: string[];
+	    PublicIPs: string[];
+	    SecurityGroupIDs: string[];
+	    SubnetID: string;
+	    VPCID: string;
+	    AvailabilityZone: string;
+	    MacAddress: string;
+	    Tags: Record<string, string>;
+	
+	    static createFrom(source: any = {}) {
+	        return new NetworkInterfaceInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.ID = source["ID"];
+	        this.Description = source["Description"];
+	        this.InterfaceType = source["InterfaceType"];
+	        this.Status = source["Status"];
+	        this.RequesterID = source["RequesterID"];
+	        this.RequesterManaged = source["RequesterManaged"];
+	        this.Requester = source["Requester"];
+	        this.InstanceID = source["InstanceID"];
+	        this.AttachmentStatus = source["AttachmentStatus"];
+	        this.PrivateIPs = source["PrivateIPs"];
+	        this.PublicIPs = source["PublicIPs"];
+	        this.SecurityGroupIDs = source["SecurityGroupIDs"];
+	        this.SubnetID = source["SubnetID"];
+	        this.VPCID = source["VPCID"];
+	        this.AvailabilityZone = source["AvailabilityZone"];
+	        this.MacAddress = source["MacAddress"];
+	        this.Tags = source["Tags"];
+	    }
+	}
+	export class OpenSearchDomainInfo {
+	    DomainName: string;
+	    ARN: string;
+	    EngineVersion: string;
+	    Status: string;
+	    InstanceType: string;
+	    InstanceCount: number;
+	    DedicatedMasterEnabled: boolean;
+	    DedicatedMasterType: string;
+	    DedicatedMasterCount: number;
+	    WarmCount: number;
+	    ZoneAwareness: boolean;
+	    VolumeType: string;
+	    VolumeSize: number;
+	    TransitEncryption: boolean;
+	    AtRestEncryption: boolean;
+	    EnforceHTTPS: boolean;
+	    Endpoint: string;
+	    VPCID: string;
+	    SubnetIDs: string[];
+	    SecurityGroupIDs: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new OpenSearchDomainInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.DomainName = source["DomainName"];
+	        this.ARN = source["ARN"];
+	        this.EngineVersion = source["EngineVersion"];
+	        this.Status = source["Status"];
+	        this.InstanceType = source["InstanceType"];
+	        this.InstanceCount = source["InstanceCount"];
+	        this.DedicatedMasterEnabled = source["DedicatedMasterEnabled"];
+	        this.DedicatedMasterType = source["DedicatedMasterType"];
+	        this.DedicatedMasterCount = source["DedicatedMasterCount"];
+	        this.WarmCount = source["WarmCount"];
+	        this.ZoneAwareness = source["ZoneAwareness"];
+	        this.VolumeType = source["VolumeType"];
+	        this.VolumeSize = source["VolumeSize"];
+	        this.TransitEncryption = source["TransitEncryption"];
+	        this.AtRestEncryption = source["AtRestEncryption"];
+	        this.EnforceHTTPS = source["EnforceHTTPS"];
+	        this.Endpoint = source["Endpoint"];
+	        this.VPCID = source["VPCID"];
+	        this.SubnetIDs = source["SubnetIDs"];
+	        this.SecurityGroupIDs = source["SecurityGroupIDs"];
+	    }
+	}
+	
+	export class PolicyRisk {
+	    risk: string;
+	    severity: string;
+	    policy: string;
+	    via: string;
+	    statement: string;
+	    conditional: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new PolicyRisk(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.risk = source["risk"];
+	        this.severity = source["severity"];
+	        this.policy = source["policy"];
+	        this.via = source["via"];
+	        this.statement = source["statement"];
+	        this.conditional = source["conditional"];
+	    }
+	}
+	export class PrincipalPolicyReport {
+	    name: string;
+	    arn: string;
+	    type: string;
+	    policies: string[];
+	    risks: PolicyRisk[];
+	
+	    static createFrom(source: any = {}) {
+	        return new PrincipalPolicyReport(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.arn = source["arn"];
+	        this.type = source["type"];
+	        this.policies = source["policies"];
+	        this.risks = this.convertValues(source["risks"], PolicyRisk);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class PolicyAnalysis {
+	    principals: PrincipalPolicyReport[];
+	    principals_analyzed: number;
+	    policies_analyzed: number;
+	    findings: SecurityFinding[];
+	    errors: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new PolicyAnalysis(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.principals = this.convertValues(source["principals"], PrincipalPolicyReport);
+	        this.principals_analyzed = source["principals_analyzed"];
+	        this.policies_analyzed = source["policies_analyzed"];
+	        this.findings = this.convertValues(source["findings"], SecurityFinding);
+	        this.errors = source["errors"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	
+	export class RDSClusterMemberInfo {
+	    DBInstanceIdentifier: string;
+	    IsWriter: boolean;
+	    PromotionTier: number;
+	    ParameterGroupStatus: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new RDSClusterMemberInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.DBInstanceIdentifier = source["DBInstanceIdentifier"];
+	        this.IsWriter = source["IsWriter"];
+	        this.PromotionTier = source["PromotionTier"];
+	        this.ParameterGroupStatus = source["ParameterGroupStatus"];
+	    }
+	}
+	export class RDSClusterInfo {
+	    DBClusterIdentifier: string;
+	    ARN: string;
+	    Engine: string;
+	    EngineVersion: string;
+	    EngineMode: string;
+	    Status: string;
+	    Endpoint: string;
+	    ReaderEndpoint: string;
+	    CustomEndpoints: string[];
+	    Port: number;
+	    MultiAZ: boolean;
+	    WriterInstance: string;
+	    Members: RDSClusterMemberInfo[];
+	    ServerlessV2MinACU: number;
+	    ServerlessV2MaxACU: number;
+	    BacktrackWindow: number;
+	    EarliestBacktrackTime: string;
+	    BackupRetentionPeriod: number;
+	    ParameterGroup: string;
+	    SubnetGroup: string;
+	    StorageEncrypted: boolean;
+	    DeletionProtection: boolean;
+	    CreatedAt: string;
+	    Tags: Record<string, string>;
+	
+	    static createFrom(source: any = {}) {
+	        return new RDSClusterInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.DBClusterIdentifier = source["DBClusterIdentifier"];
+	        this.ARN = source["ARN"];
+	        this.Engine = source["Engine"];
+	        this.EngineVersion = source["EngineVersion"];
+	        this.EngineMode = source["EngineMode"];
+	        this.Status = source["Status"];
+	        this.Endpoint = source["Endpoint"];
+	        this.ReaderEndpoint = source["ReaderEndpoint"];
+	        this.CustomEndpoints = source["CustomEndpoints"];
+	        this.Port = source["Port"];
+	        this.MultiAZ = source["MultiAZ"];
+	        this.WriterInstance = source["WriterInstance"];
+	        this.Members = this.convertValues(source["Members"], RDSClusterMemberInfo);
+	        this.ServerlessV2MinACU = source["ServerlessV2MinACU"];
+	        this.ServerlessV2MaxACU = source["ServerlessV2MaxACU"];
+	        this.BacktrackWindow = source["BacktrackWindow"];
+	        this.EarliestBacktrackTime = source["EarliestBacktrackTime"];
+	        this.BackupRetentionPeriod = source["BackupRetentionPeriod"];
+	        this.ParameterGroup = source["ParameterGroup"];
+	        this.SubnetGroup = source["SubnetGroup"];
+	        this.StorageEncrypted = source["StorageEncrypted"];
+	        this.DeletionProtection = source["DeletionProtection"];
+	        this.CreatedAt = source["CreatedAt"];
+	        this.Tags = source["Tags"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	export class RDSEventInfo {
+	    source_identifier: string;
+	    source_type: string;
+	    message: string;
+	    categories: string[];
+	    date: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new RDSEventInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.source_identifier = source["source_identifier"];
+	        this.source_type = source["source_type"];
+	        this.message = source["message"];
+	        this.categories = source["categories"];
+	        this.date = source["date"];
+	    }
+	}
+	export class RDSInstanceInfo {
+	    DBInstanceIdentifier: string;
+	    Engine: string;
+	    EngineVersion: string;
+	    DBInstanceStatus: string;
+	    Endpoint: string;
+	    AllocatedStorage: number;
+	    DBInstanceClass: string;
+	    VpcId: string;
+	    AvailabilityZone: string;
+	    MultiAZ: boolean;
+	    PubliclyAccessible: boolean;
+	    MasterUsername: string;
+	    DBClusterIdentifier: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new RDSInstanceInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.DBInstanceIdentifier = source["DBInstanceIdentifier"];
+	        this.Engine = source["Engine"];
+	        this.EngineVersion = source["EngineVersion"];
+	        this.DBInstanceStatus = source["DBInstanceStatus"];
+	        this.Endpoint = source["Endpoint"];
+	        this.AllocatedStorage = source["AllocatedStorage"];
+	        this.DBInstanceClass = source["DBInstanceClass"];
+	        this.VpcId = source["VpcId"];
+	        this.AvailabilityZone = source["AvailabilityZone"];
+	        this.MultiAZ = source["MultiAZ"];
+	        this.PubliclyAccessible = source["PubliclyAccessible"];
+	        this.MasterUsername = source["MasterUsername"];
+	        this.DBClusterIdentifier = source["DBClusterIdentifier"];
+	    }
+	}
+	export class RDSParameterInfo {
+	    Name: string;
+	    Value: string;
+	    ApplyType: string;
+	    ApplyMethod: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new RDSParameterInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.Name = source["Name"];
+	        this.Value = source["Value"];
+	        this.ApplyType = source["ApplyType"];
+	        this.ApplyMethod = source["ApplyMethod"];
+	    }
+	}
+	export class RDSParameterGroupInfo {
+	    Name: string;
+	    Family: string;
+	    Description: string;
+	    IsCluster: boolean;
+	    IsDefault: boolean;
+	    ModifiedParameters: RDSParameterInfo[];
+	
+	    static createFrom(source: any = {}) {
+	        return new RDSParameterGroupInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.Name = source["Name"];
+	        this.Family = source["Family"];
+	        this.Description = source["Description"];
+	        this.IsCluster = source["IsCluster"];
+	        this.IsDefault = source["IsDefault"];
+	        this.ModifiedParameters = this.convertValues(source["ModifiedParameters"], RDSParameterInfo);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	export class RDSPendingMaintenanceInfo {
+	    resource_arn: string;
+	    action: string;
+	    description: string;
+	    auto_applied_after: string;
+	    forced_apply_date: string;
+	    current_apply_date: string;
+	    opt_in_status: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new RDSPendingMaintenanceInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.resource_arn = source["resource_arn"];
+	        this.action = source["action"];
+	        this.description = source["description"];
+	        this.auto_applied_after = source["auto_applied_after"];
+	        this.forced_apply_date = source["forced_apply_date"];
+	        this.current_apply_date = source["current_apply_date"];
+	        this.opt_in_status = source["opt_in_status"];
+	    }
+	}
+	export class RDSSnapshotInfo {
+	    Identifier: string;
+	    ARN: string;
+	    SourceIdentifier: string;
+	    IsCluster: boolean;
+	    Type: string;
+	    Status: string;
+	    Engine: string;
+	    EngineVersion: string;
+	    AllocatedStorage: number;
+	    Encrypted: boolean;
+	    CreatedAt: string;
+	    AgeDays: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new RDSSnapshotInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.Identifier = source["Identifier"];
+	        this.ARN = source["ARN"];
+	        this.SourceIdentifier = source["SourceIdentifier"];
+	        this.IsCluster = source["IsCluster"];
+	        this.Type = source["Type"];
+	        this.Status = source["Status"];
+	        this.Engine = source["Engine"];
+	        this.EngineVersion = source["EngineVersion"];
+	        this.AllocatedStorage = source["AllocatedStorage"];
+	        this.Encrypted = source["Encrypted"];
+	        this.CreatedAt = source["CreatedAt"];
+	        this.AgeDays = source["AgeDays"];
+	    }
+	}
+	export class ResourceMetrics {
+	    metrics: MetricData[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ResourceMetrics(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.metrics = this.convertValues(source["metrics"], MetricData);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class Route53HostedZoneInfo {
+	    ID: string;
+	    Name: string;
+	    Private: boolean;
+	    RecordCount: number;
+	    Comment: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new Route53HostedZoneInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.ID = source["ID"];
+	        this.Name = source["Name"];
+	        this.Private = source["Private"];
+	        this.RecordCount = source["RecordCount"];
+	        this.Comment = source["Comment"];
+	    }
+	}
+	export class Route53RecordInfo {
+	    name: string;
+	    type: string;
+	    ttl: number;
+	    values: string[];
+	    alias_target: string;
+	    routing: string;
+	    set_identifier: string;
+	    health_check_id: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new Route53RecordInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.type = source["type"];
+	        this.ttl = source["ttl"];
+	        this.values = source["values"];
+	        this.alias_target = source["alias_target"];
+	        this.routing = source["routing"];
+	        this.set_identifier = source["set_identifier"];
+	        this.health_check_id = source["health_check_id"];
+	    }
+	}
+	export class RouteInfo {
+	    Destination: string;
+	    TargetType: string;
+	    TargetID: string;
+	    State: string;
+	    Origin: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new RouteInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.Destination = source["Destination"];
+	        this.TargetType = source["TargetType"];
+	        this.TargetID = source["TargetID"];
+	        this.State = source["State"];
+	        this.Origin = source["Origin"];
+	    }
+	}
+	export class RouteTableInfo {
+	    ID: string;
+	    Name: string;
+	    VPCID: string;
+	    IsMain: boolean;
+	    Routes: number;
+	    Subnets: number;
+	    SubnetIDs: string[];
+	    RouteEntries: RouteInfo[];
+	
+	    static createFrom(source: any = {}) {
+	        return new RouteTableInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.ID = source["ID"];
+	        this.Name = source["Name"];
+	        this.VPCID = source["VPCID"];
+	        this.IsMain = source["IsMain"];
+	        this.Routes = source["Routes"];
+	        this.Subnets = source["Subnets"];
+	        this.SubnetIDs = source["SubnetIDs"];
+	        this.RouteEntries = this.convertValues(source["RouteEntries"], RouteInfo);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class S3BucketInfo {
+	    Name: string;
+	    Region: string;
+	    CreationDate: string;
+	    Versioning: string;
+	    PublicAccess: boolean;
+	    Encryption: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new S3BucketInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.Name = source["Name"];
+	        this.Region = source["Region"];
+	        this.CreationDate = source["CreationDate"];
+	        this.Versioning = source["Versioning"];
+	        this.PublicAccess = source["PublicAccess"];
+	        this.Encryption = source["Encryption"];
+	    }
+	}
+	export class SNSSubscriptionInfo {
+	    ARN: string;
+	    Protocol: string;
+	    Endpoint: string;
+	    PendingConfirmation: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new SNSSubscriptionInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.ARN = source["ARN"];
+	        this.Protocol = source["Protocol"];
+	        this.Endpoint = source["Endpoint"];
+	        this.PendingConfirmation = source["PendingConfirmation"];
+	    }
+	}
+	export class SNSTopicInfo {
+	    TopicName: string;
+	    ARN: string;
+	    DisplayName: string;
+	    FIFO: boolean;
+	    KMSKeyID: string;
+	    SubscriptionsConfirmed: number;
+	    SubscriptionsPending: number;
+	    SubscriptionsByProtocol: Record<string, number>;
+	    Subscriptions: SNSSubscriptionInfo[];
+	
+	    static createFrom(source: any = {}) {
+	        return new SNSTopicInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.TopicName = source["TopicName"];
+	        this.ARN = source["ARN"];
+	        this.DisplayName = source["DisplayName"];
+	        this.FIFO = source["FIFO"];
+	        this.KMSKeyID = source["KMSKeyID"];
+	        this.SubscriptionsConfirmed = source["SubscriptionsConfirmed"];
+	        this.SubscriptionsPending = source["SubscriptionsPending"];
+	        this.SubscriptionsByProtocol = source["SubscriptionsByProtocol"];
+	        this.Subscriptions = this.convertValues(source["Subscriptions"], SNSSubscriptionInfo);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class SQSQueueInfo {
+	    QueueName: string;
+	    QueueURL: string;
+	    ARN: string;
+	    FIFO: boolean;
+	    Messages: number;
+	    MessagesInFlight: number;
+	    MessagesDelayed: number;
+	    OldestMessageAge: number;
+	    VisibilityTimeout: number;
+	    RetentionPeriod: number;
+	    DeadLetterQueue: string;
+	    MaxReceiveCount: number;
+	    SourceQueues: string[];
+	    Encryption: string;
+	    KMSKeyID: string;
+	    CreatedAt: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new SQSQueueInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.QueueName = source["QueueName"];
+	        this.QueueURL = source["QueueURL"];
+	        this.ARN = source["ARN"];
+	        this.FIFO = source["FIFO"];
+	        this.Messages = source["Messages"];
+	        this.MessagesInFlight = source["MessagesInFlight"];
+	        this.MessagesDelayed = source["MessagesDelayed"];
+	        this.OldestMessageAge = source["OldestMessageAge"];
+	        this.VisibilityTimeout = source["VisibilityTimeout"];
+	        this.RetentionPeriod = source["RetentionPeriod"];
+	        this.DeadLetterQueue = source["DeadLetterQueue"];
+	        this.MaxReceiveCount = source["MaxReceiveCount"];
+	        this.SourceQueues = source["SourceQueues"];
+	        this.Encryption = source["Encryption"];
+	        this.KMSKeyID = source["KMSKeyID"];
+	        this.CreatedAt = source["CreatedAt"];
+	    }
+	}
+	export class ScalingActivityInfo {
+	    id: string;
+	    description: string;
+	    cause: string;
+	    status_code: string;
+	    status_message: string;
+	    progress: number;
+	    start_time: string;
+	    end_time: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ScalingActivityInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.description = source["description"];
+	        this.cause = source["cause"];
+	        this.status_code = source["status_code"];
+	        this.status_message = source["status_message"];
+	        this.progress = source["progress"];
+	        this.start_time = source["start_time"];
+	        this.end_time = source["end_time"];
+	    }
+	}
+	
+	export class SecretReplicaInfo {
+	    Region: string;
+	    Status: string;
+	    StatusMessage: string;
+	    KMSKeyID: string;
+	    LastAccessed: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new SecretReplicaInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.Region = source["Region"];
+	        this.Status = source["Status"];
+	        this.StatusMessage = source["StatusMessage"];
+	        this.KMSKeyID = source["KMSKeyID"];
+	        this.LastAccessed = source["LastAccessed"];
+	    }
+	}
+	export class SecretInfo {
+	    Name: string;
+	    ARN: string;
+	    Description: string;
+	    KMSKeyID: string;
+	    OwningService: string;
+	    RotationEnabled: boolean;
+	    RotationLambda: string;
+	    RotationSchedule: string;
+	    LastRotated: string;
+	    NextRotation: string;
+	    LastChanged: string;
+	    LastAccessed: string;
+	    DaysSinceAccess: number;
+	    CreatedAt: string;
+	    PrimaryRegion: string;
+	    Replicas: SecretReplicaInfo[];
+	    Stale: boolean;
+	    Issues: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new SecretInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.Name = source["Name"];
+	        this.ARN = source["ARN"];
+	        this.Description = source["Description"];
+	        this.KMSKeyID = source["KMSKeyID"];
+	        this.OwningService = source["OwningService"];
+	        this.RotationEnabled = source["RotationEnabled"];
+	        this.RotationLambda = source["RotationLambda"];
+	        this.RotationSchedule = source["RotationSchedule"];
+	        this.LastRotated = source["LastRotated"];
+	        this.NextRotation = source["NextRotation"];
+	        this.LastChanged = source["LastChanged"];
+	        this.LastAccessed = source["LastAccessed"];
+	        this.DaysSinceAccess = source["DaysSinceAccess"];
+	        this.CreatedAt = source["CreatedAt"];
+	        this.PrimaryRegion = source["PrimaryRegion"];
+	        this.Replicas = this.convertValues(source["Replicas"], SecretReplicaInfo);
+	        this.Stale = source["Stale"];
+	        this.Issues = source["Issues"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	
+	export class SecurityGroupRule {
+	    Protocol: string;
+	    FromPort: number;
+	    ToPort: number;
+	    CIDR: string;
+	    Description: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new SecurityGroupRule(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.Protocol = source["Protocol"];
+	        this.FromPort = source["FromPort"];
+	        this.ToPort = source["ToPort"];
+	        this.CIDR = source["CIDR"];
+	        this.Description = source["Description"];
+	    }
+	}
+	export class SecurityGroupInfo {
+	    ID: string;
+	    Name: string;
+	    Description: string;
+	    VPCID: string;
+	    IngressRules: SecurityGroupRule[];
+	    EgressRules: SecurityGroupRule[];
+	
+	    static createFrom(source: any = {}) {
+	        return new SecurityGroupInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.ID = source["ID"];
+	        this.Name = source["Name"];
+	        this.Description = source["Description"];
 	        this.VPCID = source["VPCID"];
-	        this.AvailabilityZones = source["AvailabilityZones"];
+	        this.IngressRules = this.convertValues(source["IngressRules"], SecurityGroupRule);
+	        this.EgressRules = this.convertValues(source["EgressRules"], SecurityGroupRule);
 	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
 	}
-	export class MetricData {
-	    label: string;
-	    values: number[];
-	    times: string[];
+	
+	export class StackOwnerInfo {
+	    stack_name: string;
+	    stack_id: string;
+	    logical_id: string;
+	    resource_type: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new StackOwnerInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.stack_name = source["stack_name"];
+	        this.stack_id = source["stack_id"];
+	        this.logical_id = source["logical_id"];
+	        this.resource_type = source["resource_type"];
+	    }
+	}
+	export class StackOwnershipIndex {
+	    by_physical_id: Record<string, StackOwnerInfo>;
+	    stack_ids: Record<string, string>;
+	    errors: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new StackOwnershipIndex(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.by_physical_id = this.convertValues(source["by_physical_id"], StackOwnerInfo, true);
+	        this.stack_ids = source["stack_ids"];
+	        this.errors = source["errors"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class StateMachineInfo {
+	    ARN: string;
+	    Name: string;
+	    Type: string;
+	    Status: string;
+	    RoleARN: string;
+	    LoggingLevel: string;
+	    TracingEnabled: boolean;
+	    CreatedAt: string;
+	    LambdaARNs: string[];
+	    ExecutionCounts: Record<string, number>;
+	    SampledExecutions: number;
+	    LastExecution: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new MetricData(source);
+	        return new StateMachineInfo(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.label = source["label"];
-	        this.values = source["values"];
-	        this.times = source["times"];
+	        this.ARN = source["ARN"];
+	        this.Name = source["Name"];
+	        this.Type = source["Type"];
+	        this.Status = source["Status"];
+	        this.RoleARN = source["RoleARN"];
+	        this.LoggingLevel = source["LoggingLevel"];
+	        this.TracingEnabled = source["TracingEnabled"];
+	        this.CreatedAt = source["CreatedAt"];
+	        this.LambdaARNs = source["LambdaARNs"];
+	        this.ExecutionCounts = source["ExecutionCounts"];
+	        this.SampledExecutions = source["SampledExecutions"];
+	        this.LastExecution = source["LastExecution"];
 	    }
 	}
-	export class NATGatewayInfo {
+	
+	export class SubnetInfo {
 	    ID: string;
+	    CIDRBlock: string;
 	    Name: string;
-	    State: string;
-	    SubnetID: string;
 	    VPCID: string;
-	    PublicIP: string;
-	    PrivateIP: string;
-	    ConnectivityType: string;
+	    AvailabilityZone: string;
+	    State: string;
+	    MapPublicIPOnLaunch: boolean;
+	    AvailableIpAddressCount: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new NATGatewayInfo(source);
+	        return new SubnetInfo(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.ID = source["ID"];
+	        this.CIDRBlock = source["CIDRBlock"];
 	        this.Name = source["Name"];
+	        this.VPCID = source["VPCID"];
+	        this.AvailabilityZone = source["AvailabilityZone"];
 	        this.State = source["State"];
-	        this.SubnetID = source["SubnetID"];
+	        this.MapPublicIPOnLaunch = source["MapPublicIPOnLaunch"];
+	        this.AvailableIpAddressCount = source["AvailableIpAddressCount"];
+	    }
+	}
+	export class TargetGroupInfo {
+	    Name: string;
+	    ARN: string;
+	    Protocol: string;
+	    Port: number;
+	    TargetType: string;
+	    VPCID: string;
+	    HealthyCount: number;
+	    UnhealthyCount: number;
+	    HealthCheckPath: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new TargetGroupInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.Name = source["Name"];
+	        this.ARN = source["ARN"];
+	        this.Protocol = source["Protocol"];
+	        this.Port = source["Port"];
+	        this.TargetType = source["TargetType"];
 	        this.VPCID = source["VPCID"];
-	        this.PublicIP = source["PublicIP"];
-	        this.PrivateIP = source["PrivateIP"];
-	        this.ConnectivityType = source["ConnectivityType"];
+	        this.HealthyCount = source["HealthyCount"];
+	        this.UnhealthyCount = source["UnhealthyCount"];
+	        this.HealthCheckPath = source["HealthCheckPath"];
 	    }
 	}
-	export class PermissionStatus {
-	    Action: string;
-	    Allowed: boolean;
-	    Reason: string;
+	export class TransitGatewayAttachmentInfo {
+	    ID: string;
+	    Name: string;
+	    TransitGatewayID: string;
+	    ResourceType: string;
+	    ResourceID: string;
+	    ResourceOwnerID: string;
+	    VPCID: string;
+	    State: string;
+	    RouteTableID: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new PermissionStatus(source);
+	        return new TransitGatewayAttachmentInfo(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.Action = source["Action"];
-	        this.Allowed = source["Allowed"];
-	        this.Reason = source["Reason"];
+	        this.ID = source["ID"];
+	        this.Name = source["Name"];
+	        this.TransitGatewayID = source["TransitGatewayID"];
+	        this.ResourceType = source["ResourceType"];
+	        this.ResourceID = source["ResourceID"];
+	        this.ResourceOwnerID = source["ResourceOwnerID"];
+	        this.VPCID = source["VPCID"];
+	        this.State = source["State"];
+	        this.RouteTableID = source["RouteTableID"];
 	    }
 	}
-	export class RDSInstanceInfo {
-	    DBInstanceIdentifier: string;
-	    Engine: string;
-	    EngineVersion: string;
-	    DBInstanceStatus: string;
-	    Endpoint: string;
-	    AllocatedStorage: number;
-	    DBInstanceClass: string;
-	    VpcId: string;
-	    AvailabilityZone: string;
-	    MultiAZ: boolean;
-	    PubliclyAccessible: boolean;
-	    MasterUsername: string;
+	export class TransitGatewayRouteInfo {
+	    Destination: string;
+	    Type: string;
+	    State: string;
+	    AttachmentIDs: string[];
+	    ResourceIDs: string[];
 	
 	    static createFrom(source: any = {}) {
-	        return new RDSInstanceInfo(source);
+	        return new TransitGatewayRouteInfo(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.DBInstanceIdentifier = source["DBInstanceIdentifier"];
-	        this.Engine = source["Engine"];
-	        this.EngineVersion = source["EngineVersion"];
-	        this.DBInstanceStatus = source["DBInstanceStatus"];
-	        this.Endpoint = source["Endpoint"];
-	        this.AllocatedStorage = source["AllocatedStorage"];
-	        this.DBInstanceClass = source["DBInstanceClass"];
-	        this.VpcId = source["VpcId"];
-	        this.AvailabilityZone = source["AvailabilityZone"];
-	        this.MultiAZ = source["MultiAZ"];
-	        this.PubliclyAccessible = source["PubliclyAccessible"];
-	        this.MasterUsername = source["MasterUsername"];
+	        this.Destination = source["Destination"];
+	        this.Type = source["Type"];
+	        this.State = source["State"];
+	        this.AttachmentIDs = source["AttachmentIDs"];
+	        this.ResourceIDs = source["ResourceIDs"];
 	    }
 	}
-	export class ResourceMetrics {
-	    metrics: MetricData[];
+	export class TransitGatewayRouteTableInfo {
+	    ID: string;
+	    Name: string;
+	    TransitGatewayID: string;
+	    State: string;
+	    DefaultAssociation: boolean;
+	    DefaultPropagation: boolean;
+	    Routes: TransitGatewayRouteInfo[];
 	
 	    static createFrom(source: any = {}) {
-	        return new ResourceMetrics(source);
+	        return new TransitGatewayRouteTableInfo(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.metrics = this.convertValues(source["metrics"], MetricData);
+	        this.ID = source["ID"];
+	        this.Name = source["Name"];
+	        this.TransitGatewayID = source["TransitGatewayID"];
+	        this.State = source["State"];
+	        this.DefaultAssociation = source["DefaultAssociation"];
+	        this.DefaultPropagation = source["DefaultPropagation"];
+	        this.Routes = this.convertValues(source["Routes"], TransitGatewayRouteInfo);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -402,93 +4186,51 @@ export namespace models {
 		    return a;
 		}
 	}
-	export class RouteTableInfo {
-	    ID: string;
-	    Name: string;
-	    VPCID: string;
-	    IsMain: boolean;
-	    Routes: number;
-	    Subnets: number;
-	    SubnetIDs: string[];
-	
-	    static createFrom(source: any = {}) {
-	        return new RouteTableInfo(source);
-	    }
-	
-	    constructor(source: any = {}) {
-	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.ID = source["ID"];
-	        this.Name = source["Name"];
-	        this.VPCID = source["VPCID"];
-	        this.IsMain = source["IsMain"];
-	        this.Routes = source["Routes"];
-	        this.Subnets = source["Subnets"];
-	        this.SubnetIDs = source["SubnetIDs"];
-	    }
-	}
-	export class S3BucketInfo {
-	    Name: string;
-	    Region: string;
-	    CreationDate: string;
-	    Versioning: string;
-	    PublicAccess: boolean;
-	    Encryption: boolean;
-	
-	    static createFrom(source: any = {}) {
-	        return new S3BucketInfo(source);
-	    }
-	
-	    constructor(source: any = {}) {
-	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.Name = source["Name"];
-	        this.Region = source["Region"];
-	        this.CreationDate = source["CreationDate"];
-	        this.Versioning = source["Versioning"];
-	        this.PublicAccess = source["PublicAccess"];
-	        this.Encryption = source["Encryption"];
-	    }
-	}
 	
-	export class SecurityGroupRule {
-	    Protocol: string;
-	    FromPort: number;
-	    ToPort: number;
-	    CIDR: string;
-	    Description: string;
+	export class VPNTunnelInfo {
+	    OutsideIP: string;
+	    Status: string;
+	    StatusMessage: string;
+	    LastStatusChange: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new SecurityGroupRule(source);
+	        return new VPNTunnelInfo(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.Protocol = source["Protocol"];
-	        this.FromPort = source["FromPort"];
-	        this.ToPort = source["ToPort"];
-	        this.CIDR = source["CIDR"];
-	        this.Description = source["Description"];
+	        this.OutsideIP = source["OutsideIP"];
+	        this.Status = source["Status"];
+	        this.StatusMessage = source["StatusMessage"];
+	        this.LastStatusChange = source["LastStatusChange"];
 	    }
 	}
-	export class SecurityGroupInfo {
+	export class VPNConnectionInfo {
 	    ID: string;
 	    Name: string;
-	    Description: string;
+	    State: string;
+	    Type: string;
+	    CustomerGatewayID: string;
+	    VPNGatewayID: string;
+	    TransitGatewayID: string;
 	    VPCID: string;
-	    IngressRules: SecurityGroupRule[];
-	    EgressRules: SecurityGroupRule[];
+	    Tunnels: VPNTunnelInfo[];
 	
 	    static createFrom(source: any = {}) {
-	        return new SecurityGroupInfo(source);
+	        return new VPNConnectionInfo(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.ID = source["ID"];
 	        this.Name = source["Name"];
-	        this.Description = source["Description"];
+	        this.State = source["State"];
+	        this.Type = source["Type"];
+	        this.CustomerGatewayID = source["CustomerGatewayID"];
+	        this.VPNGatewayID = source["VPNGatewayID"];
+	        this.TransitGatewayID = source["TransitGatewayID"];
 	        this.VPCID = source["VPCID"];
-	        this.IngressRules = this.convertValues(source["IngressRules"], SecurityGroupRule);
-	        this.EgressRules = this.convertValues(source["EgressRules"], SecurityGroupRule);
+	        this.Tunnels = this.convertValues(source["Tunnels"], VPNTunnelInfo);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -509,60 +4251,111 @@ export namespace models {
 		    return a;
 		}
 	}
-	
-	export class SubnetInfo {
+	export class VPCPeeringInfo {
 	    ID: string;
-	    CIDRBlock: string;
 	    Name: string;
-	    VPCID: string;
-	    AvailabilityZone: string;
-	    State: string;
-	    MapPublicIPOnLaunch: boolean;
-	    AvailableIpAddressCount: number;
+	    Status: string;
+	    RequesterVPCID: string;
+	    RequesterOwnerID: string;
+	    RequesterRegion: string;
+	    RequesterCIDR: string;
+	    AccepterVPCID: string;
+	    AccepterOwnerID: string;
+	    AccepterRegion: string;
+	    AccepterCIDR: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new SubnetInfo(source);
+	        return new VPCPeeringInfo(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.ID = source["ID"];
-	        this.CIDRBlock = source["CIDRBlock"];
 	        this.Name = source["Name"];
-	        this.VPCID = source["VPCID"];
-	        this.AvailabilityZone = source["AvailabilityZone"];
-	        this.State = source["State"];
-	        this.MapPublicIPOnLaunch = source["MapPublicIPOnLaunch"];
-	        this.AvailableIpAddressCount = source["AvailableIpAddressCount"];
+	        this.Status = source["Status"];
+	        this.RequesterVPCID = source["RequesterVPCID"];
+	        this.RequesterOwnerID = source["RequesterOwnerID"];
+	        this.RequesterRegion = source["RequesterRegion"];
+	        this.RequesterCIDR = source["RequesterCIDR"];
+	        this.AccepterVPCID = source["AccepterVPCID"];
+	        this.AccepterOwnerID = source["AccepterOwnerID"];
+	        this.AccepterRegion = source["AccepterRegion"];
+	        this.AccepterCIDR = source["AccepterCIDR"];
 	    }
 	}
-	export class TargetGroupInfo {
+	export class VPCEndpointInfo {
+	    ID: string;
 	    Name: string;
-	    ARN: string;
-	    Protocol: string;
-	    Port: number;
-	    TargetType: string;
+	    ServiceName: string;
+	    Type: string;
+	    State: string;
 	    VPCID: string;
-	    HealthyCount: number;
-	    UnhealthyCount: number;
-	    HealthCheckPath: string;
+	    SubnetIDs: string[];
+	    RouteTableIDs: string[];
+	    SecurityGroupIDs: string[];
+	    NetworkInterfaceIDs: string[];
+	    PrivateDNSEnabled: boolean;
 	
 	    static createFrom(source: any = {}) {
-	        return new TargetGroupInfo(source);
+	        return new VPCEndpointInfo(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.ID = source["ID"];
 	        this.Name = source["Name"];
-	        this.ARN = source["ARN"];
-	        this.Protocol = source["Protocol"];
-	        this.Port = source["Port"];
-	        this.TargetType = source["TargetType"];
+	        this.ServiceName = source["ServiceName"];
+	        this.Type = source["Type"];
+	        this.State = source["State"];
 	        this.VPCID = source["VPCID"];
-	        this.HealthyCount = source["HealthyCount"];
-	        this.UnhealthyCount = source["UnhealthyCount"];
-	        this.HealthCheckPath = source["HealthCheckPath"];
+	        this.SubnetIDs = source["SubnetIDs"];
+	        this.RouteTableIDs = source["RouteTableIDs"];
+	        this.SecurityGroupIDs = source["SecurityGroupIDs"];
+	        this.NetworkInterfaceIDs = source["NetworkInterfaceIDs"];
+	        this.PrivateDNSEnabled = source["PrivateDNSEnabled"];
+	    }
+	}
+	export class VPCConnectivity {
+	    internet_gateways: InternetGatewayInfo[];
+	    endpoints: VPCEndpointInfo[];
+	    peerings: VPCPeeringInfo[];
+	    transit_gateway_attachments: TransitGatewayAttachmentInfo[];
+	    transit_gateway_route_tables: TransitGatewayRouteTableInfo[];
+	    vpn_connections: VPNConnectionInfo[];
+	    errors: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new VPCConnectivity(source);
 	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.internet_gateways = this.convertValues(source["internet_gateways"], InternetGatewayInfo);
+	        this.endpoints = this.convertValues(source["endpoints"], VPCEndpointInfo);
+	        this.peerings = this.convertValues(source["peerings"], VPCPeeringInfo);
+	        this.transit_gateway_attachments = this.convertValues(source["transit_gateway_attachments"], TransitGatewayAttachmentInfo);
+	        this.transit_gateway_route_tables = this.convertValues(source["transit_gateway_route_tables"], TransitGatewayRouteTableInfo);
+	        this.vpn_connections = this.convertValues(source["vpn_connections"], VPNConnectionInfo);
+	        this.errors = source["errors"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
 	}
 	
 	export class VPCInfo {
@@ -593,6 +4386,8 @@ export namespace models {
 	        this.InstanceTenancy = source["InstanceTenancy"];
 	    }
 	}
+	
+	
 
 }
 
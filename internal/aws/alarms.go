@@ -0,0 +1,130 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwTypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+
+	"aws-terminal-sdk-v1/internal/models"
+)
+
+// alarmHistoryLimit caps the number of history items returned for a single alarm
+const alarmHistoryLimit = 100
+
+// FetchAlarms gets the metric and composite alarms of the region.
+// An empty state returns alarms in every state.
+func (c *Client) FetchAlarms(ctx context.Context, state string) ([]models.AlarmInfo, error) {
+	input := &cloudwatch.DescribeAlarmsInput{
+		AlarmTypes: []cwTypes.AlarmType{cwTypes.AlarmTypeMetricAlarm, cwTypes.AlarmTypeCompositeAlarm},
+	}
+	if state != "" {
+		input.StateValue = cwTypes.StateValue(state)
+	}
+
+	var alarms []models.AlarmInfo
+	paginator := cloudwatch.NewDescribeAlarmsPaginator(c.cwClient, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe alarms: %w", err)
+		}
+
+		for _, alarm := range output.MetricAlarms {
+			alarms = append(alarms, models.FromAWSMetricAlarm(alarm))
+		}
+		for _, alarm := range output.CompositeAlarms {
+			alarms = append(alarms, models.FromAWSCompositeAlarm(alarm))
+		}
+	}
+
+	models.SortAlarmsByState(alarms)
+	return alarms, nil
+}
+
+// FetchAlarmsForResource gets the metric alarms watching a resource, matched by the
+// CloudWatch dimensions of its catalog entry (see LookupMetricCatalog)
+func (c *Client) FetchAlarmsForResource(ctx context.Context, resourceType, resourceID string) ([]models.AlarmInfo, error) {
+	entry, ok := LookupMetricCatalog(resourceType)
+	if !ok {
+		return nil, fmt.Errorf("no alarms available for resource type %q", resourceType)
+	}
+
+	dimensions, err := entry.Dimensions(resourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	alarms, err := c.FetchAlarms(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	matched := make([]models.AlarmInfo, 0)
+	for _, alarm := range alarms {
+		if AlarmWatchesResource(alarm, entry.Namespace, dimensions) {
+			matched = append(matched, alarm)
+		}
+	}
+	return matched, nil
+}
+
+// AlarmWatchesResource reports whether a metric alarm, or any metric of a metric
+// math alarm, is in the namespace and carries all of the resource dimensions.
+// Alarms with extra dimensions (e.g. TargetGroup next to LoadBalancer) still match.
+func AlarmWatchesResource(alarm models.AlarmInfo, namespace string, dimensions map[string]string) bool {
+	if alarm.Type != "metric" || alarm.Namespace != namespace {
+		return false
+	}
+
+	if containsDimensions(alarm.Dimensions, dimensions) {
+		return true
+	}
+	for _, dims := range alarm.MetricDimensions {
+		if containsDimensions(dims, dimensions) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsDimensions(alarmDimensions, resourceDimensions map[string]string) bool {
+	if len(alarmDimensions) == 0 {
+		return false
+	}
+	for k, v := range resourceDimensions {
+		if alarmDimensions[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// FetchAlarmHistory gets the most recent state changes, configuration updates and
+// actions of an alarm, newest first
+func (c *Client) FetchAlarmHistory(ctx context.Context, alarmName string) ([]models.AlarmHistoryItem, error) {
+	input := &cloudwatch.DescribeAlarmHistoryInput{
+		AlarmName:  &alarmName,
+		AlarmTypes: []cwTypes.AlarmType{cwTypes.AlarmTypeMetricAlarm, cwTypes.AlarmTypeCompositeAlarm},
+		ScanBy:     cwTypes.ScanByTimestampDescending,
+	}
+
+	history := make([]models.AlarmHistoryItem, 0)
+	paginator := cloudwatch.NewDescribeAlarmHistoryPaginator(c.cwClient, input)
+	for paginator.HasMorePages() && len(history) < alarmHistoryLimit {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe alarm history: %w", err)
+		}
+
+		for _, item := range output.AlarmHistoryItems {
+			if len(history) == alarmHistoryLimit {
+				break
+			}
+			history = append(history, models.FromAWSAlarmHistoryItem(item))
+		}
+	}
+
+	return history, nil
+}
@@ -0,0 +1,142 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwTypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFetchAlarms(t *testing.T) {
+	mockCW := new(MockCloudWatchClient)
+	client := &Client{cwClient: mockCW}
+
+	mockCW.On("DescribeAlarms", mock.Anything, mock.Anything, mock.Anything).Return(&cloudwatch.DescribeAlarmsOutput{
+		MetricAlarms: []cwTypes.MetricAlarm{
+			{
+				AlarmName:          aws.String("web-cpu"),
+				StateValue:         cwTypes.StateValueOk,
+				Namespace:          aws.String("AWS/EC2"),
+				MetricName:         aws.String("CPUUtilization"),
+				Statistic:          cwTypes.StatisticAverage,
+				Dimensions:         []cwTypes.Dimension{{Name: aws.String("InstanceId"), Value: aws.String("i-123")}},
+				ComparisonOperator: cwTypes.ComparisonOperatorGreaterThanThreshold,
+				Threshold:          aws.Float64(80),
+				EvaluationPeriods:  aws.Int32(3),
+				AlarmActions:       []string{"arn:aws:sns:us-east-1:123456789012:ops"},
+			},
+			{
+				AlarmName:         aws.String("db-latency"),
+				StateValue:        cwTypes.StateValueAlarm,
+				StateReason:       aws.String("Threshold Crossed"),
+				Namespace:         aws.String("AWS/RDS"),
+				MetricName:        aws.String("ReadLatency"),
+				ExtendedStatistic: aws.String("p99"),
+			},
+		},
+		CompositeAlarms: []cwTypes.CompositeAlarm{
+			{AlarmName: aws.String("service-down"), StateValue: cwTypes.StateValueInsufficientData, AlarmRule: aws.String("ALARM(web-cpu) AND ALARM(db-latency)")},
+		},
+	}, nil).Once()
+
+	alarms, err := client.FetchAlarms(context.Background(), "")
+	assert.NoError(t, err)
+	assert.Len(t, alarms, 3)
+	// ALARM first, then INSUFFICIENT_DATA, then OK
+	assert.Equal(t, "db-latency", alarms[0].Name)
+	assert.Equal(t, "p99", alarms[0].Statistic)
+	assert.Equal(t, "composite", alarms[1].Type)
+	assert.Equal(t, "ALARM(web-cpu) AND ALARM(db-latency)", alarms[1].AlarmRule)
+	assert.Equal(t, float64(80), alarms[2].Threshold)
+	assert.Equal(t, "i-123", alarms[2].Dimensions["InstanceId"])
+	assert.Len(t, alarms[2].AlarmActions, 1)
+
+	// Error case
+	mockCW.On("DescribeAlarms", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("AccessDenied")).Once()
+
+	alarms, err = client.FetchAlarms(context.Background(), "ALARM")
+	assert.Error(t, err)
+	assert.Nil(t, alarms)
+}
+
+func TestFetchAlarmsForResource(t *testing.T) {
+	mockCW := new(MockCloudWatchClient)
+	client := &Client{cwClient: mockCW}
+
+	lb := "app/my-alb/50dc6c495c0c9188"
+	mockCW.On("DescribeAlarms", mock.Anything, mock.Anything, mock.Anything).Return(&cloudwatch.DescribeAlarmsOutput{
+		MetricAlarms: []cwTypes.MetricAlarm{
+			{
+				AlarmName:  aws.String("alb-5xx"),
+				Namespace:  aws.String("AWS/ApplicationELB"),
+				Dimensions: []cwTypes.Dimension{{Name: aws.String("LoadBalancer"), Value: aws.String(lb)}},
+			},
+			{
+				// Extra dimensions still match the load balancer
+				AlarmName: aws.String("tg-unhealthy"),
+				Namespace: aws.String("AWS/ApplicationELB"),
+				Dimensions: []cwTypes.Dimension{
+					{Name: aws.String("LoadBalancer"), Value: aws.String(lb)},
+					{Name: aws.String("TargetGroup"), Value: aws.String("targetgroup/web/abc")},
+				},
+			},
+			{
+				// Metric math alarm referencing the load balancer
+				AlarmName: aws.String("alb-error-rate"),
+				Metrics: []cwTypes.MetricDataQuery{
+					{Id: aws.String("e1"), Expression: aws.String("m1/m2*100")},
+					{Id: aws.String("m1"), MetricStat: &cwTypes.MetricStat{Metric: &cwTypes.Metric{
+						Namespace:  aws.String("AWS/ApplicationELB"),
+						Dimensions: []cwTypes.Dimension{{Name: aws.String("LoadBalancer"), Value: aws.String(lb)}},
+					}}},
+				},
+			},
+			{
+				AlarmName:  aws.String("other-alb"),
+				Namespace:  aws.String("AWS/ApplicationELB"),
+				Dimensions: []cwTypes.Dimension{{Name: aws.String("LoadBalancer"), Value: aws.String("app/other/1")}},
+			},
+		},
+	}, nil)
+
+	alarms, err := client.FetchAlarmsForResource(context.Background(), "alb", "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/"+lb)
+	assert.NoError(t, err)
+	assert.Len(t, alarms, 3)
+	for _, a := range alarms {
+		assert.NotEqual(t, "other-alb", a.Name)
+	}
+
+	_, err = client.FetchAlarmsForResource(context.Background(), "kinesis", "orders")
+	assert.Error(t, err)
+}
+
+func TestFetchAlarmHistory(t *testing.T) {
+	mockCW := new(MockCloudWatchClient)
+	client := &Client{cwClient: mockCW}
+
+	ts := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	mockCW.On("DescribeAlarmHistory", mock.Anything, mock.MatchedBy(func(in *cloudwatch.DescribeAlarmHistoryInput) bool {
+		return aws.ToString(in.AlarmName) == "web-cpu" && in.ScanBy == cwTypes.ScanByTimestampDescending
+	}), mock.Anything).Return(&cloudwatch.DescribeAlarmHistoryOutput{
+		AlarmHistoryItems: []cwTypes.AlarmHistoryItem{
+			{
+				AlarmName:       aws.String("web-cpu"),
+				Timestamp:       aws.Time(ts),
+				HistoryItemType: cwTypes.HistoryItemTypeStateUpdate,
+				HistorySummary:  aws.String("Alarm updated from OK to ALARM"),
+			},
+		},
+	}, nil)
+
+	history, err := client.FetchAlarmHistory(context.Background(), "web-cpu")
+	assert.NoError(t, err)
+	assert.Len(t, history, 1)
+	assert.Equal(t, "StateUpdate", history[0].Type)
+	assert.Equal(t, "2024-05-01 10:00:00", history[0].Timestamp)
+}
@@ -0,0 +1,268 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+	apigwv2Types "github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
+
+	"aws-terminal-sdk-v1/internal/models"
+)
+
+// FetchAPIGatewayAPIs gets the REST APIs and the HTTP and WebSocket APIs of the region
+func (c *Client) FetchAPIGatewayAPIs(ctx context.Context) ([]models.APIGatewayAPIInfo, error) {
+	apis := make([]models.APIGatewayAPIInfo, 0)
+	paginator := apigateway.NewGetRestApisPaginator(c.apigatewayClient, &apigateway.GetRestApisInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get REST APIs: %w", err)
+		}
+
+		for _, api := range output.Items {
+			apis = append(apis, models.FromAWSRestAPI(api, c.region))
+		}
+	}
+
+	input := &apigatewayv2.GetApisInput{}
+	for {
+		output, err := c.apigatewayv2Client.GetApis(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get HTTP APIs: %w", err)
+		}
+
+		for _, api := range output.Items {
+			apis = append(apis, models.FromAWSHTTPAPI(api))
+		}
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	sort.SliceStable(apis, func(i, j int) bool {
+		return apis[i].Name < apis[j].Name
+	})
+	return apis, nil
+}
+
+// FetchAPIGatewayAPIDetail gets the stages and the routes of an API with their integrations.
+// protocol is the Protocol of the APIGatewayAPIInfo, telling REST APIs from the others.
+func (c *Client) FetchAPIGatewayAPIDetail(ctx context.Context, apiID, protocol string) (*models.APIGatewayAPIDetail, error) {
+	detail := &models.APIGatewayAPIDetail{
+		APIID:    apiID,
+		Protocol: protocol,
+		Stages:   make([]models.APIGatewayStageInfo, 0),
+		Routes:   make([]models.APIGatewayRouteInfo, 0),
+		Errors:   make([]string, 0),
+	}
+
+	if protocol == models.APIProtocolREST {
+		if err := c.fetchRestStages(ctx, detail); err != nil {
+			detail.Errors = append(detail.Errors, err.Error())
+		}
+		routes, err := c.fetchRestRoutes(ctx, apiID)
+		if err != nil {
+			detail.Errors = append(detail.Errors, err.Error())
+		}
+		detail.Routes = append(detail.Routes, routes...)
+		return detail, nil
+	}
+
+	if err := c.fetchHTTPStages(ctx, detail); err != nil {
+		detail.Errors = append(detail.Errors, err.Error())
+	}
+	routes, err := c.fetchHTTPRoutes(ctx, apiID)
+	if err != nil {
+		detail.Errors = append(detail.Errors, err.Error())
+	}
+	detail.Routes = append(detail.Routes, routes...)
+	return detail, nil
+}
+
+func (c *Client) fetchRestStages(ctx context.Context, detail *models.APIGatewayAPIDetail) error {
+	output, err := c.apigatewayClient.GetStages(ctx, &apigateway.GetStagesInput{RestApiId: aws.String(detail.APIID)})
+	if err != nil {
+		return fmt.Errorf("failed to get stages: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("https://%s.execute-api.%s.amazonaws.com", detail.APIID, c.region)
+	for _, stage := range output.Item {
+		detail.Stages = append(detail.Stages, models.FromAWSRestStage(stage, endpoint))
+	}
+	return nil
+}
+
+// fetchRestRoutes lists the methods of every resource of a REST API, sorted by path
+func (c *Client) fetchRestRoutes(ctx context.Context, apiID string) ([]models.APIGatewayRouteInfo, error) {
+	routes := make([]models.APIGatewayRouteInfo, 0)
+	// Embedding the methods returns their integrations without a call per method
+	paginator := apigateway.NewGetResourcesPaginator(c.apigatewayClient, &apigateway.GetResourcesInput{
+		RestApiId: aws.String(apiID),
+		Embed:     []string{"methods"},
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return routes, fmt.Errorf("failed to get resources: %w", err)
+		}
+
+		for _, resource := range output.Items {
+			for _, method := range resource.ResourceMethods {
+				routes = append(routes, models.FromAWSRestMethod(aws.ToString(resource.Path), method))
+			}
+		}
+	}
+
+	sortRoutes(routes)
+	return routes, nil
+}
+
+func (c *Client) fetchHTTPStages(ctx context.Context, detail *models.APIGatewayAPIDetail) error {
+	scheme := "https"
+	if detail.Protocol == models.APIProtocolWebSocket {
+		scheme = "wss"
+	}
+	endpoint := fmt.Sprintf("%s://%s.execute-api.%s.amazonaws.com", scheme, detail.APIID, c.region)
+
+	input := &apigatewayv2.GetStagesInput{ApiId: aws.String(detail.APIID)}
+	for {
+		output, err := c.apigatewayv2Client.GetStages(ctx, input)
+		if err != nil {
+			return fmt.Errorf("failed to get stages: %w", err)
+		}
+
+		for _, stage := range output.Items {
+			detail.Stages = append(detail.Stages, models.FromAWSHTTPStage(stage, endpoint))
+		}
+		if output.NextToken == nil {
+			return nil
+		}
+		input.NextToken = output.NextToken
+	}
+}
+
+// fetchHTTPRoutes lists the routes of an HTTP or WebSocket API with their integration
+func (c *Client) fetchHTTPRoutes(ctx context.Context, apiID string) ([]models.APIGatewayRouteInfo, error) {
+	integrations := make(map[string]*apigwv2Types.Integration)
+	integrationsInput := &apigatewayv2.GetIntegrationsInput{ApiId: aws.String(apiID)}
+	for {
+		output, err := c.apigatewayv2Client.GetIntegrations(ctx, integrationsInput)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get integrations: %w", err)
+		}
+
+		for i := range output.Items {
+			integrations[aws.ToString(output.Items[i].IntegrationId)] = &output.Items[i]
+		}
+		if output.NextToken == nil {
+			break
+		}
+		integrationsInput.NextToken = output.NextToken
+	}
+
+	routes := make([]models.APIGatewayRouteInfo, 0)
+	routesInput := &apigatewayv2.GetRoutesInput{ApiId: aws.String(apiID)}
+	for {
+		output, err := c.apigatewayv2Client.GetRoutes(ctx, routesInput)
+		if err != nil {
+			return routes, fmt.Errorf("failed to get routes: %w", err)
+		}
+
+		for _, route := range output.Items {
+			// Targets have the form integrations/<integration id>
+			integrationID := strings.TrimPrefix(aws.ToString(route.Target), "integrations/")
+			routes = append(routes, models.FromAWSHTTPRoute(route, integrations[integrationID]))
+		}
+		if output.NextToken == nil {
+			break
+		}
+		routesInput.NextToken = output.NextToken
+	}
+
+	sortRoutes(routes)
+	return routes, nil
+}
+
+func sortRoutes(routes []models.APIGatewayRouteInfo) {
+	sort.SliceStable(routes, func(i, j int) bool {
+		return routes[i].Route < routes[j].Route
+	})
+}
+
+// FetchAPIGatewayDomains gets the custom domain names with the API stages mapped to them.
+// Regional domains are shared by both API Gateway versions and listed once.
+func (c *Client) FetchAPIGatewayDomains(ctx context.Context) ([]models.APIGatewayDomainInfo, error) {
+	domains := make([]models.APIGatewayDomainInfo, 0)
+	seen := make(map[string]bool)
+
+	input := &apigatewayv2.GetDomainNamesInput{}
+	for {
+		output, err := c.apigatewayv2Client.GetDomainNames(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get domain names: %w", err)
+		}
+
+		for _, raw := range output.Items {
+			domain := models.FromAWSHTTPDomainName(raw)
+			mappings, err := c.apigatewayv2Client.GetApiMappings(ctx, &apigatewayv2.GetApiMappingsInput{DomainName: raw.DomainName})
+			if err != nil {
+				fmt.Printf("Warning: failed to get API mappings of %s: %v\n", domain.DomainName, err)
+			} else {
+				for _, mapping := range mappings.Items {
+					domain.Mappings = append(domain.Mappings, models.APIGatewayMappingInfo{
+						APIID:    aws.ToString(mapping.ApiId),
+						Stage:    aws.ToString(mapping.Stage),
+						BasePath: aws.ToString(mapping.ApiMappingKey),
+					})
+				}
+			}
+			seen[domain.DomainName] = true
+			domains = append(domains, domain)
+		}
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	// Edge-optimized domains are only known to the REST API
+	paginator := apigateway.NewGetDomainNamesPaginator(c.apigatewayClient, &apigateway.GetDomainNamesInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get domain names: %w", err)
+		}
+
+		for _, raw := range output.Items {
+			domain := models.FromAWSRestDomainName(raw)
+			if seen[domain.DomainName] {
+				continue
+			}
+			mappings, err := c.apigatewayClient.GetBasePathMappings(ctx, &apigateway.GetBasePathMappingsInput{DomainName: raw.DomainName})
+			if err != nil {
+				fmt.Printf("Warning: failed to get base path mappings of %s: %v\n", domain.DomainName, err)
+			} else {
+				for _, mapping := range mappings.Items {
+					basePath := aws.ToString(mapping.BasePath)
+					if basePath == "(none)" {
+						basePath = ""
+					}
+					domain.Mappings = append(domain.Mappings, models.APIGatewayMappingInfo{
+						APIID:    aws.ToString(mapping.RestApiId),
+						Stage:    aws.ToString(mapping.Stage),
+						BasePath: basePath,
+					})
+				}
+			}
+			domains = append(domains, domain)
+		}
+	}
+
+	return domains, nil
+}
@@ -0,0 +1,185 @@
+package aws
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	apigwTypes "github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+	apigwv2Types "github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
+	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	sfnTypes "github.com/aws/aws-sdk-go-v2/service/sfn/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"aws-terminal-sdk-v1/internal/models"
+)
+
+const testFunctionARN = "arn:aws:lambda:eu-west-1:123456789012:function:orders"
+
+func TestExtractLambdaARNs(t *testing.T) {
+	uri := "arn:aws:apigateway:eu-west-1:lambda:path/2015-03-31/functions/" + testFunctionARN + ":live/invocations"
+	assert.Equal(t, []string{testFunctionARN + ":live"}, models.ExtractLambdaARNs(uri))
+
+	function, qualifier := models.SplitLambdaARN(testFunctionARN + ":live")
+	assert.Equal(t, testFunctionARN, function)
+	assert.Equal(t, "live", qualifier)
+
+	function, qualifier = models.SplitLambdaARN(testFunctionARN)
+	assert.Equal(t, testFunctionARN, function)
+	assert.Empty(t, qualifier)
+}
+
+// newServerlessTestClient returns a client with a REST API and an HTTP API both routed to
+// the orders function, and a state machine invoking it
+func newServerlessTestClient() (*Client, *MockSFNClient) {
+	mockREST := new(MockAPIGatewayClient)
+	mockHTTP := new(MockAPIGatewayV2Client)
+	mockSFN := new(MockSFNClient)
+
+	mockREST.On("GetRestApis", mock.Anything, mock.Anything, mock.Anything).Return(&apigateway.GetRestApisOutput{
+		Items: []apigwTypes.RestApi{{Id: aws.String("rest1"), Name: aws.String("orders-api")}},
+	}, nil)
+	mockREST.On("GetResources", mock.Anything, mock.MatchedBy(func(in *apigateway.GetResourcesInput) bool {
+		return aws.ToString(in.RestApiId) == "rest1" && len(in.Embed) == 1
+	}), mock.Anything).Return(&apigateway.GetResourcesOutput{
+		Items: []apigwTypes.Resource{{
+			Path: aws.String("/orders"),
+			ResourceMethods: map[string]apigwTypes.Method{
+				"POST": {
+					HttpMethod:        aws.String("POST"),
+					AuthorizationType: aws.String("AWS_IAM"),
+					MethodIntegration: &apigwTypes.Integration{
+						Type: apigwTypes.IntegrationTypeAwsProxy,
+						Uri:  aws.String("arn:aws:apigateway:eu-west-1:lambda:path/2015-03-31/functions/" + testFunctionARN + "/invocations"),
+					},
+				},
+				"GET": {
+					HttpMethod:        aws.String("GET"),
+					AuthorizationType: aws.String("NONE"),
+					MethodIntegration: &apigwTypes.Integration{Type: apigwTypes.IntegrationTypeMock},
+				},
+			},
+		}},
+	}, nil)
+
+	mockHTTP.On("GetApis", mock.Anything, mock.Anything, mock.Anything).Return(&apigatewayv2.GetApisOutput{
+		Items: []apigwv2Types.Api{{ApiId: aws.String("http1"), Name: aws.String("checkout"), ProtocolType: apigwv2Types.ProtocolTypeHttp}},
+	}, nil)
+	mockHTTP.On("GetIntegrations", mock.Anything, mock.Anything, mock.Anything).Return(&apigatewayv2.GetIntegrationsOutput{
+		Items: []apigwv2Types.Integration{
+			{IntegrationId: aws.String("int1"), IntegrationType: apigwv2Types.IntegrationTypeAwsProxy, IntegrationUri: aws.String(testFunctionARN + ":live")},
+			{
+				IntegrationId: aws.String("int2"), IntegrationType: apigwv2Types.IntegrationTypeAwsProxy,
+				IntegrationSubtype: aws.String("StepFunctions-StartExecution"),
+				RequestParameters:  map[string]string{"StateMachineArn": "arn:aws:states:eu-west-1:123456789012:stateMachine:fulfil"},
+			},
+		},
+	}, nil)
+	mockHTTP.On("GetRoutes", mock.Anything, mock.Anything, mock.Anything).Return(&apigatewayv2.GetRoutesOutput{
+		Items: []apigwv2Types.Route{
+			{RouteKey: aws.String("POST /pay"), Target: aws.String("integrations/int1")},
+			{RouteKey: aws.String("POST /fulfil"), Target: aws.String("integrations/int2")},
+		},
+	}, nil)
+
+	mockSFN.On("ListStateMachines", mock.Anything, mock.Anything, mock.Anything).Return(&sfn.ListStateMachinesOutput{
+		StateMachines: []sfnTypes.StateMachineListItem{{
+			StateMachineArn: aws.String("arn:aws:states:eu-west-1:123456789012:stateMachine:fulfil"),
+			Name:            aws.String("fulfil"),
+			Type:            sfnTypes.StateMachineTypeStandard,
+		}},
+	}, nil)
+	mockSFN.On("DescribeStateMachine", mock.Anything, mock.Anything, mock.Anything).Return(&sfn.DescribeStateMachineOutput{
+		Status: sfnTypes.StateMachineStatusActive,
+		Definition: aws.String(`{"StartAt": "Charge", "States": {"Charge": {"Type": "Task",
+			"Resource": "arn:aws:states:::lambda:invoke",
+			"Parameters": {"FunctionName": "` + testFunctionARN + `:$LATEST"}, "End": true}}}`),
+	}, nil)
+
+	client := &Client{apigatewayClient: mockREST, apigatewayv2Client: mockHTTP, sfnClient: mockSFN, region: "eu-west-1"}
+	return client, mockSFN
+}
+
+func TestFetchAPIGatewayAPIDetail(t *testing.T) {
+	client, _ := newServerlessTestClient()
+	mockREST := client.apigatewayClient.(*MockAPIGatewayClient)
+	mockREST.On("GetStages", mock.Anything, mock.Anything, mock.Anything).Return(&apigateway.GetStagesOutput{
+		Item: []apigwTypes.Stage{{
+			StageName:      aws.String("prod"),
+			TracingEnabled: true,
+			MethodSettings: map[string]apigwTypes.MethodSetting{"*/*": {ThrottlingRateLimit: 100, ThrottlingBurstLimit: 50}},
+		}},
+	}, nil).Once()
+
+	detail, err := client.FetchAPIGatewayAPIDetail(context.Background(), "rest1", models.APIProtocolREST)
+	assert.NoError(t, err)
+	assert.Empty(t, detail.Errors)
+	assert.Len(t, detail.Stages, 1)
+	assert.Equal(t, "https://rest1.execute-api.eu-west-1.amazonaws.com/prod", detail.Stages[0].InvokeURL)
+	assert.Equal(t, float64(100), detail.Stages[0].ThrottleRate)
+
+	assert.Len(t, detail.Routes, 2)
+	assert.Equal(t, "GET /orders", detail.Routes[0].Route)
+	assert.Empty(t, detail.Routes[0].LambdaARN)
+	assert.Equal(t, "POST /orders", detail.Routes[1].Route)
+	assert.Equal(t, testFunctionARN, detail.Routes[1].LambdaARN)
+
+	mockHTTP := client.apigatewayv2Client.(*MockAPIGatewayV2Client)
+	mockHTTP.On("GetStages", mock.Anything, mock.Anything, mock.Anything).Return(&apigatewayv2.GetStagesOutput{
+		Items: []apigwv2Types.Stage{{StageName: aws.String("$default"), AutoDeploy: aws.Bool(true)}},
+	}, nil).Once()
+
+	detail, err = client.FetchAPIGatewayAPIDetail(context.Background(), "http1", models.APIProtocolHTTP)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://http1.execute-api.eu-west-1.amazonaws.com", detail.Stages[0].InvokeURL)
+	assert.True(t, detail.Stages[0].AutoDeploy)
+	assert.Len(t, detail.Routes, 2)
+	assert.Equal(t, "arn:aws:states:eu-west-1:123456789012:stateMachine:fulfil", detail.Routes[0].StateMachineARN)
+	assert.Equal(t, testFunctionARN+":live", detail.Routes[1].LambdaARN)
+}
+
+func TestFetchLambdaInvokers(t *testing.T) {
+	client, _ := newServerlessTestClient()
+
+	invokers, err := client.FetchLambdaInvokers(context.Background(), testFunctionARN)
+	assert.NoError(t, err)
+	assert.Len(t, invokers, 3)
+
+	// APIs come sorted by name
+	assert.Equal(t, "checkout", invokers[0].SourceName)
+	assert.Equal(t, "POST /pay", invokers[0].Detail)
+	assert.Equal(t, "live", invokers[0].Qualifier)
+	assert.Equal(t, "orders-api", invokers[1].SourceName)
+	assert.Equal(t, "POST /orders", invokers[1].Detail)
+	assert.Equal(t, "stepfunctions", invokers[2].SourceType)
+	assert.Equal(t, "$LATEST", invokers[2].Qualifier)
+}
+
+func TestFetchStateMachines(t *testing.T) {
+	client, mockSFN := newServerlessTestClient()
+	started := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	mockSFN.On("ListExecutions", mock.Anything, mock.MatchedBy(func(in *sfn.ListExecutionsInput) bool {
+		return in.MaxResults == stateMachineExecutionSample
+	}), mock.Anything).Return(&sfn.ListExecutionsOutput{
+		Executions: []sfnTypes.ExecutionListItem{
+			{Status: sfnTypes.ExecutionStatusSucceeded, StartDate: &started},
+			{Status: sfnTypes.ExecutionStatusFailed, StartDate: aws.Time(started.Add(-time.Hour))},
+			{Status: sfnTypes.ExecutionStatusSucceeded, StartDate: aws.Time(started.Add(-2 * time.Hour))},
+		},
+	}, nil).Once()
+
+	stateMachines, err := client.FetchStateMachines(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, stateMachines, 1)
+
+	sm := stateMachines[0]
+	assert.Equal(t, []string{testFunctionARN + ":$LATEST"}, sm.LambdaARNs)
+	assert.Equal(t, 3, sm.SampledExecutions)
+	assert.Equal(t, 2, sm.ExecutionCounts["SUCCEEDED"])
+	assert.Equal(t, 1, sm.ExecutionCounts["FAILED"])
+	assert.Equal(t, "2025-03-01 12:00:00", sm.LastExecution)
+}
@@ -0,0 +1,112 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"aws-terminal-sdk-v1/internal/models"
+)
+
+// scalingActivitiesLimit caps the most recent scaling activities listed for a group
+const scalingActivitiesLimit = 20
+
+// FetchAutoScalingGroups gets the Auto Scaling groups with their instances and scaling policies
+func (c *Client) FetchAutoScalingGroups(ctx context.Context) ([]models.AutoScalingGroupInfo, error) {
+	groups := make([]models.AutoScalingGroupInfo, 0)
+	paginator := autoscaling.NewDescribeAutoScalingGroupsPaginator(c.asgClient, &autoscaling.DescribeAutoScalingGroupsInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe auto scaling groups: %w", err)
+		}
+
+		for _, group := range output.AutoScalingGroups {
+			groups = append(groups, models.FromAWSAutoScalingGroup(group))
+		}
+	}
+	if len(groups) == 0 {
+		return groups, nil
+	}
+
+	// Policies of all groups are listed at once and matched by group name
+	policies := make(map[string][]models.ScalingPolicyInfo)
+	policyPaginator := autoscaling.NewDescribePoliciesPaginator(c.asgClient, &autoscaling.DescribePoliciesInput{})
+	for policyPaginator.HasMorePages() {
+		output, err := policyPaginator.NextPage(ctx)
+		if err != nil {
+			fmt.Printf("Warning: failed to describe scaling policies: %v\n", err)
+			break
+		}
+
+		for _, policy := range output.ScalingPolicies {
+			name := safeString(policy.AutoScalingGroupName)
+			policies[name] = append(policies[name], models.FromAWSScalingPolicy(policy))
+		}
+	}
+	for i := range groups {
+		groups[i].Policies = policies[groups[i].Name]
+	}
+
+	return groups, nil
+}
+
+// FetchScalingActivities gets the most recent scaling activities of an Auto Scaling group, newest first
+func (c *Client) FetchScalingActivities(ctx context.Context, groupName string) ([]models.ScalingActivityInfo, error) {
+	output, err := c.asgClient.DescribeScalingActivities(ctx, &autoscaling.DescribeScalingActivitiesInput{
+		AutoScalingGroupName: aws.String(groupName),
+		MaxRecords:           aws.Int32(scalingActivitiesLimit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe scaling activities: %w", err)
+	}
+
+	activities := make([]models.ScalingActivityInfo, 0, len(output.Activities))
+	for _, activity := range output.Activities {
+		activities = append(activities, models.FromAWSScalingActivity(activity))
+	}
+	return activities, nil
+}
+
+// FetchLaunchTemplates gets the EC2 launch templates with their default and latest version numbers
+func (c *Client) FetchLaunchTemplates(ctx context.Context) ([]models.LaunchTemplateInfo, error) {
+	templates := make([]models.LaunchTemplateInfo, 0)
+	paginator := ec2.NewDescribeLaunchTemplatesPaginator(c.ec2Client, &ec2.DescribeLaunchTemplatesInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe launch templates: %w", err)
+		}
+
+		for _, lt := range output.LaunchTemplates {
+			templates = append(templates, models.FromAWSLaunchTemplate(lt))
+		}
+	}
+
+	return templates, nil
+}
+
+// FetchLaunchTemplateVersions gets every version of a launch template, newest first
+func (c *Client) FetchLaunchTemplateVersions(ctx context.Context, templateID string) ([]models.LaunchTemplateVersionInfo, error) {
+	versions := make([]models.LaunchTemplateVersionInfo, 0)
+	paginator := ec2.NewDescribeLaunchTemplateVersionsPaginator(c.ec2Client, &ec2.DescribeLaunchTemplateVersionsInput{
+		LaunchTemplateId: aws.String(templateID),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe launch template versions: %w", err)
+		}
+
+		for _, version := range output.LaunchTemplateVersions {
+			versions = append(versions, models.FromAWSLaunchTemplateVersion(version))
+		}
+	}
+
+	sort.Slice(versions, func(i, j int) bool { return versions[i].Number > versions[j].Number })
+	return versions, nil
+}
@@ -0,0 +1,103 @@
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	asgTypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2Types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFetchAutoScalingGroups(t *testing.T) {
+	mockASG := new(MockAutoScalingClient)
+	client := &Client{asgClient: mockASG}
+
+	mockASG.On("DescribeAutoScalingGroups", mock.Anything, mock.Anything, mock.Anything).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+		AutoScalingGroups: []asgTypes.AutoScalingGroup{
+			{
+				AutoScalingGroupName: aws.String("web"),
+				MinSize:              aws.Int32(2),
+				MaxSize:              aws.Int32(6),
+				DesiredCapacity:      aws.Int32(3),
+				VPCZoneIdentifier:    aws.String("subnet-1,subnet-2"),
+				TargetGroupARNs:      []string{"arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/web/abc"},
+				MixedInstancesPolicy: &asgTypes.MixedInstancesPolicy{
+					LaunchTemplate: &asgTypes.LaunchTemplate{
+						LaunchTemplateSpecification: &asgTypes.LaunchTemplateSpecification{
+							LaunchTemplateId: aws.String("lt-1"),
+							Version:          aws.String("$Latest"),
+						},
+					},
+				},
+				Instances: []asgTypes.Instance{
+					{InstanceId: aws.String("i-1"), LifecycleState: asgTypes.LifecycleStateInService, HealthStatus: aws.String("Healthy")},
+					{InstanceId: aws.String("i-2"), LifecycleState: asgTypes.LifecycleStateTerminatingWait},
+				},
+			},
+			{AutoScalingGroupName: aws.String("workers")},
+		},
+	}, nil)
+	mockASG.On("DescribePolicies", mock.Anything, mock.Anything, mock.Anything).Return(&autoscaling.DescribePoliciesOutput{
+		ScalingPolicies: []asgTypes.ScalingPolicy{
+			{
+				AutoScalingGroupName: aws.String("web"),
+				PolicyName:           aws.String("cpu-50"),
+				PolicyType:           aws.String("TargetTrackingScaling"),
+				TargetTrackingConfiguration: &asgTypes.TargetTrackingConfiguration{
+					TargetValue: aws.Float64(50),
+					PredefinedMetricSpecification: &asgTypes.PredefinedMetricSpecification{
+						PredefinedMetricType: asgTypes.MetricTypeASGAverageCPUUtilization,
+					},
+				},
+			},
+		},
+	}, nil)
+
+	groups, err := client.FetchAutoScalingGroups(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, groups, 2)
+
+	web := groups[0]
+	assert.Equal(t, []string{"subnet-1", "subnet-2"}, web.SubnetIDs)
+	assert.Equal(t, "lt-1", web.LaunchTemplateID)
+	assert.True(t, web.MixedInstancesPolicy)
+	assert.Len(t, web.Instances, 2)
+	assert.Equal(t, "Terminating:Wait", web.Instances[1].LifecycleState)
+	assert.Len(t, web.Policies, 1)
+	assert.True(t, web.Policies[0].Enabled)
+	assert.Equal(t, 50.0, web.Policies[0].TargetValue)
+	assert.Equal(t, "ASGAverageCPUUtilization", web.Policies[0].MetricType)
+	assert.Empty(t, groups[1].Policies)
+}
+
+func TestFetchLaunchTemplateVersions(t *testing.T) {
+	mockEC2 := new(MockEC2Client)
+	client := &Client{ec2Client: mockEC2}
+
+	mockEC2.On("DescribeLaunchTemplateVersions", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeLaunchTemplateVersionsOutput{
+		LaunchTemplateVersions: []ec2Types.LaunchTemplateVersion{
+			{VersionNumber: aws.Int64(1), DefaultVersion: aws.Bool(true)},
+			{
+				VersionNumber: aws.Int64(2),
+				LaunchTemplateData: &ec2Types.ResponseLaunchTemplateData{
+					ImageId:            aws.String("ami-1"),
+					UserData:           aws.String("IyEvYmluL2Jhc2g="),
+					IamInstanceProfile: &ec2Types.LaunchTemplateIamInstanceProfileSpecification{Name: aws.String("web")},
+				},
+			},
+		},
+	}, nil)
+
+	versions, err := client.FetchLaunchTemplateVersions(context.Background(), "lt-1")
+	assert.NoError(t, err)
+	assert.Len(t, versions, 2)
+	assert.Equal(t, int64(2), versions[0].Number)
+	assert.True(t, versions[0].HasUserData)
+	assert.Equal(t, "web", versions[0].IAMInstanceProfile)
+	assert.True(t, versions[1].IsDefault)
+}
@@ -0,0 +1,204 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	cfnTypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+
+	"aws-terminal-sdk-v1/internal/constants"
+	"aws-terminal-sdk-v1/internal/models"
+)
+
+// stackDriftPollInterval is the delay between two polls of a drift detection, which
+// usually takes from a few seconds to a few minutes
+const stackDriftPollInterval = 5 * time.Second
+
+// FetchCloudFormationStacks gets the stacks with their outputs, parameters and drift
+// status, and the stacks nested in each
+func (c *Client) FetchCloudFormationStacks(ctx context.Context) ([]models.CloudFormationStackInfo, error) {
+	stacks := make([]models.CloudFormationStackInfo, 0)
+	paginator := cloudformation.NewDescribeStacksPaginator(c.cloudformationClient, &cloudformation.DescribeStacksInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe stacks: %w", err)
+		}
+
+		for _, stack := range output.Stacks {
+			stacks = append(stacks, models.FromAWSStack(stack))
+		}
+	}
+
+	byID := make(map[string]int, len(stacks))
+	for i, stack := range stacks {
+		byID[stack.StackID] = i
+	}
+	for _, stack := range stacks {
+		if parent, ok := byID[stack.ParentID]; ok {
+			stacks[parent].NestedStacks = append(stacks[parent].NestedStacks, stack.StackName)
+		}
+	}
+
+	sort.SliceStable(stacks, func(i, j int) bool {
+		return stacks[i].StackName < stacks[j].StackName
+	})
+	return stacks, nil
+}
+
+// FetchCloudFormationStackResources gets the resources of a stack with their drift status
+func (c *Client) FetchCloudFormationStackResources(ctx context.Context, stackName string) ([]models.CloudFormationResourceInfo, error) {
+	resources := make([]models.CloudFormationResourceInfo, 0)
+	paginator := cloudformation.NewListStackResourcesPaginator(c.cloudformationClient, &cloudformation.ListStackResourcesInput{
+		StackName: aws.String(stackName),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list resources of stack %s: %w", stackName, err)
+		}
+
+		for _, resource := range output.StackResourceSummaries {
+			resources = append(resources, models.FromAWSStackResource(resource))
+		}
+	}
+
+	return resources, nil
+}
+
+// FetchStackOwnership indexes the resources of every stack by physical ID, so that
+// inventory items can be annotated with the stack that owns them
+func (c *Client) FetchStackOwnership(ctx context.Context) (*models.StackOwnershipIndex, error) {
+	stacks, err := c.FetchCloudFormationStacks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	index := &models.StackOwnershipIndex{
+		ByPhysicalID: make(map[string]models.StackOwnerInfo),
+		StackIDs:     make(map[string]string, len(stacks)),
+		Errors:       make([]string, 0),
+	}
+	for _, stack := range stacks {
+		index.StackIDs[stack.StackName] = stack.StackID
+
+		resources, err := c.FetchCloudFormationStackResources(ctx, stack.StackName)
+		if err != nil {
+			index.Errors = append(index.Errors, err.Error())
+			continue
+		}
+		for _, resource := range resources {
+			if resource.PhysicalID == "" {
+				continue
+			}
+			index.ByPhysicalID[resource.PhysicalID] = models.StackOwnerInfo{
+				StackName:    stack.StackName,
+				StackID:      stack.StackID,
+				LogicalID:    resource.LogicalID,
+				ResourceType: resource.Type,
+			}
+		}
+	}
+
+	return index, nil
+}
+
+// StartStackDriftDetection starts a drift detection on a stack and returns its ID
+func (c *Client) StartStackDriftDetection(ctx context.Context, stackName string) (string, error) {
+	if stackName == "" {
+		return "", fmt.Errorf("stack name is required")
+	}
+
+	output, err := c.cloudformationClient.DetectStackDrift(ctx, &cloudformation.DetectStackDriftInput{
+		StackName: aws.String(stackName),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to start drift detection on stack %s: %w", stackName, err)
+	}
+
+	return aws.ToString(output.StackDriftDetectionId), nil
+}
+
+// WaitForStackDriftDetection polls a drift detection until it is no longer in progress
+// or ctx is done, and returns its result with the drifted resources
+func (c *Client) WaitForStackDriftDetection(ctx context.Context, detectionID string) (*models.StackDriftResult, error) {
+	for {
+		result, err := c.FetchStackDriftDetection(ctx, detectionID)
+		if err != nil {
+			return nil, err
+		}
+		if result.Status != string(cfnTypes.StackDriftDetectionStatusDetectionInProgress) {
+			return result, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(stackDriftPollInterval):
+		}
+	}
+}
+
+// FetchStackDriftDetection gets the status of a drift detection, and the drifted
+// resources once it is done
+func (c *Client) FetchStackDriftDetection(ctx context.Context, detectionID string) (*models.StackDriftResult, error) {
+	status, err := c.cloudformationClient.DescribeStackDriftDetectionStatus(ctx, &cloudformation.DescribeStackDriftDetectionStatusInput{
+		StackDriftDetectionId: aws.String(detectionID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get drift detection status: %w", err)
+	}
+
+	result := &models.StackDriftResult{
+		DetectionID:  detectionID,
+		StackID:      aws.ToString(status.StackId),
+		StackName:    stackNameFromID(aws.ToString(status.StackId)),
+		Status:       string(status.DetectionStatus),
+		StatusReason: aws.ToString(status.DetectionStatusReason),
+		DriftStatus:  string(status.StackDriftStatus),
+		DriftedCount: aws.ToInt32(status.DriftedStackResourceCount),
+		Drifts:       make([]models.StackResourceDriftInfo, 0),
+	}
+	if status.Timestamp != nil {
+		result.CheckedAt = status.Timestamp.Format(constants.DateTimeFormat)
+	}
+	if status.DetectionStatus == cfnTypes.StackDriftDetectionStatusDetectionInProgress || result.DriftedCount == 0 {
+		return result, nil
+	}
+
+	paginator := cloudformation.NewDescribeStackResourceDriftsPaginator(c.cloudformationClient, &cloudformation.DescribeStackResourceDriftsInput{
+		StackName: status.StackId,
+		StackResourceDriftStatusFilters: []cfnTypes.StackResourceDriftStatus{
+			cfnTypes.StackResourceDriftStatusModified,
+			cfnTypes.StackResourceDriftStatusDeleted,
+		},
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe resource drifts of stack %s: %w", result.StackName, err)
+		}
+
+		for _, drift := range output.StackResourceDrifts {
+			result.Drifts = append(result.Drifts, models.FromAWSStackResourceDrift(drift))
+		}
+	}
+
+	return result, nil
+}
+
+// stackNameFromID returns the name of a stack from its ID,
+// arn:aws:cloudformation:<region>:<account>:stack/<name>/<uuid>
+func stackNameFromID(stackID string) string {
+	_, rest, found := strings.Cut(stackID, ":stack/")
+	if !found {
+		return stackID
+	}
+	name, _, _ := strings.Cut(rest, "/")
+	return name
+}
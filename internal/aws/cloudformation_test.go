@@ -0,0 +1,129 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	cfnTypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	testRootStackID   = "arn:aws:cloudformation:eu-west-1:123456789012:stack/platform/1111"
+	testNestedStackID = "arn:aws:cloudformation:eu-west-1:123456789012:stack/platform-network-ABC/2222"
+)
+
+func newCloudFormationTestClient() (*Client, *MockCloudFormationClient) {
+	mockCFN := new(MockCloudFormationClient)
+	mockCFN.On("DescribeStacks", mock.Anything, mock.Anything, mock.Anything).Return(&cloudformation.DescribeStacksOutput{
+		Stacks: []cfnTypes.Stack{
+			{
+				StackName:   aws.String("platform-network-ABC"),
+				StackId:     aws.String(testNestedStackID),
+				StackStatus: cfnTypes.StackStatusUpdateComplete,
+				ParentId:    aws.String(testRootStackID),
+				RootId:      aws.String(testRootStackID),
+			},
+			{
+				StackName:        aws.String("platform"),
+				StackId:          aws.String(testRootStackID),
+				StackStatus:      cfnTypes.StackStatusCreateComplete,
+				DriftInformation: &cfnTypes.StackDriftInformation{StackDriftStatus: cfnTypes.StackDriftStatusDrifted},
+				Outputs:          []cfnTypes.Output{{OutputKey: aws.String("VpcId"), OutputValue: aws.String("vpc-1"), ExportName: aws.String("platform-vpc")}},
+				Parameters:       []cfnTypes.Parameter{{ParameterKey: aws.String("DbPassword"), ParameterValue: aws.String("****")}},
+			},
+		},
+	}, nil)
+	return &Client{cloudformationClient: mockCFN}, mockCFN
+}
+
+func TestFetchCloudFormationStacks(t *testing.T) {
+	client, _ := newCloudFormationTestClient()
+
+	stacks, err := client.FetchCloudFormationStacks(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, stacks, 2)
+
+	root := stacks[0]
+	assert.Equal(t, "platform", root.StackName)
+	assert.Equal(t, "DRIFTED", root.DriftStatus)
+	assert.Equal(t, []string{"platform-network-ABC"}, root.NestedStacks)
+	assert.Equal(t, "platform-vpc", root.Outputs[0].ExportName)
+	assert.Equal(t, "****", root.Parameters["DbPassword"])
+
+	nested := stacks[1]
+	assert.Equal(t, testRootStackID, nested.ParentID)
+	assert.Equal(t, "NOT_CHECKED", nested.DriftStatus)
+	assert.Empty(t, nested.NestedStacks)
+}
+
+func TestFetchStackOwnership(t *testing.T) {
+	client, mockCFN := newCloudFormationTestClient()
+	mockCFN.On("ListStackResources", mock.Anything, mock.MatchedBy(func(in *cloudformation.ListStackResourcesInput) bool {
+		return aws.ToString(in.StackName) == "platform"
+	}), mock.Anything).Return(&cloudformation.ListStackResourcesOutput{
+		StackResourceSummaries: []cfnTypes.StackResourceSummary{
+			{LogicalResourceId: aws.String("Network"), PhysicalResourceId: aws.String(testNestedStackID), ResourceType: aws.String("AWS::CloudFormation::Stack")},
+			{LogicalResourceId: aws.String("OrdersFunction"), PhysicalResourceId: aws.String("orders"), ResourceType: aws.String("AWS::Lambda::Function")},
+			// Failed creations leave resources without physical ID
+			{LogicalResourceId: aws.String("Broken"), ResourceType: aws.String("AWS::SQS::Queue")},
+		},
+	}, nil)
+	mockCFN.On("ListStackResources", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("throttled"))
+
+	index, err := client.FetchStackOwnership(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, index.ByPhysicalID, 2)
+	assert.Equal(t, "OrdersFunction", index.ByPhysicalID["orders"].LogicalID)
+	assert.Equal(t, "platform", index.ByPhysicalID["orders"].StackName)
+	assert.Equal(t, testNestedStackID, index.StackIDs["platform-network-ABC"])
+	assert.Len(t, index.Errors, 1)
+}
+
+func TestWaitForStackDriftDetection(t *testing.T) {
+	client, mockCFN := newCloudFormationTestClient()
+	mockCFN.On("DetectStackDrift", mock.Anything, mock.Anything, mock.Anything).Return(&cloudformation.DetectStackDriftOutput{
+		StackDriftDetectionId: aws.String("detection-1"),
+	}, nil)
+	mockCFN.On("DescribeStackDriftDetectionStatus", mock.Anything, mock.Anything, mock.Anything).Return(&cloudformation.DescribeStackDriftDetectionStatusOutput{
+		StackDriftDetectionId:     aws.String("detection-1"),
+		StackId:                   aws.String(testRootStackID),
+		DetectionStatus:           cfnTypes.StackDriftDetectionStatusDetectionComplete,
+		StackDriftStatus:          cfnTypes.StackDriftStatusDrifted,
+		DriftedStackResourceCount: aws.Int32(1),
+	}, nil)
+	mockCFN.On("DescribeStackResourceDrifts", mock.Anything, mock.MatchedBy(func(in *cloudformation.DescribeStackResourceDriftsInput) bool {
+		return aws.ToString(in.StackName) == testRootStackID && len(in.StackResourceDriftStatusFilters) == 2
+	}), mock.Anything).Return(&cloudformation.DescribeStackResourceDriftsOutput{
+		StackResourceDrifts: []cfnTypes.StackResourceDrift{{
+			LogicalResourceId:        aws.String("OrdersFunction"),
+			PhysicalResourceId:       aws.String("orders"),
+			ResourceType:             aws.String("AWS::Lambda::Function"),
+			StackResourceDriftStatus: cfnTypes.StackResourceDriftStatusModified,
+			PropertyDifferences: []cfnTypes.PropertyDifference{{
+				PropertyPath:   aws.String("/MemorySize"),
+				DifferenceType: cfnTypes.DifferenceTypeNotEqual,
+				ExpectedValue:  aws.String("128"),
+				ActualValue:    aws.String("512"),
+			}},
+		}},
+	}, nil)
+
+	detectionID, err := client.StartStackDriftDetection(context.Background(), "platform")
+	assert.NoError(t, err)
+	assert.Equal(t, "detection-1", detectionID)
+
+	result, err := client.WaitForStackDriftDetection(context.Background(), detectionID)
+	assert.NoError(t, err)
+	assert.Equal(t, "platform", result.StackName)
+	assert.Equal(t, "DRIFTED", result.DriftStatus)
+	assert.Len(t, result.Drifts, 1)
+	assert.Equal(t, []string{"/MemorySize NOT_EQUAL: 128 → 512"}, result.Drifts[0].Differences)
+
+	_, err = client.StartStackDriftDetection(context.Background(), "")
+	assert.Error(t, err)
+}
@@ -0,0 +1,211 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2Types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"aws-terminal-sdk-v1/internal/models"
+)
+
+// transitGatewayRoutesLimit is the most routes SearchTransitGatewayRoutes returns per route table
+const transitGatewayRoutesLimit = 1000
+
+// FetchInternetGateways gets the internet gateways and the VPC each one is attached to
+func (c *Client) FetchInternetGateways(ctx context.Context) ([]models.InternetGatewayInfo, error) {
+	gateways := make([]models.InternetGatewayInfo, 0)
+	paginator := ec2.NewDescribeInternetGatewaysPaginator(c.ec2Client, &ec2.DescribeInternetGatewaysInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe internet gateways: %w", err)
+		}
+
+		for _, igw := range output.InternetGateways {
+			gateways = append(gateways, models.FromAWSInternetGateway(igw))
+		}
+	}
+
+	return gateways, nil
+}
+
+// FetchVPCEndpoints gets the gateway and interface VPC endpoints
+func (c *Client) FetchVPCEndpoints(ctx context.Context) ([]models.VPCEndpointInfo, error) {
+	endpoints := make([]models.VPCEndpointInfo, 0)
+	paginator := ec2.NewDescribeVpcEndpointsPaginator(c.ec2Client, &ec2.DescribeVpcEndpointsInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe VPC endpoints: %w", err)
+		}
+
+		for _, endpoint := range output.VpcEndpoints {
+			endpoints = append(endpoints, models.FromAWSVPCEndpoint(endpoint))
+		}
+	}
+
+	return endpoints, nil
+}
+
+// FetchVPCPeerings gets the VPC peering connections where one of our VPCs is requester or accepter
+func (c *Client) FetchVPCPeerings(ctx context.Context) ([]models.VPCPeeringInfo, error) {
+	peerings := make([]models.VPCPeeringInfo, 0)
+	paginator := ec2.NewDescribeVpcPeeringConnectionsPaginator(c.ec2Client, &ec2.DescribeVpcPeeringConnectionsInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe VPC peering connections: %w", err)
+		}
+
+		for _, peering := range output.VpcPeeringConnections {
+			peerings = append(peerings, models.FromAWSVPCPeering(peering))
+		}
+	}
+
+	return peerings, nil
+}
+
+// FetchTransitGatewayAttachments gets the VPC, VPN and peering attachments of the transit gateways
+func (c *Client) FetchTransitGatewayAttachments(ctx context.Context) ([]models.TransitGatewayAttachmentInfo, error) {
+	attachments := make([]models.TransitGatewayAttachmentInfo, 0)
+	paginator := ec2.NewDescribeTransitGatewayAttachmentsPaginator(c.ec2Client, &ec2.DescribeTransitGatewayAttachmentsInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe transit gateway attachments: %w", err)
+		}
+
+		for _, attachment := range output.TransitGatewayAttachments {
+			attachments = append(attachments, models.FromAWSTransitGatewayAttachment(attachment))
+		}
+	}
+
+	return attachments, nil
+}
+
+// FetchTransitGatewayRouteTables gets the transit gateway route tables with their active and blackhole routes
+func (c *Client) FetchTransitGatewayRouteTables(ctx context.Context) ([]models.TransitGatewayRouteTableInfo, error) {
+	routeTables := make([]models.TransitGatewayRouteTableInfo, 0)
+	paginator := ec2.NewDescribeTransitGatewayRouteTablesPaginator(c.ec2Client, &ec2.DescribeTransitGatewayRouteTablesInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe transit gateway route tables: %w", err)
+		}
+
+		for _, rt := range output.TransitGatewayRouteTables {
+			routeTables = append(routeTables, models.FromAWSTransitGatewayRouteTable(rt))
+		}
+	}
+
+	for i := range routeTables {
+		output, err := c.ec2Client.SearchTransitGatewayRoutes(ctx, &ec2.SearchTransitGatewayRoutesInput{
+			TransitGatewayRouteTableId: aws.String(routeTables[i].ID),
+			Filters: []ec2Types.Filter{
+				{Name: aws.String("state"), Values: []string{"active", "blackhole"}},
+			},
+			MaxResults: aws.Int32(transitGatewayRoutesLimit),
+		})
+		if err != nil {
+			fmt.Printf("Warning: failed to search routes of transit gateway route table %s: %v\n", routeTables[i].ID, err)
+			continue
+		}
+
+		for _, route := range output.Routes {
+			routeTables[i].Routes = append(routeTables[i].Routes, models.FromAWSTransitGatewayRoute(route))
+		}
+	}
+
+	return routeTables, nil
+}
+
+// FetchVPNConnections gets the site-to-site VPN connections, resolving the VPC
+// behind each virtual private gateway
+func (c *Client) FetchVPNConnections(ctx context.Context) ([]models.VPNConnectionInfo, error) {
+	output, err := c.ec2Client.DescribeVpnConnections(ctx, &ec2.DescribeVpnConnectionsInput{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe VPN connections: %w", err)
+	}
+
+	vpns := make([]models.VPNConnectionInfo, 0, len(output.VpnConnections))
+	needsGateways := false
+	for _, vpn := range output.VpnConnections {
+		info := models.FromAWSVPNConnection(vpn)
+		needsGateways = needsGateways || info.VPNGatewayID != ""
+		vpns = append(vpns, info)
+	}
+	if !needsGateways {
+		return vpns, nil
+	}
+
+	gateways, err := c.ec2Client.DescribeVpnGateways(ctx, &ec2.DescribeVpnGatewaysInput{})
+	if err != nil {
+		fmt.Printf("Warning: failed to describe VPN gateways: %v\n", err)
+		return vpns, nil
+	}
+
+	gatewayVPCs := make(map[string]string)
+	for _, gw := range gateways.VpnGateways {
+		for _, attachment := range gw.VpcAttachments {
+			if attachment.State == ec2Types.AttachmentStatusAttached {
+				gatewayVPCs[safeString(gw.VpnGatewayId)] = safeString(attachment.VpcId)
+			}
+		}
+	}
+	for i := range vpns {
+		vpns[i].VPCID = gatewayVPCs[vpns[i].VPNGatewayID]
+	}
+
+	return vpns, nil
+}
+
+// FetchVPCConnectivity gathers internet gateways, endpoints, peerings, transit gateway
+// attachments and route tables, and VPN connections. A source that fails is reported
+// in Errors and does not prevent the others from being returned.
+func (c *Client) FetchVPCConnectivity(ctx context.Context) (*models.VPCConnectivity, error) {
+	result := &models.VPCConnectivity{
+		InternetGateways:          make([]models.InternetGatewayInfo, 0),
+		Endpoints:                 make([]models.VPCEndpointInfo, 0),
+		Peerings:                  make([]models.VPCPeeringInfo, 0),
+		TransitGatewayAttachments: make([]models.TransitGatewayAttachmentInfo, 0),
+		TransitGatewayRouteTables: make([]models.TransitGatewayRouteTableInfo, 0),
+		VPNConnections:            make([]models.VPNConnectionInfo, 0),
+		Errors:                    make([]string, 0),
+	}
+
+	if igws, err := c.FetchInternetGateways(ctx); err != nil {
+		result.Errors = append(result.Errors, err.Error())
+	} else {
+		result.InternetGateways = igws
+	}
+	if endpoints, err := c.FetchVPCEndpoints(ctx); err != nil {
+		result.Errors = append(result.Errors, err.Error())
+	} else {
+		result.Endpoints = endpoints
+	}
+	if peerings, err := c.FetchVPCPeerings(ctx); err != nil {
+		result.Errors = append(result.Errors, err.Error())
+	} else {
+		result.Peerings = peerings
+	}
+	if attachments, err := c.FetchTransitGatewayAttachments(ctx); err != nil {
+		result.Errors = append(result.Errors, err.Error())
+	} else {
+		result.TransitGatewayAttachments = attachments
+	}
+	if routeTables, err := c.FetchTransitGatewayRouteTables(ctx); err != nil {
+		result.Errors = append(result.Errors, err.Error())
+	} else {
+		result.TransitGatewayRouteTables = routeTables
+	}
+	if vpns, err := c.FetchVPNConnections(ctx); err != nil {
+		result.Errors = append(result.Errors, err.Error())
+	} else {
+		result.VPNConnections = vpns
+	}
+
+	return result, nil
+}
@@ -0,0 +1,162 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2Types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"aws-terminal-sdk-v1/internal/models"
+)
+
+func TestFetchRouteTables_RouteTargets(t *testing.T) {
+	mockEC2 := new(MockEC2Client)
+	client := &Client{ec2Client: mockEC2}
+
+	mockEC2.On("DescribeRouteTables", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeRouteTablesOutput{
+		RouteTables: []ec2Types.RouteTable{
+			{
+				RouteTableId: aws.String("rtb-1"),
+				VpcId:        aws.String("vpc-1"),
+				Routes: []ec2Types.Route{
+					{DestinationCidrBlock: aws.String("10.0.0.0/16"), GatewayId: aws.String("local"), State: ec2Types.RouteStateActive},
+					{DestinationCidrBlock: aws.String("0.0.0.0/0"), GatewayId: aws.String("igw-1"), State: ec2Types.RouteStateActive},
+					{DestinationCidrBlock: aws.String("10.1.0.0/16"), VpcPeeringConnectionId: aws.String("pcx-1")},
+					{DestinationCidrBlock: aws.String("172.16.0.0/12"), TransitGatewayId: aws.String("tgw-1"), State: ec2Types.RouteStateBlackhole},
+					{DestinationPrefixListId: aws.String("pl-s3"), GatewayId: aws.String("vpce-1")},
+				},
+			},
+		},
+	}, nil)
+
+	rts, err := client.FetchRouteTables(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, rts, 1)
+
+	routes := rts[0].RouteEntries
+	assert.Len(t, routes, 5)
+	assert.Equal(t, models.RouteTargetLocal, routes[0].TargetType)
+	assert.Equal(t, models.RouteTargetInternetGateway, routes[1].TargetType)
+	assert.Equal(t, "igw-1", routes[1].TargetID)
+	assert.Equal(t, models.RouteTargetPeering, routes[2].TargetType)
+	assert.Equal(t, models.RouteTargetTransitGateway, routes[3].TargetType)
+	assert.Equal(t, "blackhole", routes[3].State)
+	assert.Equal(t, models.RouteTargetVPCEndpoint, routes[4].TargetType)
+	assert.Equal(t, "pl-s3", routes[4].Destination)
+}
+
+func TestFetchVPCConnectivity(t *testing.T) {
+	mockEC2 := new(MockEC2Client)
+	client := &Client{ec2Client: mockEC2}
+
+	mockEC2.On("DescribeInternetGateways", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeInternetGatewaysOutput{
+		InternetGateways: []ec2Types.InternetGateway{
+			{
+				InternetGatewayId: aws.String("igw-1"),
+				Attachments:       []ec2Types.InternetGatewayAttachment{{VpcId: aws.String("vpc-1"), State: ec2Types.AttachmentStatus("available")}},
+			},
+			{InternetGatewayId: aws.String("igw-2")},
+		},
+	}, nil)
+	mockEC2.On("DescribeVpcEndpoints", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("UnauthorizedOperation"))
+	mockEC2.On("DescribeVpcPeeringConnections", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeVpcPeeringConnectionsOutput{
+		VpcPeeringConnections: []ec2Types.VpcPeeringConnection{
+			{
+				VpcPeeringConnectionId: aws.String("pcx-1"),
+				Status:                 &ec2Types.VpcPeeringConnectionStateReason{Code: ec2Types.VpcPeeringConnectionStateReasonCodeActive},
+				RequesterVpcInfo:       &ec2Types.VpcPeeringConnectionVpcInfo{VpcId: aws.String("vpc-1")},
+				AccepterVpcInfo:        &ec2Types.VpcPeeringConnectionVpcInfo{VpcId: aws.String("vpc-2"), OwnerId: aws.String("210987654321")},
+			},
+		},
+	}, nil)
+	mockEC2.On("DescribeTransitGatewayAttachments", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeTransitGatewayAttachmentsOutput{
+		TransitGatewayAttachments: []ec2Types.TransitGatewayAttachment{
+			{
+				TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+				TransitGatewayId:           aws.String("tgw-1"),
+				ResourceType:               ec2Types.TransitGatewayAttachmentResourceTypeVpc,
+				ResourceId:                 aws.String("vpc-1"),
+				Association:                &ec2Types.TransitGatewayAttachmentAssociation{TransitGatewayRouteTableId: aws.String("tgw-rtb-1")},
+			},
+			{
+				TransitGatewayAttachmentId: aws.String("tgw-attach-2"),
+				TransitGatewayId:           aws.String("tgw-1"),
+				ResourceType:               ec2Types.TransitGatewayAttachmentResourceTypeVpn,
+				ResourceId:                 aws.String("vpn-1"),
+			},
+		},
+	}, nil)
+	mockEC2.On("DescribeTransitGatewayRouteTables", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeTransitGatewayRouteTablesOutput{
+		TransitGatewayRouteTables: []ec2Types.TransitGatewayRouteTable{
+			{TransitGatewayRouteTableId: aws.String("tgw-rtb-1"), TransitGatewayId: aws.String("tgw-1")},
+		},
+	}, nil)
+	mockEC2.On("SearchTransitGatewayRoutes", mock.Anything, mock.MatchedBy(func(in *ec2.SearchTransitGatewayRoutesInput) bool {
+		return aws.ToString(in.TransitGatewayRouteTableId) == "tgw-rtb-1"
+	}), mock.Anything).Return(&ec2.SearchTransitGatewayRoutesOutput{
+		Routes: []ec2Types.TransitGatewayRoute{
+			{
+				DestinationCidrBlock: aws.String("10.0.0.0/16"),
+				Type:                 ec2Types.TransitGatewayRouteTypePropagated,
+				TransitGatewayAttachments: []ec2Types.TransitGatewayRouteAttachment{
+					{TransitGatewayAttachmentId: aws.String("tgw-attach-1"), ResourceId: aws.String("vpc-1")},
+				},
+			},
+		},
+	}, nil)
+	mockEC2.On("DescribeVpnConnections", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeVpnConnectionsOutput{
+		VpnConnections: []ec2Types.VpnConnection{
+			{
+				VpnConnectionId: aws.String("vpn-2"),
+				VpnGatewayId:    aws.String("vgw-1"),
+				VgwTelemetry: []ec2Types.VgwTelemetry{
+					{OutsideIpAddress: aws.String("34.1.1.1"), Status: ec2Types.TelemetryStatusUp},
+					{OutsideIpAddress: aws.String("34.1.1.2"), Status: ec2Types.TelemetryStatusDown},
+				},
+			},
+		},
+	}, nil)
+	mockEC2.On("DescribeVpnGateways", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeVpnGatewaysOutput{
+		VpnGateways: []ec2Types.VpnGateway{
+			{
+				VpnGatewayId:   aws.String("vgw-1"),
+				VpcAttachments: []ec2Types.VpcAttachment{{VpcId: aws.String("vpc-2"), State: ec2Types.AttachmentStatusAttached}},
+			},
+		},
+	}, nil)
+
+	result, err := client.FetchVPCConnectivity(context.Background())
+	assert.NoError(t, err)
+
+	assert.Len(t, result.InternetGateways, 2)
+	assert.Equal(t, "vpc-1", result.InternetGateways[0].VPCID)
+	assert.Equal(t, "detached", result.InternetGateways[1].State)
+
+	// The endpoints failure is reported without dropping the other sources
+	assert.NotNil(t, result.Endpoints)
+	assert.Empty(t, result.Endpoints)
+	assert.Len(t, result.Errors, 1)
+
+	assert.Len(t, result.Peerings, 1)
+	assert.Equal(t, "vpc-2", result.Peerings[0].PeerVPCID("vpc-1"))
+	assert.Equal(t, "vpc-1", result.Peerings[0].PeerVPCID("vpc-2"))
+	assert.Equal(t, "", result.Peerings[0].PeerVPCID("vpc-3"))
+
+	assert.Len(t, result.TransitGatewayAttachments, 2)
+	assert.Equal(t, "vpc-1", result.TransitGatewayAttachments[0].VPCID)
+	assert.Equal(t, "tgw-rtb-1", result.TransitGatewayAttachments[0].RouteTableID)
+	assert.Equal(t, "", result.TransitGatewayAttachments[1].VPCID)
+
+	assert.Len(t, result.TransitGatewayRouteTables, 1)
+	assert.Len(t, result.TransitGatewayRouteTables[0].Routes, 1)
+	assert.Equal(t, []string{"vpc-1"}, result.TransitGatewayRouteTables[0].Routes[0].ResourceIDs)
+
+	assert.Len(t, result.VPNConnections, 1)
+	assert.Equal(t, "vpc-2", result.VPNConnections[0].VPCID)
+	assert.Len(t, result.VPNConnections[0].Tunnels, 2)
+}
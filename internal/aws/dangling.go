@@ -0,0 +1,274 @@
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+
+	"aws-terminal-sdk-v1/internal/constants"
+	"aws-terminal-sdk-v1/internal/models"
+)
+
+// awsIPRangesURL publishes the public IP ranges of AWS by region and service
+var awsIPRangesURL = "https://ip-ranges.amazonaws.com/ip-ranges.json"
+
+// Hostnames of the AWS endpoints a record can point at
+var (
+	// ALB and classic: <name>.<region>.elb.amazonaws.com, NLB: <name>.elb.<region>.amazonaws.com
+	elbHostPattern = regexp.MustCompile(`(?:\.([a-z0-9-]+)\.elb|\.elb\.([a-z0-9-]+))\.amazonaws\.com$`)
+	// [<bucket>.]s3[-website][.-<region>].amazonaws.com; alias targets of website buckets
+	// carry no bucket, which is then the record name
+	s3HostPattern         = regexp.MustCompile(`^(?:(.+)\.)?s3(?:[.-]website)?(?:[.-][a-z0-9-]+)?\.amazonaws\.com$`)
+	cloudFrontHostPattern = regexp.MustCompile(`\.cloudfront\.net$`)
+)
+
+// danglingDNSIndex holds the resources of the account the records are checked against.
+// A nil set means the resources could not be listed and the matching check is skipped.
+type danglingDNSIndex struct {
+	loadBalancers map[string]bool
+	distributions map[string]bool
+	publicIPs     map[string]bool
+	ec2Ranges     []*net.IPNet
+	buckets       map[string]bool // HeadBucket results, by bucket name
+}
+
+// FetchDanglingDNSRecords reports the records of the public hosted zones pointing at a
+// resource that no longer exists and could be claimed by someone else: a load balancer
+// or CloudFront distribution missing from the account, a deleted S3 bucket, or an EC2
+// public IP of the region no longer held by an Elastic IP or a network interface.
+// Load balancers are only checked in the current region.
+func (c *Client) FetchDanglingDNSRecords(ctx context.Context) ([]models.SecurityFinding, error) {
+	zones, err := c.FetchRoute53HostedZones(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	index := c.buildDanglingDNSIndex(ctx)
+	now := time.Now().Format(constants.DateTimeFormat)
+	findings := make([]models.SecurityFinding, 0)
+	for _, zone := range zones {
+		if zone.Private {
+			continue
+		}
+
+		records, err := c.FetchRoute53Records(ctx, zone.ID)
+		if err != nil {
+			fmt.Printf("Warning: %v\n", err)
+			continue
+		}
+
+		for _, record := range records {
+			severity, reason := c.danglingDNSReason(ctx, index, record)
+			if reason == "" {
+				continue
+			}
+			findings = append(findings, models.SecurityFinding{
+				Title:      "Dangling DNS record",
+				Severity:   severity,
+				ResourceID: fmt.Sprintf("%s %s", record.Name, record.Type),
+				Category:   reason,
+				UpdatedAt:  now,
+			})
+		}
+	}
+
+	return findings, nil
+}
+
+func (c *Client) buildDanglingDNSIndex(ctx context.Context) *danglingDNSIndex {
+	index := &danglingDNSIndex{buckets: make(map[string]bool)}
+
+	// Classic load balancers share the hostnames of application load balancers
+	lbs, lbErr := c.FetchLoadBalancers(ctx)
+	classic, classicErr := c.fetchClassicLoadBalancerDNSNames(ctx)
+	if err := errors.Join(lbErr, classicErr); err != nil {
+		fmt.Printf("Warning: skipping dangling load balancer check: %v\n", err)
+	} else {
+		index.loadBalancers = make(map[string]bool)
+		for _, lb := range lbs {
+			index.loadBalancers[models.NormalizeDNSName(lb.DNSName)] = true
+		}
+		for _, dnsName := range classic {
+			index.loadBalancers[models.NormalizeDNSName(dnsName)] = true
+		}
+	}
+
+	if distributions, err := c.FetchCloudFrontDistributions(ctx); err != nil {
+		fmt.Printf("Warning: %v\n", err)
+	} else {
+		index.distributions = make(map[string]bool)
+		for _, dist := range distributions {
+			index.distributions[models.NormalizeDNSName(dist.DomainName)] = true
+		}
+	}
+
+	eips, eipErr := c.FetchElasticIPs(ctx)
+	enis, eniErr := c.FetchNetworkInterfaces(ctx)
+	ranges, rangesErr := fetchEC2IPRanges(ctx, c.region)
+	if err := errors.Join(eipErr, eniErr, rangesErr); err != nil {
+		fmt.Printf("Warning: skipping dangling IP check: %v\n", err)
+	} else {
+		index.publicIPs = make(map[string]bool)
+		for _, eip := range eips {
+			index.publicIPs[eip.PublicIP] = true
+		}
+		for _, eni := range enis {
+			for _, ip := range eni.PublicIPs {
+				index.publicIPs[ip] = true
+			}
+		}
+		index.ec2Ranges = ranges
+	}
+
+	return index
+}
+
+// fetchClassicLoadBalancerDNSNames lists the DNS names of the classic load balancers
+func (c *Client) fetchClassicLoadBalancerDNSNames(ctx context.Context) ([]string, error) {
+	dnsNames := make([]string, 0)
+	paginator := elasticloadbalancing.NewDescribeLoadBalancersPaginator(c.elbClient, &elasticloadbalancing.DescribeLoadBalancersInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe classic load balancers: %w", err)
+		}
+		for _, lb := range output.LoadBalancerDescriptions {
+			dnsNames = append(dnsNames, aws.ToString(lb.DNSName))
+		}
+	}
+	return dnsNames, nil
+}
+
+// danglingDNSReason returns the severity and the reason why a record dangles, or an
+// empty reason when it points at an existing resource or outside of what is checked
+func (c *Client) danglingDNSReason(ctx context.Context, index *danglingDNSIndex, record models.Route53RecordInfo) (string, string) {
+	target := record.AliasTarget
+	pointer := "Alias to"
+	if target == "" && record.Type == "CNAME" && len(record.Values) > 0 {
+		target = models.NormalizeDNSName(record.Values[0])
+		pointer = "CNAME to"
+	}
+
+	if target != "" {
+		if m := elbHostPattern.FindStringSubmatch(target); m != nil {
+			region := firstNonEmpty(m[1], m[2])
+			if index.loadBalancers == nil || region != c.region || index.loadBalancers[target] {
+				return "", ""
+			}
+			return constants.SecurityHubSeverityHigh,
+				fmt.Sprintf("%s %s, no load balancer of the account has this DNS name", pointer, target)
+		}
+
+		if cloudFrontHostPattern.MatchString(target) {
+			if index.distributions == nil || index.distributions[target] {
+				return "", ""
+			}
+			return constants.SecurityHubSeverityHigh,
+				fmt.Sprintf("%s %s, no CloudFront distribution of the account has this domain name", pointer, target)
+		}
+
+		if m := s3HostPattern.FindStringSubmatch(target); m != nil {
+			bucket := firstNonEmpty(m[1], record.Name)
+			exists, checked := index.buckets[bucket]
+			if !checked {
+				exists = c.bucketExists(ctx, bucket)
+				index.buckets[bucket] = exists
+			}
+			if exists {
+				return "", ""
+			}
+			return constants.SecurityHubSeverityHigh,
+				fmt.Sprintf("%s %s, bucket %s does not exist", pointer, target, bucket)
+		}
+
+		return "", ""
+	}
+
+	if record.Type != "A" || index.publicIPs == nil {
+		return "", ""
+	}
+	for _, value := range record.Values {
+		ip := net.ParseIP(value)
+		if ip == nil || index.publicIPs[ip.String()] || !ipInRanges(ip, index.ec2Ranges) {
+			continue
+		}
+		return constants.SecurityHubSeverityMedium,
+			fmt.Sprintf("Points at %s, an EC2 address of %s not held by an Elastic IP or network interface of the account", value, c.region)
+	}
+
+	return "", ""
+}
+
+// bucketExists reports whether an S3 bucket exists, in any account. Only a 404 means
+// the bucket is gone: denied access or a redirect to another region mean it exists.
+func (c *Client) bucketExists(ctx context.Context, bucket string) bool {
+	_, err := c.s3Client.HeadBucket(ctx, &s3.HeadBucketInput{Bucket: aws.String(bucket)})
+	var respErr *awshttp.ResponseError
+	if errors.As(err, &respErr) && respErr.HTTPStatusCode() == http.StatusNotFound {
+		return false
+	}
+	if err != nil && !errors.As(err, &respErr) {
+		fmt.Printf("Warning: failed to check bucket %s: %v\n", bucket, err)
+	}
+	return true
+}
+
+// fetchEC2IPRanges downloads the public EC2 ranges of a region from ip-ranges.json
+func fetchEC2IPRanges(ctx context.Context, region string) ([]*net.IPNet, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.AWSIPRangesTimeoutSeconds*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, awsIPRangesURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch AWS IP ranges: %w", err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch AWS IP ranges: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch AWS IP ranges: %s", resp.Status)
+	}
+
+	var document struct {
+		Prefixes []struct {
+			IPPrefix string `json:"ip_prefix"`
+			Region   string `json:"region"`
+			Service  string `json:"service"`
+		} `json:"prefixes"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&document); err != nil {
+		return nil, fmt.Errorf("failed to parse AWS IP ranges: %w", err)
+	}
+
+	ranges := make([]*net.IPNet, 0)
+	for _, prefix := range document.Prefixes {
+		if prefix.Service != "EC2" || prefix.Region != region {
+			continue
+		}
+		if _, network, err := net.ParseCIDR(prefix.IPPrefix); err == nil {
+			ranges = append(ranges, network)
+		}
+	}
+	return ranges, nil
+}
+
+func ipInRanges(ip net.IP, ranges []*net.IPNet) bool {
+	for _, network := range ranges {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,159 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/aws/aws-sdk-go-v2/service/kafka"
+	"github.com/aws/aws-sdk-go-v2/service/opensearch"
+
+	"aws-terminal-sdk-v1/internal/models"
+)
+
+// openSearchDescribeBatch is the most domains DescribeDomains accepts per call
+const openSearchDescribeBatch = 5
+
+// FetchElastiCacheClusters gets the replication groups and the cache clusters outside of
+// any group, with the VPC and subnets of their subnet group
+func (c *Client) FetchElastiCacheClusters(ctx context.Context) ([]models.ElastiCacheClusterInfo, error) {
+	clusters := make([]models.ElastiCacheClusterInfo, 0)
+	groupIndex := make(map[string]int)
+
+	groupPaginator := elasticache.NewDescribeReplicationGroupsPaginator(c.elasticacheClient, &elasticache.DescribeReplicationGroupsInput{})
+	for groupPaginator.HasMorePages() {
+		output, err := groupPaginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe replication groups: %w", err)
+		}
+
+		for _, group := range output.ReplicationGroups {
+			groupIndex[aws.ToString(group.ReplicationGroupId)] = len(clusters)
+			clusters = append(clusters, models.FromAWSReplicationGroup(group))
+		}
+	}
+
+	clusterPaginator := elasticache.NewDescribeCacheClustersPaginator(c.elasticacheClient, &elasticache.DescribeCacheClustersInput{
+		ShowCacheNodeInfo: aws.Bool(true),
+	})
+	for clusterPaginator.HasMorePages() {
+		output, err := clusterPaginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe cache clusters: %w", err)
+		}
+
+		for _, cluster := range output.CacheClusters {
+			if i, ok := groupIndex[aws.ToString(cluster.ReplicationGroupId)]; ok {
+				clusters[i].AddMemberCluster(cluster)
+				continue
+			}
+			clusters = append(clusters, models.FromAWSCacheCluster(cluster))
+		}
+	}
+
+	// The subnet groups are looked up once, a failure only loses the VPC placement
+	subnetPaginator := elasticache.NewDescribeCacheSubnetGroupsPaginator(c.elasticacheClient, &elasticache.DescribeCacheSubnetGroupsInput{})
+	for subnetPaginator.HasMorePages() {
+		output, err := subnetPaginator.NextPage(ctx)
+		if err != nil {
+			fmt.Printf("Warning: failed to describe cache subnet groups: %v\n", err)
+			break
+		}
+
+		for _, group := range output.CacheSubnetGroups {
+			for i := range clusters {
+				if clusters[i].SubnetGroup == aws.ToString(group.CacheSubnetGroupName) {
+					clusters[i].SetSubnetGroup(group)
+				}
+			}
+		}
+	}
+
+	sort.SliceStable(clusters, func(i, j int) bool {
+		return clusters[i].ID < clusters[j].ID
+	})
+	return clusters, nil
+}
+
+// FetchOpenSearchDomains gets the OpenSearch Service domains with their cluster,
+// encryption and VPC configuration
+func (c *Client) FetchOpenSearchDomains(ctx context.Context) ([]models.OpenSearchDomainInfo, error) {
+	names, err := c.opensearchClient.ListDomainNames(ctx, &opensearch.ListDomainNamesInput{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list OpenSearch domains: %w", err)
+	}
+
+	domains := make([]models.OpenSearchDomainInfo, 0, len(names.DomainNames))
+	for start := 0; start < len(names.DomainNames); start += openSearchDescribeBatch {
+		end := min(start+openSearchDescribeBatch, len(names.DomainNames))
+		batch := make([]string, 0, end-start)
+		for _, domain := range names.DomainNames[start:end] {
+			batch = append(batch, aws.ToString(domain.DomainName))
+		}
+
+		output, err := c.opensearchClient.DescribeDomains(ctx, &opensearch.DescribeDomainsInput{DomainNames: batch})
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe OpenSearch domains: %w", err)
+		}
+		for _, domain := range output.DomainStatusList {
+			domains = append(domains, models.FromAWSOpenSearchDomain(domain))
+		}
+	}
+
+	sort.SliceStable(domains, func(i, j int) bool {
+		return domains[i].DomainName < domains[j].DomainName
+	})
+	return domains, nil
+}
+
+// FetchMSKClusters gets the provisioned and serverless MSK clusters. MSK only reports the
+// subnets of a cluster, its VPC is resolved from them.
+func (c *Client) FetchMSKClusters(ctx context.Context) ([]models.MSKClusterInfo, error) {
+	clusters := make([]models.MSKClusterInfo, 0)
+	paginator := kafka.NewListClustersV2Paginator(c.kafkaClient, &kafka.ListClustersV2Input{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list MSK clusters: %w", err)
+		}
+
+		for _, cluster := range output.ClusterInfoList {
+			clusters = append(clusters, models.FromAWSMSKCluster(cluster))
+		}
+	}
+
+	subnetIDs := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, cluster := range clusters {
+		for _, id := range cluster.SubnetIDs {
+			if !seen[id] {
+				seen[id] = true
+				subnetIDs = append(subnetIDs, id)
+			}
+		}
+	}
+	if len(subnetIDs) > 0 {
+		output, err := c.ec2Client.DescribeSubnets(ctx, &ec2.DescribeSubnetsInput{SubnetIds: subnetIDs})
+		if err != nil {
+			fmt.Printf("Warning: failed to describe the subnets of the MSK clusters: %v\n", err)
+		} else {
+			vpcs := make(map[string]string, len(output.Subnets))
+			for _, subnet := range output.Subnets {
+				vpcs[aws.ToString(subnet.SubnetId)] = aws.ToString(subnet.VpcId)
+			}
+			for i := range clusters {
+				if len(clusters[i].SubnetIDs) > 0 {
+					clusters[i].VPCID = vpcs[clusters[i].SubnetIDs[0]]
+				}
+			}
+		}
+	}
+
+	sort.SliceStable(clusters, func(i, j int) bool {
+		return clusters[i].Name < clusters[j].Name
+	})
+	return clusters, nil
+}
@@ -0,0 +1,201 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2Types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	elasticacheTypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+	"github.com/aws/aws-sdk-go-v2/service/kafka"
+	kafkaTypes "github.com/aws/aws-sdk-go-v2/service/kafka/types"
+	"github.com/aws/aws-sdk-go-v2/service/opensearch"
+	opensearchTypes "github.com/aws/aws-sdk-go-v2/service/opensearch/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFetchElastiCacheClusters(t *testing.T) {
+	mockEC := new(MockElastiCacheClient)
+	client := &Client{elasticacheClient: mockEC}
+
+	mockEC.On("DescribeReplicationGroups", mock.Anything, mock.Anything, mock.Anything).Return(&elasticache.DescribeReplicationGroupsOutput{
+		ReplicationGroups: []elasticacheTypes.ReplicationGroup{{
+			ReplicationGroupId:       aws.String("sessions"),
+			Engine:                   aws.String("redis"),
+			CacheNodeType:            aws.String("cache.r7g.large"),
+			MemberClusters:           []string{"sessions-001", "sessions-002"},
+			MultiAZ:                  elasticacheTypes.MultiAZStatusEnabled,
+			AutomaticFailover:        elasticacheTypes.AutomaticFailoverStatusEnabled,
+			TransitEncryptionEnabled: aws.Bool(true),
+			NodeGroups: []elasticacheTypes.NodeGroup{{
+				PrimaryEndpoint: &elasticacheTypes.Endpoint{Address: aws.String("sessions.abc.cache.amazonaws.com"), Port: aws.Int32(6379)},
+				NodeGroupMembers: []elasticacheTypes.NodeGroupMember{
+					{CacheClusterId: aws.String("sessions-001"), CurrentRole: aws.String("replica")},
+					{CacheClusterId: aws.String("sessions-002"), CurrentRole: aws.String("primary")},
+				},
+			}},
+		}},
+	}, nil)
+	mockEC.On("DescribeCacheClusters", mock.Anything, mock.MatchedBy(func(in *elasticache.DescribeCacheClustersInput) bool {
+		return aws.ToBool(in.ShowCacheNodeInfo)
+	}), mock.Anything).Return(&elasticache.DescribeCacheClustersOutput{
+		CacheClusters: []elasticacheTypes.CacheCluster{
+			{
+				CacheClusterId: aws.String("sessions-001"), ReplicationGroupId: aws.String("sessions"),
+				EngineVersion: aws.String("7.1.0"), CacheSubnetGroupName: aws.String("private"),
+				SecurityGroups: []elasticacheTypes.SecurityGroupMembership{{SecurityGroupId: aws.String("sg-redis")}},
+			},
+			{
+				CacheClusterId: aws.String("sessions-002"), ReplicationGroupId: aws.String("sessions"),
+				EngineVersion: aws.String("7.1.0"), CacheSubnetGroupName: aws.String("private"),
+				SecurityGroups: []elasticacheTypes.SecurityGroupMembership{{SecurityGroupId: aws.String("sg-redis")}},
+			},
+			{
+				CacheClusterId: aws.String("catalog"), Engine: aws.String("memcached"), NumCacheNodes: aws.Int32(3),
+				CacheSubnetGroupName:  aws.String("private"),
+				ConfigurationEndpoint: &elasticacheTypes.Endpoint{Address: aws.String("catalog.cfg.cache.amazonaws.com"), Port: aws.Int32(11211)},
+			},
+		},
+	}, nil)
+	mockEC.On("DescribeCacheSubnetGroups", mock.Anything, mock.Anything, mock.Anything).Return(&elasticache.DescribeCacheSubnetGroupsOutput{
+		CacheSubnetGroups: []elasticacheTypes.CacheSubnetGroup{{
+			CacheSubnetGroupName: aws.String("private"),
+			VpcId:                aws.String("vpc-1"),
+			Subnets: []elasticacheTypes.Subnet{
+				{SubnetIdentifier: aws.String("subnet-a")},
+				{SubnetIdentifier: aws.String("subnet-b")},
+			},
+		}},
+	}, nil)
+
+	clusters, err := client.FetchElastiCacheClusters(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, clusters, 2)
+
+	memcached := clusters[0]
+	assert.Equal(t, "catalog", memcached.ID)
+	assert.False(t, memcached.ReplicationGroup)
+	assert.Equal(t, 3, memcached.NodeCount)
+	assert.Equal(t, "catalog.cfg.cache.amazonaws.com", memcached.Endpoint)
+	assert.Equal(t, "vpc-1", memcached.VPCID)
+
+	redis := clusters[1]
+	assert.Equal(t, "sessions", redis.ID)
+	assert.True(t, redis.ReplicationGroup)
+	assert.Equal(t, 2, redis.NodeCount)
+	assert.Equal(t, "7.1.0", redis.EngineVersion)
+	assert.Equal(t, "sessions-002", redis.MetricClusterID)
+	assert.Equal(t, int32(6379), redis.Port)
+	assert.Equal(t, []string{"sg-redis"}, redis.SecurityGroupIDs)
+	assert.Equal(t, "vpc-1", redis.VPCID)
+	assert.Equal(t, []string{"subnet-a", "subnet-b"}, redis.SubnetIDs)
+	assert.True(t, redis.TransitEncryption)
+	assert.False(t, redis.AtRestEncryption)
+}
+
+func TestFetchOpenSearchDomains(t *testing.T) {
+	mockOS := new(MockOpenSearchClient)
+	client := &Client{opensearchClient: mockOS}
+
+	names := make([]opensearchTypes.DomainInfo, 0, 7)
+	for i := 0; i < 7; i++ {
+		names = append(names, opensearchTypes.DomainInfo{DomainName: aws.String(fmt.Sprintf("domain-%d", i))})
+	}
+	mockOS.On("ListDomainNames", mock.Anything, mock.Anything, mock.Anything).Return(&opensearch.ListDomainNamesOutput{DomainNames: names}, nil)
+	describe := func(names ...string) *opensearch.DescribeDomainsOutput {
+		output := &opensearch.DescribeDomainsOutput{}
+		for _, name := range names {
+			output.DomainStatusList = append(output.DomainStatusList, opensearchTypes.DomainStatus{
+				DomainName:    aws.String(name),
+				ClusterConfig: &opensearchTypes.ClusterConfig{InstanceType: opensearchTypes.OpenSearchPartitionInstanceTypeR6gLargeSearch, InstanceCount: aws.Int32(3)},
+				VPCOptions:    &opensearchTypes.VPCDerivedInfo{VPCId: aws.String("vpc-1"), SubnetIds: []string{"subnet-a"}},
+				Endpoints:     map[string]string{"vpc": "vpc-" + name + ".es.amazonaws.com"},
+				Processing:    aws.Bool(name == "domain-3"),
+			})
+		}
+		return output
+	}
+	// Described five domains at a time
+	mockOS.On("DescribeDomains", mock.Anything, mock.MatchedBy(func(in *opensearch.DescribeDomainsInput) bool {
+		return len(in.DomainNames) == 5
+	}), mock.Anything).Return(describe("domain-0", "domain-1", "domain-2", "domain-3", "domain-4"), nil).Once()
+	mockOS.On("DescribeDomains", mock.Anything, mock.MatchedBy(func(in *opensearch.DescribeDomainsInput) bool {
+		return len(in.DomainNames) == 2
+	}), mock.Anything).Return(describe("domain-5", "domain-6"), nil).Once()
+
+	domains, err := client.FetchOpenSearchDomains(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, domains, 7)
+	mockOS.AssertExpectations(t)
+
+	assert.Equal(t, "vpc-domain-0.es.amazonaws.com", domains[0].Endpoint)
+	assert.Equal(t, "vpc-1", domains[0].VPCID)
+	assert.Equal(t, int32(3), domains[0].InstanceCount)
+	assert.Equal(t, "Active", domains[0].Status)
+	assert.Equal(t, "Processing", domains[3].Status)
+}
+
+func TestFetchMSKClusters(t *testing.T) {
+	mockKafka := new(MockKafkaClient)
+	mockEC2 := new(MockEC2Client)
+	client := &Client{kafkaClient: mockKafka, ec2Client: mockEC2}
+
+	mockKafka.On("ListClustersV2", mock.Anything, mock.Anything, mock.Anything).Return(&kafka.ListClustersV2Output{
+		ClusterInfoList: []kafkaTypes.Cluster{
+			{
+				ClusterName: aws.String("events"),
+				ClusterType: kafkaTypes.ClusterTypeProvisioned,
+				State:       kafkaTypes.ClusterStateActive,
+				Provisioned: &kafkaTypes.Provisioned{
+					NumberOfBrokerNodes:       aws.Int32(3),
+					CurrentBrokerSoftwareInfo: &kafkaTypes.BrokerSoftwareInfo{KafkaVersion: aws.String("3.6.0")},
+					BrokerNodeGroupInfo: &kafkaTypes.BrokerNodeGroupInfo{
+						InstanceType:   aws.String("kafka.m5.large"),
+						ClientSubnets:  []string{"subnet-a", "subnet-b"},
+						SecurityGroups: []string{"sg-kafka"},
+					},
+					EncryptionInfo: &kafkaTypes.EncryptionInfo{
+						EncryptionInTransit: &kafkaTypes.EncryptionInTransit{ClientBroker: kafkaTypes.ClientBrokerTlsPlaintext, InCluster: aws.Bool(true)},
+					},
+				},
+			},
+			{
+				ClusterName: aws.String("audit"),
+				ClusterType: kafkaTypes.ClusterTypeServerless,
+				Serverless:  &kafkaTypes.Serverless{VpcConfigs: []kafkaTypes.VpcConfig{{SubnetIds: []string{"subnet-c"}}}},
+			},
+		},
+	}, nil)
+	mockEC2.On("DescribeSubnets", mock.Anything, mock.MatchedBy(func(in *ec2.DescribeSubnetsInput) bool {
+		return len(in.SubnetIds) == 3
+	}), mock.Anything).Return(&ec2.DescribeSubnetsOutput{
+		Subnets: []ec2Types.Subnet{
+			{SubnetId: aws.String("subnet-a"), VpcId: aws.String("vpc-1")},
+			{SubnetId: aws.String("subnet-b"), VpcId: aws.String("vpc-1")},
+			{SubnetId: aws.String("subnet-c"), VpcId: aws.String("vpc-2")},
+		},
+	}, nil)
+
+	clusters, err := client.FetchMSKClusters(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, clusters, 2)
+
+	serverless := clusters[0]
+	assert.Equal(t, "audit", serverless.Name)
+	assert.Equal(t, "vpc-2", serverless.VPCID)
+	assert.True(t, serverless.TransitEncryption)
+
+	provisioned := clusters[1]
+	assert.Equal(t, "events", provisioned.Name)
+	assert.Equal(t, "3.6.0", provisioned.KafkaVersion)
+	assert.Equal(t, int32(3), provisioned.BrokerCount)
+	assert.Equal(t, "vpc-1", provisioned.VPCID)
+	assert.Equal(t, "TLS_PLAINTEXT", provisioned.ClientBrokerEncryption)
+	// Plaintext clients are still allowed
+	assert.False(t, provisioned.TransitEncryption)
+	assert.True(t, provisioned.AtRestEncryption)
+}
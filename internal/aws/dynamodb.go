@@ -0,0 +1,62 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	dynamodbTypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+
+	"aws-terminal-sdk-v1/internal/models"
+)
+
+// FetchDynamoDBTables gets the DynamoDB tables with their indexes, point-in-time
+// recovery, TTL and stream settings
+func (c *Client) FetchDynamoDBTables(ctx context.Context) ([]models.DynamoDBTableInfo, error) {
+	tables := make([]models.DynamoDBTableInfo, 0)
+	paginator := dynamodb.NewListTablesPaginator(c.dynamodbClient, &dynamodb.ListTablesInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list DynamoDB tables: %w", err)
+		}
+
+		for _, name := range output.TableNames {
+			table, err := c.fetchDynamoDBTable(ctx, name)
+			if err != nil {
+				// The table may have been deleted since it was listed
+				fmt.Printf("Warning: %v\n", err)
+				continue
+			}
+			tables = append(tables, table)
+		}
+	}
+
+	return tables, nil
+}
+
+func (c *Client) fetchDynamoDBTable(ctx context.Context, name string) (models.DynamoDBTableInfo, error) {
+	output, err := c.dynamodbClient.DescribeTable(ctx, &dynamodb.DescribeTableInput{TableName: aws.String(name)})
+	if err != nil {
+		return models.DynamoDBTableInfo{}, fmt.Errorf("failed to describe DynamoDB table %s: %w", name, err)
+	}
+	table := models.FromAWSDynamoDBTable(*output.Table)
+
+	backups, err := c.dynamodbClient.DescribeContinuousBackups(ctx, &dynamodb.DescribeContinuousBackupsInput{TableName: aws.String(name)})
+	if err != nil {
+		fmt.Printf("Warning: failed to describe continuous backups of %s: %v\n", name, err)
+	} else if desc := backups.ContinuousBackupsDescription; desc != nil && desc.PointInTimeRecoveryDescription != nil {
+		table.PITREnabled = desc.PointInTimeRecoveryDescription.PointInTimeRecoveryStatus == dynamodbTypes.PointInTimeRecoveryStatusEnabled
+	}
+
+	ttl, err := c.dynamodbClient.DescribeTimeToLive(ctx, &dynamodb.DescribeTimeToLiveInput{TableName: aws.String(name)})
+	if err != nil {
+		fmt.Printf("Warning: failed to describe TTL of %s: %v\n", name, err)
+	} else if desc := ttl.TimeToLiveDescription; desc != nil {
+		table.TTLStatus = string(desc.TimeToLiveStatus)
+		table.TTLAttribute = aws.ToString(desc.AttributeName)
+	}
+
+	return table, nil
+}
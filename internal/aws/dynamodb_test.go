@@ -0,0 +1,77 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	dynamodbTypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFetchDynamoDBTables(t *testing.T) {
+	mockDynamo := new(MockDynamoDBClient)
+	client := &Client{dynamodbClient: mockDynamo}
+
+	mockDynamo.On("ListTables", mock.Anything, mock.Anything, mock.Anything).Return(&dynamodb.ListTablesOutput{
+		TableNames: []string{"orders", "deleted"},
+	}, nil).Once()
+	mockDynamo.On("DescribeTable", mock.Anything, mock.MatchedBy(func(in *dynamodb.DescribeTableInput) bool {
+		return aws.ToString(in.TableName) == "orders"
+	}), mock.Anything).Return(&dynamodb.DescribeTableOutput{
+		Table: &dynamodbTypes.TableDescription{
+			TableName:          aws.String("orders"),
+			TableStatus:        dynamodbTypes.TableStatusActive,
+			BillingModeSummary: &dynamodbTypes.BillingModeSummary{BillingMode: dynamodbTypes.BillingModePayPerRequest},
+			ItemCount:          aws.Int64(1200),
+			TableSizeBytes:     aws.Int64(48000),
+			KeySchema: []dynamodbTypes.KeySchemaElement{
+				{AttributeName: aws.String("pk"), KeyType: dynamodbTypes.KeyTypeHash},
+				{AttributeName: aws.String("sk"), KeyType: dynamodbTypes.KeyTypeRange},
+			},
+			GlobalSecondaryIndexes: []dynamodbTypes.GlobalSecondaryIndexDescription{{
+				IndexName:  aws.String("by-customer"),
+				KeySchema:  []dynamodbTypes.KeySchemaElement{{AttributeName: aws.String("customer"), KeyType: dynamodbTypes.KeyTypeHash}},
+				Projection: &dynamodbTypes.Projection{ProjectionType: dynamodbTypes.ProjectionTypeAll},
+			}},
+			StreamSpecification: &dynamodbTypes.StreamSpecification{
+				StreamEnabled:  aws.Bool(true),
+				StreamViewType: dynamodbTypes.StreamViewTypeNewAndOldImages,
+			},
+		},
+	}, nil).Once()
+	mockDynamo.On("DescribeTable", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("ResourceNotFoundException")).Once()
+	mockDynamo.On("DescribeContinuousBackups", mock.Anything, mock.Anything, mock.Anything).Return(&dynamodb.DescribeContinuousBackupsOutput{
+		ContinuousBackupsDescription: &dynamodbTypes.ContinuousBackupsDescription{
+			PointInTimeRecoveryDescription: &dynamodbTypes.PointInTimeRecoveryDescription{
+				PointInTimeRecoveryStatus: dynamodbTypes.PointInTimeRecoveryStatusEnabled,
+			},
+		},
+	}, nil).Once()
+	mockDynamo.On("DescribeTimeToLive", mock.Anything, mock.Anything, mock.Anything).Return(&dynamodb.DescribeTimeToLiveOutput{
+		TimeToLiveDescription: &dynamodbTypes.TimeToLiveDescription{
+			TimeToLiveStatus: dynamodbTypes.TimeToLiveStatusEnabled,
+			AttributeName:    aws.String("expires_at"),
+		},
+	}, nil).Once()
+
+	tables, err := client.FetchDynamoDBTables(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, tables, 1)
+
+	table := tables[0]
+	assert.Equal(t, "PAY_PER_REQUEST", table.BillingMode)
+	assert.Equal(t, int64(1200), table.ItemCount)
+	assert.Equal(t, "pk", table.PartitionKey)
+	assert.Equal(t, "sk", table.SortKey)
+	assert.Len(t, table.GlobalIndexes, 1)
+	assert.Equal(t, "customer", table.GlobalIndexes[0].PartitionKey)
+	assert.True(t, table.PITREnabled)
+	assert.Equal(t, "ENABLED", table.TTLStatus)
+	assert.Equal(t, "expires_at", table.TTLAttribute)
+	assert.True(t, table.StreamEnabled)
+	assert.Equal(t, "NEW_AND_OLD_IMAGES", table.StreamViewType)
+}
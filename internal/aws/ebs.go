@@ -0,0 +1,158 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"aws-terminal-sdk-v1/internal/models"
+)
+
+// FetchEBSVolumes retrieves all EBS volumes with their attachments and estimated monthly cost
+func (c *Client) FetchEBSVolumes(ctx context.Context) ([]models.EBSVolumeInfo, error) {
+	volumes := make([]models.EBSVolumeInfo, 0)
+	paginator := ec2.NewDescribeVolumesPaginator(c.ec2Client, &ec2.DescribeVolumesInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe EBS volumes: %w", err)
+		}
+
+		for _, volume := range output.Volumes {
+			volumes = append(volumes, models.FromAWSVolume(volume))
+		}
+	}
+
+	return volumes, nil
+}
+
+// FetchEBSSnapshots retrieves the snapshots owned by the account and flags the ones
+// whose source volume or AMI no longer exists
+func (c *Client) FetchEBSSnapshots(ctx context.Context) ([]models.EBSSnapshotInfo, error) {
+	volumes, err := c.FetchEBSVolumes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.fetchEBSSnapshots(ctx, volumes)
+}
+
+func (c *Client) fetchEBSSnapshots(ctx context.Context, volumes []models.EBSVolumeInfo) ([]models.EBSSnapshotInfo, error) {
+	existingVolumes := make(map[string]bool, len(volumes))
+	for _, v := range volumes {
+		existingVolumes[v.ID] = true
+	}
+
+	existingImages, imagesBySnapshot, err := c.fetchOwnedImageSnapshots(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make([]models.EBSSnapshotInfo, 0)
+	paginator := ec2.NewDescribeSnapshotsPaginator(c.ec2Client, &ec2.DescribeSnapshotsInput{
+		OwnerIds: []string{"self"},
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe EBS snapshots: %w", err)
+		}
+
+		for _, snapshot := range output.Snapshots {
+			info := models.FromAWSSnapshot(snapshot)
+			info.ImageIDs = imagesBySnapshot[info.ID]
+			classifySnapshot(&info, existingVolumes, existingImages)
+			snapshots = append(snapshots, info)
+		}
+	}
+
+	return snapshots, nil
+}
+
+// fetchOwnedImageSnapshots lists the account's AMIs and the snapshots backing them
+func (c *Client) fetchOwnedImageSnapshots(ctx context.Context) (map[string]bool, map[string][]string, error) {
+	images := make(map[string]bool)
+	bySnapshot := make(map[string][]string)
+
+	paginator := ec2.NewDescribeImagesPaginator(c.ec2Client, &ec2.DescribeImagesInput{
+		Owners: []string{"self"},
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to describe images: %w", err)
+		}
+
+		for _, image := range output.Images {
+			imageID := safeString(image.ImageId)
+			images[imageID] = true
+			for _, bdm := range image.BlockDeviceMappings {
+				if bdm.Ebs != nil && bdm.Ebs.SnapshotId != nil {
+					bySnapshot[*bdm.Ebs.SnapshotId] = append(bySnapshot[*bdm.Ebs.SnapshotId], imageID)
+				}
+			}
+		}
+	}
+
+	return images, bySnapshot, nil
+}
+
+// classifySnapshot marks a snapshot orphaned when nothing it came from or backs still exists.
+// Snapshots backing a registered AMI are always kept.
+func classifySnapshot(snapshot *models.EBSSnapshotInfo, existingVolumes, existingImages map[string]bool) {
+	if len(snapshot.ImageIDs) > 0 {
+		return
+	}
+
+	// Copies, such as cross-region copies, keep the description of their source: neither
+	// the volume nor the AMI it names are in this region
+	if snapshot.Copy {
+		return
+	}
+
+	if imageID := snapshot.SourceImageID(); imageID != "" && !existingImages[imageID] {
+		snapshot.Orphaned = true
+		snapshot.OrphanReason = fmt.Sprintf("source AMI %s no longer exists", imageID)
+		return
+	}
+
+	if snapshot.VolumeID != "" && !existingVolumes[snapshot.VolumeID] {
+		snapshot.Orphaned = true
+		snapshot.OrphanReason = fmt.Sprintf("source volume %s no longer exists", snapshot.VolumeID)
+	}
+}
+
+// FetchEBSStorageReport gathers volumes and snapshots with unattached and orphaned
+// totals and their estimated monthly cost
+func (c *Client) FetchEBSStorageReport(ctx context.Context) (*models.EBSStorageReport, error) {
+	volumes, err := c.FetchEBSVolumes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots, err := c.fetchEBSSnapshots(ctx, volumes)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &models.EBSStorageReport{
+		Volumes:   volumes,
+		Snapshots: snapshots,
+	}
+	for _, v := range volumes {
+		report.VolumeMonthlyCost += v.MonthlyCost
+		if v.Unattached {
+			report.UnattachedVolumes++
+			report.UnattachedMonthlyCost += v.MonthlyCost
+		}
+	}
+	for _, s := range snapshots {
+		report.SnapshotMonthlyCost += s.MonthlyCost
+		if s.Orphaned {
+			report.OrphanedSnapshots++
+			report.OrphanedMonthlyCost += s.MonthlyCost
+		}
+	}
+
+	return report, nil
+}
@@ -0,0 +1,127 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2Types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFetchEBSVolumes(t *testing.T) {
+	mockEC2 := new(MockEC2Client)
+	client := &Client{ec2Client: mockEC2}
+
+	mockEC2.On("DescribeVolumes", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeVolumesOutput{
+		Volumes: []ec2Types.Volume{
+			{
+				VolumeId:   aws.String("vol-attached"),
+				Size:       aws.Int32(100),
+				VolumeType: ec2Types.VolumeTypeGp3,
+				Iops:       aws.Int32(4000),
+				Throughput: aws.Int32(125),
+				State:      ec2Types.VolumeStateInUse,
+				Encrypted:  aws.Bool(true),
+				Attachments: []ec2Types.VolumeAttachment{
+					{InstanceId: aws.String("i-123"), Device: aws.String("/dev/xvda"), State: ec2Types.VolumeAttachmentStateAttached},
+				},
+				Tags: []ec2Types.Tag{{Key: aws.String("Name"), Value: aws.String("web-root")}},
+			},
+			{
+				VolumeId:   aws.String("vol-free"),
+				Size:       aws.Int32(50),
+				VolumeType: ec2Types.VolumeTypeGp2,
+				State:      ec2Types.VolumeStateAvailable,
+			},
+		},
+	}, nil).Once()
+
+	volumes, err := client.FetchEBSVolumes(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, volumes, 2)
+	assert.Equal(t, "web-root", volumes[0].Name)
+	assert.Equal(t, "i-123", volumes[0].Attachments[0].InstanceID)
+	assert.False(t, volumes[0].Unattached)
+	// 100 GiB x 0.08 + 1000 IOPS above baseline x 0.005
+	assert.InDelta(t, 13.0, volumes[0].MonthlyCost, 0.001)
+	assert.True(t, volumes[1].Unattached)
+	assert.InDelta(t, 5.0, volumes[1].MonthlyCost, 0.001)
+
+	// Error case
+	mockEC2.On("DescribeVolumes", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("AccessDenied")).Once()
+
+	volumes, err = client.FetchEBSVolumes(context.Background())
+	assert.Error(t, err)
+	assert.Nil(t, volumes)
+}
+
+func TestFetchEBSStorageReport(t *testing.T) {
+	mockEC2 := new(MockEC2Client)
+	client := &Client{ec2Client: mockEC2}
+
+	mockEC2.On("DescribeVolumes", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeVolumesOutput{
+		Volumes: []ec2Types.Volume{
+			{VolumeId: aws.String("vol-live"), Size: aws.Int32(20), VolumeType: ec2Types.VolumeTypeGp2, State: ec2Types.VolumeStateAvailable},
+		},
+	}, nil)
+	mockEC2.On("DescribeImages", mock.Anything, mock.MatchedBy(func(in *ec2.DescribeImagesInput) bool {
+		return len(in.Owners) == 1 && in.Owners[0] == "self"
+	}), mock.Anything).Return(&ec2.DescribeImagesOutput{
+		Images: []ec2Types.Image{
+			{
+				ImageId: aws.String("ami-live"),
+				BlockDeviceMappings: []ec2Types.BlockDeviceMapping{
+					{Ebs: &ec2Types.EbsBlockDevice{SnapshotId: aws.String("snap-ami")}},
+				},
+			},
+		},
+	}, nil)
+	mockEC2.On("DescribeSnapshots", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeSnapshotsOutput{
+		Snapshots: []ec2Types.Snapshot{
+			// Backs a registered AMI even though its volume is gone
+			{SnapshotId: aws.String("snap-ami"), VolumeId: aws.String("vol-gone"), VolumeSize: aws.Int32(8)},
+			{SnapshotId: aws.String("snap-ok"), VolumeId: aws.String("vol-live"), VolumeSize: aws.Int32(20)},
+			{SnapshotId: aws.String("snap-novol"), VolumeId: aws.String("vol-gone"), VolumeSize: aws.Int32(10)},
+			{
+				SnapshotId:  aws.String("snap-noami"),
+				VolumeId:    aws.String("vol-live"),
+				VolumeSize:  aws.Int32(30),
+				Description: aws.String("Created by CreateImage(i-0abc) for ami-0deadbeef"),
+				StorageTier: ec2Types.StorageTierArchive,
+			},
+			// Cross-region copy
+			{SnapshotId: aws.String("snap-copy"), VolumeId: aws.String("vol-ffffffff"), VolumeSize: aws.Int32(50)},
+			{
+				SnapshotId:  aws.String("snap-amicopy"),
+				VolumeId:    aws.String("vol-ffffffff"),
+				VolumeSize:  aws.Int32(8),
+				Description: aws.String("[Copied snap-0abc from us-east-1] Created by CreateImage(i-0abc) for ami-0f00ba7"),
+			},
+		},
+	}, nil)
+
+	report, err := client.FetchEBSStorageReport(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, report.Snapshots, 6)
+	assert.Equal(t, []string{"ami-live"}, report.Snapshots[0].ImageIDs)
+	assert.False(t, report.Snapshots[0].Orphaned)
+	assert.False(t, report.Snapshots[1].Orphaned)
+	assert.True(t, report.Snapshots[2].Orphaned)
+	assert.Contains(t, report.Snapshots[2].OrphanReason, "vol-gone")
+	assert.True(t, report.Snapshots[3].Orphaned)
+	assert.Contains(t, report.Snapshots[3].OrphanReason, "ami-0deadbeef")
+	assert.True(t, report.Snapshots[4].Copy)
+	assert.False(t, report.Snapshots[4].Orphaned)
+	assert.True(t, report.Snapshots[5].Copy)
+	assert.False(t, report.Snapshots[5].Orphaned)
+
+	assert.Equal(t, 1, report.UnattachedVolumes)
+	assert.InDelta(t, 2.0, report.UnattachedMonthlyCost, 0.001)
+	assert.Equal(t, 2, report.OrphanedSnapshots)
+	// 10 GiB standard + 30 GiB archive
+	assert.InDelta(t, 0.5+0.375, report.OrphanedMonthlyCost, 0.001)
+}
@@ -0,0 +1,50 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"aws-terminal-sdk-v1/internal/models"
+)
+
+// FetchInstanceStatuses gets the status checks and scheduled events of the given
+// instances, or of every instance when no ID is given. Stopped instances are
+// included with their state only.
+func (c *Client) FetchInstanceStatuses(ctx context.Context, instanceIDs ...string) ([]models.InstanceStatusInfo, error) {
+	input := &ec2.DescribeInstanceStatusInput{
+		IncludeAllInstances: aws.Bool(true),
+	}
+	if len(instanceIDs) > 0 {
+		input.InstanceIds = instanceIDs
+	}
+
+	statuses := make([]models.InstanceStatusInfo, 0)
+	paginator := ec2.NewDescribeInstanceStatusPaginator(c.ec2Client, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe instance status: %w", err)
+		}
+
+		for _, status := range output.InstanceStatuses {
+			statuses = append(statuses, models.FromAWSInstanceStatus(status))
+		}
+	}
+
+	return statuses, nil
+}
+
+// FetchInstanceStatus gets the status checks and scheduled events of a single instance
+func (c *Client) FetchInstanceStatus(ctx context.Context, instanceID string) (*models.InstanceStatusInfo, error) {
+	statuses, err := c.FetchInstanceStatuses(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	if len(statuses) == 0 {
+		return nil, fmt.Errorf("no status found for instance %s", instanceID)
+	}
+	return &statuses[0], nil
+}
@@ -0,0 +1,124 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2Types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFetchEC2Instances_Detail(t *testing.T) {
+	mockEC2 := new(MockEC2Client)
+	client := &Client{ec2Client: mockEC2}
+
+	mockEC2.On("DescribeInstances", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeInstancesOutput{
+		Reservations: []ec2Types.Reservation{{
+			Instances: []ec2Types.Instance{{
+				InstanceId:        aws.String("i-1"),
+				InstanceType:      ec2Types.InstanceTypeM5Large,
+				State:             &ec2Types.InstanceState{Name: ec2Types.InstanceStateNameRunning},
+				LaunchTime:        aws.Time(time.Now()),
+				ImageId:           aws.String("ami-123"),
+				InstanceLifecycle: ec2Types.InstanceLifecycleTypeSpot,
+				SecurityGroups: []ec2Types.GroupIdentifier{
+					{GroupId: aws.String("sg-1"), GroupName: aws.String("web")},
+				},
+				IamInstanceProfile: &ec2Types.IamInstanceProfile{Arn: aws.String("arn:aws:iam::123456789012:instance-profile/web")},
+				MetadataOptions: &ec2Types.InstanceMetadataOptionsResponse{
+					HttpTokens:              ec2Types.HttpTokensStateRequired,
+					HttpEndpoint:            ec2Types.InstanceMetadataEndpointStateEnabled,
+					HttpPutResponseHopLimit: aws.Int32(2),
+				},
+				Monitoring: &ec2Types.Monitoring{State: ec2Types.MonitoringStateEnabled},
+				Placement:  &ec2Types.Placement{AvailabilityZone: aws.String("us-east-1a"), Tenancy: ec2Types.TenancyDefault},
+				BlockDeviceMappings: []ec2Types.InstanceBlockDeviceMapping{{
+					DeviceName: aws.String("/dev/xvda"),
+					Ebs: &ec2Types.EbsInstanceBlockDevice{
+						VolumeId:            aws.String("vol-1"),
+						Status:              ec2Types.AttachmentStatusAttached,
+						DeleteOnTermination: aws.Bool(true),
+					},
+				}},
+				Tags: []ec2Types.Tag{
+					{Key: aws.String("Name"), Value: aws.String("web-1")},
+					{Key: aws.String("team"), Value: aws.String("platform")},
+				},
+			}},
+		}},
+	}, nil).Once()
+
+	instances, err := client.FetchEC2Instances(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, instances, 1)
+
+	instance := instances[0]
+	assert.Equal(t, []string{"web"}, instance.SecurityGroups)
+	assert.Equal(t, []string{"sg-1"}, instance.SecurityGroupIDs)
+	assert.Equal(t, "ami-123", instance.ImageID)
+	assert.Equal(t, "spot", instance.Lifecycle)
+	assert.Equal(t, "arn:aws:iam::123456789012:instance-profile/web", instance.IAMInstanceProfile)
+	assert.True(t, instance.IMDSv2Required)
+	assert.True(t, instance.IMDSEnabled)
+	assert.Equal(t, int32(2), instance.IMDSHopLimit)
+	assert.Equal(t, "enabled", instance.Monitoring)
+	assert.Equal(t, "us-east-1a", instance.AvailabilityZone)
+	assert.Equal(t, "platform", instance.Tags["team"])
+	assert.Len(t, instance.BlockDevices, 1)
+	assert.Equal(t, "vol-1", instance.BlockDevices[0].VolumeID)
+	assert.True(t, instance.BlockDevices[0].DeleteOnTermination)
+}
+
+func TestFetchInstanceStatuses(t *testing.T) {
+	mockEC2 := new(MockEC2Client)
+	client := &Client{ec2Client: mockEC2}
+
+	// Test Case: Success
+	mockEC2.On("DescribeInstanceStatus", mock.Anything, mock.MatchedBy(func(in *ec2.DescribeInstanceStatusInput) bool {
+		return aws.ToBool(in.IncludeAllInstances) && len(in.InstanceIds) == 1 && in.InstanceIds[0] == "i-1"
+	}), mock.Anything).Return(&ec2.DescribeInstanceStatusOutput{
+		InstanceStatuses: []ec2Types.InstanceStatus{{
+			InstanceId:       aws.String("i-1"),
+			AvailabilityZone: aws.String("us-east-1a"),
+			InstanceState:    &ec2Types.InstanceState{Name: ec2Types.InstanceStateNameRunning},
+			SystemStatus: &ec2Types.InstanceStatusSummary{
+				Status: ec2Types.SummaryStatusImpaired,
+				Details: []ec2Types.InstanceStatusDetails{{
+					Name:          ec2Types.StatusNameReachability,
+					Status:        ec2Types.StatusTypeFailed,
+					ImpairedSince: aws.Time(time.Now()),
+				}},
+			},
+			InstanceStatus: &ec2Types.InstanceStatusSummary{Status: ec2Types.SummaryStatusOk},
+			Events: []ec2Types.InstanceStatusEvent{{
+				InstanceEventId: aws.String("instance-event-1"),
+				Code:            ec2Types.EventCodeInstanceRetirement,
+				Description:     aws.String("The instance is running on degraded hardware"),
+				NotBefore:       aws.Time(time.Now().Add(48 * time.Hour)),
+			}},
+		}},
+	}, nil).Once()
+
+	status, err := client.FetchInstanceStatus(context.Background(), "i-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "running", status.State)
+	assert.Equal(t, "impaired", status.SystemStatus)
+	assert.Equal(t, "ok", status.InstanceStatus)
+	assert.Len(t, status.SystemChecks, 1)
+	assert.Equal(t, "failed", status.SystemChecks[0].Status)
+	assert.NotEmpty(t, status.SystemChecks[0].ImpairedSince)
+	assert.Len(t, status.ScheduledEvents, 1)
+	assert.Equal(t, "instance-retirement", status.ScheduledEvents[0].Code)
+
+	// Test Case: Error
+	mockEC2.On("DescribeInstanceStatus", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("api error")).Once()
+
+	statuses, err := client.FetchInstanceStatuses(context.Background())
+	assert.Error(t, err)
+	assert.Nil(t, statuses)
+}
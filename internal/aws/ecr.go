@@ -0,0 +1,230 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	ecrTypes "github.com/aws/aws-sdk-go-v2/service/ecr/types"
+
+	"aws-terminal-sdk-v1/internal/models"
+)
+
+// FetchECRRepositories gets the ECR repositories with their image count, size,
+// scan settings, lifecycle policy presence and summed scan severities
+func (c *Client) FetchECRRepositories(ctx context.Context) ([]models.ECRRepositoryInfo, error) {
+	repositories := make([]models.ECRRepositoryInfo, 0)
+	paginator := ecr.NewDescribeRepositoriesPaginator(c.ecrClient, &ecr.DescribeRepositoriesInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe ECR repositories: %w", err)
+		}
+
+		for _, raw := range output.Repositories {
+			repo := models.FromAWSECRRepository(raw)
+
+			images, err := c.FetchECRImages(ctx, repo.Name)
+			if err != nil {
+				fmt.Printf("Warning: %v\n", err)
+			}
+			for _, image := range images {
+				repo.AddImage(image)
+			}
+
+			_, err = c.ecrClient.GetLifecyclePolicy(ctx, &ecr.GetLifecyclePolicyInput{RepositoryName: raw.RepositoryName})
+			var notFound *ecrTypes.LifecyclePolicyNotFoundException
+			switch {
+			case err == nil:
+				repo.LifecyclePolicy = true
+			case !errors.As(err, &notFound):
+				fmt.Printf("Warning: failed to get lifecycle policy of %s: %v\n", repo.Name, err)
+			}
+
+			repositories = append(repositories, repo)
+		}
+	}
+
+	return repositories, nil
+}
+
+// FetchECRImages gets the images of a repository, most recently pushed first
+func (c *Client) FetchECRImages(ctx context.Context, repository string) ([]models.ECRImageInfo, error) {
+	images := make([]models.ECRImageInfo, 0)
+	paginator := ecr.NewDescribeImagesPaginator(c.ecrClient, &ecr.DescribeImagesInput{
+		RepositoryName: aws.String(repository),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe images of %s: %w", repository, err)
+		}
+
+		for _, image := range output.ImageDetails {
+			images = append(images, models.FromAWSECRImage(image))
+		}
+	}
+
+	sort.SliceStable(images, func(i, j int) bool {
+		return images[i].PushedAt > images[j].PushedAt
+	})
+	return images, nil
+}
+
+// ecrSeverityRank orders scan findings from the most to the least severe
+var ecrSeverityRank = map[string]int{
+	"CRITICAL":      0,
+	"HIGH":          1,
+	"MEDIUM":        2,
+	"LOW":           3,
+	"INFORMATIONAL": 4,
+	"UNTRIAGED":     5,
+	"UNDEFINED":     5,
+}
+
+// FetchECRImageScanFindings gets the vulnerabilities found by the last scan of an image,
+// most severe first. Both basic and enhanced scanning results are returned.
+func (c *Client) FetchECRImageScanFindings(ctx context.Context, repository, digest string) ([]models.ECRScanFinding, error) {
+	findings := make([]models.ECRScanFinding, 0)
+	paginator := ecr.NewDescribeImageScanFindingsPaginator(c.ecrClient, &ecr.DescribeImageScanFindingsInput{
+		RepositoryName: aws.String(repository),
+		ImageId:        &ecrTypes.ImageIdentifier{ImageDigest: aws.String(digest)},
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe scan findings of %s@%s: %w", repository, digest, err)
+		}
+		if output.ImageScanFindings == nil {
+			continue
+		}
+
+		for _, finding := range output.ImageScanFindings.Findings {
+			findings = append(findings, models.FromAWSECRScanFinding(finding))
+		}
+		for _, finding := range output.ImageScanFindings.EnhancedFindings {
+			findings = append(findings, models.FromAWSECREnhancedFinding(finding))
+		}
+	}
+
+	sort.SliceStable(findings, func(i, j int) bool {
+		return ecrSeverityRank[findings[i].Severity] < ecrSeverityRank[findings[j].Severity]
+	})
+	return findings, nil
+}
+
+// FetchECRImageUsage cross-references the container images of the ECS services with
+// the ECR images they run, so that services running vulnerable images can be spotted.
+// Images outside ECR or in another region are skipped.
+func (c *Client) FetchECRImageUsage(ctx context.Context) (*models.ECRImageUsageReport, error) {
+	clusters, err := c.FetchECSClusters(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &models.ECRImageUsageReport{
+		Usages: make([]models.ECRImageUsage, 0),
+		Errors: make([]string, 0),
+	}
+	taskDefinitions := make(map[string]*models.ECSTaskDefinitionInfo)
+	images := make(map[string]*models.ECRImageInfo)
+	failed := make(map[string]bool)
+
+	for _, cluster := range clusters {
+		services, err := c.FetchECSServices(ctx, cluster.ClusterArn)
+		if err != nil {
+			report.Errors = append(report.Errors, fmt.Sprintf("%s: %v", cluster.ClusterName, err))
+			continue
+		}
+
+		for _, service := range services {
+			td, ok := taskDefinitions[service.TaskDefinition]
+			if !ok {
+				td, err = c.FetchECSTaskDefinition(ctx, service.TaskDefinition)
+				if err != nil {
+					report.Errors = append(report.Errors, fmt.Sprintf("%s/%s: %v", cluster.ClusterName, service.ServiceName, err))
+				}
+				taskDefinitions[service.TaskDefinition] = td
+			}
+			if td == nil {
+				continue
+			}
+
+			for _, container := range td.Containers {
+				registry, region, repository, tag, digest, ok := models.ParseECRImage(container.Image)
+				if !ok || (c.region != "" && region != c.region) {
+					continue
+				}
+
+				usage := models.ECRImageUsage{
+					Cluster:        cluster.ClusterName,
+					Service:        service.ServiceName,
+					TaskDefinition: td.Arn,
+					Container:      container.Name,
+					Image:          container.Image,
+					RegistryID:     registry,
+					Repository:     repository,
+					Tag:            tag,
+					Digest:         digest,
+					SeverityCounts: make(map[string]int32),
+				}
+
+				image, seen := images[container.Image]
+				if !seen {
+					image, err = c.describeECRImage(ctx, registry, repository, tag, digest)
+					if err != nil {
+						report.Errors = append(report.Errors, err.Error())
+						failed[container.Image] = true
+					}
+					images[container.Image] = image
+				}
+
+				switch {
+				case image != nil:
+					usage.Digest = image.Digest
+					usage.ScanStatus = image.ScanStatus
+					usage.SeverityCounts = image.SeverityCounts
+				case !failed[container.Image]:
+					usage.NotFound = true
+				}
+				report.Usages = append(report.Usages, usage)
+			}
+		}
+	}
+
+	return report, nil
+}
+
+// describeECRImage looks an image up by tag or digest. A missing image or repository
+// returns nil without error.
+func (c *Client) describeECRImage(ctx context.Context, registry, repository, tag, digest string) (*models.ECRImageInfo, error) {
+	id := ecrTypes.ImageIdentifier{}
+	if digest != "" {
+		id.ImageDigest = aws.String(digest)
+	} else {
+		id.ImageTag = aws.String(tag)
+	}
+
+	output, err := c.ecrClient.DescribeImages(ctx, &ecr.DescribeImagesInput{
+		RegistryId:     aws.String(registry),
+		RepositoryName: aws.String(repository),
+		ImageIds:       []ecrTypes.ImageIdentifier{id},
+	})
+	var imageNotFound *ecrTypes.ImageNotFoundException
+	var repoNotFound *ecrTypes.RepositoryNotFoundException
+	if errors.As(err, &imageNotFound) || errors.As(err, &repoNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe image %s: %w", repository, err)
+	}
+	if len(output.ImageDetails) == 0 {
+		return nil, nil
+	}
+
+	image := models.FromAWSECRImage(output.ImageDetails[0])
+	return &image, nil
+}
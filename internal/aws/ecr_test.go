@@ -0,0 +1,192 @@
+package aws
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	ecrTypes "github.com/aws/aws-sdk-go-v2/service/ecr/types"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	ecsTypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"aws-terminal-sdk-v1/internal/models"
+)
+
+func TestFetchECRRepositories(t *testing.T) {
+	mockECR := new(MockECRClient)
+	client := &Client{ecrClient: mockECR}
+
+	mockECR.On("DescribeRepositories", mock.Anything, mock.Anything, mock.Anything).Return(&ecr.DescribeRepositoriesOutput{
+		Repositories: []ecrTypes.Repository{
+			{
+				RepositoryName:             aws.String("api"),
+				ImageTagMutability:         ecrTypes.ImageTagMutabilityImmutable,
+				ImageScanningConfiguration: &ecrTypes.ImageScanningConfiguration{ScanOnPush: true},
+			},
+			{RepositoryName: aws.String("worker"), ImageTagMutability: ecrTypes.ImageTagMutabilityMutable},
+		},
+	}, nil).Once()
+
+	older := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	newer := older.Add(48 * time.Hour)
+	mockECR.On("DescribeImages", mock.Anything, mock.MatchedBy(func(in *ecr.DescribeImagesInput) bool {
+		return aws.ToString(in.RepositoryName) == "api"
+	}), mock.Anything).Return(&ecr.DescribeImagesOutput{
+		ImageDetails: []ecrTypes.ImageDetail{
+			{
+				ImageDigest: aws.String("sha256:aaa"), ImagePushedAt: &older, ImageSizeInBytes: aws.Int64(100),
+				ImageScanFindingsSummary: &ecrTypes.ImageScanFindingsSummary{FindingSeverityCounts: map[string]int32{"HIGH": 2}},
+			},
+			{
+				ImageDigest: aws.String("sha256:bbb"), ImageTags: []string{"v2"}, ImagePushedAt: &newer, ImageSizeInBytes: aws.Int64(150),
+				ImageScanStatus:          &ecrTypes.ImageScanStatus{Status: ecrTypes.ScanStatusComplete},
+				ImageScanFindingsSummary: &ecrTypes.ImageScanFindingsSummary{FindingSeverityCounts: map[string]int32{"CRITICAL": 1, "HIGH": 1}},
+			},
+		},
+	}, nil).Once()
+	mockECR.On("DescribeImages", mock.Anything, mock.Anything, mock.Anything).Return(&ecr.DescribeImagesOutput{}, nil).Once()
+	mockECR.On("GetLifecyclePolicy", mock.Anything, mock.MatchedBy(func(in *ecr.GetLifecyclePolicyInput) bool {
+		return aws.ToString(in.RepositoryName) == "api"
+	}), mock.Anything).Return(&ecr.GetLifecyclePolicyOutput{}, nil).Once()
+	mockECR.On("GetLifecyclePolicy", mock.Anything, mock.Anything, mock.Anything).Return(nil, &ecrTypes.LifecyclePolicyNotFoundException{}).Once()
+
+	repos, err := client.FetchECRRepositories(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, repos, 2)
+
+	api := repos[0]
+	assert.True(t, api.TagImmutability)
+	assert.True(t, api.ScanOnPush)
+	assert.True(t, api.LifecyclePolicy)
+	assert.Equal(t, 2, api.ImageCount)
+	assert.Equal(t, int64(250), api.TotalSizeBytes)
+	assert.Equal(t, "2025-01-03 00:00:00", api.LastPushedAt)
+	assert.Equal(t, int32(3), api.SeverityCounts["HIGH"])
+	assert.Equal(t, int32(1), api.SeverityCounts["CRITICAL"])
+
+	assert.False(t, repos[1].LifecyclePolicy)
+	assert.Equal(t, 0, repos[1].ImageCount)
+}
+
+func TestParseECRImage(t *testing.T) {
+	registry, region, repo, tag, digest, ok := models.ParseECRImage("123456789012.dkr.ecr.eu-west-1.amazonaws.com/team/api:v2")
+	assert.True(t, ok)
+	assert.Equal(t, "123456789012", registry)
+	assert.Equal(t, "eu-west-1", region)
+	assert.Equal(t, "team/api", repo)
+	assert.Equal(t, "v2", tag)
+	assert.Empty(t, digest)
+
+	_, _, _, tag, digest, ok = models.ParseECRImage("123456789012.dkr.ecr.us-east-1.amazonaws.com/api@sha256:abc123")
+	assert.True(t, ok)
+	assert.Empty(t, tag)
+	assert.Equal(t, "sha256:abc123", digest)
+
+	_, _, _, tag, _, ok = models.ParseECRImage("123456789012.dkr.ecr.us-east-1.amazonaws.com/api")
+	assert.True(t, ok)
+	assert.Equal(t, "latest", tag)
+
+	_, _, _, _, _, ok = models.ParseECRImage("nginx:1.25")
+	assert.False(t, ok)
+}
+
+func TestFetchECRImageUsage(t *testing.T) {
+	mockECS := new(MockECSClient)
+	mockECR := new(MockECRClient)
+	client := &Client{ecsClient: mockECS, ecrClient: mockECR, region: "us-east-1"}
+
+	mockECS.On("ListClusters", mock.Anything, mock.Anything, mock.Anything).Return(&ecs.ListClustersOutput{
+		ClusterArns: []string{"arn:aws:ecs:us-east-1:123456789012:cluster/prod"},
+	}, nil).Once()
+	mockECS.On("DescribeClusters", mock.Anything, mock.Anything, mock.Anything).Return(&ecs.DescribeClustersOutput{
+		Clusters: []ecsTypes.Cluster{{
+			ClusterName: aws.String("prod"),
+			ClusterArn:  aws.String("arn:aws:ecs:us-east-1:123456789012:cluster/prod"),
+		}},
+	}, nil).Once()
+	mockECS.On("ListServices", mock.Anything, mock.Anything, mock.Anything).Return(&ecs.ListServicesOutput{
+		ServiceArns: []string{"svc-api", "svc-web"},
+	}, nil).Once()
+	mockECS.On("DescribeServices", mock.Anything, mock.Anything, mock.Anything).Return(&ecs.DescribeServicesOutput{
+		Services: []ecsTypes.Service{
+			{ServiceName: aws.String("api"), TaskDefinition: aws.String("api:7")},
+			{ServiceName: aws.String("web"), TaskDefinition: aws.String("web:3")},
+		},
+	}, nil).Once()
+	mockECS.On("DescribeTaskDefinition", mock.Anything, mock.MatchedBy(func(in *ecs.DescribeTaskDefinitionInput) bool {
+		return aws.ToString(in.TaskDefinition) == "api:7"
+	}), mock.Anything).Return(&ecs.DescribeTaskDefinitionOutput{
+		TaskDefinition: &ecsTypes.TaskDefinition{
+			TaskDefinitionArn: aws.String("arn:aws:ecs:us-east-1:123456789012:task-definition/api:7"),
+			ContainerDefinitions: []ecsTypes.ContainerDefinition{
+				{Name: aws.String("app"), Image: aws.String("123456789012.dkr.ecr.us-east-1.amazonaws.com/api:v2")},
+				{Name: aws.String("proxy"), Image: aws.String("envoyproxy/envoy:v1.29")},
+			},
+		},
+	}, nil).Once()
+	mockECS.On("DescribeTaskDefinition", mock.Anything, mock.Anything, mock.Anything).Return(&ecs.DescribeTaskDefinitionOutput{
+		TaskDefinition: &ecsTypes.TaskDefinition{
+			TaskDefinitionArn: aws.String("arn:aws:ecs:us-east-1:123456789012:task-definition/web:3"),
+			ContainerDefinitions: []ecsTypes.ContainerDefinition{
+				{Name: aws.String("app"), Image: aws.String("123456789012.dkr.ecr.us-east-1.amazonaws.com/web:old")},
+			},
+		},
+	}, nil).Once()
+
+	mockECR.On("DescribeImages", mock.Anything, mock.MatchedBy(func(in *ecr.DescribeImagesInput) bool {
+		return aws.ToString(in.RepositoryName) == "api" && aws.ToString(in.ImageIds[0].ImageTag) == "v2"
+	}), mock.Anything).Return(&ecr.DescribeImagesOutput{
+		ImageDetails: []ecrTypes.ImageDetail{{
+			ImageDigest:              aws.String("sha256:bbb"),
+			ImageScanStatus:          &ecrTypes.ImageScanStatus{Status: ecrTypes.ScanStatusComplete},
+			ImageScanFindingsSummary: &ecrTypes.ImageScanFindingsSummary{FindingSeverityCounts: map[string]int32{"CRITICAL": 2}},
+		}},
+	}, nil).Once()
+	mockECR.On("DescribeImages", mock.Anything, mock.Anything, mock.Anything).Return(nil, &ecrTypes.ImageNotFoundException{}).Once()
+
+	report, err := client.FetchECRImageUsage(context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, report.Errors)
+	// The Docker Hub image is not cross-referenced
+	assert.Len(t, report.Usages, 2)
+
+	api := report.Usages[0]
+	assert.Equal(t, "prod", api.Cluster)
+	assert.Equal(t, "api", api.Service)
+	assert.Equal(t, "sha256:bbb", api.Digest)
+	assert.Equal(t, int32(2), api.SeverityCounts["CRITICAL"])
+	assert.False(t, api.NotFound)
+
+	assert.Equal(t, "web", report.Usages[1].Service)
+	assert.True(t, report.Usages[1].NotFound)
+}
+
+func TestFetchECRImageScanFindings(t *testing.T) {
+	mockECR := new(MockECRClient)
+	client := &Client{ecrClient: mockECR}
+
+	mockECR.On("DescribeImageScanFindings", mock.Anything, mock.Anything, mock.Anything).Return(&ecr.DescribeImageScanFindingsOutput{
+		ImageScanFindings: &ecrTypes.ImageScanFindings{
+			Findings: []ecrTypes.ImageScanFinding{
+				{Name: aws.String("CVE-2024-0001"), Severity: ecrTypes.FindingSeverityLow},
+				{
+					Name: aws.String("CVE-2024-0002"), Severity: ecrTypes.FindingSeverityCritical,
+					Attributes: []ecrTypes.Attribute{
+						{Key: aws.String("package_name"), Value: aws.String("openssl")},
+						{Key: aws.String("package_version"), Value: aws.String("3.0.1")},
+					},
+				},
+			},
+		},
+	}, nil).Once()
+
+	findings, err := client.FetchECRImageScanFindings(context.Background(), "api", "sha256:bbb")
+	assert.NoError(t, err)
+	assert.Len(t, findings, 2)
+	assert.Equal(t, "CVE-2024-0002", findings[0].Name)
+	assert.Equal(t, "openssl 3.0.1", findings[0].Package)
+}
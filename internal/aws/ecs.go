@@ -0,0 +1,117 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	ecsTypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+
+	"aws-terminal-sdk-v1/internal/models"
+)
+
+// ECS API batch limits
+const (
+	ecsDescribeServicesBatchSize = 10
+	ecsDescribeTasksBatchSize    = 100
+)
+
+// FetchECSServices retrieves all services of an ECS cluster
+func (c *Client) FetchECSServices(ctx context.Context, cluster string) ([]models.ECSServiceInfo, error) {
+	var serviceArns []string
+	paginator := ecs.NewListServicesPaginator(c.ecsClient, &ecs.ListServicesInput{
+		Cluster: aws.String(cluster),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list ECS services: %w", err)
+		}
+		serviceArns = append(serviceArns, output.ServiceArns...)
+	}
+
+	services := make([]models.ECSServiceInfo, 0, len(serviceArns))
+	for _, batch := range chunkStrings(serviceArns, ecsDescribeServicesBatchSize) {
+		output, err := c.ecsClient.DescribeServices(ctx, &ecs.DescribeServicesInput{
+			Cluster:  aws.String(cluster),
+			Services: batch,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe ECS services: %w", err)
+		}
+		for _, service := range output.Services {
+			services = append(services, models.FromAWSECSService(service))
+		}
+	}
+
+	return services, nil
+}
+
+// FetchECSTasks retrieves the running and recently stopped tasks of an ECS service.
+// An empty service name returns the tasks of the whole cluster.
+func (c *Client) FetchECSTasks(ctx context.Context, cluster, service string) ([]models.ECSTaskInfo, error) {
+	var taskArns []string
+	// Stopped tasks are kept by ECS for a short while, they carry the stopped reason
+	for _, status := range []ecsTypes.DesiredStatus{ecsTypes.DesiredStatusRunning, ecsTypes.DesiredStatusStopped} {
+		input := &ecs.ListTasksInput{
+			Cluster:       aws.String(cluster),
+			DesiredStatus: status,
+		}
+		if service != "" {
+			input.ServiceName = aws.String(service)
+		}
+
+		paginator := ecs.NewListTasksPaginator(c.ecsClient, input)
+		for paginator.HasMorePages() {
+			output, err := paginator.NextPage(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list ECS tasks: %w", err)
+			}
+			taskArns = append(taskArns, output.TaskArns...)
+		}
+	}
+
+	tasks := make([]models.ECSTaskInfo, 0, len(taskArns))
+	for _, batch := range chunkStrings(taskArns, ecsDescribeTasksBatchSize) {
+		output, err := c.ecsClient.DescribeTasks(ctx, &ecs.DescribeTasksInput{
+			Cluster: aws.String(cluster),
+			Tasks:   batch,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe ECS tasks: %w", err)
+		}
+		for _, task := range output.Tasks {
+			tasks = append(tasks, models.FromAWSECSTask(task))
+		}
+	}
+
+	return tasks, nil
+}
+
+// FetchECSTaskDefinition retrieves a task definition by family, family:revision or ARN
+func (c *Client) FetchECSTaskDefinition(ctx context.Context, taskDefinition string) (*models.ECSTaskDefinitionInfo, error) {
+	output, err := c.ecsClient.DescribeTaskDefinition(ctx, &ecs.DescribeTaskDefinitionInput{
+		TaskDefinition: aws.String(taskDefinition),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe ECS task definition: %w", err)
+	}
+
+	if output.TaskDefinition == nil {
+		return nil, fmt.Errorf("task definition %s not found", taskDefinition)
+	}
+
+	td := models.FromAWSECSTaskDefinition(*output.TaskDefinition)
+	return &td, nil
+}
+
+// chunkStrings splits a slice into batches of at most size elements
+func chunkStrings(items []string, size int) [][]string {
+	var chunks [][]string
+	for start := 0; start < len(items); start += size {
+		end := min(start+size, len(items))
+		chunks = append(chunks, items[start:end])
+	}
+	return chunks
+}
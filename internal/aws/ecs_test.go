@@ -0,0 +1,155 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	ecsTypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFetchECSServices(t *testing.T) {
+	mockECS := new(MockECSClient)
+	client := &Client{ecsClient: mockECS}
+
+	// 12 services forces two DescribeServices batches (limit 10)
+	arns := make([]string, 12)
+	for i := range arns {
+		arns[i] = "arn:aws:ecs:us-east-1:123456789012:service/test-cluster/svc"
+	}
+	mockECS.On("ListServices", mock.Anything, mock.Anything, mock.Anything).Return(&ecs.ListServicesOutput{
+		ServiceArns: arns,
+	}, nil).Once()
+
+	mockECS.On("DescribeServices", mock.Anything, mock.MatchedBy(func(in *ecs.DescribeServicesInput) bool {
+		return len(in.Services) == 10
+	}), mock.Anything).Return(&ecs.DescribeServicesOutput{
+		Services: []ecsTypes.Service{
+			{
+				ServiceName:    aws.String("api"),
+				Status:         aws.String("ACTIVE"),
+				DesiredCount:   3,
+				RunningCount:   2,
+				PendingCount:   1,
+				LaunchType:     ecsTypes.LaunchTypeFargate,
+				TaskDefinition: aws.String("arn:aws:ecs:us-east-1:123456789012:task-definition/api:7"),
+				Deployments: []ecsTypes.Deployment{
+					{Id: aws.String("ecs-svc/1"), Status: aws.String("PRIMARY"), RolloutState: ecsTypes.DeploymentRolloutStateInProgress},
+					{Id: aws.String("ecs-svc/0"), Status: aws.String("ACTIVE"), RolloutState: ecsTypes.DeploymentRolloutStateCompleted},
+				},
+				LoadBalancers: []ecsTypes.LoadBalancer{
+					{TargetGroupArn: aws.String("arn:tg"), ContainerName: aws.String("web"), ContainerPort: aws.Int32(8080)},
+				},
+			},
+		},
+	}, nil).Once()
+
+	mockECS.On("DescribeServices", mock.Anything, mock.MatchedBy(func(in *ecs.DescribeServicesInput) bool {
+		return len(in.Services) == 2
+	}), mock.Anything).Return(&ecs.DescribeServicesOutput{
+		Services: []ecsTypes.Service{{ServiceName: aws.String("worker")}},
+	}, nil).Once()
+
+	services, err := client.FetchECSServices(context.Background(), "test-cluster")
+	assert.NoError(t, err)
+	assert.Len(t, services, 2)
+	assert.Equal(t, "api", services[0].ServiceName)
+	assert.Equal(t, "FARGATE", services[0].LaunchType)
+	assert.Equal(t, "IN_PROGRESS", services[0].RolloutState)
+	assert.Len(t, services[0].Deployments, 2)
+	assert.Equal(t, int32(8080), services[0].LoadBalancers[0].ContainerPort)
+	mockECS.AssertNumberOfCalls(t, "DescribeServices", 2)
+
+	// Error case
+	mockECS.On("ListServices", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("AccessDenied")).Once()
+
+	services, err = client.FetchECSServices(context.Background(), "test-cluster")
+	assert.Error(t, err)
+	assert.Nil(t, services)
+}
+
+func TestFetchECSTasks(t *testing.T) {
+	mockECS := new(MockECSClient)
+	client := &Client{ecsClient: mockECS}
+
+	mockECS.On("ListTasks", mock.Anything, mock.MatchedBy(func(in *ecs.ListTasksInput) bool {
+		return in.DesiredStatus == ecsTypes.DesiredStatusRunning && aws.ToString(in.ServiceName) == "api"
+	}), mock.Anything).Return(&ecs.ListTasksOutput{TaskArns: []string{"arn:task/1"}}, nil).Once()
+	mockECS.On("ListTasks", mock.Anything, mock.MatchedBy(func(in *ecs.ListTasksInput) bool {
+		return in.DesiredStatus == ecsTypes.DesiredStatusStopped
+	}), mock.Anything).Return(&ecs.ListTasksOutput{TaskArns: []string{"arn:task/2"}}, nil).Once()
+
+	mockECS.On("DescribeTasks", mock.Anything, mock.Anything, mock.Anything).Return(&ecs.DescribeTasksOutput{
+		Tasks: []ecsTypes.Task{
+			{
+				TaskArn:           aws.String("arn:task/1"),
+				TaskDefinitionArn: aws.String("arn:aws:ecs:us-east-1:123456789012:task-definition/api:7"),
+				LastStatus:        aws.String("RUNNING"),
+				HealthStatus:      ecsTypes.HealthStatusHealthy,
+				Containers: []ecsTypes.Container{
+					{Name: aws.String("web"), Image: aws.String("nginx:latest"), HealthStatus: ecsTypes.HealthStatusHealthy},
+				},
+			},
+			{
+				TaskArn:           aws.String("arn:task/2"),
+				TaskDefinitionArn: aws.String("arn:aws:ecs:us-east-1:123456789012:task-definition/api:6"),
+				LastStatus:        aws.String("STOPPED"),
+				StoppedReason:     aws.String("Essential container in task exited"),
+			},
+		},
+	}, nil).Once()
+
+	tasks, err := client.FetchECSTasks(context.Background(), "test-cluster", "api")
+	assert.NoError(t, err)
+	assert.Len(t, tasks, 2)
+	assert.Equal(t, "api", tasks[0].Family)
+	assert.Equal(t, "7", tasks[0].Revision)
+	assert.Equal(t, "HEALTHY", tasks[0].HealthStatus)
+	assert.Len(t, tasks[0].Containers, 1)
+	assert.Equal(t, "Essential container in task exited", tasks[1].StoppedReason)
+}
+
+func TestFetchECSTaskDefinition(t *testing.T) {
+	mockECS := new(MockECSClient)
+	client := &Client{ecsClient: mockECS}
+
+	mockECS.On("DescribeTaskDefinition", mock.Anything, mock.Anything, mock.Anything).Return(&ecs.DescribeTaskDefinitionOutput{
+		TaskDefinition: &ecsTypes.TaskDefinition{
+			TaskDefinitionArn: aws.String("arn:aws:ecs:us-east-1:123456789012:task-definition/api:7"),
+			Family:            aws.String("api"),
+			Revision:          7,
+			Cpu:               aws.String("256"),
+			Memory:            aws.String("512"),
+			ContainerDefinitions: []ecsTypes.ContainerDefinition{
+				{
+					Name:  aws.String("web"),
+					Image: aws.String("123456789012.dkr.ecr.us-east-1.amazonaws.com/api:1.2.3"),
+					Environment: []ecsTypes.KeyValuePair{
+						{Name: aws.String("DB_PASSWORD"), Value: aws.String("hunter2")},
+						{Name: aws.String("APP_ENV"), Value: aws.String("prod")},
+					},
+					LogConfiguration: &ecsTypes.LogConfiguration{
+						LogDriver: ecsTypes.LogDriverAwslogs,
+						Options:   map[string]string{"awslogs-group": "/ecs/api", "splunk-token": "s3cr3t"},
+					},
+				},
+			},
+		},
+	}, nil).Once()
+
+	td, err := client.FetchECSTaskDefinition(context.Background(), "api:7")
+	assert.NoError(t, err)
+	assert.NotNil(t, td)
+	assert.Equal(t, int32(7), td.Revision)
+	assert.Equal(t, "256", td.CPU)
+	assert.Len(t, td.Containers, 1)
+	assert.Equal(t, []string{"APP_ENV", "DB_PASSWORD"}, td.Containers[0].EnvironmentVars)
+	assert.Equal(t, "awslogs", td.Containers[0].LogDriver)
+	assert.Equal(t, "/ecs/api", td.Containers[0].LogOptions["awslogs-group"])
+	assert.Equal(t, "<redacted>", td.Containers[0].LogOptions["splunk-token"])
+	assert.Equal(t, "/ecs/api", td.Containers[0].LogGroup)
+}
@@ -0,0 +1,187 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/acm"
+	acmTypes "github.com/aws/aws-sdk-go-v2/service/acm/types"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+
+	"aws-terminal-sdk-v1/internal/constants"
+	"aws-terminal-sdk-v1/internal/models"
+)
+
+// cloudFrontCertificateRegion is the region CloudFront reads its ACM certificates from
+const cloudFrontCertificateRegion = "us-east-1"
+
+// FetchCloudFrontDistributions gets the CloudFront distributions with their origins,
+// aliases, WAF web ACL and price class
+func (c *Client) FetchCloudFrontDistributions(ctx context.Context) ([]models.CloudFrontDistributionInfo, error) {
+	distributions := make([]models.CloudFrontDistributionInfo, 0)
+	paginator := cloudfront.NewListDistributionsPaginator(c.cloudfrontClient, &cloudfront.ListDistributionsInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list CloudFront distributions: %w", err)
+		}
+		if output.DistributionList == nil {
+			continue
+		}
+
+		for _, dist := range output.DistributionList.Items {
+			distributions = append(distributions, models.FromAWSCloudFrontDistribution(dist))
+		}
+	}
+
+	return distributions, nil
+}
+
+// FetchRoute53HostedZones gets the public and private hosted zones of the account
+func (c *Client) FetchRoute53HostedZones(ctx context.Context) ([]models.Route53HostedZoneInfo, error) {
+	zones := make([]models.Route53HostedZoneInfo, 0)
+	paginator := route53.NewListHostedZonesPaginator(c.route53Client, &route53.ListHostedZonesInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list hosted zones: %w", err)
+		}
+
+		for _, zone := range output.HostedZones {
+			zones = append(zones, models.FromAWSRoute53HostedZone(zone))
+		}
+	}
+
+	return zones, nil
+}
+
+// FetchRoute53Records gets the record sets of a hosted zone
+func (c *Client) FetchRoute53Records(ctx context.Context, zoneID string) ([]models.Route53RecordInfo, error) {
+	records := make([]models.Route53RecordInfo, 0)
+	paginator := route53.NewListResourceRecordSetsPaginator(c.route53Client, &route53.ListResourceRecordSetsInput{
+		HostedZoneId: aws.String(zoneID),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list records of hosted zone %s: %w", zoneID, err)
+		}
+
+		for _, record := range output.ResourceRecordSets {
+			records = append(records, models.FromAWSRoute53Record(record))
+		}
+	}
+
+	return records, nil
+}
+
+// FetchACMCertificates gets the ACM certificates of the region and, when the region
+// is another one, those of us-east-1 used by CloudFront. Certificates expiring first
+// come first.
+func (c *Client) FetchACMCertificates(ctx context.Context) ([]models.ACMCertificateInfo, error) {
+	certificates, err := c.fetchACMCertificates(ctx, c.acmClient, c.region)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.acmEdgeClient != nil && c.region != cloudFrontCertificateRegion {
+		edge, err := c.fetchACMCertificates(ctx, c.acmEdgeClient, cloudFrontCertificateRegion)
+		if err != nil {
+			fmt.Printf("Warning: %v\n", err)
+		}
+		certificates = append(certificates, edge...)
+	}
+
+	sort.SliceStable(certificates, func(i, j int) bool {
+		if (certificates[i].NotAfter == "") != (certificates[j].NotAfter == "") {
+			return certificates[j].NotAfter == ""
+		}
+		return certificates[i].NotAfter < certificates[j].NotAfter
+	})
+	return certificates, nil
+}
+
+func (c *Client) fetchACMCertificates(ctx context.Context, client ACMClientAPI, region string) ([]models.ACMCertificateInfo, error) {
+	now := time.Now()
+	certificates := make([]models.ACMCertificateInfo, 0)
+	// ListCertificates only returns RSA 1024 and 2048 bit keys unless asked for every key type
+	paginator := acm.NewListCertificatesPaginator(client, &acm.ListCertificatesInput{
+		Includes: &acmTypes.Filters{KeyTypes: acmTypes.KeyAlgorithm("").Values()},
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list ACM certificates in %s: %w", region, err)
+		}
+
+		for _, summary := range output.CertificateSummaryList {
+			detail, err := client.DescribeCertificate(ctx, &acm.DescribeCertificateInput{CertificateArn: summary.CertificateArn})
+			if err != nil {
+				// The certificate may have been deleted since it was listed
+				fmt.Printf("Warning: failed to describe certificate %s: %v\n", aws.ToString(summary.CertificateArn), err)
+				continue
+			}
+			certificates = append(certificates, models.FromAWSACMCertificate(*detail.Certificate, region, now))
+		}
+	}
+
+	return certificates, nil
+}
+
+// FetchExpiringCertificates reports a finding for each issued certificate expiring within
+// the given number of days, and for each expired certificate still in use. Expired
+// certificates are critical, in-use ones high and unused ones medium.
+func (c *Client) FetchExpiringCertificates(ctx context.Context, days int) ([]models.SecurityFinding, error) {
+	certificates, err := c.FetchACMCertificates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().Format(constants.DateTimeFormat)
+	findings := make([]models.SecurityFinding, 0)
+	for _, cert := range certificates {
+		if cert.NotAfter == "" {
+			continue
+		}
+		expired := cert.DaysToExpiry < 0 || cert.Status == string(acmTypes.CertificateStatusExpired)
+		inUse := len(cert.InUseBy) > 0
+
+		var finding models.SecurityFinding
+		switch {
+		case expired && inUse:
+			finding = models.SecurityFinding{
+				Title:    "Certificate expired",
+				Severity: constants.SecurityHubSeverityCritical,
+				Category: fmt.Sprintf("Expired on %s and still in use by %d resource(s)", cert.NotAfter, len(cert.InUseBy)),
+			}
+		case !expired && cert.Status == string(acmTypes.CertificateStatusIssued) && cert.DaysToExpiry <= days:
+			severity := constants.SecurityHubSeverityMedium
+			if inUse {
+				severity = constants.SecurityHubSeverityHigh
+			}
+			category := fmt.Sprintf("Expires on %s, in %d day(s), used by %d resource(s)", cert.NotAfter, cert.DaysToExpiry, len(cert.InUseBy))
+			if cert.RenewalEligibility == string(acmTypes.RenewalEligibilityEligible) {
+				category += "; managed renewal " + firstNonEmpty(cert.RenewalStatus, "not started")
+			} else {
+				category += "; not eligible for managed renewal"
+			}
+			finding = models.SecurityFinding{
+				Title:    "Certificate expiring soon",
+				Severity: severity,
+				Category: category,
+			}
+		default:
+			continue
+		}
+
+		finding.ResourceID = fmt.Sprintf("%s (%s)", cert.DomainName, cert.ARN)
+		finding.UpdatedAt = now
+		findings = append(findings, finding)
+	}
+
+	return findings, nil
+}
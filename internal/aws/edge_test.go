@@ -0,0 +1,228 @@
+package aws
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/service/acm"
+	acmTypes "github.com/aws/aws-sdk-go-v2/service/acm/types"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	cloudfrontTypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2Types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	elb "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing"
+	elbTypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing/types"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2Types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	route53Types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"aws-terminal-sdk-v1/internal/constants"
+	"aws-terminal-sdk-v1/internal/models"
+)
+
+func TestNormalizeDNSName(t *testing.T) {
+	assert.Equal(t, "my-alb-1.eu-west-1.elb.amazonaws.com", models.NormalizeDNSName("dualstack.My-ALB-1.eu-west-1.elb.amazonaws.com."))
+	assert.Equal(t, "*.example.com", models.NormalizeDNSName(`\052.example.com.`))
+}
+
+// newACMTestClient returns a client whose regional ACM holds a certificate expiring in
+// 200 days, one in use expiring in 10 days and an expired one still in use
+func newACMTestClient() *Client {
+	mockACM := new(MockACMClient)
+	mockEdge := new(MockACMClient)
+
+	certificates := []acmTypes.CertificateDetail{
+		{
+			CertificateArn: aws.String("arn:later"), DomainName: aws.String("later.example.com"),
+			Status: acmTypes.CertificateStatusIssued, NotAfter: aws.Time(time.Now().Add(200 * 24 * time.Hour)),
+			RenewalEligibility: acmTypes.RenewalEligibilityEligible,
+		},
+		{
+			CertificateArn: aws.String("arn:soon"), DomainName: aws.String("soon.example.com"),
+			Status: acmTypes.CertificateStatusIssued, NotAfter: aws.Time(time.Now().Add(10*24*time.Hour + time.Hour)),
+			InUseBy:            []string{"arn:aws:elasticloadbalancing:eu-west-1:123456789012:loadbalancer/app/web/1"},
+			RenewalEligibility: acmTypes.RenewalEligibilityIneligible,
+		},
+		{
+			CertificateArn: aws.String("arn:expired"), DomainName: aws.String("old.example.com"),
+			Status: acmTypes.CertificateStatusExpired, NotAfter: aws.Time(time.Now().Add(-48 * time.Hour)),
+			InUseBy: []string{"arn:aws:cloudfront::123456789012:distribution/E1"},
+		},
+	}
+
+	summaries := make([]acmTypes.CertificateSummary, 0, len(certificates))
+	for _, cert := range certificates {
+		summaries = append(summaries, acmTypes.CertificateSummary{CertificateArn: cert.CertificateArn})
+		mockACM.On("DescribeCertificate", mock.Anything, &acm.DescribeCertificateInput{CertificateArn: cert.CertificateArn}, mock.Anything).
+			Return(&acm.DescribeCertificateOutput{Certificate: &cert}, nil).Once()
+	}
+	// Every key type is requested, not only the RSA default
+	mockACM.On("ListCertificates", mock.Anything, mock.MatchedBy(func(in *acm.ListCertificatesInput) bool {
+		return in.Includes != nil && len(in.Includes.KeyTypes) > 2
+	}), mock.Anything).Return(&acm.ListCertificatesOutput{CertificateSummaryList: summaries}, nil).Once()
+	mockEdge.On("ListCertificates", mock.Anything, mock.Anything, mock.Anything).Return(&acm.ListCertificatesOutput{}, nil).Once()
+
+	return &Client{acmClient: mockACM, acmEdgeClient: mockEdge, region: "eu-west-1"}
+}
+
+func TestFetchACMCertificates(t *testing.T) {
+	client := newACMTestClient()
+
+	certificates, err := client.FetchACMCertificates(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, certificates, 3)
+	assert.Equal(t, "arn:expired", certificates[0].ARN)
+	assert.True(t, certificates[0].DaysToExpiry < 0)
+	assert.Equal(t, "arn:soon", certificates[1].ARN)
+	assert.Equal(t, 10, certificates[1].DaysToExpiry)
+	assert.Equal(t, "eu-west-1", certificates[1].Region)
+	assert.Equal(t, "arn:later", certificates[2].ARN)
+}
+
+func TestFetchExpiringCertificates(t *testing.T) {
+	client := newACMTestClient()
+
+	findings, err := client.FetchExpiringCertificates(context.Background(), 30)
+	assert.NoError(t, err)
+	assert.Len(t, findings, 2)
+
+	assert.Equal(t, "Certificate expired", findings[0].Title)
+	assert.Equal(t, constants.SecurityHubSeverityCritical, findings[0].Severity)
+	assert.Equal(t, "old.example.com (arn:expired)", findings[0].ResourceID)
+
+	assert.Equal(t, "Certificate expiring soon", findings[1].Title)
+	assert.Equal(t, constants.SecurityHubSeverityHigh, findings[1].Severity)
+	assert.Contains(t, findings[1].Category, "in 10 day(s)")
+	assert.Contains(t, findings[1].Category, "not eligible for managed renewal")
+}
+
+func TestFetchDanglingDNSRecords(t *testing.T) {
+	ipRanges := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"prefixes": [
+			{"ip_prefix": "203.0.113.0/24", "region": "eu-west-1", "service": "EC2"},
+			{"ip_prefix": "198.51.100.0/24", "region": "us-east-1", "service": "EC2"}
+		]}`))
+	}))
+	defer ipRanges.Close()
+	defer func(url string) { awsIPRangesURL = url }(awsIPRangesURL)
+	awsIPRangesURL = ipRanges.URL
+
+	mockRoute53 := new(MockRoute53Client)
+	mockCloudFront := new(MockCloudFrontClient)
+	mockELB := new(MockELBv2Client)
+	mockClassicELB := new(MockELBClient)
+	mockEC2 := new(MockEC2Client)
+	mockS3 := new(MockS3Client)
+	client := &Client{
+		route53Client:    mockRoute53,
+		cloudfrontClient: mockCloudFront,
+		elbv2Client:      mockELB,
+		elbClient:        mockClassicELB,
+		ec2Client:        mockEC2,
+		s3Client:         mockS3,
+		region:           "eu-west-1",
+	}
+
+	mockRoute53.On("ListHostedZones", mock.Anything, mock.Anything, mock.Anything).Return(&route53.ListHostedZonesOutput{
+		HostedZones: []route53Types.HostedZone{
+			{Id: aws.String("/hostedzone/Z1"), Name: aws.String("example.com.")},
+			{Id: aws.String("/hostedzone/Z2"), Name: aws.String("internal."), Config: &route53Types.HostedZoneConfig{PrivateZone: true}},
+		},
+	}, nil).Once()
+	mockRoute53.On("ListResourceRecordSets", mock.Anything, mock.MatchedBy(func(in *route53.ListResourceRecordSetsInput) bool {
+		return aws.ToString(in.HostedZoneId) == "Z1"
+	}), mock.Anything).Return(&route53.ListResourceRecordSetsOutput{
+		ResourceRecordSets: []route53Types.ResourceRecordSet{
+			{
+				Name: aws.String("app.example.com."), Type: route53Types.RRTypeA,
+				AliasTarget: &route53Types.AliasTarget{DNSName: aws.String("dualstack.live-1.eu-west-1.elb.amazonaws.com.")},
+			},
+			{
+				Name: aws.String("old.example.com."), Type: route53Types.RRTypeA,
+				AliasTarget: &route53Types.AliasTarget{DNSName: aws.String("dualstack.gone-2.eu-west-1.elb.amazonaws.com.")},
+			},
+			{
+				// Classic load balancers share the hostnames of application load balancers
+				Name: aws.String("legacy.example.com."), Type: route53Types.RRTypeCname, TTL: aws.Int64(300),
+				ResourceRecords: []route53Types.ResourceRecord{{Value: aws.String("legacy-123.eu-west-1.elb.amazonaws.com")}},
+			},
+			{
+				// Another region is not checked
+				Name: aws.String("us.example.com."), Type: route53Types.RRTypeCname, TTL: aws.Int64(300),
+				ResourceRecords: []route53Types.ResourceRecord{{Value: aws.String("web-3.elb.us-east-1.amazonaws.com")}},
+			},
+			{
+				Name: aws.String("cdn.example.com."), Type: route53Types.RRTypeCname, TTL: aws.Int64(300),
+				ResourceRecords: []route53Types.ResourceRecord{{Value: aws.String("d222.cloudfront.net")}},
+			},
+			{
+				Name: aws.String("www.example.com."), Type: route53Types.RRTypeA,
+				AliasTarget: &route53Types.AliasTarget{DNSName: aws.String("s3-website-eu-west-1.amazonaws.com.")},
+			},
+			{
+				Name: aws.String("eip.example.com."), Type: route53Types.RRTypeA, TTL: aws.Int64(300),
+				ResourceRecords: []route53Types.ResourceRecord{{Value: aws.String("203.0.113.10")}, {Value: aws.String("203.0.113.20")}},
+			},
+			{
+				// Outside the EC2 ranges of the region
+				Name: aws.String("office.example.com."), Type: route53Types.RRTypeA, TTL: aws.Int64(300),
+				ResourceRecords: []route53Types.ResourceRecord{{Value: aws.String("198.51.100.7")}},
+			},
+		},
+	}, nil).Once()
+
+	mockELB.On("DescribeLoadBalancers", mock.Anything, mock.Anything, mock.Anything).Return(&elbv2.DescribeLoadBalancersOutput{
+		LoadBalancers: []elbv2Types.LoadBalancer{{
+			DNSName: aws.String("live-1.eu-west-1.elb.amazonaws.com"),
+			State:   &elbv2Types.LoadBalancerState{Code: elbv2Types.LoadBalancerStateEnumActive},
+		}},
+	}, nil).Once()
+	mockClassicELB.On("DescribeLoadBalancers", mock.Anything, mock.Anything, mock.Anything).Return(&elb.DescribeLoadBalancersOutput{
+		LoadBalancerDescriptions: []elbTypes.LoadBalancerDescription{{DNSName: aws.String("legacy-123.eu-west-1.elb.amazonaws.com")}},
+	}, nil).Once()
+	mockCloudFront.On("ListDistributions", mock.Anything, mock.Anything, mock.Anything).Return(&cloudfront.ListDistributionsOutput{
+		DistributionList: &cloudfrontTypes.DistributionList{
+			Items: []cloudfrontTypes.DistributionSummary{{Id: aws.String("E1"), DomainName: aws.String("d111.cloudfront.net")}},
+		},
+	}, nil).Once()
+	mockEC2.On("DescribeAddresses", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeAddressesOutput{
+		Addresses: []ec2Types.Address{{PublicIp: aws.String("203.0.113.10")}},
+	}, nil).Once()
+	mockEC2.On("DescribeNetworkInterfaces", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeNetworkInterfacesOutput{}, nil).Once()
+	mockS3.On("HeadBucket", mock.Anything, mock.MatchedBy(func(in *s3.HeadBucketInput) bool {
+		return aws.ToString(in.Bucket) == "www.example.com"
+	}), mock.Anything).Return(nil, &awshttp.ResponseError{
+		ResponseError: &smithyhttp.ResponseError{Response: &smithyhttp.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}},
+	}).Once()
+
+	findings, err := client.FetchDanglingDNSRecords(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, findings, 4)
+
+	byRecord := make(map[string]models.SecurityFinding)
+	for _, finding := range findings {
+		assert.Equal(t, "Dangling DNS record", finding.Title)
+		byRecord[finding.ResourceID] = finding
+	}
+	assert.Equal(t, constants.SecurityHubSeverityHigh, byRecord["old.example.com A"].Severity)
+	assert.Contains(t, byRecord["cdn.example.com CNAME"].Category, "d222.cloudfront.net")
+	assert.Contains(t, byRecord["www.example.com A"].Category, "bucket www.example.com does not exist")
+	assert.Equal(t, constants.SecurityHubSeverityMedium, byRecord["eip.example.com A"].Severity)
+	assert.Contains(t, byRecord["eip.example.com A"].Category, "203.0.113.20")
+
+	assert.NotContains(t, byRecord, "legacy.example.com CNAME")
+
+	mockRoute53.AssertExpectations(t)
+	mockClassicELB.AssertExpectations(t)
+	mockS3.AssertExpectations(t)
+}
@@ -0,0 +1,225 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2Types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+
+	"aws-terminal-sdk-v1/internal/models"
+)
+
+// FetchEKSClusters gets the EKS clusters with their endpoint access, logging and
+// the support status of their Kubernetes version
+func (c *Client) FetchEKSClusters(ctx context.Context) ([]models.EKSClusterInfo, error) {
+	clusters := make([]models.EKSClusterInfo, 0)
+	now := time.Now()
+
+	paginator := eks.NewListClustersPaginator(c.eksClient, &eks.ListClustersInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list EKS clusters: %w", err)
+		}
+
+		for _, name := range output.Clusters {
+			cluster, err := c.eksClient.DescribeCluster(ctx, &eks.DescribeClusterInput{Name: aws.String(name)})
+			if err != nil {
+				// The cluster may have been deleted since it was listed
+				fmt.Printf("Warning: failed to describe EKS cluster %s: %v\n", name, err)
+				continue
+			}
+			clusters = append(clusters, models.FromAWSEKSCluster(*cluster.Cluster, now))
+		}
+	}
+
+	return clusters, nil
+}
+
+// FetchEKSClusterDetail gets the managed node groups, Fargate profiles, add-ons and
+// the ENI/subnet footprint of a cluster. A failing call is recorded in Errors and
+// does not prevent the other sections from being filled.
+func (c *Client) FetchEKSClusterDetail(ctx context.Context, clusterName string) (*models.EKSClusterDetail, error) {
+	cluster, err := c.eksClient.DescribeCluster(ctx, &eks.DescribeClusterInput{Name: aws.String(clusterName)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe EKS cluster %s: %w", clusterName, err)
+	}
+
+	detail := &models.EKSClusterDetail{
+		ClusterName:     clusterName,
+		NodeGroups:      make([]models.EKSNodeGroupInfo, 0),
+		FargateProfiles: make([]models.EKSFargateProfileInfo, 0),
+		AddOns:          make([]models.EKSAddonInfo, 0),
+		Footprint:       make([]models.EKSSubnetFootprint, 0),
+		Errors:          make([]string, 0),
+	}
+
+	if err := c.fetchEKSNodeGroups(ctx, detail); err != nil {
+		detail.Errors = append(detail.Errors, err.Error())
+	}
+	if err := c.fetchEKSFargateProfiles(ctx, detail); err != nil {
+		detail.Errors = append(detail.Errors, err.Error())
+	}
+	if err := c.fetchEKSAddons(ctx, detail); err != nil {
+		detail.Errors = append(detail.Errors, err.Error())
+	}
+
+	var controlPlaneSubnets []string
+	if cluster.Cluster.ResourcesVpcConfig != nil {
+		controlPlaneSubnets = cluster.Cluster.ResourcesVpcConfig.SubnetIds
+	}
+	if err := c.fetchEKSFootprint(ctx, detail, controlPlaneSubnets); err != nil {
+		detail.Errors = append(detail.Errors, err.Error())
+	}
+
+	return detail, nil
+}
+
+func (c *Client) fetchEKSNodeGroups(ctx context.Context, detail *models.EKSClusterDetail) error {
+	paginator := eks.NewListNodegroupsPaginator(c.eksClient, &eks.ListNodegroupsInput{
+		ClusterName: aws.String(detail.ClusterName),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list node groups: %w", err)
+		}
+
+		for _, name := range output.Nodegroups {
+			group, err := c.eksClient.DescribeNodegroup(ctx, &eks.DescribeNodegroupInput{
+				ClusterName:   aws.String(detail.ClusterName),
+				NodegroupName: aws.String(name),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to describe node group %s: %w", name, err)
+			}
+			detail.NodeGroups = append(detail.NodeGroups, models.FromAWSEKSNodeGroup(*group.Nodegroup))
+		}
+	}
+	return nil
+}
+
+func (c *Client) fetchEKSFargateProfiles(ctx context.Context, detail *models.EKSClusterDetail) error {
+	paginator := eks.NewListFargateProfilesPaginator(c.eksClient, &eks.ListFargateProfilesInput{
+		ClusterName: aws.String(detail.ClusterName),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list Fargate profiles: %w", err)
+		}
+
+		for _, name := range output.FargateProfileNames {
+			profile, err := c.eksClient.DescribeFargateProfile(ctx, &eks.DescribeFargateProfileInput{
+				ClusterName:        aws.String(detail.ClusterName),
+				FargateProfileName: aws.String(name),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to describe Fargate profile %s: %w", name, err)
+			}
+			detail.FargateProfiles = append(detail.FargateProfiles, models.FromAWSEKSFargateProfile(*profile.FargateProfile))
+		}
+	}
+	return nil
+}
+
+func (c *Client) fetchEKSAddons(ctx context.Context, detail *models.EKSClusterDetail) error {
+	paginator := eks.NewListAddonsPaginator(c.eksClient, &eks.ListAddonsInput{
+		ClusterName: aws.String(detail.ClusterName),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list add-ons: %w", err)
+		}
+
+		for _, name := range output.Addons {
+			addon, err := c.eksClient.DescribeAddon(ctx, &eks.DescribeAddonInput{
+				ClusterName: aws.String(detail.ClusterName),
+				AddonName:   aws.String(name),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to describe add-on %s: %w", name, err)
+			}
+			detail.AddOns = append(detail.AddOns, models.FromAWSEKSAddon(*addon.Addon))
+		}
+	}
+	return nil
+}
+
+// fetchEKSFootprint counts, in every subnet used by the cluster, the interfaces created
+// for it against all the interfaces and the IP addresses still available
+func (c *Client) fetchEKSFootprint(ctx context.Context, detail *models.EKSClusterDetail, controlPlaneSubnets []string) error {
+	usedBy := make(map[string][]string)
+	subnetIDs := make([]string, 0)
+	use := func(subnets []string, user string) {
+		for _, id := range subnets {
+			if _, ok := usedBy[id]; !ok {
+				subnetIDs = append(subnetIDs, id)
+			}
+			if !slices.Contains(usedBy[id], user) {
+				usedBy[id] = append(usedBy[id], user)
+			}
+		}
+	}
+	use(controlPlaneSubnets, "control-plane")
+	for _, group := range detail.NodeGroups {
+		use(group.SubnetIDs, "nodegroup/"+group.Name)
+	}
+	for _, profile := range detail.FargateProfiles {
+		use(profile.SubnetIDs, "fargate/"+profile.Name)
+	}
+	if len(subnetIDs) == 0 {
+		return nil
+	}
+
+	subnets, err := c.ec2Client.DescribeSubnets(ctx, &ec2.DescribeSubnetsInput{SubnetIds: subnetIDs})
+	if err != nil {
+		return fmt.Errorf("failed to describe cluster subnets: %w", err)
+	}
+	footprint := make(map[string]*models.EKSSubnetFootprint, len(subnetIDs))
+	for _, subnet := range subnets.Subnets {
+		id := aws.ToString(subnet.SubnetId)
+		footprint[id] = &models.EKSSubnetFootprint{
+			SubnetID:         id,
+			AvailabilityZone: aws.ToString(subnet.AvailabilityZone),
+			UsedBy:           usedBy[id],
+			AvailableIPs:     aws.ToInt32(subnet.AvailableIpAddressCount),
+		}
+	}
+
+	paginator := ec2.NewDescribeNetworkInterfacesPaginator(c.ec2Client, &ec2.DescribeNetworkInterfacesInput{
+		Filters: []ec2Types.Filter{{Name: aws.String("subnet-id"), Values: subnetIDs}},
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to describe cluster network interfaces: %w", err)
+		}
+
+		for _, raw := range output.NetworkInterfaces {
+			eni := models.FromAWSNetworkInterface(raw)
+			entry, ok := footprint[eni.SubnetID]
+			if !ok {
+				continue
+			}
+			entry.TotalENIs++
+			if models.IsEKSClusterENI(eni, detail.ClusterName) {
+				entry.ClusterENIs++
+				detail.ENICount++
+			}
+		}
+	}
+
+	for _, id := range subnetIDs {
+		if entry, ok := footprint[id]; ok {
+			detail.Footprint = append(detail.Footprint, *entry)
+		}
+	}
+	return nil
+}
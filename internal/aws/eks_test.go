@@ -0,0 +1,151 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2Types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	eksTypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"aws-terminal-sdk-v1/internal/models"
+)
+
+func TestFetchEKSClusters(t *testing.T) {
+	mockEKS := new(MockEKSClient)
+	client := &Client{eksClient: mockEKS}
+
+	mockEKS.On("ListClusters", mock.Anything, mock.Anything, mock.Anything).Return(&eks.ListClustersOutput{
+		Clusters: []string{"prod", "gone"},
+	}, nil).Once()
+	mockEKS.On("DescribeCluster", mock.Anything, mock.MatchedBy(func(in *eks.DescribeClusterInput) bool {
+		return aws.ToString(in.Name) == "prod"
+	}), mock.Anything).Return(&eks.DescribeClusterOutput{
+		Cluster: &eksTypes.Cluster{
+			Name:    aws.String("prod"),
+			Status:  eksTypes.ClusterStatusActive,
+			Version: aws.String("1.24"),
+			ResourcesVpcConfig: &eksTypes.VpcConfigResponse{
+				VpcId:                aws.String("vpc-1"),
+				SubnetIds:            []string{"subnet-a", "subnet-b"},
+				EndpointPublicAccess: true,
+				PublicAccessCidrs:    []string{"0.0.0.0/0"},
+			},
+			Logging: &eksTypes.Logging{ClusterLogging: []eksTypes.LogSetup{
+				{Enabled: aws.Bool(true), Types: []eksTypes.LogType{eksTypes.LogTypeApi, eksTypes.LogTypeAudit}},
+				{Enabled: aws.Bool(false), Types: []eksTypes.LogType{eksTypes.LogTypeScheduler}},
+			}},
+			UpgradePolicy: &eksTypes.UpgradePolicyResponse{SupportType: eksTypes.SupportTypeExtended},
+		},
+	}, nil).Once()
+	mockEKS.On("DescribeCluster", mock.Anything, mock.Anything, mock.Anything).Return(nil, &eksTypes.ResourceNotFoundException{}).Once()
+
+	clusters, err := client.FetchEKSClusters(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, clusters, 1)
+
+	cluster := clusters[0]
+	assert.Equal(t, "vpc-1", cluster.VPCID)
+	assert.True(t, cluster.EndpointPublicAccess)
+	assert.Equal(t, []string{"api", "audit"}, cluster.EnabledLogTypes)
+	assert.Equal(t, "EXTENDED", cluster.SupportType)
+	assert.Equal(t, "2024-01-31", cluster.StandardSupportEnd)
+	assert.Equal(t, models.EKSSupportUnsupported, cluster.SupportStatus)
+}
+
+func TestLookupEKSVersionSupport(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, models.EKSSupportStandard, models.LookupEKSVersionSupport("1.31", now).Status)
+	assert.Equal(t, models.EKSSupportExtended, models.LookupEKSVersionSupport("1.28", now).Status)
+	assert.Equal(t, models.EKSSupportUnsupported, models.LookupEKSVersionSupport("1.22", now).Status)
+	assert.Empty(t, models.LookupEKSVersionSupport("2.0", now).Status)
+
+	calendar := models.EKSVersionCalendar(now)
+	assert.Equal(t, "1.21", calendar[len(calendar)-1].Version)
+	assert.Greater(t, calendar[0].Version, calendar[1].Version)
+}
+
+func TestFetchEKSClusterDetail(t *testing.T) {
+	mockEKS := new(MockEKSClient)
+	mockEC2 := new(MockEC2Client)
+	client := &Client{eksClient: mockEKS, ec2Client: mockEC2}
+
+	mockEKS.On("DescribeCluster", mock.Anything, mock.Anything, mock.Anything).Return(&eks.DescribeClusterOutput{
+		Cluster: &eksTypes.Cluster{
+			Name:               aws.String("prod"),
+			ResourcesVpcConfig: &eksTypes.VpcConfigResponse{SubnetIds: []string{"subnet-a"}},
+		},
+	}, nil).Once()
+	mockEKS.On("ListNodegroups", mock.Anything, mock.Anything, mock.Anything).Return(&eks.ListNodegroupsOutput{
+		Nodegroups: []string{"workers"},
+	}, nil).Once()
+	mockEKS.On("DescribeNodegroup", mock.Anything, mock.Anything, mock.Anything).Return(&eks.DescribeNodegroupOutput{
+		Nodegroup: &eksTypes.Nodegroup{
+			NodegroupName: aws.String("workers"),
+			CapacityType:  eksTypes.CapacityTypesSpot,
+			InstanceTypes: []string{"m6i.large"},
+			Subnets:       []string{"subnet-a", "subnet-b"},
+			ScalingConfig: &eksTypes.NodegroupScalingConfig{MinSize: aws.Int32(2), MaxSize: aws.Int32(6), DesiredSize: aws.Int32(3)},
+			Resources: &eksTypes.NodegroupResources{
+				AutoScalingGroups: []eksTypes.AutoScalingGroup{{Name: aws.String("eks-workers-1234")}},
+			},
+		},
+	}, nil).Once()
+	mockEKS.On("ListFargateProfiles", mock.Anything, mock.Anything, mock.Anything).Return(&eks.ListFargateProfilesOutput{
+		FargateProfileNames: []string{"batch"},
+	}, nil).Once()
+	mockEKS.On("DescribeFargateProfile", mock.Anything, mock.Anything, mock.Anything).Return(&eks.DescribeFargateProfileOutput{
+		FargateProfile: &eksTypes.FargateProfile{
+			FargateProfileName: aws.String("batch"),
+			Subnets:            []string{"subnet-b"},
+			Selectors: []eksTypes.FargateProfileSelector{
+				{Namespace: aws.String("batch"), Labels: map[string]string{"tier": "jobs"}},
+				{Namespace: aws.String("kube-system")},
+			},
+		},
+	}, nil).Once()
+	mockEKS.On("ListAddons", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("access denied")).Once()
+
+	mockEC2.On("DescribeSubnets", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeSubnetsOutput{
+		Subnets: []ec2Types.Subnet{
+			{SubnetId: aws.String("subnet-a"), AvailabilityZone: aws.String("us-east-1a"), AvailableIpAddressCount: aws.Int32(200)},
+			{SubnetId: aws.String("subnet-b"), AvailabilityZone: aws.String("us-east-1b"), AvailableIpAddressCount: aws.Int32(12)},
+		},
+	}, nil).Once()
+	mockEC2.On("DescribeNetworkInterfaces", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeNetworkInterfacesOutput{
+		NetworkInterfaces: []ec2Types.NetworkInterface{
+			{NetworkInterfaceId: aws.String("eni-1"), SubnetId: aws.String("subnet-a"), Description: aws.String("Amazon EKS prod")},
+			{NetworkInterfaceId: aws.String("eni-2"), SubnetId: aws.String("subnet-b"),
+				TagSet: []ec2Types.Tag{{Key: aws.String("cluster.k8s.amazonaws.com/name"), Value: aws.String("prod")}}},
+			{NetworkInterfaceId: aws.String("eni-3"), SubnetId: aws.String("subnet-b"), Description: aws.String("Amazon EKS staging")},
+		},
+	}, nil).Once()
+
+	detail, err := client.FetchEKSClusterDetail(context.Background(), "prod")
+	assert.NoError(t, err)
+
+	assert.Len(t, detail.NodeGroups, 1)
+	group := detail.NodeGroups[0]
+	assert.Equal(t, "SPOT", group.CapacityType)
+	assert.Equal(t, int32(3), group.DesiredSize)
+	assert.Equal(t, []string{"eks-workers-1234"}, group.AutoScalingGroups)
+
+	assert.Equal(t, []string{"batch {tier=jobs}", "kube-system"}, detail.FargateProfiles[0].Selectors)
+	assert.Empty(t, detail.AddOns)
+	assert.Len(t, detail.Errors, 1)
+
+	assert.Len(t, detail.Footprint, 2)
+	assert.Equal(t, []string{"control-plane", "nodegroup/workers"}, detail.Footprint[0].UsedBy)
+	assert.Equal(t, []string{"nodegroup/workers", "fargate/batch"}, detail.Footprint[1].UsedBy)
+	assert.Equal(t, 1, detail.Footprint[1].ClusterENIs)
+	assert.Equal(t, 2, detail.Footprint[1].TotalENIs)
+	assert.Equal(t, int32(12), detail.Footprint[1].AvailableIPs)
+	assert.Equal(t, 2, detail.ENICount)
+}
@@ -0,0 +1,166 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"aws-terminal-sdk-v1/internal/constants"
+	"aws-terminal-sdk-v1/internal/models"
+)
+
+// FetchNetworkInterfaces retrieves all elastic network interfaces
+func (c *Client) FetchNetworkInterfaces(ctx context.Context) ([]models.NetworkInterfaceInfo, error) {
+	enis := make([]models.NetworkInterfaceInfo, 0)
+	paginator := ec2.NewDescribeNetworkInterfacesPaginator(c.ec2Client, &ec2.DescribeNetworkInterfacesInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe network interfaces: %w", err)
+		}
+
+		for _, eni := range output.NetworkInterfaces {
+			enis = append(enis, models.FromAWSNetworkInterface(eni))
+		}
+	}
+
+	return enis, nil
+}
+
+// LookupIP finds the resources holding an IP address across network interfaces,
+// Elastic IPs, instances, load balancers and NAT gateways. A source that cannot
+// be searched is reported in Errors instead of failing the whole lookup.
+func (c *Client) LookupIP(ctx context.Context, ip string) (*models.IPLookupResult, error) {
+	parsed := net.ParseIP(strings.TrimSpace(ip))
+	if parsed == nil {
+		return nil, fmt.Errorf("invalid IP address %q", ip)
+	}
+	ip = parsed.String()
+
+	result := &models.IPLookupResult{
+		IP:     ip,
+		Owners: make([]models.IPOwner, 0),
+		Errors: make([]string, 0),
+	}
+
+	if enis, err := c.FetchNetworkInterfaces(ctx); err != nil {
+		result.Errors = append(result.Errors, err.Error())
+	} else {
+		for _, eni := range enis {
+			if slices.Contains(eni.PrivateIPs, ip) || slices.Contains(eni.PublicIPs, ip) {
+				result.Owners = append(result.Owners, models.IPOwner{
+					ResourceType: "eni",
+					ResourceID:   eni.ID,
+					Name:         eni.Tags[constants.TagName],
+					Requester:    eni.Requester,
+					Detail:       eniOwnerDetail(eni),
+				})
+			}
+		}
+	}
+
+	if eips, err := c.FetchElasticIPs(ctx); err != nil {
+		result.Errors = append(result.Errors, err.Error())
+	} else {
+		for _, eip := range eips {
+			if eip.PublicIP == ip || eip.PrivateIP == ip {
+				result.Owners = append(result.Owners, models.IPOwner{
+					ResourceType: "eip",
+					ResourceID:   eip.AllocationID,
+					Name:         eip.Tags[constants.TagName],
+					Detail:       fmt.Sprintf("%s associated with %s", eip.PublicIP, firstNonEmpty(eip.InstanceID, eip.NetworkInterfaceID, "nothing")),
+				})
+			}
+		}
+	}
+
+	if instances, err := c.FetchEC2Instances(ctx); err != nil {
+		result.Errors = append(result.Errors, err.Error())
+	} else {
+		for _, instance := range instances {
+			if instance.PublicIPAddress == ip || instance.PrivateIPAddress == ip {
+				result.Owners = append(result.Owners, models.IPOwner{
+					ResourceType: "ec2",
+					ResourceID:   instance.ID,
+					Name:         instance.Name,
+					Requester:    "EC2",
+					Detail:       fmt.Sprintf("%s in %s", instance.InstanceType, instance.SubnetID),
+				})
+			}
+		}
+	}
+
+	if lbs, err := c.FetchLoadBalancers(ctx); err != nil {
+		result.Errors = append(result.Errors, err.Error())
+	} else {
+		for _, lb := range lbs {
+			if slices.Contains(lb.Addresses, ip) {
+				result.Owners = append(result.Owners, models.IPOwner{
+					ResourceType: "load-balancer",
+					ResourceID:   lb.ARN,
+					Name:         lb.Name,
+					Requester:    "ELB",
+					Detail:       lb.DNSName,
+				})
+			}
+		}
+	}
+
+	if owners, err := c.natGatewayOwners(ctx, ip); err != nil {
+		result.Errors = append(result.Errors, err.Error())
+	} else {
+		result.Owners = append(result.Owners, owners...)
+	}
+
+	return result, nil
+}
+
+// natGatewayOwners returns the NAT gateways holding ip among any of their addresses
+func (c *Client) natGatewayOwners(ctx context.Context, ip string) ([]models.IPOwner, error) {
+	nats, err := c.ec2Client.DescribeNatGateways(ctx, &ec2.DescribeNatGatewaysInput{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe NAT gateways: %w", err)
+	}
+
+	owners := make([]models.IPOwner, 0)
+	for _, nat := range nats.NatGateways {
+		for _, addr := range nat.NatGatewayAddresses {
+			if safeString(addr.PublicIp) == ip || safeString(addr.PrivateIp) == ip {
+				info := models.FromAWSNATGateway(nat)
+				owners = append(owners, models.IPOwner{
+					ResourceType: "nat-gateway",
+					ResourceID:   info.ID,
+					Name:         info.Name,
+					Requester:    "NAT Gateway",
+					Detail:       fmt.Sprintf("%s NAT in %s", info.ConnectivityType, info.SubnetID),
+				})
+				break
+			}
+		}
+	}
+	return owners, nil
+}
+
+// eniOwnerDetail describes what an interface is attached to
+func eniOwnerDetail(eni models.NetworkInterfaceInfo) string {
+	if eni.InstanceID != "" {
+		return fmt.Sprintf("attached to %s in %s", eni.InstanceID, eni.SubnetID)
+	}
+	if eni.Description != "" {
+		return fmt.Sprintf("%s in %s", eni.Description, eni.SubnetID)
+	}
+	return fmt.Sprintf("%s in %s", eni.Status, eni.SubnetID)
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
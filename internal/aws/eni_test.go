@@ -0,0 +1,110 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2Types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2Types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFetchNetworkInterfaces(t *testing.T) {
+	mockEC2 := new(MockEC2Client)
+	client := &Client{ec2Client: mockEC2}
+
+	mockEC2.On("DescribeNetworkInterfaces", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeNetworkInterfacesOutput{
+		NetworkInterfaces: []ec2Types.NetworkInterface{
+			{
+				NetworkInterfaceId: aws.String("eni-lambda"),
+				InterfaceType:      ec2Types.NetworkInterfaceTypeLambda,
+				Description:        aws.String("AWS Lambda VPC ENI-orders-handler"),
+				PrivateIpAddresses: []ec2Types.NetworkInterfacePrivateIpAddress{{PrivateIpAddress: aws.String("10.2.14.37")}},
+				Groups:             []ec2Types.GroupIdentifier{{GroupId: aws.String("sg-1")}},
+				SubnetId:           aws.String("subnet-1"),
+			},
+			{
+				NetworkInterfaceId: aws.String("eni-rds"),
+				Description:        aws.String("RDSNetworkInterface"),
+				RequesterManaged:   aws.Bool(true),
+			},
+			{
+				NetworkInterfaceId: aws.String("eni-ec2"),
+				Attachment:         &ec2Types.NetworkInterfaceAttachment{InstanceId: aws.String("i-123")},
+				PrivateIpAddresses: []ec2Types.NetworkInterfacePrivateIpAddress{
+					{PrivateIpAddress: aws.String("10.2.1.5"), Association: &ec2Types.NetworkInterfaceAssociation{PublicIp: aws.String("54.1.2.3")}},
+				},
+			},
+		},
+	}, nil).Once()
+
+	enis, err := client.FetchNetworkInterfaces(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, enis, 3)
+	assert.Equal(t, "Lambda", enis[0].Requester)
+	assert.Equal(t, []string{"sg-1"}, enis[0].SecurityGroupIDs)
+	assert.Equal(t, "RDS", enis[1].Requester)
+	assert.Equal(t, "EC2", enis[2].Requester)
+	assert.Equal(t, []string{"54.1.2.3"}, enis[2].PublicIPs)
+}
+
+func TestLookupIP(t *testing.T) {
+	mockEC2 := new(MockEC2Client)
+	mockELB := new(MockELBv2Client)
+	client := &Client{ec2Client: mockEC2, elbv2Client: mockELB}
+
+	mockEC2.On("DescribeNetworkInterfaces", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeNetworkInterfacesOutput{
+		NetworkInterfaces: []ec2Types.NetworkInterface{
+			{
+				NetworkInterfaceId: aws.String("eni-nlb"),
+				InterfaceType:      ec2Types.NetworkInterfaceTypeNetworkLoadBalancer,
+				Description:        aws.String("ELB net/edge/abc"),
+				PrivateIpAddresses: []ec2Types.NetworkInterfacePrivateIpAddress{{PrivateIpAddress: aws.String("10.2.14.37")}},
+			},
+		},
+	}, nil)
+	mockEC2.On("DescribeAddresses", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("AccessDenied"))
+	mockEC2.On("DescribeInstances", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeInstancesOutput{}, nil)
+	mockEC2.On("DescribeNatGateways", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeNatGatewaysOutput{
+		NatGateways: []ec2Types.NatGateway{
+			{
+				NatGatewayId:        aws.String("nat-1"),
+				NatGatewayAddresses: []ec2Types.NatGatewayAddress{{PublicIp: aws.String("3.3.3.3"), PrivateIp: aws.String("10.2.0.9")}},
+			},
+		},
+	}, nil)
+	mockELB.On("DescribeLoadBalancers", mock.Anything, mock.Anything, mock.Anything).Return(&elbv2.DescribeLoadBalancersOutput{
+		LoadBalancers: []elbv2Types.LoadBalancer{
+			{
+				LoadBalancerName: aws.String("edge"),
+				LoadBalancerArn:  aws.String("arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/net/edge/abc"),
+				State:            &elbv2Types.LoadBalancerState{Code: elbv2Types.LoadBalancerStateEnumActive},
+				AvailabilityZones: []elbv2Types.AvailabilityZone{
+					{ZoneName: aws.String("us-east-1a"), LoadBalancerAddresses: []elbv2Types.LoadBalancerAddress{{PrivateIPv4Address: aws.String("10.2.14.37")}}},
+				},
+			},
+		},
+	}, nil)
+
+	result, err := client.LookupIP(context.Background(), " 10.2.14.37 ")
+	assert.NoError(t, err)
+	assert.Equal(t, "10.2.14.37", result.IP)
+	assert.Len(t, result.Owners, 2)
+	assert.Equal(t, "ELB", result.Owners[0].Requester)
+	assert.Equal(t, "load-balancer", result.Owners[1].ResourceType)
+	// The Elastic IP lookup failed but did not abort the search
+	assert.Len(t, result.Errors, 1)
+
+	result, err = client.LookupIP(context.Background(), "3.3.3.3")
+	assert.NoError(t, err)
+	assert.Len(t, result.Owners, 1)
+	assert.Equal(t, "nat-1", result.Owners[0].ResourceID)
+
+	_, err = client.LookupIP(context.Background(), "not-an-ip")
+	assert.Error(t, err)
+}
@@ -4,27 +4,49 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/acm"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
 	cwTypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
 	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
 	costexplorerTypes "github.com/aws/aws-sdk-go-v2/service/costexplorer/types"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	ec2Types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
 	"github.com/aws/aws-sdk-go-v2/service/ecs"
 	ecsTypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing"
 	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
 	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamTypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/aws-sdk-go-v2/service/kafka"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/opensearch"
 	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
 	"github.com/aws/aws-sdk-go-v2/service/securityhub"
 	securityhubTypes "github.com/aws/aws-sdk-go-v2/service/securityhub/types"
 	"github.com/aws/aws-sdk-go-v2/service/servicequotas"
+	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/aws/aws-sdk-go-v2/service/support"
 
@@ -42,21 +64,46 @@ func safeString(s *string) string {
 
 // Client wraps the AWS clients
 type Client struct {
-	ec2Client     EC2ClientAPI
-	ecsClient     ECSClientAPI
-	elbv2Client   ELBv2ClientAPI
-	iamClient     IAMClientAPI
-	lambdaClient  LambdaClientAPI
-	rdsClient     RDSClientAPI
-	s3Client      S3ClientAPI
-	stsClient     STSClientAPI
-	cwClient      CloudWatchClientAPI
-	ceClient      CostExplorerClientAPI
-	sqClient      ServiceQuotasClientAPI
-	shClient      SecurityHubClientAPI
-	supportClient SupportClientAPI
-	region        string
-	cfg           aws.Config // Store config for Cost Explorer
+	ec2Client            EC2ClientAPI
+	ecsClient            ECSClientAPI
+	elbv2Client          ELBv2ClientAPI
+	iamClient            IAMClientAPI
+	lambdaClient         LambdaClientAPI
+	rdsClient            RDSClientAPI
+	s3Client             S3ClientAPI
+	stsClient            STSClientAPI
+	cwClient             CloudWatchClientAPI
+	logsClient           CloudWatchLogsClientAPI
+	ceClient             CostExplorerClientAPI
+	sqClient             ServiceQuotasClientAPI
+	shClient             SecurityHubClientAPI
+	supportClient        SupportClientAPI
+	asgClient            AutoScalingClientAPI
+	dynamodbClient       DynamoDBClientAPI
+	sqsClient            SQSClientAPI
+	snsClient            SNSClientAPI
+	eksClient            EKSClientAPI
+	ecrClient            ECRClientAPI
+	cloudfrontClient     CloudFrontClientAPI
+	route53Client        Route53ClientAPI
+	acmClient            ACMClientAPI
+	acmEdgeClient        ACMClientAPI // us-east-1, where the certificates of CloudFront distributions live
+	apigatewayClient     APIGatewayClientAPI
+	apigatewayv2Client   APIGatewayV2ClientAPI
+	sfnClient            SFNClientAPI
+	elasticacheClient    ElastiCacheClientAPI
+	opensearchClient     OpenSearchClientAPI
+	kafkaClient          KafkaClientAPI
+	cloudformationClient CloudFormationClientAPI
+	kmsClient            KMSClientAPI
+	secretsmanagerClient SecretsManagerClientAPI
+	elbClient            ELBClientAPI
+	region               string
+	cfg                  aws.Config // Store config for Cost Explorer
+
+	// Actions known to fail, with the reason, skipped by the home page
+	deniedMu sync.RWMutex
+	denied   map[string]string
 }
 
 // NewClient creates a new AWS client with default configuration
@@ -67,42 +114,84 @@ func NewClient(ctx context.Context) (*Client, error) {
 	}
 
 	return &Client{
-		ec2Client:     ec2.NewFromConfig(cfg),
-		ecsClient:     ecs.NewFromConfig(cfg),
-		elbv2Client:   elasticloadbalancingv2.NewFromConfig(cfg),
-		iamClient:     iam.NewFromConfig(cfg),
-		lambdaClient:  lambda.NewFromConfig(cfg),
-		rdsClient:     rds.NewFromConfig(cfg),
-		s3Client:      s3.NewFromConfig(cfg),
-		stsClient:     sts.NewFromConfig(cfg),
-		cwClient:      cloudwatch.NewFromConfig(cfg),
-		ceClient:      costexplorer.NewFromConfig(cfg),
-		sqClient:      servicequotas.NewFromConfig(cfg),
-		shClient:      securityhub.NewFromConfig(cfg),
-		supportClient: support.NewFromConfig(cfg),
-		region:        cfg.Region,
-		cfg:           cfg,
+		ec2Client:            ec2.NewFromConfig(cfg),
+		ecsClient:            ecs.NewFromConfig(cfg),
+		elbv2Client:          elasticloadbalancingv2.NewFromConfig(cfg),
+		iamClient:            iam.NewFromConfig(cfg),
+		lambdaClient:         lambda.NewFromConfig(cfg),
+		rdsClient:            rds.NewFromConfig(cfg),
+		s3Client:             s3.NewFromConfig(cfg),
+		stsClient:            sts.NewFromConfig(cfg),
+		cwClient:             cloudwatch.NewFromConfig(cfg),
+		logsClient:           cloudwatchlogs.NewFromConfig(cfg),
+		ceClient:             costexplorer.NewFromConfig(cfg),
+		sqClient:             servicequotas.NewFromConfig(cfg),
+		shClient:             securityhub.NewFromConfig(cfg),
+		supportClient:        support.NewFromConfig(cfg),
+		asgClient:            autoscaling.NewFromConfig(cfg),
+		dynamodbClient:       dynamodb.NewFromConfig(cfg),
+		sqsClient:            sqs.NewFromConfig(cfg),
+		snsClient:            sns.NewFromConfig(cfg),
+		eksClient:            eks.NewFromConfig(cfg),
+		ecrClient:            ecr.NewFromConfig(cfg),
+		cloudfrontClient:     cloudfront.NewFromConfig(cfg),
+		route53Client:        route53.NewFromConfig(cfg),
+		acmClient:            acm.NewFromConfig(cfg),
+		acmEdgeClient:        acm.NewFromConfig(cfg, func(o *acm.Options) { o.Region = cloudFrontCertificateRegion }),
+		apigatewayClient:     apigateway.NewFromConfig(cfg),
+		apigatewayv2Client:   apigatewayv2.NewFromConfig(cfg),
+		sfnClient:            sfn.NewFromConfig(cfg),
+		elasticacheClient:    elasticache.NewFromConfig(cfg),
+		opensearchClient:     opensearch.NewFromConfig(cfg),
+		kafkaClient:          kafka.NewFromConfig(cfg),
+		cloudformationClient: cloudformation.NewFromConfig(cfg),
+		kmsClient:            kms.NewFromConfig(cfg),
+		secretsmanagerClient: secretsmanager.NewFromConfig(cfg),
+		elbClient:            elasticloadbalancing.NewFromConfig(cfg),
+		region:               cfg.Region,
+		cfg:                  cfg,
 	}, nil
 }
 
 // NewClientWithConfig creates a new AWS client with provided configuration
 func NewClientWithConfig(ctx context.Context, cfg aws.Config) (*Client, error) {
 	return &Client{
-		ec2Client:     ec2.NewFromConfig(cfg),
-		ecsClient:     ecs.NewFromConfig(cfg),
-		elbv2Client:   elasticloadbalancingv2.NewFromConfig(cfg),
-		iamClient:     iam.NewFromConfig(cfg),
-		lambdaClient:  lambda.NewFromConfig(cfg),
-		rdsClient:     rds.NewFromConfig(cfg),
-		s3Client:      s3.NewFromConfig(cfg),
-		stsClient:     sts.NewFromConfig(cfg),
-		cwClient:      cloudwatch.NewFromConfig(cfg),
-		ceClient:      costexplorer.NewFromConfig(cfg),
-		sqClient:      servicequotas.NewFromConfig(cfg),
-		shClient:      securityhub.NewFromConfig(cfg),
-		supportClient: support.NewFromConfig(cfg),
-		region:        cfg.Region,
-		cfg:           cfg,
+		ec2Client:            ec2.NewFromConfig(cfg),
+		ecsClient:            ecs.NewFromConfig(cfg),
+		elbv2Client:          elasticloadbalancingv2.NewFromConfig(cfg),
+		iamClient:            iam.NewFromConfig(cfg),
+		lambdaClient:         lambda.NewFromConfig(cfg),
+		rdsClient:            rds.NewFromConfig(cfg),
+		s3Client:             s3.NewFromConfig(cfg),
+		stsClient:            sts.NewFromConfig(cfg),
+		cwClient:             cloudwatch.NewFromConfig(cfg),
+		logsClient:           cloudwatchlogs.NewFromConfig(cfg),
+		ceClient:             costexplorer.NewFromConfig(cfg),
+		sqClient:             servicequotas.NewFromConfig(cfg),
+		shClient:             securityhub.NewFromConfig(cfg),
+		supportClient:        support.NewFromConfig(cfg),
+		asgClient:            autoscaling.NewFromConfig(cfg),
+		dynamodbClient:       dynamodb.NewFromConfig(cfg),
+		sqsClient:            sqs.NewFromConfig(cfg),
+		snsClient:            sns.NewFromConfig(cfg),
+		eksClient:            eks.NewFromConfig(cfg),
+		ecrClient:            ecr.NewFromConfig(cfg),
+		cloudfrontClient:     cloudfront.NewFromConfig(cfg),
+		route53Client:        route53.NewFromConfig(cfg),
+		acmClient:            acm.NewFromConfig(cfg),
+		acmEdgeClient:        acm.NewFromConfig(cfg, func(o *acm.Options) { o.Region = cloudFrontCertificateRegion }),
+		apigatewayClient:     apigateway.NewFromConfig(cfg),
+		apigatewayv2Client:   apigatewayv2.NewFromConfig(cfg),
+		sfnClient:            sfn.NewFromConfig(cfg),
+		elasticacheClient:    elasticache.NewFromConfig(cfg),
+		opensearchClient:     opensearch.NewFromConfig(cfg),
+		kafkaClient:          kafka.NewFromConfig(cfg),
+		cloudformationClient: cloudformation.NewFromConfig(cfg),
+		kmsClient:            kms.NewFromConfig(cfg),
+		secretsmanagerClient: secretsmanager.NewFromConfig(cfg),
+		elbClient:            elasticloadbalancing.NewFromConfig(cfg),
+		region:               cfg.Region,
+		cfg:                  cfg,
 	}, nil
 }
 
@@ -295,14 +384,17 @@ func (c *Client) FetchElasticIPs(ctx context.Context) ([]models.ElasticIPInfo, e
 }
 
 func (c *Client) FetchLambdaFunctions(ctx context.Context) ([]models.LambdaFunctionInfo, error) {
-	output, err := c.lambdaClient.ListFunctions(ctx, &lambda.ListFunctionsInput{})
-	if err != nil {
-		return nil, fmt.Errorf("failed to list lambda functions: %w", err)
-	}
+	functions := make([]models.LambdaFunctionInfo, 0)
+	paginator := lambda.NewListFunctionsPaginator(c.lambdaClient, &lambda.ListFunctionsInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list lambda functions: %w", err)
+		}
 
-	functions := make([]models.LambdaFunctionInfo, 0, len(output.Functions))
-	for _, fn := range output.Functions {
-		functions = append(functions, models.FromAWSLambdaFunction(fn))
+		for _, fn := range output.Functions {
+			functions = append(functions, models.FromAWSLambdaFunction(fn))
+		}
 	}
 
 	return functions, nil
@@ -414,24 +506,10 @@ func (c *Client) checkRolePolicies(ctx context.Context, roleName string) bool {
 	return false
 }
 
-// VerifyPermissions checks if the current principal has the required actions
-func (c *Client) VerifyPermissions(ctx context.Context) ([]models.PermissionStatus, error) {
-	requiredActions := []string{
-		"ec2:DescribeInstances",
-		"ec2:DescribeVpcs",
-		"ec2:DescribeSubnets",
-		"ec2:DescribeSecurityGroups",
-		"ec2:DescribeNatGateways",
-		"ec2:DescribeRouteTables",
-		"ecs:ListClusters",
-		"ecs:DescribeClusters",
-		"elasticloadbalancing:DescribeLoadBalancers",
-		"elasticloadbalancing:DescribeTargetGroups",
-		"s3:ListBuckets",
-		"sts:GetCallerIdentity",
-		"iam:ListAttachedUserPolicies",
-		"iam:ListAttachedRolePolicies",
-	}
+// VerifyPermissions simulates the actions of every feature of the registry for the
+// current principal
+func (c *Client) VerifyPermissions(ctx context.Context) ([]models.FeaturePermissions, error) {
+	requiredActions := registeredActions()
 
 	// 1. Get Current Identity
 	identity, err := c.stsClient.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
@@ -441,6 +519,7 @@ func (c *Client) VerifyPermissions(ctx context.Context) ([]models.PermissionStat
 
 	var policySourceArn string
 	arn := *identity.Arn
+	statuses := make(map[string]models.PermissionStatus, len(requiredActions))
 
 	// 2. Determine Policy Source ARN (Role or User)
 	if strings.Contains(arn, ":assumed-role/") {
@@ -454,50 +533,98 @@ func (c *Client) VerifyPermissions(ctx context.Context) ([]models.PermissionStat
 	} else if strings.Contains(arn, ":user/") {
 		policySourceArn = arn
 	} else {
-		// Fallback or root (root can do everything, but let's try strict check if possible or strict allow)
+		// Root can do everything, policies do not apply to it
 		if strings.HasSuffix(arn, ":root") {
-			// Root user
-			results := make([]models.PermissionStatus, len(requiredActions))
-			for i, action := range requiredActions {
-				results[i] = models.PermissionStatus{Action: action, Allowed: true, Reason: "Root User"}
+			for _, action := range requiredActions {
+				statuses[action] = models.PermissionStatus{Action: action, Allowed: true, Reason: "Root User"}
 			}
-			return results, nil
+			return groupPermissions(statuses), nil
 		}
 		return nil, fmt.Errorf("unsupported principal type: %s", arn)
 	}
 
-	// 3. Simulate Policy
-	// Note: We batch actions. Max items per call is likely enough for our list.
-	input := &iam.SimulatePrincipalPolicyInput{
-		PolicySourceArn: &policySourceArn,
-		ActionNames:     requiredActions,
-	}
-
-	output, err := c.iamClient.SimulatePrincipalPolicy(ctx, input)
-	if err != nil {
-		// Fallback: If we assume we don't have permission to simulate, we might return error
-		// But better to return a "Unknown" status or fail.
-		return nil, fmt.Errorf("failed to simulate policy (missing iam:SimulatePrincipalPolicy?): %w", err)
+	// 3. Simulate Policy, in batches to keep the requests small. The region the calls
+	// target is known, other condition keys are not and leave their actions undecided.
+	var simulationContext []iamTypes.ContextEntry
+	if c.region != "" {
+		simulationContext = append(simulationContext, iamTypes.ContextEntry{
+			ContextKeyName:   aws.String("aws:RequestedRegion"),
+			ContextKeyType:   iamTypes.ContextKeyTypeEnumString,
+			ContextKeyValues: []string{c.region},
+		})
 	}
-
-	results := make([]models.PermissionStatus, 0, len(output.EvaluationResults))
-	for _, eval := range output.EvaluationResults {
-		allowed := eval.EvalDecision == "allowed"
-		reason := "Implicit Deny"
-		if allowed {
-			reason = "Explicit Allow" // Simplified
-		} else {
-			// Try to find unmatched statements if useful, but 'Implicit Deny' is usually enough
+	for start := 0; start < len(requiredActions); start += permissionSimulationBatchSize {
+		end := start + permissionSimulationBatchSize
+		if end > len(requiredActions) {
+			end = len(requiredActions)
 		}
 
-		results = append(results, models.PermissionStatus{
-			Action:  *eval.EvalActionName,
-			Allowed: allowed,
-			Reason:  reason,
+		paginator := iam.NewSimulatePrincipalPolicyPaginator(c.iamClient, &iam.SimulatePrincipalPolicyInput{
+			PolicySourceArn: &policySourceArn,
+			ActionNames:     requiredActions[start:end],
+			ContextEntries:  simulationContext,
 		})
+		for paginator.HasMorePages() {
+			output, err := paginator.NextPage(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to simulate policy (missing iam:SimulatePrincipalPolicy?): %w", err)
+			}
+			for _, eval := range output.EvaluationResults {
+				status := models.PermissionStatus{Action: safeString(eval.EvalActionName), Reason: "Implicit Deny"}
+				switch eval.EvalDecision {
+				case iamTypes.PolicyEvaluationDecisionTypeAllowed:
+					status.Allowed = true
+					status.Reason = "Explicit Allow"
+				case iamTypes.PolicyEvaluationDecisionTypeExplicitDeny:
+					status.Reason = "Explicit Deny"
+				}
+				// A condition on a missing key, such as aws:MultiFactorAuthPresent, may
+				// allow the actual call: only a definite deny counts
+				if !status.Allowed && len(eval.MissingContextValues) > 0 {
+					status.Unknown = true
+					status.Reason = "Depends on " + strings.Join(eval.MissingContextValues, ", ")
+				}
+				statuses[status.Action] = status
+			}
+		}
 	}
 
-	return results, nil
+	return groupPermissions(statuses), nil
+}
+
+// groupPermissions groups the simulated actions by feature, in registry order. Actions
+// missing from the simulation are reported as denied, undecided ones are not. Denied
+// optional actions only degrade their feature.
+func groupPermissions(statuses map[string]models.PermissionStatus) []models.FeaturePermissions {
+	features := make([]models.FeaturePermissions, 0, len(featureRegistry))
+	for _, feature := range featureRegistry {
+		group := models.FeaturePermissions{
+			Feature:     feature.key,
+			Label:       feature.label,
+			Allowed:     true,
+			Missing:     make([]string, 0),
+			Degraded:    make([]string, 0),
+			Permissions: make([]models.PermissionStatus, 0),
+		}
+		for _, action := range feature.actions() {
+			status, ok := statuses[action]
+			if !ok {
+				status = models.PermissionStatus{Action: action, Reason: "Not Simulated"}
+			}
+			status.Optional = feature.optional(action)
+			switch {
+			case status.Allowed || status.Unknown:
+			case status.Optional:
+				group.Degraded = append(group.Degraded, action)
+			default:
+				group.Allowed = false
+				group.Missing = append(group.Missing, action)
+			}
+			group.Permissions = append(group.Permissions, status)
+		}
+		features = append(features, group)
+	}
+	return features
 }
 
 // FetchResourceMetrics gets metrics for a specific AWS resource
@@ -505,60 +632,24 @@ func (c *Client) FetchResourceMetrics(ctx context.Context, namespace, metricName
 	endTime := time.Now()
 	startTime := endTime.Add(-24 * time.Hour)
 
-	var cwDimensions []cwTypes.Dimension
-	for k, v := range dimensions {
-		cwDimensions = append(cwDimensions, cwTypes.Dimension{
-			Name:  aws.String(k),
-			Value: aws.String(v),
-		})
-	}
-
-	input := &cloudwatch.GetMetricDataInput{
-		MetricDataQueries: []cwTypes.MetricDataQuery{
-			{
-				Id: aws.String("m1"),
-				MetricStat: &cwTypes.MetricStat{
-					Metric: &cwTypes.Metric{
-						Namespace:  aws.String(namespace),
-						MetricName: aws.String(metricName),
-						Dimensions: cwDimensions,
-					},
-					Period: aws.Int32(period),
-					Stat:   aws.String("Average"),
-				},
-				ReturnData: aws.Bool(true),
-			},
+	queries := []models.MetricQuery{
+		{
+			ID:         MetricQueryID(metricName, "Average"),
+			Label:      metricName,
+			Namespace:  namespace,
+			MetricName: metricName,
+			Dimensions: dimensions,
+			Stat:       "Average",
+			Period:     period,
 		},
-		StartTime: aws.Time(startTime),
-		EndTime:   aws.Time(endTime),
 	}
 
-	output, err := c.cwClient.GetMetricData(ctx, input)
+	data, err := c.FetchMetricQueries(ctx, queries, startTime, endTime)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get metric data: %w", err)
+		return nil, err
 	}
 
-	metrics := &models.ResourceMetrics{
-		Metrics: make([]models.MetricData, 0),
-	}
-
-	for _, result := range output.MetricDataResults {
-		values := result.Values
-		if values == nil {
-			values = []float64{}
-		}
-		data := models.MetricData{
-			Label:  aws.ToString(result.Label),
-			Values: values,
-			Times:  make([]string, len(result.Timestamps)),
-		}
-		for i, ts := range result.Timestamps {
-			data.Times[i] = ts.Format("2006-01-02 15:04")
-		}
-		metrics.Metrics = append(metrics.Metrics, data)
-	}
-
-	return metrics, nil
+	return &models.ResourceMetrics{Metrics: data}, nil
 }
 
 // FetchAccountHomeInfo gathers comprehensive account metadata and cost information
@@ -568,26 +659,10 @@ func (c *Client) FetchAccountHomeInfo(ctx context.Context) (*models.AccountHomeI
 		Currency: constants.AWSCurrency,
 	}
 
-	// 1. Get Caller Identity (AccountID, UserARN)
-	identity, err := c.stsClient.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
-	if err == nil && identity != nil {
-		info.AccountID = safeString(identity.Account)
-		info.UserARN = safeString(identity.Arn)
-	}
-
-	// 2. Get Account Alias
-	aliases, err := c.iamClient.ListAccountAliases(ctx, &iam.ListAccountAliasesInput{})
-	if err == nil && aliases != nil && len(aliases.AccountAliases) > 0 {
-		info.AccountAlias = aliases.AccountAliases[0]
-	}
-
-	// 3. MFA Status
-	mfa, err := c.iamClient.ListMFADevices(ctx, &iam.ListMFADevicesInput{})
-	if err == nil && mfa != nil {
-		info.MFAEnabled = len(mfa.MFADevices) > 0
-	}
+	// 1. Account ID, user, alias and MFA status
+	c.fetchAccountIdentity(ctx, info)
 
-	// 4. Costs (Yesterday, MTD, Last Month)
+	// 2. Costs (Yesterday, MTD, Last Month)
 	now := time.Now().UTC()
 	today := now.Format(constants.DateFormat)
 	yesterday := now.AddDate(0, 0, -1).Format(constants.DateFormat)
@@ -598,19 +673,28 @@ func (c *Client) FetchAccountHomeInfo(ctx context.Context) (*models.AccountHomeI
 	lastMonthStart := time.Date(lastMonthTime.Year(), lastMonthTime.Month(), 1, 0, 0, 0, 0, time.UTC).Format(constants.DateFormat)
 	lastMonthEnd := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC).Format(constants.DateFormat)
 
-	// Yesterday's Cost
-	yCost, _ := c.getCost(ctx, yesterday, today)
-	info.CostYesterday = yCost
+	// Cost Explorer is skipped once known to be denied or not enabled, as every call
+	// would fail the same way
+	info.CostsEnabled = !c.isDenied("ce:GetCostAndUsage")
+	if info.CostsEnabled {
+		// Yesterday's Cost
+		yCost, err := c.getCost(ctx, yesterday, today)
+		if err != nil && c.recordUnavailable("ce:GetCostAndUsage", err) {
+			info.CostsEnabled = false
+		} else {
+			info.CostYesterday = yCost
 
-	// MTD Cost
-	mtdCost, _ := c.getCost(ctx, firstOfMonth, today)
-	info.CostMonthToDate = mtdCost
+			// MTD Cost
+			mtdCost, _ := c.getCost(ctx, firstOfMonth, today)
+			info.CostMonthToDate = mtdCost
 
-	// Last Month Cost
-	lmCost, _ := c.getCost(ctx, lastMonthStart, lastMonthEnd)
-	info.CostLastMonth = lmCost
+			// Last Month Cost
+			lmCost, _ := c.getCost(ctx, lastMonthStart, lastMonthEnd)
+			info.CostLastMonth = lmCost
+		}
+	}
 
-	// 5. Service Usage & Quotas
+	// 3. Service Usage & Quotas
 	info.VPCUsage = c.getUsageCount(ctx, "vpc")
 	info.InstanceUsage = c.getUsageCount(ctx, "ec2")
 	info.EIPUsage = c.getUsageCount(ctx, "eip")
@@ -626,15 +710,18 @@ func (c *Client) FetchAccountHomeInfo(ctx context.Context) (*models.AccountHomeI
 	info.LambdaLimit = c.getQuota(ctx, "lambda", constants.QuotaCodeLambda, constants.DefaultLimitLambda)
 	info.S3Limit = c.getQuota(ctx, "s3", constants.QuotaCodeS3, constants.DefaultLimitS3)
 
-	// 6. Security Findings (Critical & High)
-	findings, err := c.FetchSecurityFindings(ctx)
-	info.TopFindings = findings
-	info.SecurityHubEnabled = true
-	if err != nil && strings.Contains(err.Error(), "SubscriptionRequiredException") {
-		info.SecurityHubEnabled = false
+	// 4. Security Findings (Critical & High)
+	info.TopFindings = []models.SecurityFinding{}
+	info.SecurityHubEnabled = !c.isDenied("securityhub:GetFindings")
+	if info.SecurityHubEnabled {
+		findings, err := c.FetchSecurityFindings(ctx)
+		info.TopFindings = findings
+		if err != nil && (c.recordUnavailable("securityhub:GetFindings", err) || strings.Contains(err.Error(), "SubscriptionRequiredException")) {
+			info.SecurityHubEnabled = false
+		}
 	}
 
-	for _, f := range findings {
+	for _, f := range info.TopFindings {
 		if f.Severity == "CRITICAL" {
 			info.CriticalFindings++
 		} else if f.Severity == "HIGH" {
@@ -642,23 +729,71 @@ func (c *Client) FetchAccountHomeInfo(ctx context.Context) (*models.AccountHomeI
 		}
 	}
 
-	// 7. Trusted Advisor Recommendations
-	recs, err := c.FetchTrustedAdvisorRecommendations(ctx)
-	info.Recommendations = recs
-	info.SupportAccessEnabled = true
-	if err != nil && (strings.Contains(err.Error(), "SubscriptionRequiredException") || strings.Contains(err.Error(), "AccessDenied")) {
-		info.SupportAccessEnabled = false
+	// 5. Trusted Advisor Recommendations, which need a Business or Enterprise support plan
+	info.Recommendations = []models.TrustedAdvisorRecommendation{}
+	info.SupportAccessEnabled = !c.isDenied("support:DescribeTrustedAdvisorCheckResult")
+	if info.SupportAccessEnabled {
+		recs, err := c.FetchTrustedAdvisorRecommendations(ctx)
+		if err != nil {
+			c.recordUnavailable("support:DescribeTrustedAdvisorCheckResult", err)
+			info.SupportAccessEnabled = false
+		} else {
+			info.Recommendations = recs
+		}
 	}
 
-	for _, r := range recs {
+	for _, r := range info.Recommendations {
 		if r.Category == "Cost Optimization" && r.Status == "Red" {
 			info.PotentialSavings += r.EstimatedSavings
 		}
 	}
 
+	// 6. Alarms currently firing
+	info.AlarmsInAlarm = []models.AlarmInfo{}
+	info.AlarmsEnabled = !c.isDenied("cloudwatch:DescribeAlarms")
+	if info.AlarmsEnabled {
+		alarms, err := c.FetchAlarms(ctx, string(cwTypes.StateValueAlarm))
+		if err != nil {
+			c.recordUnavailable("cloudwatch:DescribeAlarms", err)
+			info.AlarmsEnabled = false
+			fmt.Printf("Warning: Could not fetch CloudWatch alarms: %v\n", err)
+		} else {
+			info.AlarmsInAlarm = alarms
+		}
+	}
+
 	return info, nil
 }
 
+// fetchAccountIdentity sets the account ID, user ARN, account alias and MFA status
+// of the home info
+func (c *Client) fetchAccountIdentity(ctx context.Context, info *models.AccountHomeInfo) {
+	// Get Caller Identity (AccountID, UserARN)
+	identity, err := c.stsClient.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err == nil && identity != nil {
+		info.AccountID = safeString(identity.Account)
+		info.UserARN = safeString(identity.Arn)
+	}
+
+	// Get Account Alias
+	if !c.isDenied("iam:ListAccountAliases") {
+		aliases, err := c.iamClient.ListAccountAliases(ctx, &iam.ListAccountAliasesInput{})
+		if err == nil && aliases != nil && len(aliases.AccountAliases) > 0 {
+			info.AccountAlias = aliases.AccountAliases[0]
+		}
+		c.recordUnavailable("iam:ListAccountAliases", err)
+	}
+
+	// MFA Status
+	if !c.isDenied("iam:ListMFADevices") {
+		mfa, err := c.iamClient.ListMFADevices(ctx, &iam.ListMFADevicesInput{})
+		if err == nil && mfa != nil {
+			info.MFAEnabled = len(mfa.MFADevices) > 0
+		}
+		c.recordUnavailable("iam:ListMFADevices", err)
+	}
+}
+
 // FetchSecurityFindings retrieves the top high-severity security issues
 func (c *Client) FetchSecurityFindings(ctx context.Context) ([]models.SecurityFinding, error) {
 	// Filter for CRITICAL and HIGH severity
@@ -718,7 +853,10 @@ func (c *Client) FetchTrustedAdvisorRecommendations(ctx context.Context) ([]mode
 		})
 
 		if err != nil {
-			// Handle AccessDenied for basic support plans
+			// Basic support plans are denied every check, the others may lack a single one
+			if unavailableReason(err) != "" {
+				return nil, fmt.Errorf("failed to describe trusted advisor check: %w", err)
+			}
 			continue
 		}
 
@@ -746,8 +884,22 @@ func (c *Client) FetchTrustedAdvisorRecommendations(ctx context.Context) ([]mode
 	return recommendations, nil
 }
 
+// usageActions are the IAM actions getUsageCount calls for each service
+var usageActions = map[string]string{
+	"vpc":    "ec2:DescribeVpcs",
+	"ec2":    "ec2:DescribeInstances",
+	"eip":    "ec2:DescribeAddresses",
+	"nat":    "ec2:DescribeNatGateways",
+	"lambda": "lambda:ListFunctions",
+	"s3":     "s3:ListAllMyBuckets",
+}
+
 // getUsageCount is a helper to count active resources
 func (c *Client) getUsageCount(ctx context.Context, service string) int {
+	if c.isDenied(usageActions[service]) {
+		return 0
+	}
+
 	switch service {
 	case "vpc":
 		vpcs, err := c.ec2Client.DescribeVpcs(ctx, &ec2.DescribeVpcsInput{})
@@ -797,6 +949,10 @@ func (c *Client) getUsageCount(ctx context.Context, service string) int {
 
 // getQuota is a helper to fetch a specific service quota with a fallback
 func (c *Client) getQuota(ctx context.Context, serviceCode, quotaCode string, fallback int) int {
+	if c.isDenied("servicequotas:GetServiceQuota") {
+		return fallback
+	}
+
 	quota, err := c.sqClient.GetServiceQuota(ctx, &servicequotas.GetServiceQuotaInput{
 		ServiceCode: aws.String(serviceCode),
 		QuotaCode:   aws.String(quotaCode),
@@ -804,6 +960,7 @@ func (c *Client) getQuota(ctx context.Context, serviceCode, quotaCode string, fa
 	if err == nil && quota != nil && quota.Quota != nil {
 		return int(*quota.Quota.Value)
 	}
+	c.recordUnavailable("servicequotas:GetServiceQuota", err)
 	return fallback
 }
 
@@ -23,11 +23,15 @@ import (
 	rdsTypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	s3Types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub"
 	"github.com/aws/aws-sdk-go-v2/service/servicequotas"
 	servicequotasTypes "github.com/aws/aws-sdk-go-v2/service/servicequotas/types"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/smithy-go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+
+	"aws-terminal-sdk-v1/internal/constants"
 )
 
 func TestFetchVPCs(t *testing.T) {
@@ -85,6 +89,7 @@ func TestFetchEC2Instances(t *testing.T) {
 						},
 						Tags: []ec2Types.Tag{
 							{Key: aws.String("Name"), Value: aws.String("MyServer")},
+							{Key: aws.String("aws:autoscaling:groupName"), Value: aws.String("web")},
 						},
 						LaunchTime: aws.Time(now),
 					},
@@ -100,6 +105,7 @@ func TestFetchEC2Instances(t *testing.T) {
 	assert.Equal(t, "t2.micro", instances[0].InstanceType)
 	assert.Equal(t, "running", instances[0].State)
 	assert.Equal(t, "MyServer", instances[0].Name)
+	assert.Equal(t, "web", instances[0].AutoScalingGroup)
 }
 
 func TestFetchECSClusters(t *testing.T) {
@@ -270,6 +276,7 @@ func TestFetchRDSInstances(t *testing.T) {
 				DBInstanceClass:      aws.String("db.t3.micro"),
 				DBInstanceStatus:     aws.String("available"),
 				Engine:               aws.String("postgres"),
+				DBClusterIdentifier:  aws.String("test-cluster"),
 			},
 		},
 	}, nil).Once()
@@ -278,6 +285,7 @@ func TestFetchRDSInstances(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Len(t, instances, 1)
 	assert.Equal(t, "test-db", instances[0].DBInstanceIdentifier)
+	assert.Equal(t, "test-cluster", instances[0].DBClusterIdentifier)
 }
 
 func TestFetchLambdaFunctions(t *testing.T) {
@@ -299,6 +307,7 @@ func TestFetchLambdaFunctions(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Len(t, funcs, 1)
 	assert.Equal(t, "test-func", funcs[0].FunctionName)
+	assert.Equal(t, "/aws/lambda/test-func", funcs[0].LogGroup)
 }
 func TestFetchAccountHomeInfo(t *testing.T) {
 	mockSTS := new(MockSTSClient)
@@ -308,15 +317,21 @@ func TestFetchAccountHomeInfo(t *testing.T) {
 	mockEC2 := new(MockEC2Client)
 	mockLambda := new(MockLambdaClient)
 	mockS3 := new(MockS3Client)
+	mockSH := new(MockSecurityHubClient)
+	mockSupport := new(MockSupportClient)
+	mockCW := new(MockCloudWatchClient)
 
 	client := &Client{
-		stsClient:    mockSTS,
-		iamClient:    mockIAM,
-		ceClient:     mockCE,
-		sqClient:     mockSQ,
-		ec2Client:    mockEC2,
-		lambdaClient: mockLambda,
-		s3Client:     mockS3,
+		stsClient:     mockSTS,
+		iamClient:     mockIAM,
+		ceClient:      mockCE,
+		sqClient:      mockSQ,
+		ec2Client:     mockEC2,
+		lambdaClient:  mockLambda,
+		s3Client:      mockS3,
+		shClient:      mockSH,
+		supportClient: mockSupport,
+		cwClient:      mockCW,
 	}
 
 	// Mock STS
@@ -355,6 +370,17 @@ func TestFetchAccountHomeInfo(t *testing.T) {
 	// Mock S3
 	mockS3.On("ListBuckets", mock.Anything, mock.Anything, mock.Anything).Return(&s3.ListBucketsOutput{}, nil)
 
+	// Mock Security Hub & Support (Basic plan: no Trusted Advisor access)
+	mockSH.On("GetFindings", mock.Anything, mock.Anything, mock.Anything).Return(&securityhub.GetFindingsOutput{}, nil)
+	mockSupport.On("DescribeTrustedAdvisorCheckResult", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("SubscriptionRequiredException"))
+
+	// Mock CloudWatch alarms
+	mockCW.On("DescribeAlarms", mock.Anything, mock.MatchedBy(func(in *cloudwatch.DescribeAlarmsInput) bool {
+		return in.StateValue == cwTypes.StateValueAlarm
+	}), mock.Anything).Return(&cloudwatch.DescribeAlarmsOutput{
+		MetricAlarms: []cwTypes.MetricAlarm{{AlarmName: aws.String("high-cpu"), StateValue: cwTypes.StateValueAlarm}},
+	}, nil)
+
 	info, err := client.FetchAccountHomeInfo(context.Background())
 	assert.NoError(t, err)
 	assert.NotNil(t, info)
@@ -362,4 +388,80 @@ func TestFetchAccountHomeInfo(t *testing.T) {
 	assert.Equal(t, "test-alias", info.AccountAlias)
 	assert.True(t, info.MFAEnabled)
 	assert.Equal(t, 10, info.VPCLimit)
+	assert.True(t, info.AlarmsEnabled)
+	assert.Len(t, info.AlarmsInAlarm, 1)
+}
+
+func TestFetchAccountHomeInfoSkipsDenied(t *testing.T) {
+	mockSTS := new(MockSTSClient)
+	mockIAM := new(MockIAMClient)
+	mockCE := new(MockCostExplorerClient)
+	mockSQ := new(MockServiceQuotasClient)
+	mockEC2 := new(MockEC2Client)
+	mockLambda := new(MockLambdaClient)
+	mockS3 := new(MockS3Client)
+	mockSH := new(MockSecurityHubClient)
+	mockSupport := new(MockSupportClient)
+	mockCW := new(MockCloudWatchClient)
+
+	client := &Client{
+		stsClient:     mockSTS,
+		iamClient:     mockIAM,
+		ceClient:      mockCE,
+		sqClient:      mockSQ,
+		ec2Client:     mockEC2,
+		lambdaClient:  mockLambda,
+		s3Client:      mockS3,
+		shClient:      mockSH,
+		supportClient: mockSupport,
+		cwClient:      mockCW,
+	}
+
+	// Denied by the permission simulation: none of these may be called
+	client.DenyActions(map[string]string{
+		"iam:ListAccountAliases":        "denied by IAM policy",
+		"iam:ListMFADevices":            "denied by IAM policy",
+		"ce:GetCostAndUsage":            "denied by IAM policy",
+		"ec2:DescribeVpcs":              "denied by IAM policy",
+		"ec2:DescribeInstances":         "denied by IAM policy",
+		"ec2:DescribeAddresses":         "denied by IAM policy",
+		"ec2:DescribeNatGateways":       "denied by IAM policy",
+		"lambda:ListFunctions":          "denied by IAM policy",
+		"s3:ListAllMyBuckets":           "denied by IAM policy",
+		"servicequotas:GetServiceQuota": "denied by IAM policy",
+		"cloudwatch:DescribeAlarms":     "denied by IAM policy",
+	})
+
+	mockSTS.On("GetCallerIdentity", mock.Anything, mock.Anything, mock.Anything).Return(&sts.GetCallerIdentityOutput{
+		Account: aws.String("123456789012"),
+	}, nil)
+	mockSH.On("GetFindings", mock.Anything, mock.Anything, mock.Anything).Return(nil, &smithy.GenericAPIError{
+		Code: "InvalidAccessException", Message: "Account is not subscribed to AWS Security Hub",
+	}).Once()
+	mockSupport.On("DescribeTrustedAdvisorCheckResult", mock.Anything, mock.Anything, mock.Anything).Return(nil, &smithy.GenericAPIError{
+		Code: "SubscriptionRequiredException", Message: "AWS Premium Support Subscription is required",
+	}).Once()
+
+	info, err := client.FetchAccountHomeInfo(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "123456789012", info.AccountID)
+	assert.False(t, info.CostsEnabled)
+	assert.False(t, info.SecurityHubEnabled)
+	assert.False(t, info.SupportAccessEnabled)
+	assert.False(t, info.AlarmsEnabled)
+	assert.Equal(t, constants.DefaultLimitVPC, info.VPCLimit)
+	assert.NotNil(t, info.Recommendations)
+	assert.NotNil(t, info.AlarmsInAlarm)
+
+	// Trusted Advisor stops at the first check, and both failures are remembered
+	mockSupport.AssertNumberOfCalls(t, "DescribeTrustedAdvisorCheckResult", 1)
+	denied := client.DeniedActions()
+	assert.Equal(t, "requires a subscription", denied["support:DescribeTrustedAdvisorCheckResult"])
+	assert.Equal(t, "not enabled for the account", denied["securityhub:GetFindings"])
+
+	// The next refresh does not call them again
+	_, err = client.FetchAccountHomeInfo(context.Background())
+	assert.NoError(t, err)
+	mockSH.AssertNumberOfCalls(t, "GetFindings", 1)
+	mockSupport.AssertNumberOfCalls(t, "DescribeTrustedAdvisorCheckResult", 1)
 }
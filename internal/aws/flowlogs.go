@@ -0,0 +1,185 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2Types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"aws-terminal-sdk-v1/internal/constants"
+	"aws-terminal-sdk-v1/internal/models"
+)
+
+const (
+	// flowLogDefaultRange is the analysis window used when none is given
+	flowLogDefaultRange = time.Hour
+	// flowLogTopLimit caps the rows of each top-talkers section
+	flowLogTopLimit = 20
+)
+
+// Logs Insights queries over the default flow log format fields
+var (
+	flowLogTopTalkersQuery = fmt.Sprintf(
+		"stats sum(bytes) as bytes, sum(packets) as packets by srcAddr, dstAddr | sort bytes desc | limit %d", flowLogTopLimit)
+	flowLogRejectedQuery = fmt.Sprintf(
+		`filter action = "REJECT" | stats count(*) as flows by srcAddr, dstAddr, dstPort, protocol | sort flows desc | limit %d`, flowLogTopLimit)
+	flowLogBytesByPortQuery = fmt.Sprintf(
+		"stats sum(bytes) as bytes by dstPort, protocol | sort bytes desc | limit %d", flowLogTopLimit)
+)
+
+// FetchFlowLogs gets the flow logs of the VPCs, subnets and network interfaces
+func (c *Client) FetchFlowLogs(ctx context.Context) ([]models.FlowLogInfo, error) {
+	flowLogs := make([]models.FlowLogInfo, 0)
+	paginator := ec2.NewDescribeFlowLogsPaginator(c.ec2Client, &ec2.DescribeFlowLogsInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe flow logs: %w", err)
+		}
+
+		for _, fl := range output.FlowLogs {
+			flowLogs = append(flowLogs, models.FromAWSFlowLog(fl))
+		}
+	}
+
+	return flowLogs, nil
+}
+
+// FetchFlowLogFindings reports a finding for each VPC without an active VPC-level flow log.
+// Subnet and network interface flow logs only cover part of the traffic and do not clear it.
+func (c *Client) FetchFlowLogFindings(ctx context.Context) ([]models.SecurityFinding, error) {
+	vpcs, err := c.FetchVPCs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	flowLogs, err := c.FetchFlowLogs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	covered := make(map[string]bool)
+	for _, fl := range flowLogs {
+		if fl.ResourceType == "VPC" && fl.Status == "ACTIVE" {
+			covered[fl.ResourceID] = true
+		}
+	}
+
+	now := time.Now().Format(constants.DateTimeFormat)
+	findings := make([]models.SecurityFinding, 0)
+	for _, vpc := range vpcs {
+		if covered[vpc.ID] {
+			continue
+		}
+
+		findings = append(findings, models.SecurityFinding{
+			Title:      "No active VPC-level flow log captures the traffic of this VPC",
+			Severity:   constants.SecurityHubSeverityMedium,
+			ResourceID: vpc.ID,
+			Category:   "Flow logs",
+			UpdatedAt:  now,
+		})
+	}
+
+	return findings, nil
+}
+
+// flowLogWindow parses the analysis window of a flow log summary. It accepts the same
+// range keys as the metrics, since Go durations have no day unit.
+func flowLogWindow(timeRange string) (time.Duration, error) {
+	if timeRange == "" {
+		return flowLogDefaultRange, nil
+	}
+	if r, ok := metricRanges[timeRange]; ok {
+		return r.Duration, nil
+	}
+	window, err := time.ParseDuration(timeRange)
+	if err != nil || window <= 0 {
+		return 0, fmt.Errorf("invalid time range %q", timeRange)
+	}
+	return window, nil
+}
+
+// FetchFlowLogSummary runs Logs Insights queries against the CloudWatch Logs flow log
+// of a VPC for its top talkers, rejected traffic and bytes by port over the last
+// timeRange (a metric range such as "24h" or "7d", or a duration such as "15m", one hour
+// when empty).
+func (c *Client) FetchFlowLogSummary(ctx context.Context, vpcID, timeRange string) (*models.FlowLogSummary, error) {
+	window, err := flowLogWindow(timeRange)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := c.ec2Client.DescribeFlowLogs(ctx, &ec2.DescribeFlowLogsInput{
+		Filter: []ec2Types.Filter{
+			{Name: aws.String("resource-id"), Values: []string{vpcID}},
+			{Name: aws.String("log-destination-type"), Values: []string{string(ec2Types.LogDestinationTypeCloudWatchLogs)}},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe flow logs: %w", err)
+	}
+
+	var flowLog *models.FlowLogInfo
+	for _, fl := range output.FlowLogs {
+		info := models.FromAWSFlowLog(fl)
+		if info.LogGroup != "" && (flowLog == nil || info.Status == "ACTIVE") {
+			flowLog = &info
+		}
+	}
+	if flowLog == nil {
+		return nil, fmt.Errorf("VPC %s has no flow log delivered to CloudWatch Logs", vpcID)
+	}
+
+	end := time.Now().UTC()
+	summary := &models.FlowLogSummary{
+		VPCID:     vpcID,
+		FlowLogID: flowLog.ID,
+		LogGroup:  flowLog.LogGroup,
+		Start:     end.Add(-window).Format(time.RFC3339),
+		End:       end.Format(time.RFC3339),
+		Errors:    make([]string, 0),
+	}
+
+	queries := []string{flowLogTopTalkersQuery, flowLogRejectedQuery, flowLogBytesByPortQuery}
+	results := make([]*models.LogsInsightsResult, len(queries))
+	errs := make([]error, len(queries))
+
+	var wg sync.WaitGroup
+	for i, query := range queries {
+		wg.Add(1)
+		go func(i int, query string) {
+			defer wg.Done()
+			results[i], errs[i] = c.RunLogsInsightsQuery(ctx, models.LogsInsightsRequest{
+				LogGroups: []string{flowLog.LogGroup},
+				Query:     query,
+				Start:     summary.Start,
+				End:       summary.End,
+				Limit:     flowLogTopLimit,
+			})
+		}(i, query)
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			summary.Errors = append(summary.Errors, err.Error())
+			continue
+		}
+		for _, row := range results[i].Rows {
+			switch i {
+			case 0:
+				summary.TopTalkers = append(summary.TopTalkers, models.FlowLogTalkerFromRow(row))
+			case 1:
+				summary.Rejected = append(summary.Rejected, models.FlowLogRejectedFromRow(row))
+			case 2:
+				summary.BytesByPort = append(summary.BytesByPort, models.FlowLogPortBytesFromRow(row))
+			}
+		}
+	}
+
+	return summary, nil
+}
@@ -0,0 +1,135 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	logsTypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2Types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFetchFlowLogFindings(t *testing.T) {
+	mockEC2 := new(MockEC2Client)
+	client := &Client{ec2Client: mockEC2}
+
+	mockEC2.On("DescribeVpcs", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeVpcsOutput{
+		Vpcs: []ec2Types.Vpc{{VpcId: aws.String("vpc-1")}, {VpcId: aws.String("vpc-2")}, {VpcId: aws.String("vpc-3")}},
+	}, nil)
+	mockEC2.On("DescribeFlowLogs", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeFlowLogsOutput{
+		FlowLogs: []ec2Types.FlowLog{
+			{FlowLogId: aws.String("fl-1"), ResourceId: aws.String("vpc-1"), FlowLogStatus: aws.String("ACTIVE")},
+			// Subnet-level coverage does not clear the VPC finding
+			{FlowLogId: aws.String("fl-2"), ResourceId: aws.String("subnet-9"), FlowLogStatus: aws.String("ACTIVE")},
+		},
+	}, nil)
+
+	findings, err := client.FetchFlowLogFindings(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, findings, 2)
+	assert.Equal(t, "vpc-2", findings[0].ResourceID)
+	assert.Equal(t, "vpc-3", findings[1].ResourceID)
+	assert.Equal(t, "MEDIUM", findings[0].Severity)
+	assert.Equal(t, "Flow logs", findings[0].Category)
+}
+
+func TestFlowLogWindow(t *testing.T) {
+	for timeRange, want := range map[string]time.Duration{
+		"":    time.Hour,
+		"15m": 15 * time.Minute,
+		"24h": 24 * time.Hour,
+		"7d":  7 * 24 * time.Hour,
+	} {
+		window, err := flowLogWindow(timeRange)
+		assert.NoError(t, err, timeRange)
+		assert.Equal(t, want, window, timeRange)
+	}
+
+	for _, timeRange := range []string{"soon", "-1h", "0s"} {
+		_, err := flowLogWindow(timeRange)
+		assert.Error(t, err, timeRange)
+	}
+}
+
+func TestFetchFlowLogSummary(t *testing.T) {
+	mockEC2 := new(MockEC2Client)
+	mockLogs := new(MockCloudWatchLogsClient)
+	client := &Client{ec2Client: mockEC2, logsClient: mockLogs}
+
+	mockEC2.On("DescribeFlowLogs", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeFlowLogsOutput{
+		FlowLogs: []ec2Types.FlowLog{
+			{
+				FlowLogId:          aws.String("fl-1"),
+				ResourceId:         aws.String("vpc-1"),
+				FlowLogStatus:      aws.String("ACTIVE"),
+				LogDestinationType: ec2Types.LogDestinationTypeCloudWatchLogs,
+				LogGroupName:       aws.String("/vpc/flow"),
+			},
+		},
+	}, nil)
+
+	startQuery := func(id, fragment string) {
+		mockLogs.On("StartQuery", mock.Anything, mock.MatchedBy(func(in *cloudwatchlogs.StartQueryInput) bool {
+			return strings.Contains(aws.ToString(in.QueryString), fragment) &&
+				aws.ToInt64(in.EndTime)-aws.ToInt64(in.StartTime) == 900
+		}), mock.Anything).Return(&cloudwatchlogs.StartQueryOutput{QueryId: aws.String(id)}, nil)
+	}
+	startQuery("q-talkers", "sum(packets)")
+	startQuery("q-rejected", `"REJECT"`)
+	mockLogs.On("StartQuery", mock.Anything, mock.MatchedBy(func(in *cloudwatchlogs.StartQueryInput) bool {
+		return strings.Contains(aws.ToString(in.QueryString), "by dstPort")
+	}), mock.Anything).Return(nil, errors.New("LimitExceededException"))
+
+	field := func(name, value string) logsTypes.ResultField {
+		return logsTypes.ResultField{Field: aws.String(name), Value: aws.String(value)}
+	}
+	mockLogs.On("GetQueryResults", mock.Anything, mock.MatchedBy(func(in *cloudwatchlogs.GetQueryResultsInput) bool {
+		return aws.ToString(in.QueryId) == "q-talkers"
+	}), mock.Anything).Return(&cloudwatchlogs.GetQueryResultsOutput{
+		Status: logsTypes.QueryStatusComplete,
+		Results: [][]logsTypes.ResultField{
+			{field("srcAddr", "10.0.1.5"), field("dstAddr", "10.0.2.9"), field("bytes", "1.2e6"), field("packets", "900")},
+		},
+	}, nil)
+	mockLogs.On("GetQueryResults", mock.Anything, mock.MatchedBy(func(in *cloudwatchlogs.GetQueryResultsInput) bool {
+		return aws.ToString(in.QueryId) == "q-rejected"
+	}), mock.Anything).Return(&cloudwatchlogs.GetQueryResultsOutput{
+		Status: logsTypes.QueryStatusComplete,
+		Results: [][]logsTypes.ResultField{
+			{field("srcAddr", "198.51.100.7"), field("dstAddr", "10.0.1.5"), field("dstPort", "22"), field("protocol", "6"), field("flows", "42")},
+		},
+	}, nil)
+
+	summary, err := client.FetchFlowLogSummary(context.Background(), "vpc-1", "15m")
+	assert.NoError(t, err)
+	assert.Equal(t, "/vpc/flow", summary.LogGroup)
+	assert.Len(t, summary.TopTalkers, 1)
+	assert.Equal(t, int64(1200000), summary.TopTalkers[0].Bytes)
+	assert.Len(t, summary.Rejected, 1)
+	assert.Equal(t, "TCP", summary.Rejected[0].Protocol)
+	assert.Equal(t, int64(42), summary.Rejected[0].Flows)
+	// The failed query is reported without dropping the others
+	assert.Empty(t, summary.BytesByPort)
+	assert.Len(t, summary.Errors, 1)
+
+	_, err = client.FetchFlowLogSummary(context.Background(), "vpc-1", "soon")
+	assert.Error(t, err)
+}
+
+func TestFetchFlowLogSummary_NoCloudWatchFlowLog(t *testing.T) {
+	mockEC2 := new(MockEC2Client)
+	client := &Client{ec2Client: mockEC2}
+
+	mockEC2.On("DescribeFlowLogs", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeFlowLogsOutput{}, nil)
+
+	_, err := client.FetchFlowLogSummary(context.Background(), "vpc-1", "")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "no flow log")
+}
@@ -0,0 +1,256 @@
+package aws
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamTypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+
+	"aws-terminal-sdk-v1/internal/constants"
+	"aws-terminal-sdk-v1/internal/models"
+)
+
+const (
+	// credentialReportPollInterval is the delay between two checks of the credential
+	// report generation, which takes a few seconds
+	credentialReportPollInterval = 2 * time.Second
+	// iamRoleLookupConcurrency bounds the parallel GetRole calls, ListRoles does not
+	// return the last use of the roles
+	iamRoleLookupConcurrency = 5
+)
+
+// FetchIAMHygiene reports users without MFA, old or unused access keys, inactive
+// console users, unused roles and password policy gaps
+func (c *Client) FetchIAMHygiene(ctx context.Context, req models.IAMHygieneRequest) (*models.IAMHygieneReport, error) {
+	req = req.WithDefaults()
+
+	users, generatedAt, err := c.fetchCredentialReport(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().Format(constants.DateTimeFormat)
+	report := &models.IAMHygieneReport{
+		GeneratedAt: generatedAt,
+		Thresholds:  req,
+		Users:       users,
+		Roles:       make([]models.IAMRoleInfo, 0),
+		Findings:    make([]models.SecurityFinding, 0),
+		Errors:      make([]string, 0),
+	}
+	finding := func(title, severity, resourceID, category string) {
+		report.Findings = append(report.Findings, models.SecurityFinding{
+			Title:      title,
+			Severity:   severity,
+			ResourceID: resourceID,
+			Category:   category,
+			UpdatedAt:  now,
+		})
+	}
+
+	for i := range report.Users {
+		user := &report.Users[i]
+		for _, issue := range userIssues(user, req) {
+			user.Issues = append(user.Issues, issue.title)
+			finding(issue.title, issue.severity, user.UserName, issue.category)
+			switch issue.category {
+			case "MFA":
+				report.UsersWithoutMFA++
+			case "Console access":
+				report.InactiveConsoleUsers++
+			case "Access key age":
+				report.OldAccessKeys++
+			case "Unused access key":
+				report.UnusedAccessKeys++
+			}
+		}
+	}
+
+	roles, err := c.fetchIAMRoles(ctx)
+	if err != nil {
+		report.Errors = append(report.Errors, err.Error())
+	}
+	for _, role := range roles {
+		if !role.ServiceLinked {
+			if role.DaysSinceUse > req.RoleUnusedDays {
+				role.Issues = append(role.Issues, fmt.Sprintf("Unused for %d days", role.DaysSinceUse))
+			} else if role.DaysSinceUse < 0 && role.AgeDays > req.RoleUnusedDays {
+				role.Issues = append(role.Issues, fmt.Sprintf("Never used since its creation %d days ago", role.AgeDays))
+			}
+		}
+		for _, issue := range role.Issues {
+			finding(issue, "LOW", role.RoleName, "Unused role")
+			report.UnusedRoles++
+		}
+		report.Roles = append(report.Roles, role)
+	}
+
+	policy, err := c.iamClient.GetAccountPasswordPolicy(ctx, &iam.GetAccountPasswordPolicyInput{})
+	var noPolicy *iamTypes.NoSuchEntityException
+	switch {
+	case err == nil:
+		report.PasswordPolicyGaps = models.PasswordPolicyGaps(policy.PasswordPolicy)
+	case errors.As(err, &noPolicy):
+		report.PasswordPolicyGaps = models.PasswordPolicyGaps(nil)
+	default:
+		report.PasswordPolicyGaps = make([]string, 0)
+		report.Errors = append(report.Errors, fmt.Sprintf("failed to get password policy: %v", err))
+	}
+	for _, gap := range report.PasswordPolicyGaps {
+		finding(gap, "MEDIUM", "Account password policy", "Password policy")
+	}
+
+	return report, nil
+}
+
+// hygieneIssue is a problem found on a user, turned into an issue and a finding
+type hygieneIssue struct {
+	title    string
+	severity string
+	category string
+}
+
+// userIssues checks a user of the credential report against the thresholds
+func userIssues(user *models.IAMUserInfo, req models.IAMHygieneRequest) []hygieneIssue {
+	issues := make([]hygieneIssue, 0)
+
+	if user.Root {
+		if !user.MFAActive {
+			issues = append(issues, hygieneIssue{"Root account without MFA", "CRITICAL", "MFA"})
+		}
+		for _, key := range user.AccessKeys {
+			if key.Active {
+				issues = append(issues, hygieneIssue{fmt.Sprintf("Root account has active access key %d", key.Slot), "CRITICAL", "Root access key"})
+			}
+		}
+		return issues
+	}
+
+	if user.PasswordEnabled {
+		if !user.MFAActive {
+			issues = append(issues, hygieneIssue{"Console access without MFA", "HIGH", "MFA"})
+		}
+		if user.DaysSinceLogin > req.ConsoleInactiveDays {
+			issues = append(issues, hygieneIssue{fmt.Sprintf("No console sign-in for %d days", user.DaysSinceLogin), "MEDIUM", "Console access"})
+		} else if user.DaysSinceLogin < 0 && user.AgeDays > req.ConsoleInactiveDays {
+			issues = append(issues, hygieneIssue{fmt.Sprintf("Console password never used in %d days", user.AgeDays), "MEDIUM", "Console access"})
+		}
+	}
+
+	for _, key := range user.AccessKeys {
+		if !key.Active {
+			continue
+		}
+		if key.AgeDays > req.AccessKeyMaxAgeDays {
+			issues = append(issues, hygieneIssue{fmt.Sprintf("Access key %d not rotated for %d days", key.Slot, key.AgeDays), "MEDIUM", "Access key age"})
+		}
+		if key.DaysSinceUse > req.AccessKeyUnusedDays {
+			issues = append(issues, hygieneIssue{fmt.Sprintf("Access key %d unused for %d days", key.Slot, key.DaysSinceUse), "MEDIUM", "Unused access key"})
+		} else if key.DaysSinceUse < 0 && key.AgeDays > req.AccessKeyUnusedDays {
+			issues = append(issues, hygieneIssue{fmt.Sprintf("Access key %d never used in %d days", key.Slot, key.AgeDays), "MEDIUM", "Unused access key"})
+		}
+	}
+	return issues
+}
+
+// fetchCredentialReport generates the credential report if needed, waits for it and
+// returns its users with the report generation time
+func (c *Client) fetchCredentialReport(ctx context.Context) ([]models.IAMUserInfo, string, error) {
+	for {
+		output, err := c.iamClient.GenerateCredentialReport(ctx, &iam.GenerateCredentialReportInput{})
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to generate credential report: %w", err)
+		}
+		if output.State == iamTypes.ReportStateTypeComplete {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, "", ctx.Err()
+		case <-time.After(credentialReportPollInterval):
+		}
+	}
+
+	output, err := c.iamClient.GetCredentialReport(ctx, &iam.GetCredentialReportInput{})
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get credential report: %w", err)
+	}
+
+	rows, err := csv.NewReader(bytes.NewReader(output.Content)).ReadAll()
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to parse credential report: %w", err)
+	}
+
+	users := make([]models.IAMUserInfo, 0, len(rows))
+	for i := 1; i < len(rows); i++ {
+		row := make(map[string]string, len(rows[0]))
+		for col, name := range rows[0] {
+			if col < len(rows[i]) {
+				row[name] = rows[i][col]
+			}
+		}
+		users = append(users, models.IAMUserFromCredentialReport(row))
+	}
+
+	generatedAt := ""
+	if output.GeneratedTime != nil {
+		generatedAt = output.GeneratedTime.Format(constants.DateTimeFormat)
+	}
+	return users, generatedAt, nil
+}
+
+// fetchIAMRoles lists the roles and gets each one for its last use. Roles that
+// cannot be read are left out, their errors are joined.
+func (c *Client) fetchIAMRoles(ctx context.Context) ([]models.IAMRoleInfo, error) {
+	listed := make([]iamTypes.Role, 0)
+	paginator := iam.NewListRolesPaginator(c.iamClient, &iam.ListRolesInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list roles: %w", err)
+		}
+		listed = append(listed, output.Roles...)
+	}
+
+	found := make([]*models.IAMRoleInfo, len(listed))
+	errs := make([]error, len(listed))
+	sem := make(chan struct{}, iamRoleLookupConcurrency)
+	var wg sync.WaitGroup
+	for i, role := range listed {
+		wg.Add(1)
+		go func(i int, role iamTypes.Role) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			output, err := c.iamClient.GetRole(ctx, &iam.GetRoleInput{RoleName: role.RoleName})
+			if err != nil {
+				errs[i] = fmt.Errorf("failed to get role %s: %w", aws.ToString(role.RoleName), err)
+				return
+			}
+			info := models.FromAWSRole(*output.Role)
+			found[i] = &info
+		}(i, role)
+	}
+	wg.Wait()
+
+	roles := make([]models.IAMRoleInfo, 0, len(found))
+	for _, role := range found {
+		if role != nil {
+			roles = append(roles, *role)
+		}
+	}
+	sort.SliceStable(roles, func(i, j int) bool {
+		return roles[i].RoleName < roles[j].RoleName
+	})
+	return roles, errors.Join(errs...)
+}
@@ -0,0 +1,124 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamTypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"aws-terminal-sdk-v1/internal/models"
+)
+
+func daysAgo(days int) time.Time {
+	return time.Now().AddDate(0, 0, -days)
+}
+
+func TestFetchIAMHygiene(t *testing.T) {
+	mockIAM := new(MockIAMClient)
+	client := &Client{iamClient: mockIAM}
+
+	at := func(days int) string { return daysAgo(days).Format(time.RFC3339) }
+	report := strings.Join([]string{
+		"user,arn,user_creation_time,password_enabled,password_last_used,password_last_changed,password_next_rotation,mfa_active,access_key_1_active,access_key_1_last_rotated,access_key_1_last_used_date,access_key_1_last_used_region,access_key_1_last_used_service,access_key_2_active,access_key_2_last_rotated,access_key_2_last_used_date,access_key_2_last_used_region,access_key_2_last_used_service",
+		"<root_account>,arn:aws:iam::123456789012:root," + at(900) + ",not_supported," + at(3) + ",not_supported,not_supported,false,true," + at(800) + "," + at(1) + ",us-east-1,s3,false,N/A,N/A,N/A,N/A",
+		"alice,arn:aws:iam::123456789012:user/alice," + at(500) + ",true," + at(200) + "," + at(500) + ",N/A,false,true," + at(400) + "," + at(10) + ",eu-west-1,ec2,false,N/A,N/A,N/A,N/A",
+		"ci,arn:aws:iam::123456789012:user/ci," + at(120) + ",false,N/A,N/A,N/A,false,true," + at(120) + ",N/A,N/A,N/A,false," + at(30) + ",N/A,N/A,N/A",
+	}, "\n")
+
+	// The first call starts the generation, the report is ready at the second
+	mockIAM.On("GenerateCredentialReport", mock.Anything, mock.Anything, mock.Anything).Return(&iam.GenerateCredentialReportOutput{
+		State: iamTypes.ReportStateTypeStarted,
+	}, nil).Once()
+	mockIAM.On("GenerateCredentialReport", mock.Anything, mock.Anything, mock.Anything).Return(&iam.GenerateCredentialReportOutput{
+		State: iamTypes.ReportStateTypeComplete,
+	}, nil).Once()
+	generated := daysAgo(0)
+	mockIAM.On("GetCredentialReport", mock.Anything, mock.Anything, mock.Anything).Return(&iam.GetCredentialReportOutput{
+		Content:       []byte(report),
+		GeneratedTime: &generated,
+	}, nil)
+
+	created := func(days int) *time.Time { d := daysAgo(days); return &d }
+	mockIAM.On("ListRoles", mock.Anything, mock.Anything, mock.Anything).Return(&iam.ListRolesOutput{
+		Roles: []iamTypes.Role{
+			{RoleName: aws.String("old-app")},
+			{RoleName: aws.String("AWSServiceRoleForSupport")},
+			{RoleName: aws.String("fresh")},
+			{RoleName: aws.String("broken")},
+		},
+	}, nil)
+	roles := map[string]iamTypes.Role{
+		"old-app": {RoleName: aws.String("old-app"), Path: aws.String("/"), CreateDate: created(300),
+			RoleLastUsed: &iamTypes.RoleLastUsed{LastUsedDate: created(200), Region: aws.String("eu-west-1")}},
+		"AWSServiceRoleForSupport": {RoleName: aws.String("AWSServiceRoleForSupport"), Path: aws.String("/aws-service-role/support.amazonaws.com/"), CreateDate: created(900),
+			RoleLastUsed: &iamTypes.RoleLastUsed{}},
+		"fresh": {RoleName: aws.String("fresh"), Path: aws.String("/"), CreateDate: created(10), RoleLastUsed: &iamTypes.RoleLastUsed{}},
+	}
+	for name, role := range roles {
+		role := role
+		mockIAM.On("GetRole", mock.Anything, mock.MatchedBy(func(in *iam.GetRoleInput) bool {
+			return aws.ToString(in.RoleName) == name
+		}), mock.Anything).Return(&iam.GetRoleOutput{Role: &role}, nil)
+	}
+	mockIAM.On("GetRole", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("access denied"))
+	mockIAM.On("GetAccountPasswordPolicy", mock.Anything, mock.Anything, mock.Anything).Return(nil, &iamTypes.NoSuchEntityException{})
+
+	hygiene, err := client.FetchIAMHygiene(context.Background(), models.IAMHygieneRequest{RoleUnusedDays: 180})
+	assert.NoError(t, err)
+	assert.Equal(t, 90, hygiene.Thresholds.AccessKeyMaxAgeDays)
+	assert.Equal(t, 180, hygiene.Thresholds.RoleUnusedDays)
+
+	assert.Len(t, hygiene.Users, 3)
+	root, alice, ci := hygiene.Users[0], hygiene.Users[1], hygiene.Users[2]
+	assert.True(t, root.Root)
+	assert.Equal(t, []string{"Root account without MFA", "Root account has active access key 1"}, root.Issues)
+	assert.Equal(t, []string{"Console access without MFA", "No console sign-in for 200 days", "Access key 1 not rotated for 400 days"}, alice.Issues)
+	assert.False(t, ci.PasswordEnabled)
+	assert.Len(t, ci.AccessKeys, 2)
+	assert.Equal(t, -1, ci.AccessKeys[0].DaysSinceUse)
+	assert.Equal(t, []string{"Access key 1 not rotated for 120 days", "Access key 1 never used in 120 days"}, ci.Issues)
+
+	assert.Equal(t, 2, hygiene.UsersWithoutMFA)
+	assert.Equal(t, 2, hygiene.OldAccessKeys)
+	assert.Equal(t, 1, hygiene.UnusedAccessKeys)
+	assert.Equal(t, 1, hygiene.InactiveConsoleUsers)
+
+	// broken could not be read, the service-linked and recent roles are not flagged
+	assert.Len(t, hygiene.Roles, 3)
+	assert.Equal(t, "AWSServiceRoleForSupport", hygiene.Roles[0].RoleName)
+	assert.True(t, hygiene.Roles[0].ServiceLinked)
+	assert.Empty(t, hygiene.Roles[0].Issues)
+	assert.Empty(t, hygiene.Roles[1].Issues)
+	assert.Equal(t, []string{"Unused for 200 days"}, hygiene.Roles[2].Issues)
+	assert.Equal(t, 1, hygiene.UnusedRoles)
+	assert.Len(t, hygiene.Errors, 1)
+
+	assert.Equal(t, []string{"No password policy set, IAM defaults apply"}, hygiene.PasswordPolicyGaps)
+	assert.Len(t, hygiene.Findings, 9)
+	mockIAM.AssertExpectations(t)
+}
+
+func TestPasswordPolicyGaps(t *testing.T) {
+	gaps := models.PasswordPolicyGaps(&iamTypes.PasswordPolicy{
+		MinimumPasswordLength:      aws.Int32(8),
+		RequireUppercaseCharacters: true,
+		RequireLowercaseCharacters: true,
+		RequireNumbers:             true,
+		ExpirePasswords:            true,
+		MaxPasswordAge:             aws.Int32(365),
+		PasswordReusePrevention:    aws.Int32(5),
+	})
+	assert.Equal(t, []string{
+		"Minimum length is 8, below 14",
+		"Symbols not required",
+		"Passwords expire after 365 days, above 90",
+		"Only the last 5 passwords cannot be reused, below 24",
+	}, gaps)
+}
@@ -0,0 +1,203 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamTypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+
+	"aws-terminal-sdk-v1/internal/constants"
+	"aws-terminal-sdk-v1/internal/models"
+)
+
+// FetchPolicyAnalysis analyzes the managed and inline policies of every user, group and
+// role for wildcards and privilege escalation. Users are analyzed with the policies
+// of their groups; service-linked roles are left out as they cannot be changed.
+func (c *Client) FetchPolicyAnalysis(ctx context.Context) (*models.PolicyAnalysis, error) {
+	details := &iam.GetAccountAuthorizationDetailsOutput{}
+	paginator := iam.NewGetAccountAuthorizationDetailsPaginator(c.iamClient, &iam.GetAccountAuthorizationDetailsInput{
+		Filter: []iamTypes.EntityType{
+			iamTypes.EntityTypeUser,
+			iamTypes.EntityTypeGroup,
+			iamTypes.EntityTypeRole,
+			iamTypes.EntityTypeLocalManagedPolicy,
+			iamTypes.EntityTypeAWSManagedPolicy,
+		},
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get account authorization details: %w", err)
+		}
+		details.UserDetailList = append(details.UserDetailList, output.UserDetailList...)
+		details.GroupDetailList = append(details.GroupDetailList, output.GroupDetailList...)
+		details.RoleDetailList = append(details.RoleDetailList, output.RoleDetailList...)
+		details.Policies = append(details.Policies, output.Policies...)
+	}
+
+	now := time.Now().Format(constants.DateTimeFormat)
+	analysis := &models.PolicyAnalysis{
+		Principals: make([]models.PrincipalPolicyReport, 0),
+		Findings:   make([]models.SecurityFinding, 0),
+		Errors:     make([]string, 0),
+	}
+	resolver := newPolicyResolver(c, details.Policies)
+
+	groups := make(map[string][]models.PrincipalPolicy, len(details.GroupDetailList))
+	for _, group := range details.GroupDetailList {
+		policies := resolver.policies(ctx, group.AttachedManagedPolicies, inlinePolicies(group.GroupPolicyList))
+		groups[aws.ToString(group.GroupName)] = policies
+		analyzePrincipal(analysis, aws.ToString(group.GroupName), aws.ToString(group.Arn), "group", policies, now)
+	}
+
+	for _, user := range details.UserDetailList {
+		policies := resolver.policies(ctx, user.AttachedManagedPolicies, inlinePolicies(user.UserPolicyList))
+		for _, group := range user.GroupList {
+			for _, policy := range groups[group] {
+				policy.Via = "group " + group
+				policies = append(policies, policy)
+			}
+		}
+		analyzePrincipal(analysis, aws.ToString(user.UserName), aws.ToString(user.Arn), "user", policies, now)
+	}
+
+	for _, role := range details.RoleDetailList {
+		if strings.HasPrefix(aws.ToString(role.Path), "/aws-service-role/") {
+			continue
+		}
+		policies := resolver.policies(ctx, role.AttachedManagedPolicies, inlinePolicies(role.RolePolicyList))
+		analyzePrincipal(analysis, aws.ToString(role.RoleName), aws.ToString(role.Arn), "role", policies, now)
+	}
+
+	analysis.PoliciesAnalyzed = resolver.parsed
+	analysis.Errors = append(analysis.Errors, resolver.errors...)
+	sort.SliceStable(analysis.Principals, func(i, j int) bool {
+		if analysis.Principals[i].Type != analysis.Principals[j].Type {
+			return analysis.Principals[i].Type < analysis.Principals[j].Type
+		}
+		return analysis.Principals[i].Name < analysis.Principals[j].Name
+	})
+	return analysis, nil
+}
+
+// inlinePolicies converts the inline policies of a principal to name and document pairs
+func inlinePolicies(policies []iamTypes.PolicyDetail) [][2]string {
+	inline := make([][2]string, 0, len(policies))
+	for _, policy := range policies {
+		inline = append(inline, [2]string{aws.ToString(policy.PolicyName), aws.ToString(policy.PolicyDocument)})
+	}
+	return inline
+}
+
+// policyResolver parses each attached managed policy once, and gets the default
+// version of those missing from the account authorization details
+type policyResolver struct {
+	client *Client
+	// Default version documents by policy ARN, as listed in the authorization details
+	documents map[string]string
+	managed   map[string]*models.PolicyDocument
+	parsed    int
+	errors    []string
+}
+
+func newPolicyResolver(c *Client, policies []iamTypes.ManagedPolicyDetail) *policyResolver {
+	r := &policyResolver{
+		client:    c,
+		documents: make(map[string]string, len(policies)),
+		managed:   make(map[string]*models.PolicyDocument),
+	}
+	for _, policy := range policies {
+		for _, version := range policy.PolicyVersionList {
+			if version.IsDefaultVersion {
+				r.documents[aws.ToString(policy.Arn)] = aws.ToString(version.Document)
+			}
+		}
+	}
+	return r
+}
+
+// parse parses a policy document, recording its error
+func (r *policyResolver) parse(name, document string) *models.PolicyDocument {
+	doc, err := models.ParsePolicyDocument(document)
+	if err != nil {
+		r.errors = append(r.errors, fmt.Sprintf("policy %s: %v", name, err))
+		return nil
+	}
+	r.parsed++
+	return doc
+}
+
+// policies returns the attached and inline policies of a principal
+func (r *policyResolver) policies(ctx context.Context, attached []iamTypes.AttachedPolicy, inline [][2]string) []models.PrincipalPolicy {
+	policies := make([]models.PrincipalPolicy, 0, len(attached)+len(inline))
+	for _, policy := range attached {
+		arn := aws.ToString(policy.PolicyArn)
+		doc, ok := r.managed[arn]
+		if !ok {
+			if document, listed := r.documents[arn]; listed {
+				doc = r.parse(aws.ToString(policy.PolicyName), document)
+			} else {
+				doc = r.fetch(ctx, arn, aws.ToString(policy.PolicyName))
+			}
+			r.managed[arn] = doc
+		}
+		policies = append(policies, models.PrincipalPolicy{Name: aws.ToString(policy.PolicyName), ARN: arn, Via: "attached", Document: doc})
+	}
+	for _, policy := range inline {
+		policies = append(policies, models.PrincipalPolicy{Name: policy[0], Via: "inline", Document: r.parse(policy[0], policy[1])})
+	}
+	return policies
+}
+
+// fetch gets the default version of a managed policy
+func (r *policyResolver) fetch(ctx context.Context, arn, name string) *models.PolicyDocument {
+	policy, err := r.client.iamClient.GetPolicy(ctx, &iam.GetPolicyInput{PolicyArn: aws.String(arn)})
+	if err != nil {
+		r.errors = append(r.errors, fmt.Sprintf("failed to get policy %s: %v", name, err))
+		return nil
+	}
+	version, err := r.client.iamClient.GetPolicyVersion(ctx, &iam.GetPolicyVersionInput{
+		PolicyArn: aws.String(arn),
+		VersionId: policy.Policy.DefaultVersionId,
+	})
+	if err != nil {
+		r.errors = append(r.errors, fmt.Sprintf("failed to get policy version of %s: %v", name, err))
+		return nil
+	}
+	return r.parse(name, aws.ToString(version.PolicyVersion.Document))
+}
+
+// analyzePrincipal analyzes the policies of a principal and records its risks
+func analyzePrincipal(analysis *models.PolicyAnalysis, name, arn, principalType string, policies []models.PrincipalPolicy, now string) {
+	analysis.PrincipalsAnalyzed++
+	risks := models.AnalyzePrincipalPolicies(policies)
+	if len(risks) == 0 {
+		return
+	}
+
+	names := make([]string, 0, len(policies))
+	for _, policy := range policies {
+		names = append(names, policy.Name)
+	}
+	analysis.Principals = append(analysis.Principals, models.PrincipalPolicyReport{
+		Name:     name,
+		ARN:      arn,
+		Type:     principalType,
+		Policies: names,
+		Risks:    risks,
+	})
+	for _, risk := range risks {
+		analysis.Findings = append(analysis.Findings, models.SecurityFinding{
+			Title:      risk.Risk,
+			Severity:   risk.Severity,
+			ResourceID: principalType + "/" + name,
+			Category:   "IAM policy " + risk.Policy,
+			UpdatedAt:  now,
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package aws
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamTypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"aws-terminal-sdk-v1/internal/models"
+)
+
+func TestFetchPolicyAnalysis(t *testing.T) {
+	mockIAM := new(MockIAMClient)
+	client := &Client{iamClient: mockIAM}
+
+	// IAM returns the documents URL-encoded
+	encode := func(document string) *string { return aws.String(url.QueryEscape(document)) }
+	adminARN := "arn:aws:iam::aws:policy/AdministratorAccess"
+	deployARN := "arn:aws:iam::123456789012:policy/deploy"
+
+	mockIAM.On("GetAccountAuthorizationDetails", mock.Anything, mock.Anything, mock.Anything).Return(&iam.GetAccountAuthorizationDetailsOutput{
+		GroupDetailList: []iamTypes.GroupDetail{{
+			GroupName:               aws.String("admins"),
+			AttachedManagedPolicies: []iamTypes.AttachedPolicy{{PolicyName: aws.String("AdministratorAccess"), PolicyArn: aws.String(adminARN)}},
+		}},
+		UserDetailList: []iamTypes.UserDetail{
+			{UserName: aws.String("alice"), GroupList: []string{"admins"}},
+			{UserName: aws.String("bob"), UserPolicyList: []iamTypes.PolicyDetail{{
+				PolicyName: aws.String("launcher"),
+				PolicyDocument: encode(`{"Version":"2012-10-17","Statement":[
+					{"Effect":"Allow","Action":["iam:PassRole","ec2:RunInstances"],"Resource":"*"},
+					{"Effect":"Allow","Action":"s3:*","Resource":"*"},
+					{"Effect":"Allow","Action":"s3:ListAllMyBuckets","Resource":"*"},
+					{"Effect":"Deny","Action":"*","Resource":"*"}]}`),
+			}}},
+			{UserName: aws.String("carol")},
+		},
+		RoleDetailList: []iamTypes.RoleDetail{
+			{RoleName: aws.String("deployer"), Path: aws.String("/"),
+				AttachedManagedPolicies: []iamTypes.AttachedPolicy{{PolicyName: aws.String("deploy"), PolicyArn: aws.String(deployARN)}}},
+			{RoleName: aws.String("reader"), Path: aws.String("/"), RolePolicyList: []iamTypes.PolicyDetail{{
+				PolicyName:     aws.String("decrypt"),
+				PolicyDocument: encode(`{"Statement":{"Effect":"Allow","Action":"kms:Decrypt","Resource":"*","Condition":{"StringEquals":{"kms:ViaService":"s3.eu-west-1.amazonaws.com"}}}}`),
+			}}},
+			{RoleName: aws.String("AWSServiceRoleForSupport"), Path: aws.String("/aws-service-role/support.amazonaws.com/"),
+				AttachedManagedPolicies: []iamTypes.AttachedPolicy{{PolicyName: aws.String("AdministratorAccess"), PolicyArn: aws.String(adminARN)}}},
+		},
+		Policies: []iamTypes.ManagedPolicyDetail{{
+			PolicyName: aws.String("AdministratorAccess"),
+			Arn:        aws.String(adminARN),
+			PolicyVersionList: []iamTypes.PolicyVersion{
+				{IsDefaultVersion: false, Document: encode(`{"Statement":[]}`)},
+				{IsDefaultVersion: true, Document: encode(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*"}]}`)},
+			},
+		}},
+	}, nil)
+
+	// deploy is not in the authorization details, its default version is fetched
+	mockIAM.On("GetPolicy", mock.Anything, mock.Anything, mock.Anything).Return(&iam.GetPolicyOutput{
+		Policy: &iamTypes.Policy{DefaultVersionId: aws.String("v3")},
+	}, nil).Once()
+	mockIAM.On("GetPolicyVersion", mock.Anything, mock.MatchedBy(func(in *iam.GetPolicyVersionInput) bool {
+		return aws.ToString(in.PolicyArn) == deployARN && aws.ToString(in.VersionId) == "v3"
+	}), mock.Anything).Return(&iam.GetPolicyVersionOutput{
+		PolicyVersion: &iamTypes.PolicyVersion{Document: encode(`{"Statement":[{"Effect":"Allow","NotAction":"iam:*","Resource":"*"}]}`)},
+	}, nil).Once()
+
+	analysis, err := client.FetchPolicyAnalysis(context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, analysis.Errors)
+	assert.Equal(t, 6, analysis.PrincipalsAnalyzed)
+	assert.Equal(t, 4, analysis.PoliciesAnalyzed)
+
+	byName := make(map[string]models.PrincipalPolicyReport)
+	for _, p := range analysis.Principals {
+		byName[p.Name] = p
+	}
+	assert.Len(t, analysis.Principals, 5)
+	assert.NotContains(t, byName, "carol")
+
+	risks := func(name string) []string {
+		titles := make([]string, 0)
+		for _, r := range byName[name].Risks {
+			titles = append(titles, r.Severity+" "+r.Risk)
+		}
+		return titles
+	}
+
+	assert.Equal(t, []string{"CRITICAL Full administrator access (*:*)"}, risks("admins"))
+	assert.Equal(t, []string{"CRITICAL Full administrator access (*:*)"}, risks("alice"))
+	assert.Equal(t, "group admins", byName["alice"].Risks[0].Via)
+	assert.Equal(t, `{"Effect":"Allow","Action":"*","Resource":"*"}`, byName["alice"].Risks[0].Statement)
+
+	assert.Equal(t, []string{
+		"HIGH iam:PassRole on every role",
+		"MEDIUM iam actions allowed on every resource",
+		"MEDIUM s3 actions allowed on every resource",
+		"HIGH Can pass a role to a new EC2 instance",
+	}, risks("bob"))
+	assert.Equal(t, "launcher", byName["bob"].Risks[3].Policy)
+
+	assert.Equal(t, []string{
+		"HIGH Allow with NotAction grants every action but iam:*",
+		"HIGH Can replace the code of Lambda functions",
+	}, risks("deployer"))
+
+	assert.Equal(t, []string{"MEDIUM kms actions allowed on every resource"}, risks("reader"))
+	assert.True(t, byName["reader"].Risks[0].Conditional)
+
+	assert.Len(t, analysis.Findings, 9)
+	assert.Equal(t, "user/bob", analysis.Findings[2].ResourceID)
+	mockIAM.AssertExpectations(t)
+}
+
+func TestParsePolicyDocument(t *testing.T) {
+	doc, err := models.ParsePolicyDocument(`{"Version":"2012-10-17","Statement":{"Sid":"One","Effect":"Allow","Action":"S3:Get*","NotResource":["arn:aws:s3:::private/*"]}}`)
+	assert.NoError(t, err)
+	assert.Len(t, doc.Statements, 1)
+
+	stmt := doc.Statements[0]
+	assert.Equal(t, "One", stmt.Sid)
+	assert.True(t, stmt.Allows("s3:GetObject"))
+	assert.False(t, stmt.Allows("s3:PutObject"))
+	assert.True(t, stmt.AnyResource())
+
+	_, err = models.ParsePolicyDocument("not json")
+	assert.Error(t, err)
+}
@@ -0,0 +1,206 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	lambdaTypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+
+	"aws-terminal-sdk-v1/internal/models"
+)
+
+// FetchLambdaFunctionDetail gets the concurrency settings, aliases, versions, event source
+// mappings and resource-policy triggers of a function. A failing call is recorded in
+// Errors and does not prevent the other sections from being filled.
+func (c *Client) FetchLambdaFunctionDetail(ctx context.Context, functionName string) (*models.LambdaFunctionDetail, error) {
+	detail := &models.LambdaFunctionDetail{
+		FunctionName:           functionName,
+		ProvisionedConcurrency: make([]models.LambdaProvisionedConcurrency, 0),
+		Aliases:                make([]models.LambdaAliasInfo, 0),
+		Versions:               make([]models.LambdaVersionInfo, 0),
+		EventSources:           make([]models.LambdaEventSourceInfo, 0),
+		Triggers:               make([]models.LambdaTriggerInfo, 0),
+		Errors:                 make([]string, 0),
+	}
+
+	concurrency, err := c.lambdaClient.GetFunctionConcurrency(ctx, &lambda.GetFunctionConcurrencyInput{
+		FunctionName: aws.String(functionName),
+	})
+	if err != nil {
+		detail.Errors = append(detail.Errors, fmt.Sprintf("failed to get function concurrency: %v", err))
+	} else {
+		detail.ReservedConcurrency = concurrency.ReservedConcurrentExecutions
+	}
+
+	if err := c.fetchProvisionedConcurrency(ctx, detail); err != nil {
+		detail.Errors = append(detail.Errors, err.Error())
+	}
+	if err := c.fetchLambdaAliases(ctx, detail); err != nil {
+		detail.Errors = append(detail.Errors, err.Error())
+	}
+	if err := c.fetchLambdaVersions(ctx, detail); err != nil {
+		detail.Errors = append(detail.Errors, err.Error())
+	}
+	if err := c.fetchEventSourceMappings(ctx, detail); err != nil {
+		detail.Errors = append(detail.Errors, err.Error())
+	}
+	if err := c.fetchLambdaTriggers(ctx, detail); err != nil {
+		detail.Errors = append(detail.Errors, err.Error())
+	}
+
+	return detail, nil
+}
+
+func (c *Client) fetchProvisionedConcurrency(ctx context.Context, detail *models.LambdaFunctionDetail) error {
+	paginator := lambda.NewListProvisionedConcurrencyConfigsPaginator(c.lambdaClient, &lambda.ListProvisionedConcurrencyConfigsInput{
+		FunctionName: aws.String(detail.FunctionName),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list provisioned concurrency: %w", err)
+		}
+
+		for _, config := range output.ProvisionedConcurrencyConfigs {
+			detail.ProvisionedConcurrency = append(detail.ProvisionedConcurrency, models.FromAWSProvisionedConcurrency(config))
+		}
+	}
+	return nil
+}
+
+func (c *Client) fetchLambdaAliases(ctx context.Context, detail *models.LambdaFunctionDetail) error {
+	paginator := lambda.NewListAliasesPaginator(c.lambdaClient, &lambda.ListAliasesInput{
+		FunctionName: aws.String(detail.FunctionName),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list aliases: %w", err)
+		}
+
+		for _, alias := range output.Aliases {
+			detail.Aliases = append(detail.Aliases, models.FromAWSLambdaAlias(alias))
+		}
+	}
+	return nil
+}
+
+func (c *Client) fetchLambdaVersions(ctx context.Context, detail *models.LambdaFunctionDetail) error {
+	paginator := lambda.NewListVersionsByFunctionPaginator(c.lambdaClient, &lambda.ListVersionsByFunctionInput{
+		FunctionName: aws.String(detail.FunctionName),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list versions: %w", err)
+		}
+
+		for _, version := range output.Versions {
+			detail.Versions = append(detail.Versions, models.FromAWSLambdaVersion(version))
+		}
+	}
+	return nil
+}
+
+func (c *Client) fetchEventSourceMappings(ctx context.Context, detail *models.LambdaFunctionDetail) error {
+	paginator := lambda.NewListEventSourceMappingsPaginator(c.lambdaClient, &lambda.ListEventSourceMappingsInput{
+		FunctionName: aws.String(detail.FunctionName),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list event source mappings: %w", err)
+		}
+
+		for _, mapping := range output.EventSourceMappings {
+			detail.EventSources = append(detail.EventSources, models.FromAWSEventSourceMapping(mapping))
+		}
+	}
+	return nil
+}
+
+// fetchLambdaTriggers reads the function resource policy, a function without one has no triggers
+func (c *Client) fetchLambdaTriggers(ctx context.Context, detail *models.LambdaFunctionDetail) error {
+	output, err := c.lambdaClient.GetPolicy(ctx, &lambda.GetPolicyInput{
+		FunctionName: aws.String(detail.FunctionName),
+	})
+	if err != nil {
+		var notFound *lambdaTypes.ResourceNotFoundException
+		if errors.As(err, &notFound) {
+			return nil
+		}
+		return fmt.Errorf("failed to get function policy: %w", err)
+	}
+
+	triggers, err := models.ParseLambdaPolicyTriggers(aws.ToString(output.Policy))
+	if err != nil {
+		return err
+	}
+	detail.Triggers = triggers
+	return nil
+}
+
+// FetchLambdaInvokers finds the API routes and the state machines invoking a function,
+// whatever the alias or version they call. Routes whose integration resolves the function
+// from a stage variable, and state machines naming it without its ARN, are not found.
+func (c *Client) FetchLambdaInvokers(ctx context.Context, functionARN string) ([]models.LambdaInvokerInfo, error) {
+	function, _ := models.SplitLambdaARN(functionARN)
+	invokers := make([]models.LambdaInvokerInfo, 0)
+
+	apis, apiErr := c.FetchAPIGatewayAPIs(ctx)
+	for _, api := range apis {
+		var routes []models.APIGatewayRouteInfo
+		var err error
+		if api.Protocol == models.APIProtocolREST {
+			routes, err = c.fetchRestRoutes(ctx, api.ID)
+		} else {
+			routes, err = c.fetchHTTPRoutes(ctx, api.ID)
+		}
+		if err != nil {
+			fmt.Printf("Warning: failed to get routes of API %s: %v\n", api.Name, err)
+		}
+
+		for _, route := range routes {
+			target, qualifier := models.SplitLambdaARN(route.LambdaARN)
+			if route.LambdaARN == "" || target != function {
+				continue
+			}
+			invokers = append(invokers, models.LambdaInvokerInfo{
+				SourceType: "apigateway",
+				SourceID:   api.ID,
+				SourceName: api.Name,
+				Detail:     route.Route,
+				Qualifier:  qualifier,
+			})
+		}
+	}
+
+	stateMachines, smErr := c.listStateMachines(ctx)
+	for _, sm := range stateMachines {
+		for _, arn := range sm.LambdaARNs {
+			target, qualifier := models.SplitLambdaARN(arn)
+			if target != function {
+				continue
+			}
+			invokers = append(invokers, models.LambdaInvokerInfo{
+				SourceType: "stepfunctions",
+				SourceID:   sm.ARN,
+				SourceName: sm.Name,
+				Detail:     sm.Type,
+				Qualifier:  qualifier,
+			})
+		}
+	}
+
+	// A single source failing still returns what the other one invokes
+	if apiErr != nil && smErr != nil {
+		return nil, errors.Join(apiErr, smErr)
+	}
+	if err := errors.Join(apiErr, smErr); err != nil {
+		fmt.Printf("Warning: %v\n", err)
+	}
+	return invokers, nil
+}
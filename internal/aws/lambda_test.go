@@ -0,0 +1,136 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	lambdaTypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFetchLambdaFunctions_Configuration(t *testing.T) {
+	mockLambda := new(MockLambdaClient)
+	client := &Client{lambdaClient: mockLambda}
+
+	mockLambda.On("ListFunctions", mock.Anything, mock.Anything, mock.Anything).Return(&lambda.ListFunctionsOutput{
+		Functions: []lambdaTypes.FunctionConfiguration{
+			{
+				FunctionName:  aws.String("orders-consumer"),
+				Runtime:       lambdaTypes.RuntimePython38,
+				Timeout:       aws.Int32(30),
+				Architectures: []lambdaTypes.Architecture{lambdaTypes.ArchitectureArm64},
+				PackageType:   lambdaTypes.PackageTypeZip,
+				CodeSize:      1024,
+				Layers:        []lambdaTypes.Layer{{Arn: aws.String("arn:aws:lambda:us-east-1:123456789012:layer:deps:3")}},
+				VpcConfig: &lambdaTypes.VpcConfigResponse{
+					VpcId:            aws.String("vpc-1"),
+					SubnetIds:        []string{"subnet-1"},
+					SecurityGroupIds: []string{"sg-1"},
+				},
+				Environment: &lambdaTypes.EnvironmentResponse{
+					Variables: map[string]string{"DB_PASSWORD": "hunter2", "API_URL": "https://example.com"},
+				},
+			},
+			{
+				FunctionName: aws.String("image-fn"),
+				PackageType:  lambdaTypes.PackageTypeImage,
+			},
+		},
+	}, nil).Once()
+
+	functions, err := client.FetchLambdaFunctions(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, functions, 2)
+
+	fn := functions[0]
+	assert.Equal(t, int32(30), fn.Timeout)
+	assert.Equal(t, "arm64", fn.Architecture)
+	assert.Equal(t, "Zip", fn.PackageType)
+	assert.Len(t, fn.Layers, 1)
+	assert.Equal(t, "vpc-1", fn.VPCID)
+	assert.Equal(t, []string{"API_URL", "DB_PASSWORD"}, fn.EnvVarNames)
+	assert.NotContains(t, fmt.Sprintf("%+v", fn), "hunter2")
+	assert.True(t, fn.DeprecatedRuntime)
+	assert.Equal(t, "2024-10-14", fn.RuntimeDeprecationDate)
+
+	// Container images have no runtime
+	assert.False(t, functions[1].DeprecatedRuntime)
+	assert.Empty(t, functions[1].RuntimeDeprecationDate)
+}
+
+func TestFetchLambdaFunctionDetail(t *testing.T) {
+	mockLambda := new(MockLambdaClient)
+	client := &Client{lambdaClient: mockLambda}
+
+	mockLambda.On("GetFunctionConcurrency", mock.Anything, mock.Anything, mock.Anything).Return(&lambda.GetFunctionConcurrencyOutput{
+		ReservedConcurrentExecutions: aws.Int32(50),
+	}, nil).Once()
+	mockLambda.On("ListProvisionedConcurrencyConfigs", mock.Anything, mock.Anything, mock.Anything).Return(&lambda.ListProvisionedConcurrencyConfigsOutput{
+		ProvisionedConcurrencyConfigs: []lambdaTypes.ProvisionedConcurrencyConfigListItem{{
+			FunctionArn:                              aws.String("arn:aws:lambda:us-east-1:123456789012:function:orders:live"),
+			RequestedProvisionedConcurrentExecutions: aws.Int32(10),
+			AllocatedProvisionedConcurrentExecutions: aws.Int32(10),
+			Status:                                   lambdaTypes.ProvisionedConcurrencyStatusEnumReady,
+		}},
+	}, nil).Once()
+	mockLambda.On("ListAliases", mock.Anything, mock.Anything, mock.Anything).Return(&lambda.ListAliasesOutput{
+		Aliases: []lambdaTypes.AliasConfiguration{{
+			Name:            aws.String("live"),
+			FunctionVersion: aws.String("7"),
+			RoutingConfig: &lambdaTypes.AliasRoutingConfiguration{
+				AdditionalVersionWeights: map[string]float64{"8": 0.1},
+			},
+		}},
+	}, nil).Once()
+	mockLambda.On("ListVersionsByFunction", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("access denied")).Once()
+	mockLambda.On("ListEventSourceMappings", mock.Anything, mock.Anything, mock.Anything).Return(&lambda.ListEventSourceMappingsOutput{
+		EventSourceMappings: []lambdaTypes.EventSourceMappingConfiguration{{
+			UUID:           aws.String("uuid-1"),
+			EventSourceArn: aws.String("arn:aws:sqs:us-east-1:123456789012:orders"),
+			State:          aws.String("Enabled"),
+			BatchSize:      aws.Int32(10),
+		}},
+	}, nil).Once()
+	mockLambda.On("GetPolicy", mock.Anything, mock.Anything, mock.Anything).Return(&lambda.GetPolicyOutput{
+		Policy: aws.String(`{"Version":"2012-10-17","Statement":[{"Sid":"s3-invoke","Effect":"Allow",` +
+			`"Principal":{"Service":"s3.amazonaws.com"},"Action":"lambda:InvokeFunction",` +
+			`"Condition":{"ArnLike":{"AWS:SourceArn":"arn:aws:s3:::uploads"},"StringEquals":{"AWS:SourceAccount":"123456789012"}}}]}`),
+	}, nil).Once()
+
+	detail, err := client.FetchLambdaFunctionDetail(context.Background(), "orders")
+	assert.NoError(t, err)
+	assert.Equal(t, int32(50), *detail.ReservedConcurrency)
+	assert.Len(t, detail.ProvisionedConcurrency, 1)
+	assert.Equal(t, "live", detail.ProvisionedConcurrency[0].Qualifier)
+	assert.Equal(t, 0.1, detail.Aliases[0].AdditionalVersionWeights["8"])
+	assert.Empty(t, detail.Versions)
+	assert.Len(t, detail.Errors, 1)
+	assert.Equal(t, "sqs", detail.EventSources[0].SourceType)
+	assert.Len(t, detail.Triggers, 1)
+	assert.Equal(t, "s3.amazonaws.com", detail.Triggers[0].Principal)
+	assert.Equal(t, "arn:aws:s3:::uploads", detail.Triggers[0].SourceARN)
+	assert.Equal(t, "123456789012", detail.Triggers[0].Account)
+}
+
+func TestFetchLambdaFunctionDetail_NoPolicy(t *testing.T) {
+	mockLambda := new(MockLambdaClient)
+	client := &Client{lambdaClient: mockLambda}
+
+	mockLambda.On("GetFunctionConcurrency", mock.Anything, mock.Anything, mock.Anything).Return(&lambda.GetFunctionConcurrencyOutput{}, nil).Once()
+	mockLambda.On("ListProvisionedConcurrencyConfigs", mock.Anything, mock.Anything, mock.Anything).Return(&lambda.ListProvisionedConcurrencyConfigsOutput{}, nil).Once()
+	mockLambda.On("ListAliases", mock.Anything, mock.Anything, mock.Anything).Return(&lambda.ListAliasesOutput{}, nil).Once()
+	mockLambda.On("ListVersionsByFunction", mock.Anything, mock.Anything, mock.Anything).Return(&lambda.ListVersionsByFunctionOutput{}, nil).Once()
+	mockLambda.On("ListEventSourceMappings", mock.Anything, mock.Anything, mock.Anything).Return(&lambda.ListEventSourceMappingsOutput{}, nil).Once()
+	mockLambda.On("GetPolicy", mock.Anything, mock.Anything, mock.Anything).Return(nil, &lambdaTypes.ResourceNotFoundException{}).Once()
+
+	detail, err := client.FetchLambdaFunctionDetail(context.Background(), "orders")
+	assert.NoError(t, err)
+	assert.Nil(t, detail.ReservedConcurrency)
+	assert.Empty(t, detail.Triggers)
+	assert.Empty(t, detail.Errors)
+}
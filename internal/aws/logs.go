@@ -0,0 +1,255 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	logsTypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+
+	"aws-terminal-sdk-v1/internal/models"
+)
+
+const (
+	// logStreamsLimit caps the most recent streams listed for a log group
+	logStreamsLimit = 50
+	// logTailLookback is how far back a new tail starts reading
+	logTailLookback = 5 * time.Minute
+	// logsInsightsDefaultLimit is the default number of rows returned by a Logs Insights query
+	logsInsightsDefaultLimit = 1000
+)
+
+var (
+	// logTailPollInterval is the delay between two FilterLogEvents polls of a tail
+	logTailPollInterval = 2 * time.Second
+	// logsInsightsPollInterval is the delay between two GetQueryResults polls
+	logsInsightsPollInterval = time.Second
+)
+
+// FetchLogGroups gets the log groups of the region, optionally filtered by name prefix
+func (c *Client) FetchLogGroups(ctx context.Context, prefix string) ([]models.LogGroupInfo, error) {
+	input := &cloudwatchlogs.DescribeLogGroupsInput{}
+	if prefix != "" {
+		input.LogGroupNamePrefix = aws.String(prefix)
+	}
+
+	groups := make([]models.LogGroupInfo, 0)
+	paginator := cloudwatchlogs.NewDescribeLogGroupsPaginator(c.logsClient, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe log groups: %w", err)
+		}
+
+		for _, group := range output.LogGroups {
+			groups = append(groups, models.FromAWSLogGroup(group))
+		}
+	}
+
+	return groups, nil
+}
+
+// FetchLogStreams gets the most recently active streams of a log group
+func (c *Client) FetchLogStreams(ctx context.Context, logGroup string) ([]models.LogStreamInfo, error) {
+	output, err := c.logsClient.DescribeLogStreams(ctx, &cloudwatchlogs.DescribeLogStreamsInput{
+		LogGroupName: aws.String(logGroup),
+		OrderBy:      logsTypes.OrderByLastEventTime,
+		Descending:   aws.Bool(true),
+		Limit:        aws.Int32(logStreamsLimit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe log streams: %w", err)
+	}
+
+	streams := make([]models.LogStreamInfo, 0, len(output.LogStreams))
+	for _, stream := range output.LogStreams {
+		streams = append(streams, models.FromAWSLogStream(stream))
+	}
+	return streams, nil
+}
+
+// TailLogEvents polls a log group (or one of its streams) for new events matching
+// the filter pattern and hands each non-empty batch to emit, oldest first.
+// It runs until ctx is cancelled, which is not reported as an error.
+func (c *Client) TailLogEvents(ctx context.Context, req models.LogTailRequest, emit func([]models.LogEvent)) error {
+	if req.LogGroup == "" {
+		return fmt.Errorf("log group is required")
+	}
+
+	startTime := time.Now().Add(-logTailLookback).UnixMilli()
+	// Events sharing the newest timestamp are returned again by the next poll
+	seen := make(map[string]bool)
+
+	for {
+		events, err := c.filterLogEvents(ctx, req, startTime)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+
+		fresh := make([]models.LogEvent, 0, len(events))
+		for _, event := range events {
+			if seen[event.ID] {
+				continue
+			}
+			fresh = append(fresh, event)
+		}
+
+		if len(fresh) > 0 {
+			newest := fresh[len(fresh)-1].Timestamp
+			if newest > startTime {
+				startTime = newest
+				seen = make(map[string]bool)
+			}
+			for _, event := range fresh {
+				if event.Timestamp == startTime {
+					seen[event.ID] = true
+				}
+			}
+			emit(fresh)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(logTailPollInterval):
+		}
+	}
+}
+
+// filterLogEvents reads all events from startTime (inclusive, epoch milliseconds)
+func (c *Client) filterLogEvents(ctx context.Context, req models.LogTailRequest, startTime int64) ([]models.LogEvent, error) {
+	input := &cloudwatchlogs.FilterLogEventsInput{
+		LogGroupName: aws.String(req.LogGroup),
+		StartTime:    aws.Int64(startTime),
+	}
+	if req.LogStream != "" {
+		input.LogStreamNames = []string{req.LogStream}
+	}
+	if req.FilterPattern != "" {
+		input.FilterPattern = aws.String(req.FilterPattern)
+	}
+
+	var events []models.LogEvent
+	paginator := cloudwatchlogs.NewFilterLogEventsPaginator(c.logsClient, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to filter log events: %w", err)
+		}
+
+		for _, event := range output.Events {
+			events = append(events, models.FromAWSFilteredLogEvent(event))
+		}
+	}
+
+	return events, nil
+}
+
+// RunLogsInsightsQuery starts a Logs Insights query and polls until it completes,
+// fails or ctx is done. A cancelled context also stops the query server-side.
+func (c *Client) RunLogsInsightsQuery(ctx context.Context, req models.LogsInsightsRequest) (*models.LogsInsightsResult, error) {
+	queryID, err := c.StartLogsInsightsQuery(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		result, err := c.FetchLogsInsightsResults(ctx, queryID)
+		if err != nil {
+			return nil, err
+		}
+
+		switch logsTypes.QueryStatus(result.Status) {
+		case logsTypes.QueryStatusComplete:
+			return result, nil
+		case logsTypes.QueryStatusFailed, logsTypes.QueryStatusCancelled, logsTypes.QueryStatusTimeout:
+			return nil, fmt.Errorf("logs insights query %s ended with status %s", queryID, result.Status)
+		}
+
+		select {
+		case <-ctx.Done():
+			// Use a fresh context, the query context is already done
+			_, _ = c.logsClient.StopQuery(context.Background(), &cloudwatchlogs.StopQueryInput{QueryId: aws.String(queryID)})
+			return nil, ctx.Err()
+		case <-time.After(logsInsightsPollInterval):
+		}
+	}
+}
+
+// StartLogsInsightsQuery starts a Logs Insights query and returns its ID
+func (c *Client) StartLogsInsightsQuery(ctx context.Context, req models.LogsInsightsRequest) (string, error) {
+	if len(req.LogGroups) == 0 {
+		return "", fmt.Errorf("at least one log group is required")
+	}
+	if req.Query == "" {
+		return "", fmt.Errorf("query is required")
+	}
+
+	var err error
+	endTime := time.Now()
+	if req.End != "" {
+		if endTime, err = time.Parse(time.RFC3339, req.End); err != nil {
+			return "", fmt.Errorf("invalid end time %q: %w", req.End, err)
+		}
+	}
+	startTime := endTime.Add(-time.Hour)
+	if req.Start != "" {
+		if startTime, err = time.Parse(time.RFC3339, req.Start); err != nil {
+			return "", fmt.Errorf("invalid start time %q: %w", req.Start, err)
+		}
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = logsInsightsDefaultLimit
+	}
+
+	output, err := c.logsClient.StartQuery(ctx, &cloudwatchlogs.StartQueryInput{
+		LogGroupNames: req.LogGroups,
+		QueryString:   aws.String(req.Query),
+		StartTime:     aws.Int64(startTime.Unix()),
+		EndTime:       aws.Int64(endTime.Unix()),
+		Limit:         aws.Int32(limit),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to start logs insights query: %w", err)
+	}
+
+	return aws.ToString(output.QueryId), nil
+}
+
+// FetchLogsInsightsResults gets the current status and rows of a Logs Insights query
+func (c *Client) FetchLogsInsightsResults(ctx context.Context, queryID string) (*models.LogsInsightsResult, error) {
+	output, err := c.logsClient.GetQueryResults(ctx, &cloudwatchlogs.GetQueryResultsInput{
+		QueryId: aws.String(queryID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get logs insights results: %w", err)
+	}
+
+	result := &models.LogsInsightsResult{
+		QueryID: queryID,
+		Status:  string(output.Status),
+		Rows:    make([]map[string]string, 0, len(output.Results)),
+	}
+	if output.Statistics != nil {
+		result.RecordsMatched = output.Statistics.RecordsMatched
+		result.RecordsScanned = output.Statistics.RecordsScanned
+		result.BytesScanned = output.Statistics.BytesScanned
+	}
+
+	for _, fields := range output.Results {
+		row := make(map[string]string, len(fields))
+		for _, field := range fields {
+			row[aws.ToString(field.Field)] = aws.ToString(field.Value)
+		}
+		result.Rows = append(result.Rows, row)
+	}
+
+	return result, nil
+}
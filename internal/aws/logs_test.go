@@ -0,0 +1,123 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	logsTypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"aws-terminal-sdk-v1/internal/models"
+)
+
+func TestFetchLogGroups(t *testing.T) {
+	mockLogs := new(MockCloudWatchLogsClient)
+	client := &Client{logsClient: mockLogs}
+
+	mockLogs.On("DescribeLogGroups", mock.Anything, mock.MatchedBy(func(in *cloudwatchlogs.DescribeLogGroupsInput) bool {
+		return aws.ToString(in.LogGroupNamePrefix) == "/aws/lambda/"
+	}), mock.Anything).Return(&cloudwatchlogs.DescribeLogGroupsOutput{
+		LogGroups: []logsTypes.LogGroup{
+			{LogGroupName: aws.String("/aws/lambda/orders"), RetentionInDays: aws.Int32(14), StoredBytes: aws.Int64(2048)},
+		},
+	}, nil).Once()
+
+	groups, err := client.FetchLogGroups(context.Background(), "/aws/lambda/")
+	assert.NoError(t, err)
+	assert.Len(t, groups, 1)
+	assert.Equal(t, int32(14), groups[0].RetentionInDays)
+	assert.Equal(t, int64(2048), groups[0].StoredBytes)
+
+	// Error case
+	mockLogs.On("DescribeLogGroups", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("AccessDenied")).Once()
+
+	groups, err = client.FetchLogGroups(context.Background(), "")
+	assert.Error(t, err)
+	assert.Nil(t, groups)
+}
+
+func TestTailLogEvents(t *testing.T) {
+	mockLogs := new(MockCloudWatchLogsClient)
+	client := &Client{logsClient: mockLogs}
+
+	logTailPollInterval = time.Millisecond
+	defer func() { logTailPollInterval = 2 * time.Second }()
+
+	now := time.Now().UnixMilli()
+	isTail := mock.MatchedBy(func(in *cloudwatchlogs.FilterLogEventsInput) bool {
+		return aws.ToString(in.LogGroupName) == "/ecs/api" &&
+			aws.ToString(in.FilterPattern) == "ERROR" &&
+			len(in.LogStreamNames) == 1
+	})
+
+	// The second poll starts at the newest timestamp and returns e2 again
+	mockLogs.On("FilterLogEvents", mock.Anything, isTail, mock.Anything).Return(&cloudwatchlogs.FilterLogEventsOutput{
+		Events: []logsTypes.FilteredLogEvent{
+			{EventId: aws.String("e1"), Timestamp: aws.Int64(now - 1000), Message: aws.String("ERROR first")},
+			{EventId: aws.String("e2"), Timestamp: aws.Int64(now), Message: aws.String("ERROR second")},
+		},
+	}, nil).Once()
+	mockLogs.On("FilterLogEvents", mock.Anything, mock.MatchedBy(func(in *cloudwatchlogs.FilterLogEventsInput) bool {
+		return aws.ToInt64(in.StartTime) == now
+	}), mock.Anything).Return(&cloudwatchlogs.FilterLogEventsOutput{
+		Events: []logsTypes.FilteredLogEvent{
+			{EventId: aws.String("e2"), Timestamp: aws.Int64(now), Message: aws.String("ERROR second")},
+			{EventId: aws.String("e3"), Timestamp: aws.Int64(now), Message: aws.String("ERROR third")},
+		},
+	}, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var received []models.LogEvent
+	err := client.TailLogEvents(ctx, models.LogTailRequest{LogGroup: "/ecs/api", LogStream: "api/web/1", FilterPattern: "ERROR"}, func(events []models.LogEvent) {
+		received = append(received, events...)
+		if len(received) >= 3 {
+			cancel()
+		}
+	})
+	assert.NoError(t, err)
+	assert.Len(t, received, 3)
+	assert.Equal(t, "e3", received[2].ID)
+
+	err = client.TailLogEvents(context.Background(), models.LogTailRequest{}, func([]models.LogEvent) {})
+	assert.Error(t, err)
+}
+
+func TestRunLogsInsightsQuery(t *testing.T) {
+	mockLogs := new(MockCloudWatchLogsClient)
+	client := &Client{logsClient: mockLogs}
+
+	logsInsightsPollInterval = time.Millisecond
+	defer func() { logsInsightsPollInterval = time.Second }()
+
+	mockLogs.On("StartQuery", mock.Anything, mock.MatchedBy(func(in *cloudwatchlogs.StartQueryInput) bool {
+		return len(in.LogGroupNames) == 1 && aws.ToInt64(in.EndTime)-aws.ToInt64(in.StartTime) == 3600
+	}), mock.Anything).Return(&cloudwatchlogs.StartQueryOutput{QueryId: aws.String("q-1")}, nil).Once()
+	mockLogs.On("GetQueryResults", mock.Anything, mock.Anything, mock.Anything).Return(&cloudwatchlogs.GetQueryResultsOutput{
+		Status: logsTypes.QueryStatusRunning,
+	}, nil).Once()
+	mockLogs.On("GetQueryResults", mock.Anything, mock.Anything, mock.Anything).Return(&cloudwatchlogs.GetQueryResultsOutput{
+		Status:     logsTypes.QueryStatusComplete,
+		Statistics: &logsTypes.QueryStatistics{RecordsMatched: 1, RecordsScanned: 10},
+		Results: [][]logsTypes.ResultField{
+			{{Field: aws.String("@message"), Value: aws.String("timeout")}, {Field: aws.String("count"), Value: aws.String("3")}},
+		},
+	}, nil).Once()
+
+	result, err := client.RunLogsInsightsQuery(context.Background(), models.LogsInsightsRequest{
+		LogGroups: []string{"/aws/lambda/orders"},
+		Query:     "fields @message | stats count() by @message",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "Complete", result.Status)
+	assert.Len(t, result.Rows, 1)
+	assert.Equal(t, "3", result.Rows[0]["count"])
+	mockLogs.AssertNumberOfCalls(t, "GetQueryResults", 2)
+
+	_, err = client.RunLogsInsightsQuery(context.Background(), models.LogsInsightsRequest{Query: "fields @message"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,129 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	snsTypes "github.com/aws/aws-sdk-go-v2/service/sns/types"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	sqsTypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+
+	"aws-terminal-sdk-v1/internal/models"
+)
+
+// sqsOldestMessageWindow is how far back the age of the oldest message is looked up.
+// SQS publishes its metrics every five minutes.
+const sqsOldestMessageWindow = 15 * time.Minute
+
+// FetchSQSQueues gets the SQS queues with their depth, dead-letter redrive and encryption.
+// The age of the oldest message comes from CloudWatch and is -1 when it is unavailable.
+func (c *Client) FetchSQSQueues(ctx context.Context) ([]models.SQSQueueInfo, error) {
+	queues := make([]models.SQSQueueInfo, 0)
+	paginator := sqs.NewListQueuesPaginator(c.sqsClient, &sqs.ListQueuesInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list SQS queues: %w", err)
+		}
+
+		for _, url := range output.QueueUrls {
+			attributes, err := c.sqsClient.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{
+				QueueUrl:       aws.String(url),
+				AttributeNames: []sqsTypes.QueueAttributeName{sqsTypes.QueueAttributeNameAll},
+			})
+			if err != nil {
+				// The queue may have been deleted since it was listed
+				fmt.Printf("Warning: failed to get attributes of queue %s: %v\n", url, err)
+				continue
+			}
+			queues = append(queues, models.FromSQSQueueAttributes(url, attributes.Attributes))
+		}
+	}
+
+	models.LinkDeadLetterQueues(queues)
+
+	if err := c.fillOldestMessageAge(ctx, queues); err != nil {
+		fmt.Printf("Warning: failed to get the oldest message age of SQS queues: %v\n", err)
+	}
+
+	return queues, nil
+}
+
+// fillOldestMessageAge reads ApproximateAgeOfOldestMessage of every queue in one batch
+func (c *Client) fillOldestMessageAge(ctx context.Context, queues []models.SQSQueueInfo) error {
+	if len(queues) == 0 {
+		return nil
+	}
+
+	queries := make([]models.MetricQuery, 0, len(queues))
+	for i, q := range queues {
+		queries = append(queries, models.MetricQuery{
+			ID:         fmt.Sprintf("q%d", i),
+			Namespace:  "AWS/SQS",
+			MetricName: "ApproximateAgeOfOldestMessage",
+			Dimensions: map[string]string{"QueueName": q.QueueName},
+			Stat:       "Maximum",
+			Period:     300,
+		})
+	}
+
+	endTime := time.Now()
+	data, err := c.FetchMetricQueries(ctx, queries, endTime.Add(-sqsOldestMessageWindow), endTime)
+	if err != nil {
+		return err
+	}
+
+	for _, d := range data {
+		var i int
+		if _, err := fmt.Sscanf(d.ID, "q%d", &i); err != nil || i >= len(queues) || len(d.Values) == 0 {
+			continue
+		}
+		// Values are newest first
+		queues[i].OldestMessageAge = int64(d.Values[0])
+	}
+	return nil
+}
+
+// FetchSNSTopics gets the SNS topics with their subscriptions grouped by protocol
+func (c *Client) FetchSNSTopics(ctx context.Context) ([]models.SNSTopicInfo, error) {
+	subscriptions := make(map[string][]snsTypes.Subscription)
+	subPaginator := sns.NewListSubscriptionsPaginator(c.snsClient, &sns.ListSubscriptionsInput{})
+	for subPaginator.HasMorePages() {
+		output, err := subPaginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list SNS subscriptions: %w", err)
+		}
+
+		for _, sub := range output.Subscriptions {
+			topicARN := aws.ToString(sub.TopicArn)
+			subscriptions[topicARN] = append(subscriptions[topicARN], sub)
+		}
+	}
+
+	topics := make([]models.SNSTopicInfo, 0)
+	topicPaginator := sns.NewListTopicsPaginator(c.snsClient, &sns.ListTopicsInput{})
+	for topicPaginator.HasMorePages() {
+		output, err := topicPaginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list SNS topics: %w", err)
+		}
+
+		for _, t := range output.Topics {
+			arn := aws.ToString(t.TopicArn)
+			attributes, err := c.snsClient.GetTopicAttributes(ctx, &sns.GetTopicAttributesInput{TopicArn: aws.String(arn)})
+			if err != nil {
+				fmt.Printf("Warning: failed to get attributes of topic %s: %v\n", arn, err)
+				attributes = &sns.GetTopicAttributesOutput{}
+			}
+
+			topic := models.FromSNSTopicAttributes(arn, attributes.Attributes)
+			topic.AddSubscriptions(subscriptions[arn])
+			topics = append(topics, topic)
+		}
+	}
+
+	return topics, nil
+}
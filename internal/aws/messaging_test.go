@@ -0,0 +1,121 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwTypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	snsTypes "github.com/aws/aws-sdk-go-v2/service/sns/types"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFetchSQSQueues(t *testing.T) {
+	mockSQS := new(MockSQSClient)
+	mockCW := new(MockCloudWatchClient)
+	client := &Client{sqsClient: mockSQS, cwClient: mockCW}
+
+	ordersURL := "https://sqs.us-east-1.amazonaws.com/123456789012/orders"
+	dlqURL := "https://sqs.us-east-1.amazonaws.com/123456789012/orders-dlq"
+
+	mockSQS.On("ListQueues", mock.Anything, mock.Anything, mock.Anything).Return(&sqs.ListQueuesOutput{
+		QueueUrls: []string{ordersURL, dlqURL},
+	}, nil).Once()
+	mockSQS.On("GetQueueAttributes", mock.Anything, mock.MatchedBy(func(in *sqs.GetQueueAttributesInput) bool {
+		return aws.ToString(in.QueueUrl) == ordersURL
+	}), mock.Anything).Return(&sqs.GetQueueAttributesOutput{
+		Attributes: map[string]string{
+			"QueueArn":                              "arn:aws:sqs:us-east-1:123456789012:orders",
+			"ApproximateNumberOfMessages":           "42",
+			"ApproximateNumberOfMessagesNotVisible": "3",
+			"SqsManagedSseEnabled":                  "true",
+			"RedrivePolicy":                         `{"deadLetterTargetArn":"arn:aws:sqs:us-east-1:123456789012:orders-dlq","maxReceiveCount":5}`,
+		},
+	}, nil).Once()
+	mockSQS.On("GetQueueAttributes", mock.Anything, mock.Anything, mock.Anything).Return(&sqs.GetQueueAttributesOutput{
+		Attributes: map[string]string{
+			"QueueArn":                    "arn:aws:sqs:us-east-1:123456789012:orders-dlq",
+			"ApproximateNumberOfMessages": "7",
+			"KmsMasterKeyId":              "alias/sqs",
+		},
+	}, nil).Once()
+	mockCW.On("GetMetricData", mock.Anything, mock.Anything, mock.Anything).Return(&cloudwatch.GetMetricDataOutput{
+		MetricDataResults: []cwTypes.MetricDataResult{
+			{Id: aws.String("q0"), Values: []float64{900, 600}, Timestamps: []time.Time{time.Now(), time.Now().Add(-5 * time.Minute)}},
+		},
+	}, nil).Once()
+
+	queues, err := client.FetchSQSQueues(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, queues, 2)
+
+	orders, dlq := queues[0], queues[1]
+	assert.Equal(t, "orders", orders.QueueName)
+	assert.Equal(t, int64(42), orders.Messages)
+	assert.Equal(t, int64(3), orders.MessagesInFlight)
+	assert.Equal(t, int64(900), orders.OldestMessageAge)
+	assert.Equal(t, "SSE-SQS", orders.Encryption)
+	assert.Equal(t, dlq.ARN, orders.DeadLetterQueue)
+	assert.Equal(t, int64(5), orders.MaxReceiveCount)
+
+	assert.Equal(t, []string{orders.ARN}, dlq.SourceQueues)
+	assert.Equal(t, "SSE-KMS", dlq.Encryption)
+	assert.Equal(t, int64(-1), dlq.OldestMessageAge)
+}
+
+func TestFetchSQSQueues_MetricsUnavailable(t *testing.T) {
+	mockSQS := new(MockSQSClient)
+	mockCW := new(MockCloudWatchClient)
+	client := &Client{sqsClient: mockSQS, cwClient: mockCW}
+
+	mockSQS.On("ListQueues", mock.Anything, mock.Anything, mock.Anything).Return(&sqs.ListQueuesOutput{
+		QueueUrls: []string{"https://sqs.us-east-1.amazonaws.com/123456789012/orders"},
+	}, nil).Once()
+	mockSQS.On("GetQueueAttributes", mock.Anything, mock.Anything, mock.Anything).Return(&sqs.GetQueueAttributesOutput{
+		Attributes: map[string]string{"ApproximateNumberOfMessages": "1"},
+	}, nil).Once()
+	mockCW.On("GetMetricData", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("access denied")).Once()
+
+	queues, err := client.FetchSQSQueues(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, queues, 1)
+	assert.Equal(t, int64(-1), queues[0].OldestMessageAge)
+}
+
+func TestFetchSNSTopics(t *testing.T) {
+	mockSNS := new(MockSNSClient)
+	client := &Client{snsClient: mockSNS}
+
+	topicARN := "arn:aws:sns:us-east-1:123456789012:alerts"
+	mockSNS.On("ListSubscriptions", mock.Anything, mock.Anything, mock.Anything).Return(&sns.ListSubscriptionsOutput{
+		Subscriptions: []snsTypes.Subscription{
+			{TopicArn: aws.String(topicARN), Protocol: aws.String("sqs"), SubscriptionArn: aws.String(topicARN + ":1"), Endpoint: aws.String("arn:aws:sqs:us-east-1:123456789012:alerts")},
+			{TopicArn: aws.String(topicARN), Protocol: aws.String("email"), SubscriptionArn: aws.String("PendingConfirmation"), Endpoint: aws.String("ops@example.com")},
+			{TopicArn: aws.String(topicARN), Protocol: aws.String("email"), SubscriptionArn: aws.String(topicARN + ":2"), Endpoint: aws.String("oncall@example.com")},
+			{TopicArn: aws.String("arn:aws:sns:us-east-1:123456789012:other"), Protocol: aws.String("lambda")},
+		},
+	}, nil).Once()
+	mockSNS.On("ListTopics", mock.Anything, mock.Anything, mock.Anything).Return(&sns.ListTopicsOutput{
+		Topics: []snsTypes.Topic{{TopicArn: aws.String(topicARN)}},
+	}, nil).Once()
+	mockSNS.On("GetTopicAttributes", mock.Anything, mock.Anything, mock.Anything).Return(&sns.GetTopicAttributesOutput{
+		Attributes: map[string]string{"DisplayName": "Alerts", "SubscriptionsConfirmed": "2", "SubscriptionsPending": "1"},
+	}, nil).Once()
+
+	topics, err := client.FetchSNSTopics(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, topics, 1)
+
+	topic := topics[0]
+	assert.Equal(t, "alerts", topic.TopicName)
+	assert.Equal(t, int64(1), topic.SubscriptionsPending)
+	assert.Equal(t, map[string]int{"email": 2, "sqs": 1}, topic.SubscriptionsByProtocol)
+	assert.Len(t, topic.Subscriptions, 3)
+	assert.True(t, topic.Subscriptions[0].PendingConfirmation)
+}
@@ -0,0 +1,228 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwTypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+
+	"aws-terminal-sdk-v1/internal/models"
+)
+
+// maxMetricDataQueries is the GetMetricData limit of queries per request
+const maxMetricDataQueries = 500
+
+var (
+	// metricIDInvalidChars matches characters not allowed in a GetMetricData query ID
+	metricIDInvalidChars = regexp.MustCompile(`[^a-z0-9_]`)
+	// metricExpressionIdentifier matches identifiers referenced by a metric math expression
+	metricExpressionIdentifier = regexp.MustCompile(`[a-z][a-zA-Z0-9_]*`)
+)
+
+// MetricQueryID builds a valid, predictable query ID such as "targetresponsetime_p99"
+func MetricQueryID(metricName, stat string) string {
+	id := metricIDInvalidChars.ReplaceAllString(strings.ToLower(metricName+"_"+stat), "_")
+	if id == "" || id[0] < 'a' || id[0] > 'z' {
+		id = "m_" + id
+	}
+	return id
+}
+
+// FetchMetricQueries runs metric and expression queries over a time window.
+// Queries are packed into GetMetricData requests of up to 500 queries, keeping each
+// expression in the same request as the metrics it references, and every request
+// is paginated until all datapoints are returned.
+func (c *Client) FetchMetricQueries(ctx context.Context, queries []models.MetricQuery, startTime, endTime time.Time) ([]models.MetricData, error) {
+	if len(queries) == 0 {
+		return []models.MetricData{}, nil
+	}
+	if !startTime.Before(endTime) {
+		return nil, fmt.Errorf("metric window start %s must be before end %s", startTime.Format(time.RFC3339), endTime.Format(time.RFC3339))
+	}
+
+	batches, err := packMetricQueries(queries, maxMetricDataQueries)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]models.MetricData, 0, len(queries))
+	for _, batch := range batches {
+		data, err := c.fetchMetricBatch(ctx, batch, startTime, endTime)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, data...)
+	}
+
+	return results, nil
+}
+
+// fetchMetricBatch executes a single GetMetricData request, following NextToken
+func (c *Client) fetchMetricBatch(ctx context.Context, batch []models.MetricQuery, startTime, endTime time.Time) ([]models.MetricData, error) {
+	input := &cloudwatch.GetMetricDataInput{
+		MetricDataQueries: make([]cwTypes.MetricDataQuery, 0, len(batch)),
+		StartTime:         aws.Time(startTime),
+		EndTime:           aws.Time(endTime),
+	}
+	for _, q := range batch {
+		input.MetricDataQueries = append(input.MetricDataQueries, toCloudWatchQuery(q))
+	}
+
+	// Results of the same query are split across pages, merge them by ID
+	byID := make(map[string]*models.MetricData, len(batch))
+	order := make([]string, 0, len(batch))
+
+	paginator := cloudwatch.NewGetMetricDataPaginator(c.cwClient, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get metric data: %w", err)
+		}
+
+		for _, result := range output.MetricDataResults {
+			id := aws.ToString(result.Id)
+			data, ok := byID[id]
+			if !ok {
+				data = &models.MetricData{
+					ID:     id,
+					Label:  aws.ToString(result.Label),
+					Values: []float64{},
+					Times:  []string{},
+				}
+				byID[id] = data
+				order = append(order, id)
+			}
+			data.Values = append(data.Values, result.Values...)
+			for _, ts := range result.Timestamps {
+				data.Times = append(data.Times, ts.UTC().Format(time.RFC3339))
+			}
+		}
+	}
+
+	metrics := make([]models.MetricData, 0, len(order))
+	for _, id := range order {
+		metrics = append(metrics, *byID[id])
+	}
+	return metrics, nil
+}
+
+// toCloudWatchQuery converts a metric query to its GetMetricData representation
+func toCloudWatchQuery(q models.MetricQuery) cwTypes.MetricDataQuery {
+	query := cwTypes.MetricDataQuery{
+		Id:         aws.String(q.ID),
+		ReturnData: aws.Bool(!q.Hidden),
+	}
+	if q.Label != "" {
+		query.Label = aws.String(q.Label)
+	}
+
+	if q.Expression != "" {
+		query.Expression = aws.String(q.Expression)
+		if q.Period > 0 {
+			query.Period = aws.Int32(q.Period)
+		}
+		return query
+	}
+
+	var dimensions []cwTypes.Dimension
+	for k, v := range q.Dimensions {
+		dimensions = append(dimensions, cwTypes.Dimension{
+			Name:  aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+
+	query.MetricStat = &cwTypes.MetricStat{
+		Metric: &cwTypes.Metric{
+			Namespace:  aws.String(q.Namespace),
+			MetricName: aws.String(q.MetricName),
+			Dimensions: dimensions,
+		},
+		Period: aws.Int32(q.Period),
+		Stat:   aws.String(q.Stat),
+	}
+	return query
+}
+
+// packMetricQueries splits queries into batches of at most size queries.
+// Expressions and the queries they reference are kept in the same batch.
+func packMetricQueries(queries []models.MetricQuery, size int) ([][]models.MetricQuery, error) {
+	index := make(map[string]int, len(queries))
+	for i, q := range queries {
+		if q.ID == "" {
+			return nil, fmt.Errorf("metric query %d has no id", i)
+		}
+		if _, dup := index[q.ID]; dup {
+			return nil, fmt.Errorf("duplicate metric query id %q", q.ID)
+		}
+		index[q.ID] = i
+	}
+
+	// Union-find over query positions, linking expressions to their inputs
+	parent := make([]int, len(queries))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+	for i, q := range queries {
+		if q.Expression == "" {
+			continue
+		}
+		for _, ref := range metricExpressionIdentifier.FindAllString(q.Expression, -1) {
+			if j, ok := index[ref]; ok {
+				parent[find(j)] = find(i)
+			}
+		}
+	}
+
+	// Collect groups in order of first appearance
+	groups := make(map[int][]int)
+	var roots []int
+	for i := range queries {
+		root := find(i)
+		if _, ok := groups[root]; !ok {
+			roots = append(roots, root)
+		}
+		groups[root] = append(groups[root], i)
+	}
+
+	var batches [][]models.MetricQuery
+	var current []int
+	flush := func() {
+		// Keep the caller's ordering within each request
+		sort.Ints(current)
+		batch := make([]models.MetricQuery, 0, len(current))
+		for _, i := range current {
+			batch = append(batch, queries[i])
+		}
+		batches = append(batches, batch)
+		current = nil
+	}
+	for _, root := range roots {
+		group := groups[root]
+		if len(group) > size {
+			return nil, fmt.Errorf("metric expression group of %d queries exceeds the %d query limit", len(group), size)
+		}
+		if len(current)+len(group) > size {
+			flush()
+		}
+		current = append(current, group...)
+	}
+	if len(current) > 0 {
+		flush()
+	}
+
+	return batches, nil
+}
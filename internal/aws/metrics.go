@@ -0,0 +1,356 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"aws-terminal-sdk-v1/internal/constants"
+	"aws-terminal-sdk-v1/internal/models"
+)
+
+// MetricDefinition describes a single CloudWatch metric and the statistic to chart
+type MetricDefinition struct {
+	Name string
+	Stat string
+}
+
+// MetricCatalogEntry maps a resource type to its CloudWatch namespace,
+// dimension keys and default metric set
+type MetricCatalogEntry struct {
+	Namespace     string
+	DimensionKeys []string
+	Metrics       []MetricDefinition
+
+	// dimensionValues converts a resource identifier into one value per dimension key.
+	// When nil, the identifier is used as-is for the single dimension key.
+	dimensionValues func(resourceID string) []string
+}
+
+// metricCatalog holds the default metrics shown for each supported resource type
+var metricCatalog = map[string]MetricCatalogEntry{
+	constants.ResourceTypeEC2: {
+		Namespace:     "AWS/EC2",
+		DimensionKeys: []string{"InstanceId"},
+		Metrics: []MetricDefinition{
+			{Name: "CPUUtilization", Stat: "Average"},
+			{Name: "NetworkIn", Stat: "Sum"},
+			{Name: "NetworkOut", Stat: "Sum"},
+			{Name: "StatusCheckFailed", Stat: "Maximum"},
+		},
+	},
+	constants.ResourceTypeRDS: {
+		Namespace:     "AWS/RDS",
+		DimensionKeys: []string{"DBInstanceIdentifier"},
+		Metrics: []MetricDefinition{
+			{Name: "CPUUtilization", Stat: "Average"},
+			{Name: "DatabaseConnections", Stat: "Average"},
+			{Name: "FreeStorageSpace", Stat: "Minimum"},
+			{Name: "FreeableMemory", Stat: "Average"},
+		},
+	},
+	constants.ResourceTypeLambda: {
+		Namespace:     "AWS/Lambda",
+		DimensionKeys: []string{"FunctionName"},
+		Metrics: []MetricDefinition{
+			{Name: "Invocations", Stat: "Sum"},
+			{Name: "Errors", Stat: "Sum"},
+			{Name: "Duration", Stat: "Average"},
+			{Name: "Throttles", Stat: "Sum"},
+		},
+	},
+	constants.ResourceTypeALB: {
+		Namespace:     "AWS/ApplicationELB",
+		DimensionKeys: []string{"LoadBalancer"},
+		Metrics: []MetricDefinition{
+			{Name: "RequestCount", Stat: "Sum"},
+			{Name: "HTTPCode_ELB_5XX_Count", Stat: "Sum"},
+			{Name: "HTTPCode_Target_5XX_Count", Stat: "Sum"},
+			{Name: "TargetResponseTime", Stat: "Average"},
+		},
+		dimensionValues: loadBalancerDimension,
+	},
+	constants.ResourceTypeNLB: {
+		Namespace:     "AWS/NetworkELB",
+		DimensionKeys: []string{"LoadBalancer"},
+		Metrics: []MetricDefinition{
+			{Name: "ActiveFlowCount", Stat: "Average"},
+			{Name: "NewFlowCount", Stat: "Sum"},
+			{Name: "ProcessedBytes", Stat: "Sum"},
+		},
+		dimensionValues: loadBalancerDimension,
+	},
+	constants.ResourceTypeNAT: {
+		Namespace:     "AWS/NATGateway",
+		DimensionKeys: []string{"NatGatewayId"},
+		Metrics: []MetricDefinition{
+			{Name: "BytesOutToDestination", Stat: "Sum"},
+			{Name: "BytesInFromDestination", Stat: "Sum"},
+			{Name: "ActiveConnectionCount", Stat: "Maximum"},
+			{Name: "ErrorPortAllocation", Stat: "Sum"},
+		},
+	},
+	constants.ResourceTypeECSCluster: {
+		Namespace:     "AWS/ECS",
+		DimensionKeys: []string{"ClusterName"},
+		Metrics: []MetricDefinition{
+			{Name: "CPUUtilization", Stat: "Average"},
+			{Name: "MemoryUtilization", Stat: "Average"},
+		},
+	},
+	constants.ResourceTypeECSService: {
+		Namespace:     "AWS/ECS",
+		DimensionKeys: []string{"ClusterName", "ServiceName"},
+		Metrics: []MetricDefinition{
+			{Name: "CPUUtilization", Stat: "Average"},
+			{Name: "MemoryUtilization", Stat: "Average"},
+		},
+		// Identified as "<cluster>/<service>"
+		dimensionValues: func(resourceID string) []string {
+			return strings.SplitN(resourceID, "/", 2)
+		},
+	},
+	constants.ResourceTypeDynamoDB: {
+		Namespace:     "AWS/DynamoDB",
+		DimensionKeys: []string{"TableName"},
+		Metrics: []MetricDefinition{
+			{Name: "ConsumedReadCapacityUnits", Stat: "Sum"},
+			{Name: "ConsumedWriteCapacityUnits", Stat: "Sum"},
+			{Name: "ReadThrottleEvents", Stat: "Sum"},
+			{Name: "WriteThrottleEvents", Stat: "Sum"},
+		},
+	},
+	constants.ResourceTypeSQS: {
+		Namespace:     "AWS/SQS",
+		DimensionKeys: []string{"QueueName"},
+		Metrics: []MetricDefinition{
+			{Name: "ApproximateNumberOfMessagesVisible", Stat: "Maximum"},
+			{Name: "ApproximateAgeOfOldestMessage", Stat: "Maximum"},
+			{Name: "NumberOfMessagesSent", Stat: "Sum"},
+			{Name: "NumberOfMessagesDeleted", Stat: "Sum"},
+		},
+	},
+	constants.ResourceTypeSNS: {
+		Namespace:     "AWS/SNS",
+		DimensionKeys: []string{"TopicName"},
+		Metrics: []MetricDefinition{
+			{Name: "NumberOfMessagesPublished", Stat: "Sum"},
+			{Name: "NumberOfNotificationsDelivered", Stat: "Sum"},
+			{Name: "NumberOfNotificationsFailed", Stat: "Sum"},
+		},
+		// Identified by topic ARN or name
+		dimensionValues: func(resourceID string) []string {
+			return []string{resourceID[strings.LastIndex(resourceID, ":")+1:]}
+		},
+	},
+	constants.ResourceTypeElastiCache: {
+		Namespace:     "AWS/ElastiCache",
+		DimensionKeys: []string{"CacheClusterId"},
+		Metrics: []MetricDefinition{
+			{Name: "CPUUtilization", Stat: "Average"},
+			{Name: "CurrConnections", Stat: "Average"},
+			{Name: "FreeableMemory", Stat: "Minimum"},
+			{Name: "Evictions", Stat: "Sum"},
+		},
+	},
+	constants.ResourceTypeOpenSearch: {
+		Namespace:     "AWS/ES",
+		DimensionKeys: []string{"DomainName", "ClientId"},
+		Metrics: []MetricDefinition{
+			{Name: "ClusterStatus.red", Stat: "Maximum"},
+			{Name: "CPUUtilization", Stat: "Average"},
+			{Name: "JVMMemoryPressure", Stat: "Maximum"},
+			{Name: "FreeStorageSpace", Stat: "Minimum"},
+		},
+		// Identified by domain ARN, the account is the ClientId dimension
+		dimensionValues: func(resourceID string) []string {
+			account, name := models.OpenSearchDomainFromARN(resourceID)
+			return []string{name, account}
+		},
+	},
+	constants.ResourceTypeMSK: {
+		Namespace:     "AWS/Kafka",
+		DimensionKeys: []string{"Cluster Name"},
+		Metrics: []MetricDefinition{
+			{Name: "ActiveControllerCount", Stat: "Maximum"},
+			{Name: "OfflinePartitionsCount", Stat: "Maximum"},
+			{Name: "GlobalPartitionCount", Stat: "Maximum"},
+			{Name: "GlobalTopicCount", Stat: "Maximum"},
+		},
+	},
+}
+
+// metricRange describes a selectable chart window and its datapoint period
+type metricRange struct {
+	Duration time.Duration
+	Period   int32
+}
+
+// metricRanges keeps roughly 24 datapoints per window, matching the sidebar charts
+var metricRanges = map[string]metricRange{
+	constants.MetricRange1Hour:   {Duration: time.Hour, Period: 300},
+	constants.MetricRange6Hours:  {Duration: 6 * time.Hour, Period: 900},
+	constants.MetricRange24Hours: {Duration: 24 * time.Hour, Period: 3600},
+	constants.MetricRange7Days:   {Duration: 7 * 24 * time.Hour, Period: 21600},
+}
+
+// LookupMetricCatalog returns the catalog entry of a resource type
+func LookupMetricCatalog(resourceType string) (MetricCatalogEntry, bool) {
+	entry, ok := metricCatalog[resourceType]
+	return entry, ok
+}
+
+// Dimensions builds the CloudWatch dimensions identifying a resource
+func (e MetricCatalogEntry) Dimensions(resourceID string) (map[string]string, error) {
+	values := []string{resourceID}
+	if e.dimensionValues != nil {
+		values = e.dimensionValues(resourceID)
+	}
+
+	if len(values) != len(e.DimensionKeys) {
+		return nil, fmt.Errorf("resource id %q does not match dimensions %v", resourceID, e.DimensionKeys)
+	}
+
+	dimensions := make(map[string]string, len(values))
+	for i, key := range e.DimensionKeys {
+		if values[i] == "" {
+			return nil, fmt.Errorf("resource id %q has an empty %s", resourceID, key)
+		}
+		dimensions[key] = values[i]
+	}
+	return dimensions, nil
+}
+
+// loadBalancerDimension converts a load balancer ARN into its CloudWatch
+// dimension value, e.g. "app/my-alb/50dc6c495c0c9188"
+func loadBalancerDimension(resourceID string) []string {
+	if idx := strings.Index(resourceID, ":loadbalancer/"); idx >= 0 {
+		return []string{resourceID[idx+len(":loadbalancer/"):]}
+	}
+	return []string{resourceID}
+}
+
+// FetchMetricsForResource gets the catalog's default metrics of a resource over a time range
+func (c *Client) FetchMetricsForResource(ctx context.Context, resourceType, resourceID, timeRange string) (*models.ResourceMetrics, error) {
+	entry, ok := LookupMetricCatalog(resourceType)
+	if !ok {
+		return nil, fmt.Errorf("no metrics available for resource type %q", resourceType)
+	}
+
+	dimensions, err := entry.Dimensions(resourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if timeRange == "" {
+		timeRange = constants.DefaultMetricRange
+	}
+	window, ok := metricRanges[timeRange]
+	if !ok {
+		return nil, fmt.Errorf("unsupported metric range %q", timeRange)
+	}
+
+	endTime := time.Now()
+	startTime := endTime.Add(-window.Duration)
+
+	data, err := c.FetchMetricQueries(ctx, entry.Queries(dimensions, window.Period, nil), startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.ResourceMetrics{Metrics: data}, nil
+}
+
+// QueryResourceMetrics runs a custom metrics request for a catalog resource: any
+// start/end window, several statistics per metric and metric math expressions
+func (c *Client) QueryResourceMetrics(ctx context.Context, req models.MetricRequest) (*models.ResourceMetrics, error) {
+	entry, ok := LookupMetricCatalog(req.ResourceType)
+	if !ok {
+		return nil, fmt.Errorf("no metrics available for resource type %q", req.ResourceType)
+	}
+
+	dimensions, err := entry.Dimensions(req.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	endTime := time.Now()
+	if req.End != "" {
+		if endTime, err = time.Parse(time.RFC3339, req.End); err != nil {
+			return nil, fmt.Errorf("invalid end time %q: %w", req.End, err)
+		}
+	}
+	startTime := endTime.Add(-24 * time.Hour)
+	if req.Start != "" {
+		if startTime, err = time.Parse(time.RFC3339, req.Start); err != nil {
+			return nil, fmt.Errorf("invalid start time %q: %w", req.Start, err)
+		}
+	}
+	if !startTime.Before(endTime) {
+		return nil, fmt.Errorf("start time %s must be before end time %s", startTime.Format(time.RFC3339), endTime.Format(time.RFC3339))
+	}
+
+	period := req.Period
+	if period <= 0 {
+		period = metricPeriodFor(endTime.Sub(startTime))
+	}
+
+	queries := entry.Queries(dimensions, period, req.Stats)
+	for _, expr := range req.Expressions {
+		queries = append(queries, models.MetricQuery{
+			ID:         expr.ID,
+			Label:      expr.Label,
+			Expression: expr.Expression,
+			Period:     period,
+		})
+	}
+
+	data, err := c.FetchMetricQueries(ctx, queries, startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.ResourceMetrics{Metrics: data}, nil
+}
+
+// Queries builds one query per catalog metric and statistic. Without stats, each
+// metric uses its default statistic and is labelled with the metric name alone.
+func (e MetricCatalogEntry) Queries(dimensions map[string]string, period int32, stats []string) []models.MetricQuery {
+	queries := make([]models.MetricQuery, 0, len(e.Metrics)*max(len(stats), 1))
+	for _, def := range e.Metrics {
+		if len(stats) == 0 {
+			queries = append(queries, models.MetricQuery{
+				ID:         MetricQueryID(def.Name, def.Stat),
+				Label:      def.Name,
+				Namespace:  e.Namespace,
+				MetricName: def.Name,
+				Dimensions: dimensions,
+				Stat:       def.Stat,
+				Period:     period,
+			})
+			continue
+		}
+		for _, stat := range stats {
+			queries = append(queries, models.MetricQuery{
+				ID:         MetricQueryID(def.Name, stat),
+				Label:      def.Name + " " + stat,
+				Namespace:  e.Namespace,
+				MetricName: def.Name,
+				Dimensions: dimensions,
+				Stat:       stat,
+				Period:     period,
+			})
+		}
+	}
+	return queries
+}
+
+// metricPeriodFor picks a period giving roughly 24 datapoints, rounded up to whole minutes
+func metricPeriodFor(window time.Duration) int32 {
+	seconds := int32(window.Seconds() / 24)
+	if rem := seconds % 60; rem != 0 || seconds == 0 {
+		seconds += 60 - rem
+	}
+	return seconds
+}
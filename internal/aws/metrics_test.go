@@ -0,0 +1,191 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwTypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"aws-terminal-sdk-v1/internal/models"
+)
+
+func TestMetricCatalogDimensions(t *testing.T) {
+	alb, ok := LookupMetricCatalog("alb")
+	assert.True(t, ok)
+	dims, err := alb.Dimensions("arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/my-alb/50dc6c495c0c9188")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"LoadBalancer": "app/my-alb/50dc6c495c0c9188"}, dims)
+
+	svc, ok := LookupMetricCatalog("ecs-service")
+	assert.True(t, ok)
+	dims, err = svc.Dimensions("prod/api")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"ClusterName": "prod", "ServiceName": "api"}, dims)
+
+	_, err = svc.Dimensions("prod")
+	assert.Error(t, err)
+
+	topic, ok := LookupMetricCatalog("sns")
+	assert.True(t, ok)
+	dims, err = topic.Dimensions("arn:aws:sns:us-east-1:123456789012:alerts")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"TopicName": "alerts"}, dims)
+
+	domain, ok := LookupMetricCatalog("opensearch")
+	assert.True(t, ok)
+	dims, err = domain.Dimensions("arn:aws:es:us-east-1:123456789012:domain/logs")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"DomainName": "logs", "ClientId": "123456789012"}, dims)
+
+	_, err = domain.Dimensions("logs")
+	assert.Error(t, err)
+
+	_, ok = LookupMetricCatalog("unknown")
+	assert.False(t, ok)
+}
+
+func TestFetchMetricsForResource(t *testing.T) {
+	mockCW := new(MockCloudWatchClient)
+	client := &Client{cwClient: mockCW}
+
+	now := time.Now()
+	mockCW.On("GetMetricData", mock.Anything, mock.MatchedBy(func(in *cloudwatch.GetMetricDataInput) bool {
+		stat := in.MetricDataQueries[0].MetricStat
+		return len(in.MetricDataQueries) == 4 &&
+			aws.ToString(stat.Metric.Namespace) == "AWS/Lambda" &&
+			aws.ToString(stat.Metric.Dimensions[0].Value) == "orders-handler" &&
+			aws.ToInt32(stat.Period) == 300 &&
+			in.EndTime.Sub(*in.StartTime) == time.Hour
+	}), mock.Anything).Return(&cloudwatch.GetMetricDataOutput{
+		MetricDataResults: []cwTypes.MetricDataResult{
+			{Id: aws.String("invocations_sum"), Label: aws.String("Invocations"), Values: []float64{10}, Timestamps: []time.Time{now}},
+			{Id: aws.String("errors_sum"), Label: aws.String("Errors")},
+			{Id: aws.String("duration_average"), Label: aws.String("Duration")},
+			{Id: aws.String("throttles_sum"), Label: aws.String("Throttles")},
+		},
+	}, nil)
+
+	metrics, err := client.FetchMetricsForResource(context.Background(), "lambda", "orders-handler", "1h")
+	assert.NoError(t, err)
+	// Invocations, Errors, Duration, Throttles in a single request
+	assert.Len(t, metrics.Metrics, 4)
+	assert.Equal(t, now.UTC().Format(time.RFC3339), metrics.Metrics[0].Times[0])
+	mockCW.AssertNumberOfCalls(t, "GetMetricData", 1)
+
+	_, err = client.FetchMetricsForResource(context.Background(), "lambda", "orders-handler", "90d")
+	assert.Error(t, err)
+
+	_, err = client.FetchMetricsForResource(context.Background(), "kinesis", "orders", "1h")
+	assert.Error(t, err)
+}
+
+func TestQueryResourceMetrics(t *testing.T) {
+	mockCW := new(MockCloudWatchClient)
+	client := &Client{cwClient: mockCW}
+
+	mockCW.On("GetMetricData", mock.Anything, mock.MatchedBy(func(in *cloudwatch.GetMetricDataInput) bool {
+		// 4 ALB metrics x 3 stats + 1 expression over a 2 hour window
+		last := in.MetricDataQueries[len(in.MetricDataQueries)-1]
+		return len(in.MetricDataQueries) == 13 &&
+			aws.ToString(in.MetricDataQueries[0].Id) == "requestcount_p50" &&
+			aws.ToString(in.MetricDataQueries[0].MetricStat.Stat) == "p50" &&
+			aws.ToInt32(in.MetricDataQueries[0].MetricStat.Period) == 300 &&
+			aws.ToString(last.Expression) == "httpcode_target_5xx_count_sum/requestcount_sum*100" &&
+			in.EndTime.Sub(*in.StartTime) == 2*time.Hour
+	}), mock.Anything).Return(&cloudwatch.GetMetricDataOutput{
+		MetricDataResults: []cwTypes.MetricDataResult{
+			{Id: aws.String("error_rate"), Label: aws.String("Error rate"), Values: []float64{1.5}},
+		},
+	}, nil).Once()
+
+	metrics, err := client.QueryResourceMetrics(context.Background(), models.MetricRequest{
+		ResourceType: "alb",
+		ResourceID:   "app/my-alb/50dc6c495c0c9188",
+		Start:        "2024-05-01T10:00:00Z",
+		End:          "2024-05-01T12:00:00Z",
+		Stats:        []string{"p50", "p99", "Sum"},
+		Expressions: []models.MetricExpression{
+			{ID: "error_rate", Label: "Error rate", Expression: "httpcode_target_5xx_count_sum/requestcount_sum*100"},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, metrics.Metrics, 1)
+	assert.Equal(t, "error_rate", metrics.Metrics[0].ID)
+
+	_, err = client.QueryResourceMetrics(context.Background(), models.MetricRequest{
+		ResourceType: "alb",
+		ResourceID:   "app/my-alb/50dc6c495c0c9188",
+		Start:        "yesterday",
+	})
+	assert.Error(t, err)
+}
+
+func TestFetchMetricQueriesBatching(t *testing.T) {
+	mockCW := new(MockCloudWatchClient)
+	client := &Client{cwClient: mockCW}
+
+	// 499 plain metrics followed by an expression over two of its own inputs:
+	// the expression group cannot be split, so it moves to a second request
+	queries := make([]models.MetricQuery, 0, 502)
+	for i := 0; i < 499; i++ {
+		queries = append(queries, models.MetricQuery{
+			ID: fmt.Sprintf("cpu_%d", i), Namespace: "AWS/EC2", MetricName: "CPUUtilization",
+			Dimensions: map[string]string{"InstanceId": fmt.Sprintf("i-%d", i)}, Stat: "Average", Period: 300,
+		})
+	}
+	queries = append(queries,
+		models.MetricQuery{ID: "in_sum", Namespace: "AWS/EC2", MetricName: "NetworkIn", Stat: "Sum", Period: 300, Hidden: true},
+		models.MetricQuery{ID: "out_sum", Namespace: "AWS/EC2", MetricName: "NetworkOut", Stat: "Sum", Period: 300, Hidden: true},
+		models.MetricQuery{ID: "total", Label: "Total traffic", Expression: "in_sum+out_sum"},
+	)
+
+	first := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	mockCW.On("GetMetricData", mock.Anything, mock.MatchedBy(func(in *cloudwatch.GetMetricDataInput) bool {
+		return len(in.MetricDataQueries) == 499 && in.NextToken == nil
+	}), mock.Anything).Return(&cloudwatch.GetMetricDataOutput{
+		MetricDataResults: []cwTypes.MetricDataResult{
+			{Id: aws.String("cpu_0"), Label: aws.String("CPUUtilization"), Values: []float64{10}, Timestamps: []time.Time{first}},
+		},
+		NextToken: aws.String("page-2"),
+	}, nil).Once()
+	mockCW.On("GetMetricData", mock.Anything, mock.MatchedBy(func(in *cloudwatch.GetMetricDataInput) bool {
+		return len(in.MetricDataQueries) == 499 && aws.ToString(in.NextToken) == "page-2"
+	}), mock.Anything).Return(&cloudwatch.GetMetricDataOutput{
+		MetricDataResults: []cwTypes.MetricDataResult{
+			{Id: aws.String("cpu_0"), Label: aws.String("CPUUtilization"), Values: []float64{20}, Timestamps: []time.Time{first.Add(-5 * time.Minute)}},
+		},
+	}, nil).Once()
+	mockCW.On("GetMetricData", mock.Anything, mock.MatchedBy(func(in *cloudwatch.GetMetricDataInput) bool {
+		return len(in.MetricDataQueries) == 3 &&
+			!aws.ToBool(in.MetricDataQueries[0].ReturnData) &&
+			aws.ToString(in.MetricDataQueries[2].Expression) == "in_sum+out_sum"
+	}), mock.Anything).Return(&cloudwatch.GetMetricDataOutput{
+		MetricDataResults: []cwTypes.MetricDataResult{
+			{Id: aws.String("total"), Label: aws.String("Total traffic"), Values: []float64{1024}, Timestamps: []time.Time{first}},
+		},
+	}, nil).Once()
+
+	metrics, err := client.FetchMetricQueries(context.Background(), queries, first.Add(-time.Hour), first)
+	assert.NoError(t, err)
+	assert.Len(t, metrics, 2)
+	assert.Equal(t, []float64{10, 20}, metrics[0].Values)
+	assert.Equal(t, []string{"2024-05-01T10:00:00Z", "2024-05-01T09:55:00Z"}, metrics[0].Times)
+	assert.Equal(t, "total", metrics[1].ID)
+	mockCW.AssertNumberOfCalls(t, "GetMetricData", 3)
+
+	// Duplicate IDs are rejected before any request is made
+	_, err = client.FetchMetricQueries(context.Background(), []models.MetricQuery{{ID: "a"}, {ID: "a"}}, first.Add(-time.Hour), first)
+	assert.Error(t, err)
+}
+
+func TestMetricQueryID(t *testing.T) {
+	assert.Equal(t, "targetresponsetime_p99", MetricQueryID("TargetResponseTime", "p99"))
+	assert.Equal(t, "cpuutilization_p99_9", MetricQueryID("CPUUtilization", "p99.9"))
+	assert.Equal(t, "m_5xxerror_sum", MetricQueryID("5XXError", "Sum"))
+}
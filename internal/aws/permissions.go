@@ -0,0 +1,420 @@
+package aws
+
+//go:generate go test -run TestReadOnlyPolicy -update .
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+
+	"github.com/aws/smithy-go"
+
+	"aws-terminal-sdk-v1/internal/constants"
+)
+
+// permissionSimulationBatchSize is the number of actions simulated per request
+const permissionSimulationBatchSize = 50
+
+// registeredFeature groups the fetchers of a part of the application. Each fetcher
+// declares every IAM action it needs, including those of the fetchers it calls.
+type registeredFeature struct {
+	key      string
+	label    string
+	fetchers map[string][]string
+}
+
+// featureRegistry is the source of the actions simulated by VerifyPermissions and of
+// permissions.json. TestFeatureRegistryMatchesFetchers checks it against the SDK
+// calls of the fetchers, keep both in step.
+var featureRegistry = []registeredFeature{
+	{constants.FeatureAccount, "Account identity", map[string][]string{
+		"fetchAccountIdentity": {"sts:GetCallerIdentity", "iam:ListAccountAliases", "iam:ListMFADevices"},
+		"FetchConfiguration":   {"sts:GetCallerIdentity", "iam:ListAttachedUserPolicies", "iam:ListAttachedRolePolicies"},
+		"VerifyPermissions":    {"sts:GetCallerIdentity", "iam:SimulatePrincipalPolicy"},
+	}},
+	{constants.FeatureUsage, "Resource usage", map[string][]string{
+		"getUsageCount": {"ec2:DescribeVpcs", "ec2:DescribeInstances", "ec2:DescribeAddresses", "ec2:DescribeNatGateways", "lambda:ListFunctions", "s3:ListAllMyBuckets"},
+	}},
+	{constants.FeatureServiceQuotas, "Service quotas", map[string][]string{
+		"getQuota": {"servicequotas:GetServiceQuota"},
+	}},
+	{constants.FeatureCosts, "Costs", map[string][]string{
+		"getCost": {"ce:GetCostAndUsage"},
+	}},
+	{constants.FeatureSecurityHub, "Security Hub findings", map[string][]string{
+		"FetchSecurityFindings": {"securityhub:GetFindings"},
+	}},
+	{constants.FeatureTrustedAdvisor, "Trusted Advisor", map[string][]string{
+		"FetchTrustedAdvisorRecommendations": {"support:DescribeTrustedAdvisorCheckResult"},
+	}},
+	{constants.FeatureMetrics, "CloudWatch metrics", map[string][]string{
+		"FetchResourceMetrics":    {"cloudwatch:GetMetricData"},
+		"FetchMetricsForResource": {"cloudwatch:GetMetricData"},
+		"FetchMetricQueries":      {"cloudwatch:GetMetricData"},
+		"QueryResourceMetrics":    {"cloudwatch:GetMetricData"},
+	}},
+	{constants.FeatureAlarms, "CloudWatch alarms", map[string][]string{
+		"FetchAlarms":            {"cloudwatch:DescribeAlarms"},
+		"FetchAlarmsForResource": {"cloudwatch:DescribeAlarms"},
+		"FetchAlarmHistory":      {"cloudwatch:DescribeAlarmHistory"},
+	}},
+	{constants.FeatureLogs, "CloudWatch Logs", map[string][]string{
+		"FetchLogGroups":           {"logs:DescribeLogGroups"},
+		"FetchLogStreams":          {"logs:DescribeLogStreams"},
+		"TailLogEvents":            {"logs:FilterLogEvents"},
+		"RunLogsInsightsQuery":     {"logs:StartQuery", "logs:GetQueryResults", "logs:StopQuery"},
+		"StartLogsInsightsQuery":   {"logs:StartQuery"},
+		"FetchLogsInsightsResults": {"logs:GetQueryResults"},
+	}},
+
+	{constants.FeatureVPC, "VPCs", map[string][]string{
+		"FetchVPCs": {"ec2:DescribeVpcs"},
+	}},
+	{constants.FeatureSubnet, "Subnets", map[string][]string{
+		"FetchSubnets": {"ec2:DescribeSubnets"},
+	}},
+	{constants.FeatureSecurityGroup, "Security groups", map[string][]string{
+		"FetchSecurityGroups": {"ec2:DescribeSecurityGroups"},
+	}},
+	{constants.FeatureNATGateway, "NAT gateways", map[string][]string{
+		"FetchNATGateways": {"ec2:DescribeNatGateways"},
+		"natGatewayOwners": {"ec2:DescribeNatGateways"},
+	}},
+	{constants.FeatureRouteTable, "Route tables", map[string][]string{
+		"FetchRouteTables": {"ec2:DescribeRouteTables"},
+	}},
+	{constants.FeatureElasticIP, "Elastic IPs", map[string][]string{
+		"FetchElasticIPs": {"ec2:DescribeAddresses"},
+	}},
+	{constants.FeatureNetworkInterfaces, "Network interfaces", map[string][]string{
+		"FetchNetworkInterfaces": {"ec2:DescribeNetworkInterfaces"},
+	}},
+	{constants.FeatureConnectivity, "VPC connectivity", map[string][]string{
+		"FetchInternetGateways":          {"ec2:DescribeInternetGateways"},
+		"FetchVPCEndpoints":              {"ec2:DescribeVpcEndpoints"},
+		"FetchVPCPeerings":               {"ec2:DescribeVpcPeeringConnections"},
+		"FetchTransitGatewayAttachments": {"ec2:DescribeTransitGatewayAttachments"},
+		"FetchTransitGatewayRouteTables": {"ec2:DescribeTransitGatewayRouteTables", "ec2:SearchTransitGatewayRoutes"},
+		"FetchVPNConnections":            {"ec2:DescribeVpnConnections", "ec2:DescribeVpnGateways"},
+	}},
+	{constants.FeatureFlowLogs, "VPC flow logs", map[string][]string{
+		"FetchFlowLogs":        {"ec2:DescribeFlowLogs"},
+		"FetchFlowLogFindings": {"ec2:DescribeFlowLogs", "ec2:DescribeVpcs"},
+		"FetchFlowLogSummary":  {"ec2:DescribeFlowLogs", "logs:StartQuery", "logs:GetQueryResults", "logs:StopQuery"},
+	}},
+
+	{constants.FeatureEC2, "EC2 instances", map[string][]string{
+		"FetchEC2Instances": {"ec2:DescribeInstances"},
+	}},
+	{constants.FeatureInstanceStatus, "EC2 status checks", map[string][]string{
+		"FetchInstanceStatus":   {"ec2:DescribeInstanceStatus"},
+		"FetchInstanceStatuses": {"ec2:DescribeInstanceStatus"},
+	}},
+	{constants.FeatureEBS, "EBS volumes and snapshots", map[string][]string{
+		"FetchEBSVolumes":       {"ec2:DescribeVolumes"},
+		"FetchEBSSnapshots":     {"ec2:DescribeSnapshots", "ec2:DescribeVolumes", "ec2:DescribeImages"},
+		"FetchEBSStorageReport": {"ec2:DescribeSnapshots", "ec2:DescribeVolumes", "ec2:DescribeImages"},
+	}},
+	{constants.FeatureAutoScaling, "Auto Scaling groups", map[string][]string{
+		"FetchAutoScalingGroups":      {"autoscaling:DescribeAutoScalingGroups", "autoscaling:DescribePolicies"},
+		"FetchScalingActivities":      {"autoscaling:DescribeScalingActivities"},
+		"FetchLaunchTemplates":        {"ec2:DescribeLaunchTemplates"},
+		"FetchLaunchTemplateVersions": {"ec2:DescribeLaunchTemplateVersions"},
+	}},
+	{constants.FeatureLoadBalancer, "Load balancers", map[string][]string{
+		"FetchLoadBalancers": {"elasticloadbalancing:DescribeLoadBalancers"},
+	}},
+	{constants.FeatureTargetGroup, "Target groups", map[string][]string{
+		"FetchTargetGroups": {"elasticloadbalancing:DescribeTargetGroups"},
+	}},
+	{constants.FeatureS3, "S3 buckets", map[string][]string{
+		"FetchS3Buckets": {"s3:ListAllMyBuckets"},
+	}},
+
+	{constants.FeatureLambda, "Lambda functions", map[string][]string{
+		"FetchLambdaFunctions": {"lambda:ListFunctions"},
+	}},
+	{constants.FeatureLambdaDetail, "Lambda function details", map[string][]string{
+		"FetchLambdaFunctionDetail": {"lambda:GetFunctionConcurrency", "lambda:ListProvisionedConcurrencyConfigs", "lambda:ListAliases", "lambda:ListVersionsByFunction", "lambda:ListEventSourceMappings", "lambda:GetPolicy"},
+	}},
+	{constants.FeatureLambdaInvokers, "Lambda invokers", map[string][]string{
+		"FetchLambdaInvokers": {"apigateway:GET", "states:ListStateMachines", "states:DescribeStateMachine"},
+	}},
+	{constants.FeatureECS, "ECS clusters", map[string][]string{
+		"FetchECSClusters":       {"ecs:ListClusters", "ecs:DescribeClusters"},
+		"FetchECSServices":       {"ecs:ListServices", "ecs:DescribeServices"},
+		"FetchECSTasks":          {"ecs:ListTasks", "ecs:DescribeTasks"},
+		"FetchECSTaskDefinition": {"ecs:DescribeTaskDefinition"},
+	}},
+	{constants.FeatureECR, "ECR repositories", map[string][]string{
+		"FetchECRRepositories":      {"ecr:DescribeRepositories", "ecr:DescribeImages", "ecr:GetLifecyclePolicy"},
+		"FetchECRImages":            {"ecr:DescribeImages"},
+		"FetchECRImageScanFindings": {"ecr:DescribeImageScanFindings"},
+	}},
+	{constants.FeatureECRUsage, "ECR image usage", map[string][]string{
+		"FetchECRImageUsage": {"ecr:DescribeImages", "ecs:ListClusters", "ecs:DescribeClusters", "ecs:ListServices", "ecs:DescribeServices", "ecs:DescribeTaskDefinition"},
+	}},
+	{constants.FeatureEKS, "EKS clusters", map[string][]string{
+		"FetchEKSClusters": {"eks:ListClusters", "eks:DescribeCluster"},
+	}},
+	{constants.FeatureEKSDetail, "EKS cluster details", map[string][]string{
+		"FetchEKSClusterDetail": {"eks:DescribeCluster", "eks:ListNodegroups", "eks:DescribeNodegroup", "eks:ListFargateProfiles", "eks:DescribeFargateProfile", "eks:ListAddons", "eks:DescribeAddon", "ec2:DescribeSubnets", "ec2:DescribeNetworkInterfaces"},
+	}},
+
+	{constants.FeatureRDS, "RDS instances", map[string][]string{
+		"FetchRDSInstances": {"rds:DescribeDBInstances"},
+	}},
+	{constants.FeatureRDSClusters, "RDS clusters", map[string][]string{
+		"FetchRDSClusters": {"rds:DescribeDBClusters"},
+	}},
+	{constants.FeatureRDSSnapshots, "RDS snapshots", map[string][]string{
+		"FetchRDSSnapshots": {"rds:DescribeDBSnapshots", "rds:DescribeDBClusterSnapshots"},
+	}},
+	{constants.FeatureRDSParameters, "RDS parameter groups", map[string][]string{
+		"FetchRDSParameterGroups": {"rds:DescribeDBParameterGroups", "rds:DescribeDBClusterParameterGroups", "rds:DescribeDBParameters", "rds:DescribeDBClusterParameters"},
+	}},
+	{constants.FeatureRDSMaintenance, "RDS events and maintenance", map[string][]string{
+		"FetchRDSEvents":             {"rds:DescribeEvents"},
+		"FetchRDSPendingMaintenance": {"rds:DescribePendingMaintenanceActions"},
+	}},
+	{constants.FeatureDynamoDB, "DynamoDB tables", map[string][]string{
+		"FetchDynamoDBTables": {"dynamodb:ListTables", "dynamodb:DescribeTable", "dynamodb:DescribeContinuousBackups", "dynamodb:DescribeTimeToLive"},
+	}},
+	{constants.FeatureElastiCache, "ElastiCache clusters", map[string][]string{
+		"FetchElastiCacheClusters": {"elasticache:DescribeReplicationGroups", "elasticache:DescribeCacheClusters", "elasticache:DescribeCacheSubnetGroups"},
+	}},
+	{constants.FeatureOpenSearch, "OpenSearch domains", map[string][]string{
+		"FetchOpenSearchDomains": {"es:ListDomainNames", "es:DescribeDomains"},
+	}},
+	{constants.FeatureMSK, "MSK clusters", map[string][]string{
+		"FetchMSKClusters": {"kafka:ListClustersV2", "ec2:DescribeSubnets"},
+	}},
+	{constants.FeatureSQS, "SQS queues", map[string][]string{
+		"FetchSQSQueues": {"sqs:ListQueues", "sqs:GetQueueAttributes", "cloudwatch:GetMetricData"},
+	}},
+	{constants.FeatureSNS, "SNS topics", map[string][]string{
+		"FetchSNSTopics": {"sns:ListTopics", "sns:GetTopicAttributes", "sns:ListSubscriptions"},
+	}},
+
+	{constants.FeatureCloudFront, "CloudFront distributions", map[string][]string{
+		"FetchCloudFrontDistributions": {"cloudfront:ListDistributions"},
+	}},
+	{constants.FeatureRoute53, "Route 53 hosted zones", map[string][]string{
+		"FetchRoute53HostedZones": {"route53:ListHostedZones"},
+		"FetchRoute53Records":     {"route53:ListResourceRecordSets"},
+	}},
+	{constants.FeatureACM, "ACM certificates", map[string][]string{
+		"FetchACMCertificates": {"acm:ListCertificates", "acm:DescribeCertificate"},
+	}},
+	{constants.FeatureDanglingDNS, "Dangling DNS records", map[string][]string{
+		"FetchDanglingDNSRecords": {"route53:ListHostedZones", "route53:ListResourceRecordSets", "cloudfront:ListDistributions", "elasticloadbalancing:DescribeLoadBalancers", "ec2:DescribeAddresses", "ec2:DescribeNetworkInterfaces", "s3:ListBucket"},
+	}},
+	{constants.FeatureAPIGateway, "API Gateway APIs", map[string][]string{
+		"FetchAPIGatewayAPIs":      {"apigateway:GET"},
+		"FetchAPIGatewayAPIDetail": {"apigateway:GET"},
+		"FetchAPIGatewayDomains":   {"apigateway:GET"},
+	}},
+	{constants.FeatureStepFunctions, "Step Functions state machines", map[string][]string{
+		"FetchStateMachines": {"states:ListStateMachines", "states:DescribeStateMachine", "states:ListExecutions"},
+	}},
+
+	{constants.FeatureCloudFormation, "CloudFormation stacks", map[string][]string{
+		"FetchCloudFormationStacks":         {"cloudformation:DescribeStacks"},
+		"FetchCloudFormationStackResources": {"cloudformation:ListStackResources"},
+		"FetchStackOwnership":               {"cloudformation:DescribeStacks", "cloudformation:ListStackResources"},
+	}},
+	{constants.FeatureStackDrift, "CloudFormation drift detection", map[string][]string{
+		"StartStackDriftDetection":   {"cloudformation:DetectStackDrift"},
+		"FetchStackDriftDetection":   {"cloudformation:DescribeStackDriftDetectionStatus", "cloudformation:DescribeStackResourceDrifts"},
+		"WaitForStackDriftDetection": {"cloudformation:DescribeStackDriftDetectionStatus", "cloudformation:DescribeStackResourceDrifts"},
+	}},
+	{constants.FeatureIAMHygiene, "IAM hygiene", map[string][]string{
+		"FetchIAMHygiene": {"iam:GenerateCredentialReport", "iam:GetCredentialReport", "iam:ListRoles", "iam:GetRole", "iam:GetAccountPasswordPolicy"},
+	}},
+	{constants.FeatureIAMPolicies, "IAM policy analysis", map[string][]string{
+		"FetchPolicyAnalysis": {"iam:GetAccountAuthorizationDetails", "iam:GetPolicy", "iam:GetPolicyVersion"},
+	}},
+	{constants.FeatureKMS, "KMS keys", map[string][]string{
+		"FetchKMSKeys": {"kms:ListAliases", "kms:ListKeys", "kms:DescribeKey", "kms:GetKeyRotationStatus", "kms:GetKeyPolicy"},
+	}},
+	// Metadata only, secretsmanager:GetSecretValue must never be granted
+	{constants.FeatureSecretsManager, "Secrets Manager secrets", map[string][]string{
+		"FetchSecrets": {"secretsmanager:ListSecrets", "secretsmanager:DescribeSecret"},
+	}},
+}
+
+// optionalActions lists, by feature, the actions its fetchers can do without: their
+// failure is only reported as a warning or in the Errors of the result. Denying them
+// degrades the feature, denying any other action of the feature blocks it.
+var optionalActions = map[string][]string{
+	constants.FeatureAccount:      {"iam:ListAccountAliases", "iam:ListMFADevices", "iam:ListAttachedUserPolicies", "iam:ListAttachedRolePolicies"},
+	constants.FeatureConnectivity: {"ec2:SearchTransitGatewayRoutes", "ec2:DescribeVpnGateways"},
+	constants.FeatureAutoScaling:  {"autoscaling:DescribePolicies"},
+
+	constants.FeatureLambdaDetail: {"lambda:GetFunctionConcurrency", "lambda:ListProvisionedConcurrencyConfigs", "lambda:ListAliases", "lambda:ListVersionsByFunction", "lambda:ListEventSourceMappings", "lambda:GetPolicy"},
+	// Either source of invokers is enough
+	constants.FeatureLambdaInvokers: {"apigateway:GET", "states:ListStateMachines", "states:DescribeStateMachine"},
+	constants.FeatureECR:            {"ecr:DescribeImages", "ecr:GetLifecyclePolicy"},
+	constants.FeatureECRUsage:       {"ecs:ListServices", "ecs:DescribeServices", "ecs:DescribeTaskDefinition"},
+	constants.FeatureEKSDetail:      {"eks:ListNodegroups", "eks:DescribeNodegroup", "eks:ListFargateProfiles", "eks:DescribeFargateProfile", "eks:ListAddons", "eks:DescribeAddon", "ec2:DescribeSubnets", "ec2:DescribeNetworkInterfaces"},
+
+	constants.FeatureDynamoDB:    {"dynamodb:DescribeContinuousBackups", "dynamodb:DescribeTimeToLive"},
+	constants.FeatureElastiCache: {"elasticache:DescribeCacheSubnetGroups"},
+	constants.FeatureMSK:         {"ec2:DescribeSubnets"},
+	constants.FeatureSQS:         {"cloudwatch:GetMetricData"},
+	constants.FeatureSNS:         {"sns:GetTopicAttributes"},
+
+	constants.FeatureDanglingDNS:    {"cloudfront:ListDistributions", "elasticloadbalancing:DescribeLoadBalancers", "ec2:DescribeAddresses", "ec2:DescribeNetworkInterfaces", "s3:ListBucket"},
+	constants.FeatureStepFunctions:  {"states:DescribeStateMachine", "states:ListExecutions"},
+	constants.FeatureIAMHygiene:     {"iam:ListRoles", "iam:GetRole", "iam:GetAccountPasswordPolicy"},
+	constants.FeatureIAMPolicies:    {"iam:GetPolicy", "iam:GetPolicyVersion"},
+	constants.FeatureKMS:            {"kms:GetKeyRotationStatus", "kms:GetKeyPolicy"},
+	constants.FeatureSecretsManager: {"secretsmanager:DescribeSecret"},
+}
+
+// optional reports whether the feature works, degraded, without the action
+func (f registeredFeature) optional(action string) bool {
+	return containsAction(optionalActions[f.key], action)
+}
+
+// actions returns the distinct actions of the fetchers of the feature, sorted
+func (f registeredFeature) actions() []string {
+	actions := make([]string, 0)
+	for _, fetcherActions := range f.fetchers {
+		for _, action := range fetcherActions {
+			if !containsAction(actions, action) {
+				actions = append(actions, action)
+			}
+		}
+	}
+	sort.Strings(actions)
+	return actions
+}
+
+// registeredActions returns the distinct actions of every feature, in registry order
+func registeredActions() []string {
+	actions := make([]string, 0)
+	for _, feature := range featureRegistry {
+		for _, action := range feature.actions() {
+			if !containsAction(actions, action) {
+				actions = append(actions, action)
+			}
+		}
+	}
+	return actions
+}
+
+func containsAction(actions []string, action string) bool {
+	for _, a := range actions {
+		if a == action {
+			return true
+		}
+	}
+	return false
+}
+
+// readOnlyPolicy renders the least-privilege policy granting every registered action,
+// as written to permissions.json
+func readOnlyPolicy() ([]byte, error) {
+	type statement struct {
+		Sid      string
+		Effect   string
+		Action   []string
+		Resource string
+	}
+	policy := struct {
+		Version   string
+		Statement []statement
+	}{
+		Version: "2012-10-17",
+		Statement: []statement{{
+			Sid:      "StratusphereReadOnlyAccess",
+			Effect:   "Allow",
+			Action:   registeredActions(),
+			Resource: "*",
+		}},
+	}
+
+	document, err := json.MarshalIndent(policy, "", "    ")
+	if err != nil {
+		return nil, err
+	}
+	return append(document, '\n'), nil
+}
+
+// DenyActions records actions known to fail, with the reason, such as those the
+// permission simulation found denied
+func (c *Client) DenyActions(actions map[string]string) {
+	c.deniedMu.Lock()
+	defer c.deniedMu.Unlock()
+	if c.denied == nil {
+		c.denied = make(map[string]string, len(actions))
+	}
+	for action, reason := range actions {
+		c.denied[action] = reason
+	}
+}
+
+// DeniedActions returns the actions known to fail, with the reason
+func (c *Client) DeniedActions() map[string]string {
+	c.deniedMu.RLock()
+	defer c.deniedMu.RUnlock()
+	denied := make(map[string]string, len(c.denied))
+	for action, reason := range c.denied {
+		denied[action] = reason
+	}
+	return denied
+}
+
+// isDenied tells whether an action is known to fail
+func (c *Client) isDenied(action string) bool {
+	c.deniedMu.RLock()
+	defer c.deniedMu.RUnlock()
+	_, denied := c.denied[action]
+	return denied
+}
+
+// recordUnavailable records the action as denied when err shows that the account
+// cannot use it, for lack of permission or of a subscription, and tells whether it did
+func (c *Client) recordUnavailable(action string, err error) bool {
+	reason := unavailableReason(err)
+	if reason == "" {
+		return false
+	}
+	c.DenyActions(map[string]string{action: reason})
+	return true
+}
+
+// IsAccessDenied tells whether err is an AWS access denied error
+func IsAccessDenied(err error) bool {
+	var apiErr smithy.APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	switch apiErr.ErrorCode() {
+	case "AccessDenied", "AccessDeniedException", "UnauthorizedOperation":
+		return true
+	}
+	return false
+}
+
+// unavailableReason explains the errors that will not go away by retrying, empty for
+// the others
+func unavailableReason(err error) string {
+	var apiErr smithy.APIError
+	if err == nil || !errors.As(err, &apiErr) {
+		return ""
+	}
+	if IsAccessDenied(err) {
+		return "access denied"
+	}
+	switch apiErr.ErrorCode() {
+	case "SubscriptionRequiredException":
+		return "requires a subscription"
+	case "InvalidAccessException", "OptInRequired":
+		return "not enabled for the account"
+	case "DataUnavailableException":
+		return "no data available"
+	}
+	return ""
+}
@@ -0,0 +1,364 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamTypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/smithy-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"aws-terminal-sdk-v1/internal/constants"
+	"aws-terminal-sdk-v1/internal/models"
+)
+
+var update = flag.Bool("update", false, "regenerate permissions.json from the feature registry")
+
+// sdkServices maps the SDK packages, Client fields and client interfaces to their IAM
+// service prefix
+var sdkServices = map[string]string{
+	"acm": "acm", "apigateway": "apigateway", "apigatewayv2": "apigateway", "autoscaling": "autoscaling",
+	"cloudformation": "cloudformation", "cloudfront": "cloudfront", "cloudwatch": "cloudwatch",
+	"cloudwatchlogs": "logs", "costexplorer": "ce", "dynamodb": "dynamodb", "ec2": "ec2", "ecr": "ecr",
+	"ecs": "ecs", "eks": "eks", "elasticache": "elasticache", "elasticloadbalancing": "elasticloadbalancing",
+	"elasticloadbalancingv2": "elasticloadbalancing", "iam": "iam", "kafka": "kafka", "kms": "kms", "lambda": "lambda",
+	"opensearch": "es", "rds": "rds", "route53": "route53", "s3": "s3", "secretsmanager": "secretsmanager",
+	"securityhub": "securityhub", "servicequotas": "servicequotas", "sfn": "states", "sns": "sns", "sqs": "sqs",
+	"sts": "sts", "support": "support",
+
+	"acmClient": "acm", "acmEdgeClient": "acm", "apigatewayClient": "apigateway", "apigatewayv2Client": "apigateway",
+	"asgClient": "autoscaling", "ceClient": "ce", "cloudformationClient": "cloudformation",
+	"cloudfrontClient": "cloudfront", "cwClient": "cloudwatch", "dynamodbClient": "dynamodb", "ec2Client": "ec2",
+	"ecrClient": "ecr", "ecsClient": "ecs", "eksClient": "eks", "elasticacheClient": "elasticache",
+	"elbClient": "elasticloadbalancing", "elbv2Client": "elasticloadbalancing", "iamClient": "iam",
+	"kafkaClient": "kafka", "kmsClient": "kms", "lambdaClient": "lambda", "logsClient": "logs",
+	"opensearchClient": "es", "rdsClient": "rds",
+	"route53Client": "route53", "s3Client": "s3", "secretsmanagerClient": "secretsmanager", "sfnClient": "states",
+	"shClient": "securityhub", "snsClient": "sns", "sqClient": "servicequotas", "sqsClient": "sqs",
+	"stsClient": "sts", "supportClient": "support",
+
+	"ACMClientAPI": "acm",
+}
+
+// iamAction names the IAM action authorizing an SDK operation
+func iamAction(service, operation string) string {
+	switch {
+	case service == "apigateway" && strings.HasPrefix(operation, "Get"):
+		return "apigateway:GET"
+	case service == "s3" && operation == "ListBuckets":
+		return "s3:ListAllMyBuckets"
+	case service == "s3" && operation == "HeadBucket":
+		return "s3:ListBucket"
+	}
+	return service + ":" + operation
+}
+
+var paginatorPattern = regexp.MustCompile(`^New(\w+)Paginator$`)
+
+// sourceFunction holds the SDK operations a function calls and the functions it calls
+type sourceFunction struct {
+	clientMethod bool
+	actions      map[string]bool
+	callees      map[string]bool
+}
+
+// parseFetchers reads the SDK calls of every function of the package from its sources
+func parseFetchers(t *testing.T) map[string]*sourceFunction {
+	files, err := filepath.Glob("*.go")
+	assert.NoError(t, err)
+
+	functions := make(map[string]*sourceFunction)
+	fset := token.NewFileSet()
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		parsed, err := parser.ParseFile(fset, file, nil, 0)
+		if !assert.NoError(t, err) {
+			continue
+		}
+
+		for _, decl := range parsed.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Body == nil {
+				continue
+			}
+			info := functions[fn.Name.Name]
+			if info == nil {
+				info = &sourceFunction{actions: make(map[string]bool), callees: make(map[string]bool)}
+				functions[fn.Name.Name] = info
+			}
+			if fn.Recv != nil {
+				if star, ok := fn.Recv.List[0].Type.(*ast.StarExpr); ok {
+					if ident, ok := star.X.(*ast.Ident); ok && ident.Name == "Client" {
+						info.clientMethod = true
+					}
+				}
+			}
+
+			// Parameters typed with a client interface, such as the ACM client of a region
+			clientParams := make(map[string]string)
+			for _, field := range fn.Type.Params.List {
+				if ident, ok := field.Type.(*ast.Ident); ok && sdkServices[ident.Name] != "" {
+					for _, name := range field.Names {
+						clientParams[name.Name] = sdkServices[ident.Name]
+					}
+				}
+			}
+
+			ast.Inspect(fn.Body, func(n ast.Node) bool {
+				call, ok := n.(*ast.CallExpr)
+				if !ok {
+					return true
+				}
+				switch fun := call.Fun.(type) {
+				case *ast.Ident:
+					info.callees[fun.Name] = true
+				case *ast.SelectorExpr:
+					operation := fun.Sel.Name
+					switch x := fun.X.(type) {
+					case *ast.SelectorExpr:
+						if service, ok := sdkServices[x.Sel.Name]; ok && strings.HasSuffix(x.Sel.Name, "Client") {
+							info.actions[iamAction(service, operation)] = true
+							return true
+						}
+					case *ast.Ident:
+						if service, ok := clientParams[x.Name]; ok {
+							info.actions[iamAction(service, operation)] = true
+							return true
+						}
+						if m := paginatorPattern.FindStringSubmatch(operation); m != nil {
+							service, ok := sdkServices[x.Name]
+							assert.True(t, ok, "unknown SDK package %s in %s", x.Name, fn.Name.Name)
+							info.actions[iamAction(service, m[1])] = true
+							return true
+						}
+					}
+					info.callees[operation] = true
+				}
+				return true
+			})
+		}
+	}
+	return functions
+}
+
+func TestFeatureRegistryMatchesFetchers(t *testing.T) {
+	functions := parseFetchers(t)
+
+	registered := make(map[string][]string)
+	keys := make(map[string]bool)
+	for _, feature := range featureRegistry {
+		assert.False(t, keys[feature.key], "feature %s registered twice", feature.key)
+		keys[feature.key] = true
+		for fetcher, actions := range feature.fetchers {
+			_, twice := registered[fetcher]
+			assert.False(t, twice, "fetcher %s registered twice", fetcher)
+			registered[fetcher] = actions
+		}
+	}
+
+	// The actions of a function and of the functions it calls, or only down to the
+	// registered ones with composite
+	var called func(name string, composite bool, visiting map[string]bool) map[string]bool
+	called = func(name string, composite bool, visiting map[string]bool) map[string]bool {
+		actions := make(map[string]bool)
+		fn := functions[name]
+		if fn == nil || visiting[name] {
+			return actions
+		}
+		visiting[name] = true
+		defer delete(visiting, name)
+
+		for action := range fn.actions {
+			actions[action] = true
+		}
+		for callee := range fn.callees {
+			if _, ok := registered[callee]; (ok && composite) || callee == name {
+				continue
+			}
+			for action := range called(callee, composite, visiting) {
+				actions[action] = true
+			}
+		}
+		return actions
+	}
+	sorted := func(set map[string]bool) []string {
+		list := make([]string, 0, len(set))
+		for action := range set {
+			list = append(list, action)
+		}
+		sort.Strings(list)
+		return list
+	}
+
+	for fetcher, declared := range registered {
+		if !assert.Contains(t, functions, fetcher, "registered fetcher %s does not exist", fetcher) {
+			continue
+		}
+		want := append([]string(nil), declared...)
+		sort.Strings(want)
+		assert.Equal(t, want, sorted(called(fetcher, false, map[string]bool{})), "actions of %s", fetcher)
+	}
+
+	// Unregistered methods, such as the home page, may only call AWS through registered ones
+	for name, fn := range functions {
+		if _, ok := registered[name]; ok || !fn.clientMethod || !ast.IsExported(name) {
+			continue
+		}
+		assert.Empty(t, sorted(called(name, true, map[string]bool{})), "%s calls AWS but is not in the feature registry", name)
+	}
+}
+
+func TestReadOnlyPolicy(t *testing.T) {
+	policy, err := readOnlyPolicy()
+	assert.NoError(t, err)
+
+	path := filepath.Join("..", "..", "permissions.json")
+	if *update {
+		assert.NoError(t, os.WriteFile(path, policy, 0644))
+		return
+	}
+	current, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, string(policy), string(current), "permissions.json is stale, run go generate ./internal/aws")
+}
+
+func TestVerifyPermissions(t *testing.T) {
+	mockSTS := new(MockSTSClient)
+	mockIAM := new(MockIAMClient)
+	client := &Client{stsClient: mockSTS, iamClient: mockIAM, region: "eu-west-1"}
+
+	mockSTS.On("GetCallerIdentity", mock.Anything, mock.Anything, mock.Anything).Return(&sts.GetCallerIdentityOutput{
+		Arn: aws.String("arn:aws:sts::123456789012:assumed-role/viewer/session"),
+	}, nil)
+
+	// The output is filled in for the actions of each batch
+	simulated := 0
+	output := &iam.SimulatePrincipalPolicyOutput{}
+	mockIAM.On("SimulatePrincipalPolicy", mock.Anything, mock.MatchedBy(func(in *iam.SimulatePrincipalPolicyInput) bool {
+		return aws.ToString(in.PolicySourceArn) == "arn:aws:iam::123456789012:role/viewer" && len(in.ActionNames) <= permissionSimulationBatchSize &&
+			len(in.ContextEntries) == 1 && aws.ToString(in.ContextEntries[0].ContextKeyName) == "aws:RequestedRegion" &&
+			in.ContextEntries[0].ContextKeyValues[0] == "eu-west-1"
+	}), mock.Anything).Run(func(args mock.Arguments) {
+		output.EvaluationResults = nil
+		for _, action := range args.Get(1).(*iam.SimulatePrincipalPolicyInput).ActionNames {
+			decision := iamTypes.PolicyEvaluationDecisionTypeAllowed
+			var missingContext []string
+			switch action {
+			case "rds:DescribeDBInstances":
+				decision = iamTypes.PolicyEvaluationDecisionTypeImplicitDeny
+			case "ce:GetCostAndUsage", "ecr:GetLifecyclePolicy":
+				decision = iamTypes.PolicyEvaluationDecisionTypeExplicitDeny
+			case "ec2:DescribeVpcs":
+				// Allowed from the office network only
+				decision = iamTypes.PolicyEvaluationDecisionTypeImplicitDeny
+				missingContext = []string{"aws:SourceIp"}
+			}
+			output.EvaluationResults = append(output.EvaluationResults, iamTypes.EvaluationResult{
+				EvalActionName:       aws.String(action),
+				EvalDecision:         decision,
+				MissingContextValues: missingContext,
+			})
+			simulated++
+		}
+	}).Return(output, nil)
+
+	features, err := client.VerifyPermissions(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, features, len(featureRegistry))
+	assert.Equal(t, len(registeredActions()), simulated)
+
+	byKey := make(map[string]models.FeaturePermissions)
+	for _, feature := range features {
+		byKey[feature.Feature] = feature
+	}
+	rds := byKey[constants.FeatureRDS]
+	assert.Equal(t, "RDS instances", rds.Label)
+	assert.False(t, rds.Allowed)
+	assert.Equal(t, []string{"rds:DescribeDBInstances"}, rds.Missing)
+	assert.Equal(t, "Implicit Deny", rds.Permissions[0].Reason)
+
+	assert.Equal(t, "Explicit Deny", byKey[constants.FeatureCosts].Permissions[0].Reason)
+	assert.True(t, byKey[constants.FeatureRDSClusters].Allowed)
+	assert.Empty(t, byKey[constants.FeatureEC2].Missing)
+
+	// A denied optional action only degrades its feature
+	ecr := byKey[constants.FeatureECR]
+	assert.True(t, ecr.Allowed)
+	assert.Empty(t, ecr.Missing)
+	assert.Equal(t, []string{"ecr:GetLifecyclePolicy"}, ecr.Degraded)
+
+	// An undecided action does not block its feature
+	vpc := byKey[constants.FeatureVPC]
+	assert.True(t, vpc.Allowed)
+	assert.Empty(t, vpc.Missing)
+	assert.True(t, vpc.Permissions[0].Unknown)
+	assert.Equal(t, "Depends on aws:SourceIp", vpc.Permissions[0].Reason)
+	for _, action := range []string{"ec2:DescribeAddresses", "rds:DescribeDBInstances", "lambda:ListFunctions"} {
+		assert.Contains(t, registeredActions(), action)
+	}
+}
+
+// Optional actions must belong to their feature, or they would never degrade it
+func TestOptionalActionsRegistered(t *testing.T) {
+	features := make(map[string]registeredFeature, len(featureRegistry))
+	for _, feature := range featureRegistry {
+		features[feature.key] = feature
+	}
+	for key, actions := range optionalActions {
+		feature, ok := features[key]
+		if !assert.True(t, ok, "unknown feature %s", key) {
+			continue
+		}
+		for _, action := range actions {
+			assert.Contains(t, feature.actions(), action, key)
+		}
+	}
+}
+
+func TestVerifyPermissionsRoot(t *testing.T) {
+	mockSTS := new(MockSTSClient)
+	client := &Client{stsClient: mockSTS}
+
+	mockSTS.On("GetCallerIdentity", mock.Anything, mock.Anything, mock.Anything).Return(&sts.GetCallerIdentityOutput{
+		Arn: aws.String("arn:aws:iam::123456789012:root"),
+	}, nil)
+
+	features, err := client.VerifyPermissions(context.Background())
+	assert.NoError(t, err)
+	for _, feature := range features {
+		assert.True(t, feature.Allowed, feature.Feature)
+		assert.Equal(t, "Root User", feature.Permissions[0].Reason)
+	}
+}
+
+func TestUnavailableReason(t *testing.T) {
+	assert.Equal(t, "access denied", unavailableReason(&smithy.GenericAPIError{Code: "AccessDeniedException"}))
+	assert.Equal(t, "access denied", unavailableReason(fmt.Errorf("failed to describe: %w", &smithy.GenericAPIError{Code: "UnauthorizedOperation"})))
+	assert.Equal(t, "requires a subscription", unavailableReason(&smithy.GenericAPIError{Code: "SubscriptionRequiredException"}))
+	assert.Empty(t, unavailableReason(&smithy.GenericAPIError{Code: "ThrottlingException"}))
+	assert.Empty(t, unavailableReason(errors.New("AccessDenied")))
+	assert.Empty(t, unavailableReason(nil))
+
+	client := &Client{}
+	assert.False(t, client.recordUnavailable("ec2:DescribeVpcs", &smithy.GenericAPIError{Code: "RequestLimitExceeded"}))
+	assert.True(t, client.recordUnavailable("ec2:DescribeVpcs", &smithy.GenericAPIError{Code: "UnauthorizedOperation"}))
+	assert.True(t, client.isDenied("ec2:DescribeVpcs"))
+	assert.Equal(t, map[string]string{"ec2:DescribeVpcs": "access denied"}, client.DeniedActions())
+}
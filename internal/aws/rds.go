@@ -0,0 +1,215 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	rdsTypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+
+	"aws-terminal-sdk-v1/internal/models"
+)
+
+// rdsEventWindow is how far back recent RDS events are fetched
+const rdsEventWindow = 24 * time.Hour
+
+// rdsUserParameterSource selects the parameters changed from the engine defaults
+const rdsUserParameterSource = "user"
+
+// FetchRDSClusters gets the Aurora and Multi-AZ DB clusters with their members.
+// Members are linked to RDSInstanceInfo by DBClusterIdentifier.
+func (c *Client) FetchRDSClusters(ctx context.Context) ([]models.RDSClusterInfo, error) {
+	clusters := make([]models.RDSClusterInfo, 0)
+	paginator := rds.NewDescribeDBClustersPaginator(c.rdsClient, &rds.DescribeDBClustersInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe DB clusters: %w", err)
+		}
+
+		for _, cluster := range output.DBClusters {
+			clusters = append(clusters, models.FromAWSDBCluster(cluster))
+		}
+	}
+
+	return clusters, nil
+}
+
+// FetchRDSSnapshots gets the automated and manual DB instance and DB cluster snapshots, newest first
+func (c *Client) FetchRDSSnapshots(ctx context.Context) ([]models.RDSSnapshotInfo, error) {
+	snapshots := make([]models.RDSSnapshotInfo, 0)
+
+	instancePaginator := rds.NewDescribeDBSnapshotsPaginator(c.rdsClient, &rds.DescribeDBSnapshotsInput{})
+	for instancePaginator.HasMorePages() {
+		output, err := instancePaginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe DB snapshots: %w", err)
+		}
+
+		for _, snapshot := range output.DBSnapshots {
+			snapshots = append(snapshots, models.FromAWSDBSnapshot(snapshot))
+		}
+	}
+
+	clusterPaginator := rds.NewDescribeDBClusterSnapshotsPaginator(c.rdsClient, &rds.DescribeDBClusterSnapshotsInput{})
+	for clusterPaginator.HasMorePages() {
+		output, err := clusterPaginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe DB cluster snapshots: %w", err)
+		}
+
+		for _, snapshot := range output.DBClusterSnapshots {
+			snapshots = append(snapshots, models.FromAWSDBClusterSnapshot(snapshot))
+		}
+	}
+
+	sort.SliceStable(snapshots, func(i, j int) bool {
+		return snapshots[i].CreatedAt > snapshots[j].CreatedAt
+	})
+
+	return snapshots, nil
+}
+
+// FetchRDSParameterGroups gets the DB and DB cluster parameter groups with the parameters
+// that drift from the engine defaults. Default groups cannot be modified and are not inspected.
+func (c *Client) FetchRDSParameterGroups(ctx context.Context) ([]models.RDSParameterGroupInfo, error) {
+	groups := make([]models.RDSParameterGroupInfo, 0)
+
+	groupPaginator := rds.NewDescribeDBParameterGroupsPaginator(c.rdsClient, &rds.DescribeDBParameterGroupsInput{})
+	for groupPaginator.HasMorePages() {
+		output, err := groupPaginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe DB parameter groups: %w", err)
+		}
+
+		for _, group := range output.DBParameterGroups {
+			info := models.FromAWSDBParameterGroup(group)
+			if !info.IsDefault {
+				if info.ModifiedParameters, err = c.fetchModifiedDBParameters(ctx, info.Name); err != nil {
+					return nil, err
+				}
+			}
+			groups = append(groups, info)
+		}
+	}
+
+	clusterGroupPaginator := rds.NewDescribeDBClusterParameterGroupsPaginator(c.rdsClient, &rds.DescribeDBClusterParameterGroupsInput{})
+	for clusterGroupPaginator.HasMorePages() {
+		output, err := clusterGroupPaginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe DB cluster parameter groups: %w", err)
+		}
+
+		for _, group := range output.DBClusterParameterGroups {
+			info := models.FromAWSDBClusterParameterGroup(group)
+			if !info.IsDefault {
+				if info.ModifiedParameters, err = c.fetchModifiedDBClusterParameters(ctx, info.Name); err != nil {
+					return nil, err
+				}
+			}
+			groups = append(groups, info)
+		}
+	}
+
+	return groups, nil
+}
+
+// fetchModifiedDBParameters lists the user-set parameters of a DB parameter group
+func (c *Client) fetchModifiedDBParameters(ctx context.Context, groupName string) ([]models.RDSParameterInfo, error) {
+	params := make([]models.RDSParameterInfo, 0)
+	paginator := rds.NewDescribeDBParametersPaginator(c.rdsClient, &rds.DescribeDBParametersInput{
+		DBParameterGroupName: aws.String(groupName),
+		Source:               aws.String(rdsUserParameterSource),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe parameters of %s: %w", groupName, err)
+		}
+
+		for _, param := range output.Parameters {
+			params = append(params, models.FromAWSParameter(param))
+		}
+	}
+
+	return params, nil
+}
+
+// fetchModifiedDBClusterParameters lists the user-set parameters of a DB cluster parameter group
+func (c *Client) fetchModifiedDBClusterParameters(ctx context.Context, groupName string) ([]models.RDSParameterInfo, error) {
+	params := make([]models.RDSParameterInfo, 0)
+	paginator := rds.NewDescribeDBClusterParametersPaginator(c.rdsClient, &rds.DescribeDBClusterParametersInput{
+		DBClusterParameterGroupName: aws.String(groupName),
+		Source:                      aws.String(rdsUserParameterSource),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe parameters of %s: %w", groupName, err)
+		}
+
+		for _, param := range output.Parameters {
+			params = append(params, models.FromAWSParameter(param))
+		}
+	}
+
+	return params, nil
+}
+
+// FetchRDSPendingMaintenance gets the maintenance actions pending on DB instances and clusters
+func (c *Client) FetchRDSPendingMaintenance(ctx context.Context) ([]models.RDSPendingMaintenanceInfo, error) {
+	actions := make([]models.RDSPendingMaintenanceInfo, 0)
+	paginator := rds.NewDescribePendingMaintenanceActionsPaginator(c.rdsClient, &rds.DescribePendingMaintenanceActionsInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe pending maintenance actions: %w", err)
+		}
+
+		for _, resource := range output.PendingMaintenanceActions {
+			actions = append(actions, models.FromAWSPendingMaintenance(resource)...)
+		}
+	}
+
+	return actions, nil
+}
+
+// FetchRDSEvents gets the RDS events of the last 24 hours, newest first. When sourceID is
+// set only the events of that source are returned, sourceType (db-instance, db-cluster...)
+// is then required.
+func (c *Client) FetchRDSEvents(ctx context.Context, sourceType, sourceID string) ([]models.RDSEventInfo, error) {
+	input := &rds.DescribeEventsInput{
+		Duration: aws.Int32(int32(rdsEventWindow.Minutes())),
+	}
+	if sourceID != "" {
+		if sourceType == "" {
+			return nil, fmt.Errorf("a source type is required to filter events of %s", sourceID)
+		}
+		input.SourceIdentifier = aws.String(sourceID)
+	}
+	if sourceType != "" {
+		input.SourceType = rdsTypes.SourceType(sourceType)
+	}
+
+	events := make([]models.RDSEventInfo, 0)
+	paginator := rds.NewDescribeEventsPaginator(c.rdsClient, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe RDS events: %w", err)
+		}
+
+		for _, event := range output.Events {
+			events = append(events, models.FromAWSRDSEvent(event))
+		}
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Date > events[j].Date
+	})
+
+	return events, nil
+}
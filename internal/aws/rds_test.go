@@ -0,0 +1,172 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	rdsTypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFetchRDSClusters(t *testing.T) {
+	mockRDS := new(MockRDSClient)
+	client := &Client{rdsClient: mockRDS}
+
+	// Test Case: Success
+	mockRDS.On("DescribeDBClusters", mock.Anything, mock.Anything, mock.Anything).Return(&rds.DescribeDBClustersOutput{
+		DBClusters: []rdsTypes.DBCluster{{
+			DBClusterIdentifier: aws.String("orders"),
+			Engine:              aws.String("aurora-postgresql"),
+			EngineMode:          aws.String("provisioned"),
+			Endpoint:            aws.String("orders.cluster-abc.us-east-1.rds.amazonaws.com"),
+			ReaderEndpoint:      aws.String("orders.cluster-ro-abc.us-east-1.rds.amazonaws.com"),
+			BacktrackWindow:     aws.Int64(86400),
+			ServerlessV2ScalingConfiguration: &rdsTypes.ServerlessV2ScalingConfigurationInfo{
+				MinCapacity: aws.Float64(0.5),
+				MaxCapacity: aws.Float64(16),
+			},
+			DBClusterMembers: []rdsTypes.DBClusterMember{
+				{DBInstanceIdentifier: aws.String("orders-1"), IsClusterWriter: aws.Bool(true), DBClusterParameterGroupStatus: aws.String("in-sync")},
+				{DBInstanceIdentifier: aws.String("orders-2"), IsClusterWriter: aws.Bool(false), DBClusterParameterGroupStatus: aws.String("pending-reboot")},
+			},
+		}},
+	}, nil).Once()
+
+	clusters, err := client.FetchRDSClusters(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, clusters, 1)
+	assert.Equal(t, "orders-1", clusters[0].WriterInstance)
+	assert.Len(t, clusters[0].Members, 2)
+	assert.Equal(t, "pending-reboot", clusters[0].Members[1].ParameterGroupStatus)
+	assert.Equal(t, 16.0, clusters[0].ServerlessV2MaxACU)
+	assert.Equal(t, int64(86400), clusters[0].BacktrackWindow)
+
+	// Test Case: Error
+	mockRDS.On("DescribeDBClusters", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("api error")).Once()
+
+	clusters, err = client.FetchRDSClusters(context.Background())
+	assert.Error(t, err)
+	assert.Nil(t, clusters)
+}
+
+func TestFetchRDSSnapshots(t *testing.T) {
+	mockRDS := new(MockRDSClient)
+	client := &Client{rdsClient: mockRDS}
+
+	mockRDS.On("DescribeDBSnapshots", mock.Anything, mock.Anything, mock.Anything).Return(&rds.DescribeDBSnapshotsOutput{
+		DBSnapshots: []rdsTypes.DBSnapshot{{
+			DBSnapshotIdentifier: aws.String("legacy-manual"),
+			DBInstanceIdentifier: aws.String("legacy"),
+			SnapshotType:         aws.String("manual"),
+			SnapshotCreateTime:   aws.Time(time.Now().Add(-400 * 24 * time.Hour)),
+		}},
+	}, nil).Once()
+	mockRDS.On("DescribeDBClusterSnapshots", mock.Anything, mock.Anything, mock.Anything).Return(&rds.DescribeDBClusterSnapshotsOutput{
+		DBClusterSnapshots: []rdsTypes.DBClusterSnapshot{{
+			DBClusterSnapshotIdentifier: aws.String("rds:orders-2024-01-01"),
+			DBClusterIdentifier:         aws.String("orders"),
+			SnapshotType:                aws.String("automated"),
+			SnapshotCreateTime:          aws.Time(time.Now().Add(-2 * time.Hour)),
+		}},
+	}, nil).Once()
+
+	snapshots, err := client.FetchRDSSnapshots(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, snapshots, 2)
+	// Newest first
+	assert.Equal(t, "orders", snapshots[0].SourceIdentifier)
+	assert.True(t, snapshots[0].IsCluster)
+	assert.Equal(t, 0, snapshots[0].AgeDays)
+	assert.Equal(t, "manual", snapshots[1].Type)
+	assert.Equal(t, 400, snapshots[1].AgeDays)
+}
+
+func TestFetchRDSParameterGroups(t *testing.T) {
+	mockRDS := new(MockRDSClient)
+	client := &Client{rdsClient: mockRDS}
+
+	mockRDS.On("DescribeDBParameterGroups", mock.Anything, mock.Anything, mock.Anything).Return(&rds.DescribeDBParameterGroupsOutput{
+		DBParameterGroups: []rdsTypes.DBParameterGroup{
+			{DBParameterGroupName: aws.String("default.postgres15"), DBParameterGroupFamily: aws.String("postgres15")},
+			{DBParameterGroupName: aws.String("tuned"), DBParameterGroupFamily: aws.String("postgres15")},
+		},
+	}, nil).Once()
+	mockRDS.On("DescribeDBParameters", mock.Anything, mock.MatchedBy(func(in *rds.DescribeDBParametersInput) bool {
+		return aws.ToString(in.DBParameterGroupName) == "tuned" && aws.ToString(in.Source) == "user"
+	}), mock.Anything).Return(&rds.DescribeDBParametersOutput{
+		Parameters: []rdsTypes.Parameter{{
+			ParameterName:  aws.String("work_mem"),
+			ParameterValue: aws.String("65536"),
+			ApplyType:      aws.String("dynamic"),
+			ApplyMethod:    rdsTypes.ApplyMethodImmediate,
+		}},
+	}, nil).Once()
+	mockRDS.On("DescribeDBClusterParameterGroups", mock.Anything, mock.Anything, mock.Anything).Return(&rds.DescribeDBClusterParameterGroupsOutput{
+		DBClusterParameterGroups: []rdsTypes.DBClusterParameterGroup{
+			{DBClusterParameterGroupName: aws.String("default.aurora-postgresql15")},
+		},
+	}, nil).Once()
+
+	groups, err := client.FetchRDSParameterGroups(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, groups, 3)
+	assert.True(t, groups[0].IsDefault)
+	assert.Empty(t, groups[0].ModifiedParameters)
+	assert.Len(t, groups[1].ModifiedParameters, 1)
+	assert.Equal(t, "work_mem", groups[1].ModifiedParameters[0].Name)
+	assert.True(t, groups[2].IsCluster)
+	// Default groups are not inspected
+	mockRDS.AssertNotCalled(t, "DescribeDBClusterParameters", mock.Anything, mock.Anything, mock.Anything)
+	mockRDS.AssertExpectations(t)
+}
+
+func TestFetchRDSPendingMaintenance(t *testing.T) {
+	mockRDS := new(MockRDSClient)
+	client := &Client{rdsClient: mockRDS}
+
+	mockRDS.On("DescribePendingMaintenanceActions", mock.Anything, mock.Anything, mock.Anything).Return(&rds.DescribePendingMaintenanceActionsOutput{
+		PendingMaintenanceActions: []rdsTypes.ResourcePendingMaintenanceActions{{
+			ResourceIdentifier: aws.String("arn:aws:rds:us-east-1:123456789012:cluster:orders"),
+			PendingMaintenanceActionDetails: []rdsTypes.PendingMaintenanceAction{
+				{Action: aws.String("system-update"), AutoAppliedAfterDate: aws.Time(time.Now().Add(72 * time.Hour))},
+				{Action: aws.String("db-upgrade")},
+			},
+		}},
+	}, nil).Once()
+
+	actions, err := client.FetchRDSPendingMaintenance(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, actions, 2)
+	assert.Equal(t, "arn:aws:rds:us-east-1:123456789012:cluster:orders", actions[1].ResourceARN)
+	assert.NotEmpty(t, actions[0].AutoAppliedAfter)
+}
+
+func TestFetchRDSEvents(t *testing.T) {
+	mockRDS := new(MockRDSClient)
+	client := &Client{rdsClient: mockRDS}
+
+	now := time.Now()
+	mockRDS.On("DescribeEvents", mock.Anything, mock.MatchedBy(func(in *rds.DescribeEventsInput) bool {
+		return aws.ToString(in.SourceIdentifier) == "orders" && in.SourceType == rdsTypes.SourceTypeDbCluster && aws.ToInt32(in.Duration) == 1440
+	}), mock.Anything).Return(&rds.DescribeEventsOutput{
+		Events: []rdsTypes.Event{
+			{SourceIdentifier: aws.String("orders"), Message: aws.String("Started failover"), Date: aws.Time(now.Add(-time.Hour))},
+			{SourceIdentifier: aws.String("orders"), Message: aws.String("Completed failover"), Date: aws.Time(now)},
+		},
+	}, nil).Once()
+
+	events, err := client.FetchRDSEvents(context.Background(), "db-cluster", "orders")
+	assert.NoError(t, err)
+	assert.Len(t, events, 2)
+	assert.Equal(t, "Completed failover", events[0].Message)
+
+	// Test Case: Source identifier without type
+	events, err = client.FetchRDSEvents(context.Background(), "", "orders")
+	assert.Error(t, err)
+	assert.Nil(t, events)
+}
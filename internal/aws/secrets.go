@@ -0,0 +1,118 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	kmsTypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+
+	"aws-terminal-sdk-v1/internal/models"
+)
+
+// FetchKMSKeys gets the customer-managed KMS keys with their aliases, rotation status and
+// the principals of their key policy. AWS managed keys, such as aws/s3, are left out.
+func (c *Client) FetchKMSKeys(ctx context.Context) ([]models.KMSKeyInfo, error) {
+	// Aliases are listed once for the region rather than per key
+	aliases := make(map[string][]string)
+	aliasPaginator := kms.NewListAliasesPaginator(c.kmsClient, &kms.ListAliasesInput{})
+	for aliasPaginator.HasMorePages() {
+		output, err := aliasPaginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list KMS aliases: %w", err)
+		}
+
+		for _, alias := range output.Aliases {
+			if keyID := aws.ToString(alias.TargetKeyId); keyID != "" {
+				aliases[keyID] = append(aliases[keyID], aws.ToString(alias.AliasName))
+			}
+		}
+	}
+
+	keys := make([]models.KMSKeyInfo, 0)
+	keyPaginator := kms.NewListKeysPaginator(c.kmsClient, &kms.ListKeysInput{})
+	for keyPaginator.HasMorePages() {
+		output, err := keyPaginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list KMS keys: %w", err)
+		}
+
+		for _, entry := range output.Keys {
+			keyID := aws.ToString(entry.KeyId)
+			described, err := c.kmsClient.DescribeKey(ctx, &kms.DescribeKeyInput{KeyId: aws.String(keyID)})
+			if err != nil {
+				fmt.Printf("Warning: failed to describe KMS key %s: %v\n", keyID, err)
+				continue
+			}
+			if described.KeyMetadata == nil || described.KeyMetadata.KeyManager != kmsTypes.KeyManagerTypeCustomer {
+				continue
+			}
+
+			key := models.FromAWSKeyMetadata(*described.KeyMetadata)
+			key.Aliases = append(key.Aliases, aliases[keyID]...)
+			sort.Strings(key.Aliases)
+
+			// Keys pending deletion cannot be queried for rotation
+			if key.RotationSupported && !key.PendingDeletion {
+				rotation, err := c.kmsClient.GetKeyRotationStatus(ctx, &kms.GetKeyRotationStatusInput{KeyId: aws.String(keyID)})
+				if err != nil {
+					fmt.Printf("Warning: failed to get the rotation status of KMS key %s: %v\n", keyID, err)
+					key.RotationUnknown = true
+				} else {
+					key.SetRotation(rotation.KeyRotationEnabled, rotation.RotationPeriodInDays, rotation.NextRotationDate)
+				}
+			}
+
+			policy, err := c.kmsClient.GetKeyPolicy(ctx, &kms.GetKeyPolicyInput{
+				KeyId:      aws.String(keyID),
+				PolicyName: aws.String("default"),
+			})
+			if err != nil {
+				fmt.Printf("Warning: failed to get the key policy of KMS key %s: %v\n", keyID, err)
+			} else if err := key.SetKeyPolicy(aws.ToString(policy.Policy)); err != nil {
+				fmt.Printf("Warning: failed to parse the key policy of KMS key %s: %v\n", keyID, err)
+			}
+
+			key.FlagKeyIssues()
+			keys = append(keys, key)
+		}
+	}
+
+	return keys, nil
+}
+
+// FetchSecrets gets the metadata of the Secrets Manager secrets: rotation, last rotated
+// and accessed dates, KMS key and replicas. Secret values are never read, the client
+// interface does not even have GetSecretValue.
+func (c *Client) FetchSecrets(ctx context.Context) ([]models.SecretInfo, error) {
+	secrets := make([]models.SecretInfo, 0)
+	paginator := secretsmanager.NewListSecretsPaginator(c.secretsmanagerClient, &secretsmanager.ListSecretsInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list secrets: %w", err)
+		}
+
+		for _, entry := range output.SecretList {
+			secret := models.FromAWSSecret(entry)
+
+			// Replicated secrets have a primary region, whose secret lists the replicas
+			if secret.PrimaryRegion == c.region {
+				described, err := c.secretsmanagerClient.DescribeSecret(ctx, &secretsmanager.DescribeSecretInput{
+					SecretId: aws.String(secret.ARN),
+				})
+				if err != nil {
+					fmt.Printf("Warning: failed to describe secret %s: %v\n", secret.Name, err)
+				} else {
+					secret.AddReplicas(described.ReplicationStatus)
+				}
+			}
+			secrets = append(secrets, secret)
+		}
+	}
+
+	return secrets, nil
+}
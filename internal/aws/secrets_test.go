@@ -0,0 +1,172 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	kmsTypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	secretsTypes "github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFetchKMSKeys(t *testing.T) {
+	mockKMS := new(MockKMSClient)
+	client := &Client{kmsClient: mockKMS}
+
+	mockKMS.On("ListAliases", mock.Anything, mock.Anything, mock.Anything).Return(&kms.ListAliasesOutput{
+		Aliases: []kmsTypes.AliasListEntry{
+			{AliasName: aws.String("alias/orders"), TargetKeyId: aws.String("key-orders")},
+			{AliasName: aws.String("alias/billing"), TargetKeyId: aws.String("key-orders")},
+			{AliasName: aws.String("alias/aws/s3"), TargetKeyId: aws.String("key-aws")},
+			{AliasName: aws.String("alias/unused")},
+		},
+	}, nil).Once()
+	mockKMS.On("ListKeys", mock.Anything, mock.Anything, mock.Anything).Return(&kms.ListKeysOutput{
+		Keys: []kmsTypes.KeyListEntry{{KeyId: aws.String("key-orders")}, {KeyId: aws.String("key-aws")}, {KeyId: aws.String("key-old")}, {KeyId: aws.String("key-denied")}},
+	}, nil).Once()
+
+	describe := func(id string, metadata kmsTypes.KeyMetadata) {
+		metadata.KeyId = aws.String(id)
+		mockKMS.On("DescribeKey", mock.Anything, mock.MatchedBy(func(in *kms.DescribeKeyInput) bool {
+			return aws.ToString(in.KeyId) == id
+		}), mock.Anything).Return(&kms.DescribeKeyOutput{KeyMetadata: &metadata}, nil).Once()
+	}
+	describe("key-orders", kmsTypes.KeyMetadata{
+		KeyManager: kmsTypes.KeyManagerTypeCustomer, KeyState: kmsTypes.KeyStateEnabled,
+		KeySpec: kmsTypes.KeySpecSymmetricDefault, Origin: kmsTypes.OriginTypeAwsKms,
+	})
+	describe("key-aws", kmsTypes.KeyMetadata{KeyManager: kmsTypes.KeyManagerTypeAws})
+	deletion := time.Now().AddDate(0, 0, 7)
+	describe("key-old", kmsTypes.KeyMetadata{
+		KeyManager: kmsTypes.KeyManagerTypeCustomer, KeyState: kmsTypes.KeyStatePendingDeletion,
+		KeySpec: kmsTypes.KeySpecSymmetricDefault, Origin: kmsTypes.OriginTypeAwsKms, DeletionDate: &deletion,
+	})
+
+	describe("key-denied", kmsTypes.KeyMetadata{
+		KeyManager: kmsTypes.KeyManagerTypeCustomer, KeyState: kmsTypes.KeyStateEnabled,
+		KeySpec: kmsTypes.KeySpecSymmetricDefault, Origin: kmsTypes.OriginTypeAwsKms,
+	})
+
+	// The key pending deletion is not queried for rotation
+	mockKMS.On("GetKeyRotationStatus", mock.Anything, mock.MatchedBy(func(in *kms.GetKeyRotationStatusInput) bool {
+		return aws.ToString(in.KeyId) == "key-orders"
+	}), mock.Anything).Return(&kms.GetKeyRotationStatusOutput{KeyRotationEnabled: false}, nil).Once()
+	mockKMS.On("GetKeyRotationStatus", mock.Anything, mock.MatchedBy(func(in *kms.GetKeyRotationStatusInput) bool {
+		return aws.ToString(in.KeyId) == "key-denied"
+	}), mock.Anything).Return(nil, errors.New("AccessDeniedException")).Once()
+	mockKMS.On("GetKeyPolicy", mock.Anything, mock.MatchedBy(func(in *kms.GetKeyPolicyInput) bool {
+		return aws.ToString(in.KeyId) == "key-orders"
+	}), mock.Anything).Return(&kms.GetKeyPolicyOutput{
+		Policy: aws.String(`{"Version":"2012-10-17","Statement":[
+			{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"kms:*","Resource":"*"},
+			{"Effect":"Allow","Principal":{"AWS":["arn:aws:iam::123456789012:role/orders","arn:aws:iam::123456789012:root"]},"Action":"kms:Decrypt","Resource":"*"},
+			{"Effect":"Allow","Principal":"*","Action":"kms:Encrypt","Resource":"*"}
+		]}`),
+	}, nil).Once()
+	mockKMS.On("GetKeyPolicy", mock.Anything, mock.Anything, mock.Anything).Return(&kms.GetKeyPolicyOutput{
+		Policy: aws.String(`{"Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"kms:*","Resource":"*"}]}`),
+	}, nil).Twice()
+
+	keys, err := client.FetchKMSKeys(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, keys, 3)
+
+	orders := keys[0]
+	assert.Equal(t, []string{"alias/billing", "alias/orders"}, orders.Aliases)
+	assert.True(t, orders.RotationSupported)
+	assert.False(t, orders.RotationEnabled)
+	assert.Equal(t, []string{"*", "arn:aws:iam::123456789012:role/orders", "arn:aws:iam::123456789012:root"}, orders.PolicyPrincipals)
+	assert.Equal(t, []string{"Key policy allows any principal without a condition", "Automatic rotation disabled"}, orders.Issues)
+
+	old := keys[1]
+	assert.True(t, old.PendingDeletion)
+	assert.Len(t, old.Issues, 1)
+	assert.Contains(t, old.Issues[0], "Pending deletion on")
+
+	// A rotation status that could not be read is not reported as disabled
+	denied := keys[2]
+	assert.True(t, denied.RotationUnknown)
+	assert.Empty(t, denied.Issues)
+	mockKMS.AssertExpectations(t)
+}
+
+func TestFetchSecrets(t *testing.T) {
+	mockSM := new(MockSecretsManagerClient)
+	client := &Client{secretsmanagerClient: mockSM, region: "us-east-1"}
+
+	now := time.Now()
+	daysAgo := func(days int) *time.Time {
+		t := now.AddDate(0, 0, -days)
+		return &t
+	}
+	mockSM.On("ListSecrets", mock.Anything, mock.Anything, mock.Anything).Return(&secretsmanager.ListSecretsOutput{
+		SecretList: []secretsTypes.SecretListEntry{
+			{
+				Name: aws.String("prod/db"), ARN: aws.String("arn:aws:secretsmanager:us-east-1:123456789012:secret:prod/db"),
+				KmsKeyId: aws.String("alias/orders"), RotationEnabled: aws.Bool(true), PrimaryRegion: aws.String("us-east-1"),
+				RotationRules:   &secretsTypes.RotationRulesType{AutomaticallyAfterDays: aws.Int64(30)},
+				LastRotatedDate: daysAgo(10), NextRotationDate: daysAgo(-20), LastAccessedDate: daysAgo(1), CreatedDate: daysAgo(400),
+			},
+			{
+				Name: aws.String("legacy/api-key"), ARN: aws.String("arn:aws:secretsmanager:us-east-1:123456789012:secret:legacy/api-key"),
+				LastAccessedDate: daysAgo(120), CreatedDate: daysAgo(400),
+			},
+			{
+				Name: aws.String("unused"), ARN: aws.String("arn:aws:secretsmanager:us-east-1:123456789012:secret:unused"),
+				RotationEnabled: aws.Bool(true), NextRotationDate: daysAgo(3), CreatedDate: daysAgo(200),
+			},
+		},
+	}, nil).Once()
+	// Only the replicated secret is described
+	mockSM.On("DescribeSecret", mock.Anything, mock.MatchedBy(func(in *secretsmanager.DescribeSecretInput) bool {
+		return aws.ToString(in.SecretId) == "arn:aws:secretsmanager:us-east-1:123456789012:secret:prod/db"
+	}), mock.Anything).Return(&secretsmanager.DescribeSecretOutput{
+		ReplicationStatus: []secretsTypes.ReplicationStatusType{
+			{Region: aws.String("eu-west-1"), Status: secretsTypes.StatusTypeInSync},
+			{Region: aws.String("ap-south-1"), Status: secretsTypes.StatusTypeFailed, StatusMessage: aws.String("KMS key not found")},
+		},
+	}, nil).Once()
+
+	secrets, err := client.FetchSecrets(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, secrets, 3)
+
+	prod, legacy, unused := secrets[0], secrets[1], secrets[2]
+	assert.Equal(t, "alias/orders", prod.KMSKeyID)
+	assert.Equal(t, "every 30 days", prod.RotationSchedule)
+	assert.False(t, prod.Stale)
+	assert.Empty(t, prod.Issues)
+	assert.Len(t, prod.Replicas, 2)
+	assert.Equal(t, "ap-south-1", prod.Replicas[0].Region)
+	assert.Equal(t, "Failed", prod.Replicas[0].Status)
+
+	assert.Equal(t, "aws/secretsmanager", legacy.KMSKeyID)
+	assert.True(t, legacy.Stale)
+	assert.Equal(t, []string{"Rotation disabled", "Not accessed in 120 days"}, legacy.Issues)
+
+	assert.True(t, unused.Stale)
+	assert.Equal(t, -1, unused.DaysSinceAccess)
+	assert.Len(t, unused.Issues, 2)
+	assert.Contains(t, unused.Issues[0], "Rotation overdue since")
+	assert.Equal(t, "Never accessed", unused.Issues[1])
+	mockSM.AssertExpectations(t)
+}
+
+// Secret values must never be read: neither the client nor the policy can
+func TestSecretValuesNeverRead(t *testing.T) {
+	_, found := reflect.TypeOf((*SecretsManagerClientAPI)(nil)).Elem().MethodByName("GetSecretValue")
+	assert.False(t, found)
+	_, found = reflect.TypeOf((*SecretsManagerClientAPI)(nil)).Elem().MethodByName("BatchGetSecretValue")
+	assert.False(t, found)
+
+	for _, action := range registeredActions() {
+		assert.NotContains(t, []string{"secretsmanager:GetSecretValue", "secretsmanager:BatchGetSecretValue"}, action)
+	}
+}
@@ -0,0 +1,82 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	sfnTypes "github.com/aws/aws-sdk-go-v2/service/sfn/types"
+
+	"aws-terminal-sdk-v1/internal/models"
+)
+
+// stateMachineExecutionSample is the number of most recent executions the status counts
+// of a state machine are taken from
+const stateMachineExecutionSample = 100
+
+// FetchStateMachines gets the Step Functions state machines with the Lambda functions of
+// their definition and the status counts of their recent executions
+func (c *Client) FetchStateMachines(ctx context.Context) ([]models.StateMachineInfo, error) {
+	stateMachines, err := c.listStateMachines(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range stateMachines {
+		sm := &stateMachines[i]
+		// Executions of express state machines are only recorded in their logs
+		if sm.Type == string(sfnTypes.StateMachineTypeExpress) {
+			continue
+		}
+
+		executions, err := c.sfnClient.ListExecutions(ctx, &sfn.ListExecutionsInput{
+			StateMachineArn: aws.String(sm.ARN),
+			MaxResults:      stateMachineExecutionSample,
+		})
+		if err != nil {
+			fmt.Printf("Warning: failed to list executions of %s: %v\n", sm.Name, err)
+			continue
+		}
+		for _, execution := range executions.Executions {
+			sm.AddExecution(execution)
+		}
+	}
+
+	return stateMachines, nil
+}
+
+// listStateMachines lists the state machines and reads their definition
+func (c *Client) listStateMachines(ctx context.Context) ([]models.StateMachineInfo, error) {
+	stateMachines := make([]models.StateMachineInfo, 0)
+	paginator := sfn.NewListStateMachinesPaginator(c.sfnClient, &sfn.ListStateMachinesInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list state machines: %w", err)
+		}
+
+		for _, item := range output.StateMachines {
+			sm := models.FromAWSStateMachine(item)
+
+			described, err := c.sfnClient.DescribeStateMachine(ctx, &sfn.DescribeStateMachineInput{StateMachineArn: item.StateMachineArn})
+			if err != nil {
+				fmt.Printf("Warning: failed to describe state machine %s: %v\n", sm.Name, err)
+			} else {
+				sm.Status = string(described.Status)
+				sm.RoleARN = aws.ToString(described.RoleArn)
+				sm.LambdaARNs = models.ExtractLambdaARNs(aws.ToString(described.Definition))
+				if described.LoggingConfiguration != nil {
+					sm.LoggingLevel = string(described.LoggingConfiguration.Level)
+				}
+				if described.TracingConfiguration != nil {
+					sm.TracingEnabled = described.TracingConfiguration.Enabled
+				}
+			}
+
+			stateMachines = append(stateMachines, sm)
+		}
+	}
+
+	return stateMachines, nil
+}
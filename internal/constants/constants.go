@@ -41,6 +41,7 @@ const (
 const (
 	SecurityHubSeverityCritical = "CRITICAL"
 	SecurityHubSeverityHigh     = "HIGH"
+	SecurityHubSeverityMedium   = "MEDIUM"
 	SecurityHubStateActive      = "ACTIVE"
 )
 
@@ -53,9 +54,168 @@ const (
 	TACategoryCostOptimization = "Cost Optimization"
 )
 
+// Resource Types (shared with the frontend for metrics and alarms)
+const (
+	ResourceTypeEC2         = "ec2"
+	ResourceTypeRDS         = "rds"
+	ResourceTypeLambda      = "lambda"
+	ResourceTypeALB         = "alb"
+	ResourceTypeNLB         = "nlb"
+	ResourceTypeNAT         = "nat"
+	ResourceTypeECSCluster  = "ecs-cluster"
+	ResourceTypeECSService  = "ecs-service"
+	ResourceTypeDynamoDB    = "dynamodb"
+	ResourceTypeSQS         = "sqs"
+	ResourceTypeSNS         = "sns"
+	ResourceTypeElastiCache = "elasticache"
+	ResourceTypeOpenSearch  = "opensearch"
+	ResourceTypeMSK         = "msk"
+)
+
+// CloudWatch
+const (
+	MetricRange1Hour   = "1h"
+	MetricRange6Hours  = "6h"
+	MetricRange24Hours = "24h"
+	MetricRange7Days   = "7d"
+
+	DefaultMetricRange = MetricRange24Hours
+)
+
+// CloudWatch Logs
+const (
+	// LogTailEvent is the Wails event carrying new tailed log events to the frontend
+	LogTailEvent = "logs:tail"
+	// LogsInsightsTimeoutSeconds bounds how long a Logs Insights query is polled
+	LogsInsightsTimeoutSeconds = 120
+)
+
+// CloudFormation
+const (
+	// StackDriftEvent is the Wails event carrying the result of a stack drift detection
+	StackDriftEvent = "cloudformation:drift"
+	// StackDriftTimeoutSeconds bounds how long a drift detection is polled
+	StackDriftTimeoutSeconds = 300
+)
+
+// IAM hygiene defaults, following the CIS AWS Foundations Benchmark
+const (
+	IAMAccessKeyMaxAgeDays = 90
+	IAMAccessKeyUnusedDays = 90
+	IAMConsoleInactiveDays = 90
+	IAMRoleUnusedDays      = 90
+
+	PasswordPolicyMinLength      = 14
+	PasswordPolicyMaxAgeDays     = 90
+	PasswordPolicyReusePrevented = 24
+
+	// IAMHygieneTimeoutSeconds bounds the credential report generation and role lookups
+	IAMHygieneTimeoutSeconds = 120
+)
+
+// AWSIPRangesTimeoutSeconds bounds the download of ip-ranges.json by the dangling DNS check
+const AWSIPRangesTimeoutSeconds = 30
+
+// SecretUnusedDays is how long a secret can go without being accessed before it is stale
+const SecretUnusedDays = 90
+
+// EBS storage prices (USD per month, us-east-1 on-demand), used for cost estimates
+const (
+	EBSPriceGp2PerGB      = 0.10
+	EBSPriceGp3PerGB      = 0.08
+	EBSPriceIo1PerGB      = 0.125
+	EBSPriceIo2PerGB      = 0.125
+	EBSPriceSt1PerGB      = 0.045
+	EBSPriceSc1PerGB      = 0.015
+	EBSPriceStandardPerGB = 0.05
+
+	EBSPriceGp3PerIOPS       = 0.005 // Above the 3,000 IOPS baseline
+	EBSPriceGp3PerMBps       = 0.04  // Above the 125 MB/s baseline
+	EBSPriceProvisionedIOPS  = 0.065 // io1 and io2
+	EBSGp3BaselineIOPS       = 3000
+	EBSGp3BaselineThroughput = 125
+
+	EBSPriceSnapshotPerGB        = 0.05
+	EBSPriceSnapshotArchivePerGB = 0.0125
+)
+
 // Models
 const (
-	TagName       = "Name"
-	DateFormat    = "2006-01-02"
-	S3VerDisabled = "Disabled"
+	TagName             = "Name"
+	TagAutoScalingGroup = "aws:autoscaling:groupName" // Set by EC2 Auto Scaling on the instances it launches
+	DateFormat          = "2006-01-02"
+	DateTimeFormat      = "2006-01-02 15:04:05"
+	S3VerDisabled       = "Disabled"
+)
+
+// Features of the permission registry, view features are named after the frontend views
+const (
+	FeatureAccount        = "account"
+	FeatureUsage          = "usage"
+	FeatureServiceQuotas  = "quotas"
+	FeatureCosts          = "costs"
+	FeatureSecurityHub    = "securityhub"
+	FeatureTrustedAdvisor = "trustedadvisor"
+	FeatureMetrics        = "metrics"
+	FeatureAlarms         = "alarms"
+	FeatureLogs           = "logs"
+
+	FeatureVPC               = "vpc"
+	FeatureSubnet            = "subnet"
+	FeatureSecurityGroup     = "securitygroup"
+	FeatureNATGateway        = "natgateway"
+	FeatureRouteTable        = "routetable"
+	FeatureElasticIP         = "elasticip"
+	FeatureNetworkInterfaces = "networkinterfaces"
+	FeatureConnectivity      = "connectivity"
+	FeatureFlowLogs          = "flowlogs"
+
+	FeatureEC2            = "ec2"
+	FeatureInstanceStatus = "instancestatus"
+	FeatureEBS            = "ebs"
+	FeatureAutoScaling    = "autoscaling"
+	FeatureLoadBalancer   = "loadbalancer"
+	FeatureTargetGroup    = "targetgroup"
+	FeatureS3             = "s3"
+
+	FeatureLambda         = "lambda"
+	FeatureLambdaDetail   = "lambdadetail"
+	FeatureLambdaInvokers = "lambdainvokers"
+	FeatureECS            = "ecs"
+	FeatureECR            = "ecr"
+	FeatureECRUsage       = "ecrusage"
+	FeatureEKS            = "eks"
+	FeatureEKSDetail      = "eksdetail"
+
+	FeatureRDS            = "rds"
+	FeatureRDSClusters    = "rdsclusters"
+	FeatureRDSSnapshots   = "rdssnapshots"
+	FeatureRDSParameters  = "rdsparameters"
+	FeatureRDSMaintenance = "rdsmaintenance"
+	FeatureDynamoDB       = "dynamodb"
+	FeatureElastiCache    = "elasticache"
+	FeatureOpenSearch     = "opensearch"
+	FeatureMSK            = "msk"
+	FeatureSQS            = "sqs"
+	FeatureSNS            = "sns"
+
+	FeatureCloudFront    = "cloudfront"
+	FeatureRoute53       = "route53"
+	FeatureACM           = "acm"
+	FeatureDanglingDNS   = "danglingdns"
+	FeatureAPIGateway    = "apigateway"
+	FeatureStepFunctions = "stepfunctions"
+
+	FeatureCloudFormation = "cloudformation"
+	FeatureStackDrift     = "stackdrift"
+	FeatureIAMHygiene     = "iamhygiene"
+	FeatureIAMPolicies    = "iampolicies"
+	FeatureKMS            = "kms"
+	FeatureSecretsManager = "secretsmanager"
+
+	// PermissionSimulationTimeoutSeconds bounds the permission simulation behind the
+	// feature availability
+	PermissionSimulationTimeoutSeconds = 30
+	// PermissionDeniedReason is the reason of the actions the permission simulation denies
+	PermissionDeniedReason = "denied by IAM policy"
 )
@@ -21,6 +21,8 @@ func (a *App) Logout() error {
 
 	// Reset AWS client
 	a.awsClient = nil
+	a.resetFeatures()
+	a.stopLogTails()
 
 	return nil
 }
@@ -50,6 +52,8 @@ func (a *App) SaveAWSCredentials(accessKey, secretKey, region string) error {
 	}
 
 	a.awsClient = client
+	a.resetFeatures()
+	a.stopLogTails()
 	return nil
 }
 
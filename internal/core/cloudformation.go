@@ -0,0 +1,79 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"aws-terminal-sdk-v1/internal/constants"
+	"aws-terminal-sdk-v1/internal/models"
+)
+
+// GetCloudFormationStacks returns the CloudFormation stacks with their nested stacks
+func (a *App) GetCloudFormationStacks() ([]models.CloudFormationStackInfo, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureCloudFormation); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchCloudFormationStacks(context.Background())
+}
+
+// GetCloudFormationStackResources returns the resources of a stack
+func (a *App) GetCloudFormationStackResources(stackName string) ([]models.CloudFormationResourceInfo, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureCloudFormation); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchCloudFormationStackResources(context.Background(), stackName)
+}
+
+// GetStackOwnership returns the stack resources indexed by physical ID, used to show
+// which stack owns an inventory item
+func (a *App) GetStackOwnership() (*models.StackOwnershipIndex, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureCloudFormation); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchStackOwnership(context.Background())
+}
+
+// DetectStackDrift starts a drift detection on a stack and returns its ID. The
+// detection is polled in the background and its result is emitted as
+// constants.StackDriftEvent with a models.StackDriftResult payload.
+func (a *App) DetectStackDrift(stackName string) (string, error) {
+	if a.awsClient == nil {
+		return "", fmt.Errorf("AWS client not initialized")
+	}
+	if err := a.featureUnavailable(constants.FeatureStackDrift); err != nil {
+		return "", err
+	}
+
+	// The credentials may change before the detection completes
+	client := a.awsClient
+	detectionID, err := client.StartStackDriftDetection(context.Background(), stackName)
+	if err != nil {
+		return "", err
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), constants.StackDriftTimeoutSeconds*time.Second)
+		defer cancel()
+
+		result, err := client.WaitForStackDriftDetection(ctx, detectionID)
+		if err != nil {
+			result = &models.StackDriftResult{DetectionID: detectionID, StackName: stackName, Error: err.Error()}
+		}
+		emitEvent(a.ctx, constants.StackDriftEvent, *result)
+	}()
+
+	return detectionID, nil
+}
@@ -0,0 +1,144 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"aws-terminal-sdk-v1/internal/aws"
+	"aws-terminal-sdk-v1/internal/constants"
+	"aws-terminal-sdk-v1/internal/models"
+)
+
+// GetFeatureAvailability tells which features the current credentials can use, in
+// registry order. It combines the simulated IAM permissions with the calls that already
+// failed for lack of permission or subscription.
+func (a *App) GetFeatureAvailability() ([]models.FeatureAvailability, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+
+	features := a.simulatedFeatures()
+	denied := a.awsClient.DeniedActions()
+
+	availability := make([]models.FeatureAvailability, 0, len(features))
+	for _, feature := range features {
+		availability = append(availability, featureAvailability(feature, denied))
+	}
+	return availability, nil
+}
+
+// simulatedFeatures simulates the permissions of the current client once, and hands the
+// denied actions to the client so that it skips them. When the simulation is denied,
+// without iam:SimulatePrincipalPolicy, every feature is assumed available. Other failures
+// assume the same but are not cached, the simulation is retried on the next call.
+func (a *App) simulatedFeatures() []models.FeaturePermissions {
+	a.simulateMu.Lock()
+	defer a.simulateMu.Unlock()
+
+	if features := a.cachedFeatures(); features != nil {
+		return features
+	}
+
+	client := a.awsClient
+	ctx, cancel := context.WithTimeout(context.Background(), constants.PermissionSimulationTimeoutSeconds*time.Second)
+	defer cancel()
+
+	features, err := client.VerifyPermissions(ctx)
+	if err != nil && !aws.IsAccessDenied(err) {
+		// Such as throttling or a timeout, the next call simulates again
+		slog.Warn("Failed to simulate permissions, assuming every feature is available for now", "error", err)
+		return []models.FeaturePermissions{}
+	}
+	if err != nil {
+		slog.Warn("Permissions cannot be simulated, assuming every feature is available", "error", err)
+		features = []models.FeaturePermissions{}
+	}
+
+	denied := make(map[string]string)
+	for _, feature := range features {
+		for _, actions := range [][]string{feature.Missing, feature.Degraded} {
+			for _, action := range actions {
+				denied[action] = constants.PermissionDeniedReason
+			}
+		}
+	}
+	client.DenyActions(denied)
+
+	a.featuresMu.Lock()
+	defer a.featuresMu.Unlock()
+	// The credentials may have changed during the simulation
+	if a.awsClient == client {
+		a.features = features
+	}
+	return features
+}
+
+// cachedFeatures returns the simulated permissions, nil when not simulated yet
+func (a *App) cachedFeatures() []models.FeaturePermissions {
+	a.featuresMu.Lock()
+	defer a.featuresMu.Unlock()
+	return a.features
+}
+
+// resetFeatures forgets the simulated permissions, when the credentials change
+func (a *App) resetFeatures() {
+	a.featuresMu.Lock()
+	defer a.featuresMu.Unlock()
+	a.features = nil
+}
+
+// featureUnavailable returns an error when the simulated permissions deny an action of
+// the feature, so that its view fails without calling AWS. It never simulates itself.
+func (a *App) featureUnavailable(feature string) error {
+	for _, permissions := range a.cachedFeatures() {
+		if permissions.Feature != feature {
+			continue
+		}
+		if availability := featureAvailability(permissions, nil); !availability.Available {
+			return fmt.Errorf("%s unavailable: %s", availability.Label, availability.Reason)
+		}
+		return nil
+	}
+	return nil
+}
+
+// featureAvailability merges the simulated permissions of a feature with the actions
+// that failed at runtime. Only the required actions make the feature unavailable.
+func featureAvailability(feature models.FeaturePermissions, denied map[string]string) models.FeatureAvailability {
+	availability := models.FeatureAvailability{
+		Feature:  feature.Feature,
+		Label:    feature.Label,
+		Missing:  append([]string{}, feature.Missing...),
+		Degraded: append([]string{}, feature.Degraded...),
+	}
+
+	var reasons []string
+	if len(feature.Missing) > 0 {
+		reasons = append(reasons, "missing "+strings.Join(feature.Missing, ", "))
+	}
+	if len(feature.Degraded) > 0 {
+		reasons = append(reasons, "degraded without "+strings.Join(feature.Degraded, ", "))
+	}
+
+	// Actions allowed or undecided by the simulation that failed anyway, such as without
+	// a support plan
+	for _, permission := range feature.Permissions {
+		reason, ok := denied[permission.Action]
+		if !ok || !(permission.Allowed || permission.Unknown) {
+			continue
+		}
+		if permission.Optional {
+			availability.Degraded = append(availability.Degraded, permission.Action)
+		} else {
+			availability.Missing = append(availability.Missing, permission.Action)
+		}
+		reasons = append(reasons, fmt.Sprintf("%s %s", permission.Action, reason))
+	}
+
+	availability.Available = len(availability.Missing) == 0
+	availability.Reason = strings.Join(reasons, "; ")
+	return availability
+}
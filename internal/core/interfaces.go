@@ -2,6 +2,7 @@ package core
 
 import (
 	"context"
+	"time"
 
 	"aws-terminal-sdk-v1/internal/models"
 )
@@ -10,19 +11,87 @@ import (
 type AWSClient interface {
 	FetchVPCs(ctx context.Context) ([]models.VPCInfo, error)
 	FetchEC2Instances(ctx context.Context) ([]models.EC2InstanceInfo, error)
+	FetchInstanceStatuses(ctx context.Context, instanceIDs ...string) ([]models.InstanceStatusInfo, error)
+	FetchInstanceStatus(ctx context.Context, instanceID string) (*models.InstanceStatusInfo, error)
 	FetchECSClusters(ctx context.Context) ([]models.ECSClusterInfo, error)
+	FetchECSServices(ctx context.Context, cluster string) ([]models.ECSServiceInfo, error)
+	FetchECSTasks(ctx context.Context, cluster, service string) ([]models.ECSTaskInfo, error)
+	FetchECSTaskDefinition(ctx context.Context, taskDefinition string) (*models.ECSTaskDefinitionInfo, error)
+	FetchECRRepositories(ctx context.Context) ([]models.ECRRepositoryInfo, error)
+	FetchECRImages(ctx context.Context, repository string) ([]models.ECRImageInfo, error)
+	FetchECRImageScanFindings(ctx context.Context, repository, digest string) ([]models.ECRScanFinding, error)
+	FetchECRImageUsage(ctx context.Context) (*models.ECRImageUsageReport, error)
+	FetchCloudFrontDistributions(ctx context.Context) ([]models.CloudFrontDistributionInfo, error)
+	FetchRoute53HostedZones(ctx context.Context) ([]models.Route53HostedZoneInfo, error)
+	FetchRoute53Records(ctx context.Context, zoneID string) ([]models.Route53RecordInfo, error)
+	FetchACMCertificates(ctx context.Context) ([]models.ACMCertificateInfo, error)
+	FetchExpiringCertificates(ctx context.Context, days int) ([]models.SecurityFinding, error)
+	FetchIAMHygiene(ctx context.Context, req models.IAMHygieneRequest) (*models.IAMHygieneReport, error)
+	FetchPolicyAnalysis(ctx context.Context) (*models.PolicyAnalysis, error)
+	FetchKMSKeys(ctx context.Context) ([]models.KMSKeyInfo, error)
+	FetchSecrets(ctx context.Context) ([]models.SecretInfo, error)
+	FetchDanglingDNSRecords(ctx context.Context) ([]models.SecurityFinding, error)
+	FetchAPIGatewayAPIs(ctx context.Context) ([]models.APIGatewayAPIInfo, error)
+	FetchAPIGatewayAPIDetail(ctx context.Context, apiID, protocol string) (*models.APIGatewayAPIDetail, error)
+	FetchAPIGatewayDomains(ctx context.Context) ([]models.APIGatewayDomainInfo, error)
+	FetchStateMachines(ctx context.Context) ([]models.StateMachineInfo, error)
+	FetchLambdaInvokers(ctx context.Context, functionARN string) ([]models.LambdaInvokerInfo, error)
+	FetchElastiCacheClusters(ctx context.Context) ([]models.ElastiCacheClusterInfo, error)
+	FetchOpenSearchDomains(ctx context.Context) ([]models.OpenSearchDomainInfo, error)
+	FetchMSKClusters(ctx context.Context) ([]models.MSKClusterInfo, error)
+	FetchCloudFormationStacks(ctx context.Context) ([]models.CloudFormationStackInfo, error)
+	FetchCloudFormationStackResources(ctx context.Context, stackName string) ([]models.CloudFormationResourceInfo, error)
+	FetchStackOwnership(ctx context.Context) (*models.StackOwnershipIndex, error)
+	StartStackDriftDetection(ctx context.Context, stackName string) (string, error)
+	WaitForStackDriftDetection(ctx context.Context, detectionID string) (*models.StackDriftResult, error)
+	FetchEKSClusters(ctx context.Context) ([]models.EKSClusterInfo, error)
+	FetchEKSClusterDetail(ctx context.Context, clusterName string) (*models.EKSClusterDetail, error)
 	FetchSubnets(ctx context.Context) ([]models.SubnetInfo, error)
 	FetchSecurityGroups(ctx context.Context) ([]models.SecurityGroupInfo, error)
 	FetchNATGateways(ctx context.Context) ([]models.NATGatewayInfo, error)
 	FetchRouteTables(ctx context.Context) ([]models.RouteTableInfo, error)
+	FetchVPCConnectivity(ctx context.Context) (*models.VPCConnectivity, error)
+	FetchFlowLogs(ctx context.Context) ([]models.FlowLogInfo, error)
+	FetchFlowLogFindings(ctx context.Context) ([]models.SecurityFinding, error)
+	FetchFlowLogSummary(ctx context.Context, vpcID, timeRange string) (*models.FlowLogSummary, error)
+	FetchAutoScalingGroups(ctx context.Context) ([]models.AutoScalingGroupInfo, error)
+	FetchScalingActivities(ctx context.Context, groupName string) ([]models.ScalingActivityInfo, error)
+	FetchLaunchTemplates(ctx context.Context) ([]models.LaunchTemplateInfo, error)
+	FetchLaunchTemplateVersions(ctx context.Context, templateID string) ([]models.LaunchTemplateVersionInfo, error)
 	FetchS3Buckets(ctx context.Context) ([]models.S3BucketInfo, error)
 	FetchTargetGroups(ctx context.Context) ([]models.TargetGroupInfo, error)
 	FetchLoadBalancers(ctx context.Context) ([]models.LoadBalancerInfo, error)
 	FetchElasticIPs(ctx context.Context) ([]models.ElasticIPInfo, error)
 	FetchLambdaFunctions(ctx context.Context) ([]models.LambdaFunctionInfo, error)
+	FetchLambdaFunctionDetail(ctx context.Context, functionName string) (*models.LambdaFunctionDetail, error)
 	FetchRDSInstances(ctx context.Context) ([]models.RDSInstanceInfo, error)
+	FetchRDSClusters(ctx context.Context) ([]models.RDSClusterInfo, error)
+	FetchRDSSnapshots(ctx context.Context) ([]models.RDSSnapshotInfo, error)
+	FetchRDSParameterGroups(ctx context.Context) ([]models.RDSParameterGroupInfo, error)
+	FetchRDSPendingMaintenance(ctx context.Context) ([]models.RDSPendingMaintenanceInfo, error)
+	FetchRDSEvents(ctx context.Context, sourceType, sourceID string) ([]models.RDSEventInfo, error)
+	FetchDynamoDBTables(ctx context.Context) ([]models.DynamoDBTableInfo, error)
+	FetchSQSQueues(ctx context.Context) ([]models.SQSQueueInfo, error)
+	FetchSNSTopics(ctx context.Context) ([]models.SNSTopicInfo, error)
 	FetchConfiguration(ctx context.Context) (models.ConfigurationInfo, error)
 	FetchResourceMetrics(ctx context.Context, namespace, metricName string, dimensions map[string]string, period int32) (*models.ResourceMetrics, error)
+	FetchMetricsForResource(ctx context.Context, resourceType, resourceID, timeRange string) (*models.ResourceMetrics, error)
+	FetchMetricQueries(ctx context.Context, queries []models.MetricQuery, startTime, endTime time.Time) ([]models.MetricData, error)
+	QueryResourceMetrics(ctx context.Context, req models.MetricRequest) (*models.ResourceMetrics, error)
+	FetchAlarms(ctx context.Context, state string) ([]models.AlarmInfo, error)
+	FetchAlarmsForResource(ctx context.Context, resourceType, resourceID string) ([]models.AlarmInfo, error)
+	FetchAlarmHistory(ctx context.Context, alarmName string) ([]models.AlarmHistoryItem, error)
+	FetchLogGroups(ctx context.Context, prefix string) ([]models.LogGroupInfo, error)
+	FetchLogStreams(ctx context.Context, logGroup string) ([]models.LogStreamInfo, error)
+	TailLogEvents(ctx context.Context, req models.LogTailRequest, emit func([]models.LogEvent)) error
+	RunLogsInsightsQuery(ctx context.Context, req models.LogsInsightsRequest) (*models.LogsInsightsResult, error)
+	FetchEBSVolumes(ctx context.Context) ([]models.EBSVolumeInfo, error)
+	FetchEBSSnapshots(ctx context.Context) ([]models.EBSSnapshotInfo, error)
+	FetchEBSStorageReport(ctx context.Context) (*models.EBSStorageReport, error)
+	FetchNetworkInterfaces(ctx context.Context) ([]models.NetworkInterfaceInfo, error)
+	LookupIP(ctx context.Context, ip string) (*models.IPLookupResult, error)
 	FetchAccountHomeInfo(ctx context.Context) (*models.AccountHomeInfo, error)
-	VerifyPermissions(ctx context.Context) ([]models.PermissionStatus, error)
+	VerifyPermissions(ctx context.Context) ([]models.FeaturePermissions, error)
+	DenyActions(actions map[string]string)
+	DeniedActions() map[string]string
 }
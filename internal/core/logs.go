@@ -0,0 +1,136 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"aws-terminal-sdk-v1/internal/constants"
+	"aws-terminal-sdk-v1/internal/models"
+
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+)
+
+// logTailCounter makes tail IDs unique within the process
+var logTailCounter atomic.Int64
+
+// emitEvent sends an event to the frontend; replaced in tests
+var emitEvent = runtime.EventsEmit
+
+// GetLogGroups returns the CloudWatch Logs groups, optionally filtered by name prefix
+func (a *App) GetLogGroups(prefix string) ([]models.LogGroupInfo, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureLogs); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchLogGroups(context.Background(), prefix)
+}
+
+// GetLogStreams returns the most recently active streams of a log group
+func (a *App) GetLogStreams(logGroup string) ([]models.LogStreamInfo, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureLogs); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchLogStreams(context.Background(), logGroup)
+}
+
+// StartLogTail starts live-tailing a log group or stream and returns the tail ID.
+// New events are emitted as constants.LogTailEvent with a models.LogTailBatch payload
+// until StopLogTail is called.
+func (a *App) StartLogTail(req models.LogTailRequest) (string, error) {
+	if a.awsClient == nil {
+		return "", fmt.Errorf("AWS client not initialized")
+	}
+	if err := a.featureUnavailable(constants.FeatureLogs); err != nil {
+		return "", err
+	}
+	if req.LogGroup == "" {
+		return "", fmt.Errorf("log group is required")
+	}
+
+	tailID := fmt.Sprintf("tail-%d", logTailCounter.Add(1))
+	ctx, cancel := context.WithCancel(context.Background())
+
+	a.logTailsMu.Lock()
+	if a.logTails == nil {
+		a.logTails = make(map[string]context.CancelFunc)
+	}
+	a.logTails[tailID] = cancel
+	a.logTailsMu.Unlock()
+
+	// The credentials may change before the goroutine runs
+	client := a.awsClient
+	go func() {
+		defer a.StopLogTail(tailID)
+
+		err := client.TailLogEvents(ctx, req, func(events []models.LogEvent) {
+			emitEvent(a.ctx, constants.LogTailEvent, models.LogTailBatch{TailID: tailID, Events: events})
+		})
+		if err != nil {
+			emitEvent(a.ctx, constants.LogTailEvent, models.LogTailBatch{TailID: tailID, Error: err.Error()})
+		}
+	}()
+
+	return tailID, nil
+}
+
+// StopLogTail stops a running log tail; unknown IDs are ignored
+func (a *App) StopLogTail(tailID string) {
+	a.logTailsMu.Lock()
+	defer a.logTailsMu.Unlock()
+
+	if cancel, ok := a.logTails[tailID]; ok {
+		cancel()
+		delete(a.logTails, tailID)
+	}
+}
+
+// stopLogTails stops every running log tail, when the credentials change
+func (a *App) stopLogTails() {
+	a.logTailsMu.Lock()
+	defer a.logTailsMu.Unlock()
+
+	for tailID, cancel := range a.logTails {
+		cancel()
+		delete(a.logTails, tailID)
+	}
+}
+
+// RunLogsInsightsQuery runs a Logs Insights query and waits for its results
+func (a *App) RunLogsInsightsQuery(req models.LogsInsightsRequest) (*models.LogsInsightsResult, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureLogs); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), constants.LogsInsightsTimeoutSeconds*time.Second)
+	defer cancel()
+
+	return a.awsClient.RunLogsInsightsQuery(ctx, req)
+}
+
+// GetFlowLogSummary analyzes the CloudWatch Logs flow log of a VPC over the last timeRange
+// (e.g. "1h", "7d") for top talkers, rejected traffic and bytes by port
+func (a *App) GetFlowLogSummary(vpcID string, timeRange string) (*models.FlowLogSummary, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureFlowLogs); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), constants.LogsInsightsTimeoutSeconds*time.Second)
+	defer cancel()
+
+	return a.awsClient.FetchFlowLogSummary(ctx, vpcID, timeRange)
+}
@@ -3,7 +3,10 @@ package core
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"aws-terminal-sdk-v1/internal/aws"
+	"aws-terminal-sdk-v1/internal/constants"
 	"aws-terminal-sdk-v1/internal/models"
 )
 
@@ -12,6 +15,9 @@ func (a *App) GetVPCs() ([]models.VPCInfo, error) {
 	if a.awsClient == nil {
 		return nil, nil
 	}
+	if err := a.featureUnavailable(constants.FeatureVPC); err != nil {
+		return nil, err
+	}
 
 	return a.awsClient.FetchVPCs(context.Background())
 }
@@ -21,6 +27,9 @@ func (a *App) GetEC2Instances() ([]models.EC2InstanceInfo, error) {
 	if a.awsClient == nil {
 		return nil, nil
 	}
+	if err := a.featureUnavailable(constants.FeatureEC2); err != nil {
+		return nil, err
+	}
 
 	return a.awsClient.FetchEC2Instances(context.Background())
 }
@@ -30,6 +39,9 @@ func (a *App) GetECSClusters() ([]models.ECSClusterInfo, error) {
 	if a.awsClient == nil {
 		return nil, nil
 	}
+	if err := a.featureUnavailable(constants.FeatureECS); err != nil {
+		return nil, err
+	}
 
 	return a.awsClient.FetchECSClusters(context.Background())
 }
@@ -39,6 +51,9 @@ func (a *App) GetSubnets() ([]models.SubnetInfo, error) {
 	if a.awsClient == nil {
 		return nil, nil
 	}
+	if err := a.featureUnavailable(constants.FeatureSubnet); err != nil {
+		return nil, err
+	}
 
 	return a.awsClient.FetchSubnets(context.Background())
 }
@@ -48,6 +63,9 @@ func (a *App) GetSecurityGroups() ([]models.SecurityGroupInfo, error) {
 	if a.awsClient == nil {
 		return nil, nil
 	}
+	if err := a.featureUnavailable(constants.FeatureSecurityGroup); err != nil {
+		return nil, err
+	}
 
 	return a.awsClient.FetchSecurityGroups(context.Background())
 }
@@ -57,6 +75,9 @@ func (a *App) GetNATGateways() ([]models.NATGatewayInfo, error) {
 	if a.awsClient == nil {
 		return nil, nil
 	}
+	if err := a.featureUnavailable(constants.FeatureNATGateway); err != nil {
+		return nil, err
+	}
 
 	return a.awsClient.FetchNATGateways(context.Background())
 }
@@ -66,15 +87,58 @@ func (a *App) GetRouteTables() ([]models.RouteTableInfo, error) {
 	if a.awsClient == nil {
 		return nil, nil
 	}
+	if err := a.featureUnavailable(constants.FeatureRouteTable); err != nil {
+		return nil, err
+	}
 
 	return a.awsClient.FetchRouteTables(context.Background())
 }
 
+// GetVPCConnectivity returns the internet gateways, endpoints, peerings, transit gateway
+// attachments and route tables, and VPN connections linking the VPCs
+func (a *App) GetVPCConnectivity() (*models.VPCConnectivity, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureConnectivity); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchVPCConnectivity(context.Background())
+}
+
+// GetFlowLogs returns the flow logs of the VPCs, subnets and network interfaces
+func (a *App) GetFlowLogs() ([]models.FlowLogInfo, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureFlowLogs); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchFlowLogs(context.Background())
+}
+
+// GetFlowLogFindings returns a "flow logs disabled" finding for each VPC without a VPC-level flow log
+func (a *App) GetFlowLogFindings() ([]models.SecurityFinding, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureFlowLogs); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchFlowLogFindings(context.Background())
+}
+
 // GetS3Buckets returns the list of S3 Buckets from AWS
 func (a *App) GetS3Buckets() ([]models.S3BucketInfo, error) {
 	if a.awsClient == nil {
 		return nil, nil
 	}
+	if err := a.featureUnavailable(constants.FeatureS3); err != nil {
+		return nil, err
+	}
 
 	return a.awsClient.FetchS3Buckets(context.Background())
 }
@@ -84,6 +148,9 @@ func (a *App) GetTargetGroups() ([]models.TargetGroupInfo, error) {
 	if a.awsClient == nil {
 		return nil, nil
 	}
+	if err := a.featureUnavailable(constants.FeatureTargetGroup); err != nil {
+		return nil, err
+	}
 
 	return a.awsClient.FetchTargetGroups(context.Background())
 }
@@ -93,6 +160,9 @@ func (a *App) GetLoadBalancers() ([]models.LoadBalancerInfo, error) {
 	if a.awsClient == nil {
 		return nil, nil
 	}
+	if err := a.featureUnavailable(constants.FeatureLoadBalancer); err != nil {
+		return nil, err
+	}
 
 	return a.awsClient.FetchLoadBalancers(context.Background())
 }
@@ -107,24 +177,592 @@ func (a *App) GetECSMetrics(clusterName string, period int32) (*models.ResourceM
 		"ClusterName": clusterName,
 	}
 
-	metricsToFetch := []string{"CPUUtilization", "MemoryUtilization", "NetworkIn", "NetworkOut"}
-	allMetrics := &models.ResourceMetrics{
-		Metrics: make([]models.MetricData, 0),
+	return a.fetchECSMetrics(dimensions, []string{"CPUUtilization", "MemoryUtilization", "NetworkIn", "NetworkOut"}, period)
+}
+
+// GetECSServices returns the services of a specific ECS cluster
+func (a *App) GetECSServices(cluster string) ([]models.ECSServiceInfo, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureECS); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchECSServices(context.Background(), cluster)
+}
+
+// GetECSTasks returns the running and recently stopped tasks of an ECS service
+func (a *App) GetECSTasks(cluster, service string) ([]models.ECSTaskInfo, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureECS); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchECSTasks(context.Background(), cluster, service)
+}
+
+// GetECSTaskDefinition returns the details of a task definition revision
+func (a *App) GetECSTaskDefinition(taskDefinition string) (*models.ECSTaskDefinitionInfo, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureECS); err != nil {
+		return nil, err
 	}
 
-	for _, mName := range metricsToFetch {
-		data, err := a.awsClient.FetchResourceMetrics(context.Background(), "AWS/ECS", mName, dimensions, period)
+	return a.awsClient.FetchECSTaskDefinition(context.Background(), taskDefinition)
+}
+
+// GetECRRepositories returns the ECR repositories with image counts and scan severity totals
+func (a *App) GetECRRepositories() ([]models.ECRRepositoryInfo, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureECR); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchECRRepositories(context.Background())
+}
+
+// GetECRImages returns the images of a repository, most recently pushed first
+func (a *App) GetECRImages(repository string) ([]models.ECRImageInfo, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureECR); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchECRImages(context.Background(), repository)
+}
+
+// GetECRImageScanFindings returns the vulnerabilities of an image, most severe first
+func (a *App) GetECRImageScanFindings(repository, digest string) ([]models.ECRScanFinding, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureECR); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchECRImageScanFindings(context.Background(), repository, digest)
+}
+
+// GetECRImageUsage returns the ECR images run by the ECS services with their scan severities
+func (a *App) GetECRImageUsage() (*models.ECRImageUsageReport, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureECRUsage); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchECRImageUsage(context.Background())
+}
+
+// GetCloudFrontDistributions returns the CloudFront distributions with their origins, aliases and WAF web ACL
+func (a *App) GetCloudFrontDistributions() ([]models.CloudFrontDistributionInfo, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureCloudFront); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchCloudFrontDistributions(context.Background())
+}
+
+// GetRoute53HostedZones returns the public and private hosted zones
+func (a *App) GetRoute53HostedZones() ([]models.Route53HostedZoneInfo, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureRoute53); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchRoute53HostedZones(context.Background())
+}
+
+// GetRoute53Records returns the record sets of a hosted zone
+func (a *App) GetRoute53Records(zoneID string) ([]models.Route53RecordInfo, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureRoute53); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchRoute53Records(context.Background(), zoneID)
+}
+
+// GetACMCertificates returns the ACM certificates of the region and those used by CloudFront, expiring first
+func (a *App) GetACMCertificates() ([]models.ACMCertificateInfo, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureACM); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchACMCertificates(context.Background())
+}
+
+// GetExpiringCertificates returns findings for the certificates expiring within the given number of days
+// and the expired ones still in use
+func (a *App) GetExpiringCertificates(days int) ([]models.SecurityFinding, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureACM); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchExpiringCertificates(context.Background(), days)
+}
+
+// GetIAMHygiene reports users without MFA, old or unused access keys, inactive console
+// users, unused roles and password policy gaps. Zero thresholds in req use the defaults.
+func (a *App) GetIAMHygiene(req models.IAMHygieneRequest) (*models.IAMHygieneReport, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureIAMHygiene); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), constants.IAMHygieneTimeoutSeconds*time.Second)
+	defer cancel()
+
+	return a.awsClient.FetchIAMHygiene(ctx, req)
+}
+
+// GetPolicyAnalysis flags wildcard and privilege escalation risks in the policies of
+// every user, group and role, with the offending statements
+func (a *App) GetPolicyAnalysis() (*models.PolicyAnalysis, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureIAMPolicies); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchPolicyAnalysis(context.Background())
+}
+
+// GetKMSKeys returns the customer-managed KMS keys with their rotation, aliases and key
+// policy principals
+func (a *App) GetKMSKeys() ([]models.KMSKeyInfo, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureKMS); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchKMSKeys(context.Background())
+}
+
+// GetSecrets returns the metadata of the Secrets Manager secrets, never their values
+func (a *App) GetSecrets() ([]models.SecretInfo, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureSecretsManager); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchSecrets(context.Background())
+}
+
+// GetDanglingDNSRecords returns findings for the public DNS records pointing at deleted load balancers,
+// distributions, S3 buckets or Elastic IPs
+func (a *App) GetDanglingDNSRecords() ([]models.SecurityFinding, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureDanglingDNS); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchDanglingDNSRecords(context.Background())
+}
+
+// GetAPIGatewayAPIs returns the REST, HTTP and WebSocket APIs
+func (a *App) GetAPIGatewayAPIs() ([]models.APIGatewayAPIInfo, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureAPIGateway); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchAPIGatewayAPIs(context.Background())
+}
+
+// GetAPIGatewayAPIDetail returns the stages and the routes with their integration targets of an API
+func (a *App) GetAPIGatewayAPIDetail(apiID, protocol string) (*models.APIGatewayAPIDetail, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureAPIGateway); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchAPIGatewayAPIDetail(context.Background(), apiID, protocol)
+}
+
+// GetAPIGatewayDomains returns the API Gateway custom domain names with their API mappings
+func (a *App) GetAPIGatewayDomains() ([]models.APIGatewayDomainInfo, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureAPIGateway); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchAPIGatewayDomains(context.Background())
+}
+
+// GetStateMachines returns the Step Functions state machines with their recent execution status counts
+func (a *App) GetStateMachines() ([]models.StateMachineInfo, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureStepFunctions); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchStateMachines(context.Background())
+}
+
+// GetLambdaInvokers returns the API routes and state machines invoking a Lambda function
+func (a *App) GetLambdaInvokers(functionARN string) ([]models.LambdaInvokerInfo, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureLambdaInvokers); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchLambdaInvokers(context.Background(), functionARN)
+}
+
+// GetElastiCacheClusters returns the ElastiCache replication groups and standalone cache clusters
+func (a *App) GetElastiCacheClusters() ([]models.ElastiCacheClusterInfo, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureElastiCache); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchElastiCacheClusters(context.Background())
+}
+
+// GetOpenSearchDomains returns the OpenSearch Service domains
+func (a *App) GetOpenSearchDomains() ([]models.OpenSearchDomainInfo, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureOpenSearch); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchOpenSearchDomains(context.Background())
+}
+
+// GetMSKClusters returns the provisioned and serverless MSK clusters
+func (a *App) GetMSKClusters() ([]models.MSKClusterInfo, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureMSK); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchMSKClusters(context.Background())
+}
+
+// GetEKSClusters returns the EKS clusters with the support status of their Kubernetes version
+func (a *App) GetEKSClusters() ([]models.EKSClusterInfo, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureEKS); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchEKSClusters(context.Background())
+}
+
+// GetEKSClusterDetail returns the node groups, Fargate profiles, add-ons and network footprint of a cluster
+func (a *App) GetEKSClusterDetail(clusterName string) (*models.EKSClusterDetail, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureEKSDetail); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchEKSClusterDetail(context.Background(), clusterName)
+}
+
+// GetEKSVersionCalendar returns the bundled EKS Kubernetes release calendar
+func (a *App) GetEKSVersionCalendar() []models.EKSVersionSupport {
+	return models.EKSVersionCalendar(time.Now())
+}
+
+// GetECSServiceMetrics returns CloudWatch metrics for a specific ECS service
+func (a *App) GetECSServiceMetrics(cluster, service string, period int32) (*models.ResourceMetrics, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+
+	dimensions := map[string]string{
+		"ClusterName": cluster,
+		"ServiceName": service,
+	}
+
+	return a.fetchECSMetrics(dimensions, []string{"CPUUtilization", "MemoryUtilization"}, period)
+}
+
+// fetchECSMetrics gets the 24 hour averages of several AWS/ECS metrics in a single request,
+// skipping the metrics that fail
+func (a *App) fetchECSMetrics(dimensions map[string]string, metricNames []string, period int32) (*models.ResourceMetrics, error) {
+	if err := a.featureUnavailable(constants.FeatureMetrics); err != nil {
+		return nil, err
+	}
+
+	queries := make([]models.MetricQuery, 0, len(metricNames))
+	for _, name := range metricNames {
+		queries = append(queries, models.MetricQuery{
+			ID:         aws.MetricQueryID(name, "Average"),
+			Label:      name,
+			Namespace:  "AWS/ECS",
+			MetricName: name,
+			Dimensions: dimensions,
+			Stat:       "Average",
+			Period:     period,
+		})
+	}
+
+	ctx := context.Background()
+	endTime := time.Now()
+	startTime := endTime.Add(-24 * time.Hour)
+	data, err := a.awsClient.FetchMetricQueries(ctx, queries, startTime, endTime)
+	if err == nil {
+		return &models.ResourceMetrics{Metrics: data}, nil
+	}
+
+	// One bad metric fails the whole batch, query them one by one to keep the others
+	metrics := &models.ResourceMetrics{Metrics: []models.MetricData{}}
+	for _, query := range queries {
+		data, err := a.awsClient.FetchMetricQueries(ctx, []models.MetricQuery{query}, startTime, endTime)
 		if err != nil {
-			// Log error but continue with other metrics
-			fmt.Printf("Error fetching metric %s: %v\n", mName, err)
+			fmt.Printf("Error fetching metric %s: %v\n", query.MetricName, err)
 			continue
 		}
-		if data != nil {
-			allMetrics.Metrics = append(allMetrics.Metrics, data.Metrics...)
-		}
+		metrics.Metrics = append(metrics.Metrics, data...)
+	}
+
+	return metrics, nil
+}
+
+// GetResourceMetrics returns the default CloudWatch metrics of any supported
+// resource type (see aws.LookupMetricCatalog) over a range such as "1h" or "7d"
+func (a *App) GetResourceMetrics(resourceType, resourceID, timeRange string) (*models.ResourceMetrics, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureMetrics); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchMetricsForResource(context.Background(), resourceType, resourceID, timeRange)
+}
+
+// QueryResourceMetrics runs a custom metrics request with an RFC3339 start/end window,
+// several statistics (e.g. p50, p90, p99, Maximum, Sum) and metric math expressions
+func (a *App) QueryResourceMetrics(req models.MetricRequest) (*models.ResourceMetrics, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureMetrics); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.QueryResourceMetrics(context.Background(), req)
+}
+
+// GetAlarms returns the CloudWatch metric and composite alarms, optionally
+// filtered by state (OK, ALARM, INSUFFICIENT_DATA)
+func (a *App) GetAlarms(state string) ([]models.AlarmInfo, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureAlarms); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchAlarms(context.Background(), state)
+}
+
+// GetResourceAlarms returns the alarms watching a resource, matched by its CloudWatch dimensions
+func (a *App) GetResourceAlarms(resourceType, resourceID string) ([]models.AlarmInfo, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureAlarms); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchAlarmsForResource(context.Background(), resourceType, resourceID)
+}
+
+// GetAlarmHistory returns the recent state changes and actions of an alarm
+func (a *App) GetAlarmHistory(alarmName string) ([]models.AlarmHistoryItem, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureAlarms); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchAlarmHistory(context.Background(), alarmName)
+}
+
+// GetEBSVolumes returns the EBS volumes with attachments and estimated monthly cost
+func (a *App) GetEBSVolumes() ([]models.EBSVolumeInfo, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureEBS); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchEBSVolumes(context.Background())
+}
+
+// GetEBSSnapshots returns the account's EBS snapshots, flagging orphaned ones
+func (a *App) GetEBSSnapshots() ([]models.EBSSnapshotInfo, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureEBS); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchEBSSnapshots(context.Background())
+}
+
+// GetEBSStorageReport returns volumes and snapshots with unattached/orphaned totals and costs
+func (a *App) GetEBSStorageReport() (*models.EBSStorageReport, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureEBS); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchEBSStorageReport(context.Background())
+}
+
+// GetInstanceStatuses returns the status checks and scheduled events of every EC2 instance
+func (a *App) GetInstanceStatuses() ([]models.InstanceStatusInfo, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureInstanceStatus); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchInstanceStatuses(context.Background())
+}
+
+// GetInstanceStatus returns the status checks and scheduled events of an EC2 instance
+func (a *App) GetInstanceStatus(instanceID string) (*models.InstanceStatusInfo, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureInstanceStatus); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchInstanceStatus(context.Background(), instanceID)
+}
+
+// GetAutoScalingGroups returns the Auto Scaling groups with their instances and scaling policies
+func (a *App) GetAutoScalingGroups() ([]models.AutoScalingGroupInfo, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureAutoScaling); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchAutoScalingGroups(context.Background())
+}
+
+// GetScalingActivities returns the recent scaling activities of an Auto Scaling group
+func (a *App) GetScalingActivities(groupName string) ([]models.ScalingActivityInfo, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureAutoScaling); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchScalingActivities(context.Background(), groupName)
+}
+
+// GetLaunchTemplates returns the EC2 launch templates
+func (a *App) GetLaunchTemplates() ([]models.LaunchTemplateInfo, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureAutoScaling); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchLaunchTemplates(context.Background())
+}
+
+// GetLaunchTemplateVersions returns the versions of a launch template, newest first
+func (a *App) GetLaunchTemplateVersions(templateID string) ([]models.LaunchTemplateVersionInfo, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureAutoScaling); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchLaunchTemplateVersions(context.Background(), templateID)
+}
+
+// GetNetworkInterfaces returns the network interfaces of the region with their requester
+func (a *App) GetNetworkInterfaces() ([]models.NetworkInterfaceInfo, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureNetworkInterfaces); err != nil {
+		return nil, err
 	}
 
-	return allMetrics, nil
+	return a.awsClient.FetchNetworkInterfaces(context.Background())
+}
+
+// LookupIP returns the resources owning a private or public IP address
+func (a *App) LookupIP(ip string) (*models.IPLookupResult, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+
+	return a.awsClient.LookupIP(context.Background(), ip)
 }
 
 // GetElasticIPs returns the list of Elastic IPs from AWS
@@ -132,6 +770,9 @@ func (a *App) GetElasticIPs() ([]models.ElasticIPInfo, error) {
 	if a.awsClient == nil {
 		return nil, nil
 	}
+	if err := a.featureUnavailable(constants.FeatureElasticIP); err != nil {
+		return nil, err
+	}
 
 	return a.awsClient.FetchElasticIPs(context.Background())
 }
@@ -140,16 +781,130 @@ func (a *App) GetLambdaFunctions() ([]models.LambdaFunctionInfo, error) {
 	if a.awsClient == nil {
 		return nil, nil
 	}
+	if err := a.featureUnavailable(constants.FeatureLambda); err != nil {
+		return nil, err
+	}
 	return a.awsClient.FetchLambdaFunctions(context.Background())
 }
 
+// GetLambdaFunctionDetail returns the concurrency, aliases, versions, event sources and triggers of a function
+func (a *App) GetLambdaFunctionDetail(functionName string) (*models.LambdaFunctionDetail, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureLambdaDetail); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchLambdaFunctionDetail(context.Background(), functionName)
+}
+
 func (a *App) GetRDSInstances() ([]models.RDSInstanceInfo, error) {
 	if a.awsClient == nil {
 		return nil, nil
 	}
+	if err := a.featureUnavailable(constants.FeatureRDS); err != nil {
+		return nil, err
+	}
 	return a.awsClient.FetchRDSInstances(context.Background())
 }
 
+// GetRDSClusters returns the Aurora and Multi-AZ DB clusters with their writer and readers
+func (a *App) GetRDSClusters() ([]models.RDSClusterInfo, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureRDSClusters); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchRDSClusters(context.Background())
+}
+
+// GetRDSSnapshots returns the DB instance and DB cluster snapshots, newest first
+func (a *App) GetRDSSnapshots() ([]models.RDSSnapshotInfo, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureRDSSnapshots); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchRDSSnapshots(context.Background())
+}
+
+// GetRDSParameterGroups returns the parameter groups with the parameters changed from the engine defaults
+func (a *App) GetRDSParameterGroups() ([]models.RDSParameterGroupInfo, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureRDSParameters); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchRDSParameterGroups(context.Background())
+}
+
+// GetRDSPendingMaintenance returns the maintenance actions pending on DB instances and clusters
+func (a *App) GetRDSPendingMaintenance() ([]models.RDSPendingMaintenanceInfo, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureRDSMaintenance); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchRDSPendingMaintenance(context.Background())
+}
+
+// GetRDSEvents returns the RDS events of the last 24 hours, optionally for a single source
+func (a *App) GetRDSEvents(sourceType, sourceID string) ([]models.RDSEventInfo, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureRDSMaintenance); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchRDSEvents(context.Background(), sourceType, sourceID)
+}
+
+// GetDynamoDBTables returns the DynamoDB tables with capacity, index, backup and stream settings
+func (a *App) GetDynamoDBTables() ([]models.DynamoDBTableInfo, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureDynamoDB); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchDynamoDBTables(context.Background())
+}
+
+// GetSQSQueues returns the SQS queues with depth, oldest message age and dead-letter links
+func (a *App) GetSQSQueues() ([]models.SQSQueueInfo, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureSQS); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchSQSQueues(context.Background())
+}
+
+// GetSNSTopics returns the SNS topics with their subscriptions grouped by protocol
+func (a *App) GetSNSTopics() ([]models.SNSTopicInfo, error) {
+	if a.awsClient == nil {
+		return nil, nil
+	}
+	if err := a.featureUnavailable(constants.FeatureSNS); err != nil {
+		return nil, err
+	}
+
+	return a.awsClient.FetchSNSTopics(context.Background())
+}
+
 func (a *App) GetConfiguration() (models.ConfigurationInfo, error) {
 	if a.awsClient == nil {
 		return models.ConfigurationInfo{}, nil
@@ -162,5 +917,8 @@ func (a *App) GetAccountHomeInfo() (*models.AccountHomeInfo, error) {
 	if a.awsClient == nil {
 		return nil, fmt.Errorf("AWS client not initialized")
 	}
+
+	// Lets the client skip the calls the simulation found denied
+	a.simulatedFeatures()
 	return a.awsClient.FetchAccountHomeInfo(context.Background())
 }
@@ -3,12 +3,17 @@ package core
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
+	"aws-terminal-sdk-v1/internal/constants"
 	"aws-terminal-sdk-v1/internal/models"
 
+	"github.com/aws/smithy-go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/wailsapp/wails/v2/pkg/runtime"
 )
 
 // MockAWSClient is a mock of core.AWSClient interface
@@ -31,6 +36,24 @@ func (m *MockAWSClient) FetchECSClusters(ctx context.Context) ([]models.ECSClust
 	return args.Get(0).([]models.ECSClusterInfo), args.Error(1)
 }
 
+func (m *MockAWSClient) FetchECSServices(ctx context.Context, cluster string) ([]models.ECSServiceInfo, error) {
+	args := m.Called(ctx, cluster)
+	return args.Get(0).([]models.ECSServiceInfo), args.Error(1)
+}
+
+func (m *MockAWSClient) FetchECSTasks(ctx context.Context, cluster, service string) ([]models.ECSTaskInfo, error) {
+	args := m.Called(ctx, cluster, service)
+	return args.Get(0).([]models.ECSTaskInfo), args.Error(1)
+}
+
+func (m *MockAWSClient) FetchECSTaskDefinition(ctx context.Context, taskDefinition string) (*models.ECSTaskDefinitionInfo, error) {
+	args := m.Called(ctx, taskDefinition)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ECSTaskDefinitionInfo), args.Error(1)
+}
+
 func (m *MockAWSClient) FetchSubnets(ctx context.Context) ([]models.SubnetInfo, error) {
 	args := m.Called(ctx)
 	return args.Get(0).([]models.SubnetInfo), args.Error(1)
@@ -94,44 +117,1118 @@ func (m *MockAWSClient) FetchResourceMetrics(ctx context.Context, ns, mName stri
 	return args.Get(0).(*models.ResourceMetrics), args.Error(1)
 }
 
-func (m *MockAWSClient) FetchAccountHomeInfo(ctx context.Context) (*models.AccountHomeInfo, error) {
+func (m *MockAWSClient) FetchMetricsForResource(ctx context.Context, resourceType, resourceID, timeRange string) (*models.ResourceMetrics, error) {
+	args := m.Called(ctx, resourceType, resourceID, timeRange)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ResourceMetrics), args.Error(1)
+}
+
+func (m *MockAWSClient) FetchMetricQueries(ctx context.Context, queries []models.MetricQuery, startTime, endTime time.Time) ([]models.MetricData, error) {
+	args := m.Called(ctx, queries, startTime, endTime)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.MetricData), args.Error(1)
+}
+
+func (m *MockAWSClient) QueryResourceMetrics(ctx context.Context, req models.MetricRequest) (*models.ResourceMetrics, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ResourceMetrics), args.Error(1)
+}
+
+func (m *MockAWSClient) FetchAlarms(ctx context.Context, state string) ([]models.AlarmInfo, error) {
+	args := m.Called(ctx, state)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.AlarmInfo), args.Error(1)
+}
+
+func (m *MockAWSClient) FetchAlarmsForResource(ctx context.Context, resourceType, resourceID string) ([]models.AlarmInfo, error) {
+	args := m.Called(ctx, resourceType, resourceID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.AlarmInfo), args.Error(1)
+}
+
+func (m *MockAWSClient) FetchAlarmHistory(ctx context.Context, alarmName string) ([]models.AlarmHistoryItem, error) {
+	args := m.Called(ctx, alarmName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.AlarmHistoryItem), args.Error(1)
+}
+
+func (m *MockAWSClient) FetchLogGroups(ctx context.Context, prefix string) ([]models.LogGroupInfo, error) {
+	args := m.Called(ctx, prefix)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.LogGroupInfo), args.Error(1)
+}
+
+func (m *MockAWSClient) FetchLogStreams(ctx context.Context, logGroup string) ([]models.LogStreamInfo, error) {
+	args := m.Called(ctx, logGroup)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.LogStreamInfo), args.Error(1)
+}
+
+func (m *MockAWSClient) TailLogEvents(ctx context.Context, req models.LogTailRequest, emit func([]models.LogEvent)) error {
+	args := m.Called(ctx, req, emit)
+	return args.Error(0)
+}
+
+func (m *MockAWSClient) RunLogsInsightsQuery(ctx context.Context, req models.LogsInsightsRequest) (*models.LogsInsightsResult, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.LogsInsightsResult), args.Error(1)
+}
+
+func (m *MockAWSClient) FetchEBSVolumes(ctx context.Context) ([]models.EBSVolumeInfo, error) {
 	args := m.Called(ctx)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
-	return args.Get(0).(*models.AccountHomeInfo), args.Error(1)
+	return args.Get(0).([]models.EBSVolumeInfo), args.Error(1)
 }
 
-func (m *MockAWSClient) VerifyPermissions(ctx context.Context) ([]models.PermissionStatus, error) {
+func (m *MockAWSClient) FetchEBSSnapshots(ctx context.Context) ([]models.EBSSnapshotInfo, error) {
 	args := m.Called(ctx)
-	return args.Get(0).([]models.PermissionStatus), args.Error(1)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.EBSSnapshotInfo), args.Error(1)
 }
 
-func TestAppGetVPCs(t *testing.T) {
-	mockClient := new(MockAWSClient)
-	app := &App{awsClient: mockClient}
+func (m *MockAWSClient) FetchEBSStorageReport(ctx context.Context) (*models.EBSStorageReport, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.EBSStorageReport), args.Error(1)
+}
 
-	mockClient.On("FetchVPCs", mock.Anything).Return([]models.VPCInfo{{ID: "vpc-1"}}, nil)
+func (m *MockAWSClient) FetchNetworkInterfaces(ctx context.Context) ([]models.NetworkInterfaceInfo, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.NetworkInterfaceInfo), args.Error(1)
+}
 
-	vpcs, err := app.GetVPCs()
-	assert.NoError(t, err)
-	assert.Len(t, vpcs, 1)
-	assert.Equal(t, "vpc-1", vpcs[0].ID)
+func (m *MockAWSClient) LookupIP(ctx context.Context, ip string) (*models.IPLookupResult, error) {
+	args := m.Called(ctx, ip)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.IPLookupResult), args.Error(1)
 }
 
-func TestAppGetECSMetrics(t *testing.T) {
-	mockClient := new(MockAWSClient)
-	app := &App{awsClient: mockClient}
+func (m *MockAWSClient) FetchVPCConnectivity(ctx context.Context) (*models.VPCConnectivity, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.VPCConnectivity), args.Error(1)
+}
 
-	mockClient.On("FetchResourceMetrics", mock.Anything, "AWS/ECS", mock.Anything, mock.Anything, int32(3600)).Return(&models.ResourceMetrics{
-		Metrics: []models.MetricData{{Label: "CPUUtilization", Values: []float64{50.0}}},
-	}, nil)
+func (m *MockAWSClient) FetchFlowLogs(ctx context.Context) ([]models.FlowLogInfo, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.FlowLogInfo), args.Error(1)
+}
 
-	metrics, err := app.GetECSMetrics("test-cluster", 3600)
-	assert.NoError(t, err)
-	assert.NotNil(t, metrics)
-	// GetECSMetrics calls FetchResourceMetrics 4 times (CPU, Memory, NetworkIn, NetworkOut)
-	mockClient.AssertNumberOfCalls(t, "FetchResourceMetrics", 4)
+func (m *MockAWSClient) FetchFlowLogFindings(ctx context.Context) ([]models.SecurityFinding, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.SecurityFinding), args.Error(1)
+}
+
+func (m *MockAWSClient) FetchFlowLogSummary(ctx context.Context, vpcID, timeRange string) (*models.FlowLogSummary, error) {
+	args := m.Called(ctx, vpcID, timeRange)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.FlowLogSummary), args.Error(1)
+}
+
+func (m *MockAWSClient) FetchAutoScalingGroups(ctx context.Context) ([]models.AutoScalingGroupInfo, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.AutoScalingGroupInfo), args.Error(1)
+}
+
+func (m *MockAWSClient) FetchScalingActivities(ctx context.Context, groupName string) ([]models.ScalingActivityInfo, error) {
+	args := m.Called(ctx, groupName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.ScalingActivityInfo), args.Error(1)
+}
+
+func (m *MockAWSClient) FetchLaunchTemplates(ctx context.Context) ([]models.LaunchTemplateInfo, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.LaunchTemplateInfo), args.Error(1)
+}
+
+func (m *MockAWSClient) FetchLaunchTemplateVersions(ctx context.Context, templateID string) ([]models.LaunchTemplateVersionInfo, error) {
+	args := m.Called(ctx, templateID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.LaunchTemplateVersionInfo), args.Error(1)
+}
+
+func (m *MockAWSClient) FetchInstanceStatuses(ctx context.Context, instanceIDs ...string) ([]models.InstanceStatusInfo, error) {
+	args := m.Called(ctx, instanceIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.InstanceStatusInfo), args.Error(1)
+}
+
+func (m *MockAWSClient) FetchInstanceStatus(ctx context.Context, instanceID string) (*models.InstanceStatusInfo, error) {
+	args := m.Called(ctx, instanceID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.InstanceStatusInfo), args.Error(1)
+}
+
+func (m *MockAWSClient) FetchRDSClusters(ctx context.Context) ([]models.RDSClusterInfo, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.RDSClusterInfo), args.Error(1)
+}
+
+func (m *MockAWSClient) FetchRDSSnapshots(ctx context.Context) ([]models.RDSSnapshotInfo, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.RDSSnapshotInfo), args.Error(1)
+}
+
+func (m *MockAWSClient) FetchRDSParameterGroups(ctx context.Context) ([]models.RDSParameterGroupInfo, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.RDSParameterGroupInfo), args.Error(1)
+}
+
+func (m *MockAWSClient) FetchRDSPendingMaintenance(ctx context.Context) ([]models.RDSPendingMaintenanceInfo, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.RDSPendingMaintenanceInfo), args.Error(1)
+}
+
+func (m *MockAWSClient) FetchRDSEvents(ctx context.Context, sourceType, sourceID string) ([]models.RDSEventInfo, error) {
+	args := m.Called(ctx, sourceType, sourceID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.RDSEventInfo), args.Error(1)
+}
+
+func (m *MockAWSClient) FetchLambdaFunctionDetail(ctx context.Context, functionName string) (*models.LambdaFunctionDetail, error) {
+	args := m.Called(ctx, functionName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.LambdaFunctionDetail), args.Error(1)
+}
+
+func (m *MockAWSClient) FetchDynamoDBTables(ctx context.Context) ([]models.DynamoDBTableInfo, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.DynamoDBTableInfo), args.Error(1)
+}
+
+func (m *MockAWSClient) FetchSQSQueues(ctx context.Context) ([]models.SQSQueueInfo, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.SQSQueueInfo), args.Error(1)
+}
+
+func (m *MockAWSClient) FetchSNSTopics(ctx context.Context) ([]models.SNSTopicInfo, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.SNSTopicInfo), args.Error(1)
+}
+
+func (m *MockAWSClient) FetchEKSClusters(ctx context.Context) ([]models.EKSClusterInfo, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.EKSClusterInfo), args.Error(1)
+}
+
+func (m *MockAWSClient) FetchEKSClusterDetail(ctx context.Context, clusterName string) (*models.EKSClusterDetail, error) {
+	args := m.Called(ctx, clusterName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.EKSClusterDetail), args.Error(1)
+}
+
+func (m *MockAWSClient) FetchECRRepositories(ctx context.Context) ([]models.ECRRepositoryInfo, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.ECRRepositoryInfo), args.Error(1)
+}
+
+func (m *MockAWSClient) FetchECRImages(ctx context.Context, repository string) ([]models.ECRImageInfo, error) {
+	args := m.Called(ctx, repository)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.ECRImageInfo), args.Error(1)
+}
+
+func (m *MockAWSClient) FetchECRImageScanFindings(ctx context.Context, repository, digest string) ([]models.ECRScanFinding, error) {
+	args := m.Called(ctx, repository, digest)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.ECRScanFinding), args.Error(1)
+}
+
+func (m *MockAWSClient) FetchECRImageUsage(ctx context.Context) (*models.ECRImageUsageReport, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ECRImageUsageReport), args.Error(1)
+}
+
+func (m *MockAWSClient) FetchCloudFrontDistributions(ctx context.Context) ([]models.CloudFrontDistributionInfo, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.CloudFrontDistributionInfo), args.Error(1)
+}
+
+func (m *MockAWSClient) FetchRoute53HostedZones(ctx context.Context) ([]models.Route53HostedZoneInfo, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.Route53HostedZoneInfo), args.Error(1)
+}
+
+func (m *MockAWSClient) FetchRoute53Records(ctx context.Context, zoneID string) ([]models.Route53RecordInfo, error) {
+	args := m.Called(ctx, zoneID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.Route53RecordInfo), args.Error(1)
+}
+
+func (m *MockAWSClient) FetchACMCertificates(ctx context.Context) ([]models.ACMCertificateInfo, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.ACMCertificateInfo), args.Error(1)
+}
+
+func (m *MockAWSClient) FetchExpiringCertificates(ctx context.Context, days int) ([]models.SecurityFinding, error) {
+	args := m.Called(ctx, days)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.SecurityFinding), args.Error(1)
+}
+
+func (m *MockAWSClient) FetchDanglingDNSRecords(ctx context.Context) ([]models.SecurityFinding, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.SecurityFinding), args.Error(1)
+}
+
+func (m *MockAWSClient) FetchAPIGatewayAPIs(ctx context.Context) ([]models.APIGatewayAPIInfo, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.APIGatewayAPIInfo), args.Error(1)
+}
+
+func (m *MockAWSClient) FetchAPIGatewayAPIDetail(ctx context.Context, apiID, protocol string) (*models.APIGatewayAPIDetail, error) {
+	args := m.Called(ctx, apiID, protocol)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.APIGatewayAPIDetail), args.Error(1)
+}
+
+func (m *MockAWSClient) FetchAPIGatewayDomains(ctx context.Context) ([]models.APIGatewayDomainInfo, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.APIGatewayDomainInfo), args.Error(1)
+}
+
+func (m *MockAWSClient) FetchStateMachines(ctx context.Context) ([]models.StateMachineInfo, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.StateMachineInfo), args.Error(1)
+}
+
+func (m *MockAWSClient) FetchLambdaInvokers(ctx context.Context, functionARN string) ([]models.LambdaInvokerInfo, error) {
+	args := m.Called(ctx, functionARN)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.LambdaInvokerInfo), args.Error(1)
+}
+
+func (m *MockAWSClient) FetchElastiCacheClusters(ctx context.Context) ([]models.ElastiCacheClusterInfo, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.ElastiCacheClusterInfo), args.Error(1)
+}
+
+func (m *MockAWSClient) FetchOpenSearchDomains(ctx context.Context) ([]models.OpenSearchDomainInfo, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.OpenSearchDomainInfo), args.Error(1)
+}
+
+func (m *MockAWSClient) FetchMSKClusters(ctx context.Context) ([]models.MSKClusterInfo, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.MSKClusterInfo), args.Error(1)
+}
+
+func (m *MockAWSClient) FetchCloudFormationStacks(ctx context.Context) ([]models.CloudFormationStackInfo, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.CloudFormationStackInfo), args.Error(1)
+}
+
+func (m *MockAWSClient) FetchCloudFormationStackResources(ctx context.Context, stackName string) ([]models.CloudFormationResourceInfo, error) {
+	args := m.Called(ctx, stackName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.CloudFormationResourceInfo), args.Error(1)
+}
+
+func (m *MockAWSClient) FetchStackOwnership(ctx context.Context) (*models.StackOwnershipIndex, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.StackOwnershipIndex), args.Error(1)
+}
+
+func (m *MockAWSClient) WaitForStackDriftDetection(ctx context.Context, detectionID string) (*models.StackDriftResult, error) {
+	args := m.Called(ctx, detectionID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.StackDriftResult), args.Error(1)
+}
+
+func (m *MockAWSClient) StartStackDriftDetection(ctx context.Context, stackName string) (string, error) {
+	args := m.Called(ctx, stackName)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockAWSClient) FetchIAMHygiene(ctx context.Context, req models.IAMHygieneRequest) (*models.IAMHygieneReport, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.IAMHygieneReport), args.Error(1)
+}
+
+func (m *MockAWSClient) FetchPolicyAnalysis(ctx context.Context) (*models.PolicyAnalysis, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.PolicyAnalysis), args.Error(1)
+}
+
+func (m *MockAWSClient) FetchKMSKeys(ctx context.Context) ([]models.KMSKeyInfo, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.KMSKeyInfo), args.Error(1)
+}
+
+func (m *MockAWSClient) FetchSecrets(ctx context.Context) ([]models.SecretInfo, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.SecretInfo), args.Error(1)
+}
+
+func (m *MockAWSClient) FetchAccountHomeInfo(ctx context.Context) (*models.AccountHomeInfo, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.AccountHomeInfo), args.Error(1)
+}
+
+func (m *MockAWSClient) VerifyPermissions(ctx context.Context) ([]models.FeaturePermissions, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]models.FeaturePermissions), args.Error(1)
+}
+
+func (m *MockAWSClient) DenyActions(actions map[string]string) {
+	m.Called(actions)
+}
+
+func (m *MockAWSClient) DeniedActions() map[string]string {
+	args := m.Called()
+	return args.Get(0).(map[string]string)
+}
+
+func TestAppGetVPCs(t *testing.T) {
+	mockClient := new(MockAWSClient)
+	app := &App{awsClient: mockClient}
+
+	mockClient.On("FetchVPCs", mock.Anything).Return([]models.VPCInfo{{ID: "vpc-1"}}, nil)
+
+	vpcs, err := app.GetVPCs()
+	assert.NoError(t, err)
+	assert.Len(t, vpcs, 1)
+	assert.Equal(t, "vpc-1", vpcs[0].ID)
+}
+
+func TestAppGetECSMetrics(t *testing.T) {
+	mockClient := new(MockAWSClient)
+	app := &App{awsClient: mockClient}
+
+	mockClient.On("FetchMetricQueries", mock.Anything, mock.MatchedBy(func(queries []models.MetricQuery) bool {
+		return len(queries) == 4 && queries[0].Namespace == "AWS/ECS" && queries[0].Period == 3600
+	}), mock.Anything, mock.Anything).Return([]models.MetricData{
+		{ID: "cpuutilization_average", Label: "CPUUtilization", Values: []float64{50.0}},
+	}, nil)
+
+	metrics, err := app.GetECSMetrics("test-cluster", 3600)
+	assert.NoError(t, err)
+	assert.NotNil(t, metrics)
+	// CPU, Memory, NetworkIn and NetworkOut are fetched in a single batch
+	mockClient.AssertNumberOfCalls(t, "FetchMetricQueries", 1)
+}
+
+func TestAppGetECSMetrics_PartialFailure(t *testing.T) {
+	mockClient := new(MockAWSClient)
+	app := &App{awsClient: mockClient}
+
+	mockClient.On("FetchMetricQueries", mock.Anything, mock.MatchedBy(func(queries []models.MetricQuery) bool {
+		return len(queries) == 4
+	}), mock.Anything, mock.Anything).Return(nil, errors.New("failed to get metric data: invalid query"))
+	mockClient.On("FetchMetricQueries", mock.Anything, mock.MatchedBy(func(queries []models.MetricQuery) bool {
+		return len(queries) == 1 && queries[0].MetricName == "NetworkIn"
+	}), mock.Anything, mock.Anything).Return(nil, errors.New("failed to get metric data: invalid query"))
+	mockClient.On("FetchMetricQueries", mock.Anything, mock.MatchedBy(func(queries []models.MetricQuery) bool {
+		return len(queries) == 1 && queries[0].MetricName != "NetworkIn"
+	}), mock.Anything, mock.Anything).Return([]models.MetricData{
+		{ID: "metric", Values: []float64{1}},
+	}, nil)
+
+	metrics, err := app.GetECSMetrics("test-cluster", 3600)
+	assert.NoError(t, err)
+	// The failing NetworkIn metric is skipped, the others are still returned
+	assert.Len(t, metrics.Metrics, 3)
+	mockClient.AssertNumberOfCalls(t, "FetchMetricQueries", 5)
+}
+
+func TestAppGetECSServiceMetrics(t *testing.T) {
+	mockClient := new(MockAWSClient)
+	app := &App{awsClient: mockClient}
+
+	dims := map[string]string{"ClusterName": "test-cluster", "ServiceName": "api"}
+	mockClient.On("FetchMetricQueries", mock.Anything, mock.MatchedBy(func(queries []models.MetricQuery) bool {
+		return len(queries) == 2 && assert.ObjectsAreEqual(dims, queries[0].Dimensions) && queries[0].Period == 300
+	}), mock.Anything, mock.Anything).Return([]models.MetricData{
+		{ID: "cpuutilization_average", Label: "CPUUtilization", Values: []float64{12.5}},
+		{ID: "memoryutilization_average", Label: "MemoryUtilization", Values: []float64{40}},
+	}, nil)
+
+	metrics, err := app.GetECSServiceMetrics("test-cluster", "api", 300)
+	assert.NoError(t, err)
+	assert.Len(t, metrics.Metrics, 2)
+	mockClient.AssertNumberOfCalls(t, "FetchMetricQueries", 1)
+}
+
+func TestAppGetResourceMetrics(t *testing.T) {
+	mockClient := new(MockAWSClient)
+	app := &App{awsClient: mockClient}
+
+	mockClient.On("FetchMetricsForResource", mock.Anything, "rds", "orders-db", "6h").Return(&models.ResourceMetrics{
+		Metrics: []models.MetricData{{Label: "DatabaseConnections", Values: []float64{42}}},
+	}, nil)
+
+	metrics, err := app.GetResourceMetrics("rds", "orders-db", "6h")
+	assert.NoError(t, err)
+	assert.Len(t, metrics.Metrics, 1)
+	mockClient.AssertExpectations(t)
+}
+
+func TestAppGetResourceAlarms(t *testing.T) {
+	mockClient := new(MockAWSClient)
+	app := &App{awsClient: mockClient}
+
+	mockClient.On("FetchAlarmsForResource", mock.Anything, "ec2", "i-123").Return([]models.AlarmInfo{
+		{Name: "web-cpu", State: "ALARM"},
+	}, nil)
+	mockClient.On("FetchAlarmHistory", mock.Anything, "web-cpu").Return([]models.AlarmHistoryItem{
+		{AlarmName: "web-cpu", Type: "StateUpdate"},
+	}, nil)
+
+	alarms, err := app.GetResourceAlarms("ec2", "i-123")
+	assert.NoError(t, err)
+	assert.Len(t, alarms, 1)
+
+	history, err := app.GetAlarmHistory("web-cpu")
+	assert.NoError(t, err)
+	assert.Len(t, history, 1)
+	mockClient.AssertExpectations(t)
+}
+
+func TestAppLogTail(t *testing.T) {
+	mockClient := new(MockAWSClient)
+	app := &App{awsClient: mockClient}
+
+	batches := make(chan models.LogTailBatch, 1)
+	emitEvent = func(ctx context.Context, name string, data ...interface{}) {
+		batches <- data[0].(models.LogTailBatch)
+	}
+	defer func() { emitEvent = runtime.EventsEmit }()
+
+	req := models.LogTailRequest{LogGroup: "/aws/lambda/orders", FilterPattern: "ERROR"}
+	mockClient.On("TailLogEvents", mock.Anything, req, mock.Anything).Run(func(args mock.Arguments) {
+		emit := args.Get(2).(func([]models.LogEvent))
+		emit([]models.LogEvent{{ID: "e1", Message: "ERROR boom"}})
+		<-args.Get(0).(context.Context).Done()
+	}).Return(nil)
+
+	tailID, err := app.StartLogTail(req)
+	assert.NoError(t, err)
+
+	batch := <-batches
+	assert.Equal(t, tailID, batch.TailID)
+	assert.Len(t, batch.Events, 1)
+
+	app.StopLogTail(tailID)
+	app.logTailsMu.Lock()
+	assert.Empty(t, app.logTails)
+	app.logTailsMu.Unlock()
+
+	_, err = app.StartLogTail(models.LogTailRequest{})
+	assert.Error(t, err)
+}
+
+func TestAppStopLogTails(t *testing.T) {
+	mockClient := new(MockAWSClient)
+	app := &App{awsClient: mockClient}
+
+	emitEvent = func(ctx context.Context, name string, data ...interface{}) {}
+	defer func() { emitEvent = runtime.EventsEmit }()
+
+	stopped := make(chan struct{}, 2)
+	mockClient.On("TailLogEvents", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		<-args.Get(0).(context.Context).Done()
+		stopped <- struct{}{}
+	}).Return(nil)
+
+	_, err := app.StartLogTail(models.LogTailRequest{LogGroup: "/aws/lambda/orders"})
+	assert.NoError(t, err)
+	_, err = app.StartLogTail(models.LogTailRequest{LogGroup: "/aws/lambda/billing"})
+	assert.NoError(t, err)
+
+	// The tails keep their client even if the credentials are reset before they run
+	app.awsClient = nil
+	app.stopLogTails()
+	for i := 0; i < 2; i++ {
+		select {
+		case <-stopped:
+		case <-time.After(time.Second):
+			t.Fatal("log tail not stopped")
+		}
+	}
+	app.logTailsMu.Lock()
+	assert.Empty(t, app.logTails)
+	app.logTailsMu.Unlock()
+}
+
+func TestAppGetEBSStorageReport(t *testing.T) {
+	mockClient := new(MockAWSClient)
+	app := &App{awsClient: mockClient}
+
+	mockClient.On("FetchEBSStorageReport", mock.Anything).Return(&models.EBSStorageReport{
+		UnattachedVolumes: 1,
+		OrphanedSnapshots: 2,
+	}, nil)
+
+	report, err := app.GetEBSStorageReport()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, report.UnattachedVolumes)
+	assert.Equal(t, 2, report.OrphanedSnapshots)
+}
+
+func TestAppLookupIP(t *testing.T) {
+	mockClient := new(MockAWSClient)
+	app := &App{awsClient: mockClient}
+
+	mockClient.On("LookupIP", mock.Anything, "10.2.14.37").Return(&models.IPLookupResult{
+		IP:     "10.2.14.37",
+		Owners: []models.IPOwner{{ResourceType: "network-interface", ResourceID: "eni-1", Requester: "Lambda"}},
+	}, nil)
+
+	result, err := app.LookupIP("10.2.14.37")
+	assert.NoError(t, err)
+	assert.Len(t, result.Owners, 1)
+	assert.Equal(t, "Lambda", result.Owners[0].Requester)
+}
+
+func TestAppGetVPCConnectivity(t *testing.T) {
+	mockClient := new(MockAWSClient)
+	app := &App{awsClient: mockClient}
+
+	mockClient.On("FetchVPCConnectivity", mock.Anything).Return(&models.VPCConnectivity{
+		InternetGateways: []models.InternetGatewayInfo{{ID: "igw-1", VPCID: "vpc-1"}},
+		Peerings:         []models.VPCPeeringInfo{{ID: "pcx-1", RequesterVPCID: "vpc-1", AccepterVPCID: "vpc-2"}},
+	}, nil)
+
+	result, err := app.GetVPCConnectivity()
+	assert.NoError(t, err)
+	assert.Equal(t, "vpc-1", result.InternetGateways[0].VPCID)
+	assert.Equal(t, "vpc-2", result.Peerings[0].PeerVPCID("vpc-1"))
+}
+
+func TestAppGetFlowLogSummary(t *testing.T) {
+	mockClient := new(MockAWSClient)
+	app := &App{awsClient: mockClient}
+
+	mockClient.On("FetchFlowLogSummary", mock.Anything, "vpc-1", "24h").Return(&models.FlowLogSummary{
+		VPCID:      "vpc-1",
+		TopTalkers: []models.FlowLogTalker{{Source: "10.0.1.5", Destination: "10.0.2.9", Bytes: 1024}},
+	}, nil)
+
+	summary, err := app.GetFlowLogSummary("vpc-1", "24h")
+	assert.NoError(t, err)
+	assert.Len(t, summary.TopTalkers, 1)
+	mockClient.AssertExpectations(t)
+}
+
+func TestAppGetAutoScalingGroups(t *testing.T) {
+	mockClient := new(MockAWSClient)
+	app := &App{awsClient: mockClient}
+
+	mockClient.On("FetchAutoScalingGroups", mock.Anything).Return([]models.AutoScalingGroupInfo{
+		{Name: "web", DesiredCapacity: 3, Instances: []models.AutoScalingInstanceInfo{{InstanceID: "i-1", LifecycleState: "InService"}}},
+	}, nil)
+
+	groups, err := app.GetAutoScalingGroups()
+	assert.NoError(t, err)
+	assert.Len(t, groups, 1)
+	assert.Equal(t, "i-1", groups[0].Instances[0].InstanceID)
+}
+
+func TestAppGetInstanceStatus(t *testing.T) {
+	mockClient := new(MockAWSClient)
+	app := &App{awsClient: mockClient}
+
+	mockClient.On("FetchInstanceStatus", mock.Anything, "i-1").Return(&models.InstanceStatusInfo{
+		InstanceID:      "i-1",
+		SystemStatus:    "impaired",
+		ScheduledEvents: []models.InstanceEventInfo{{Code: "instance-retirement"}},
+	}, nil)
+
+	status, err := app.GetInstanceStatus("i-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "impaired", status.SystemStatus)
+	assert.Len(t, status.ScheduledEvents, 1)
+	mockClient.AssertExpectations(t)
+}
+
+func TestAppGetRDSClusters(t *testing.T) {
+	mockClient := new(MockAWSClient)
+	app := &App{awsClient: mockClient}
+
+	mockClient.On("FetchRDSClusters", mock.Anything).Return([]models.RDSClusterInfo{
+		{DBClusterIdentifier: "orders", WriterInstance: "orders-1", Members: []models.RDSClusterMemberInfo{{DBInstanceIdentifier: "orders-1", IsWriter: true}}},
+	}, nil)
+
+	clusters, err := app.GetRDSClusters()
+	assert.NoError(t, err)
+	assert.Len(t, clusters, 1)
+	assert.Equal(t, "orders-1", clusters[0].WriterInstance)
+}
+
+func TestAppGetRDSEvents(t *testing.T) {
+	mockClient := new(MockAWSClient)
+	app := &App{awsClient: mockClient}
+
+	mockClient.On("FetchRDSEvents", mock.Anything, "db-cluster", "orders").Return([]models.RDSEventInfo{
+		{SourceIdentifier: "orders", Message: "Completed failover"},
+	}, nil)
+
+	events, err := app.GetRDSEvents("db-cluster", "orders")
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	mockClient.AssertExpectations(t)
+}
+
+func TestAppGetLambdaFunctionDetail(t *testing.T) {
+	mockClient := new(MockAWSClient)
+	app := &App{awsClient: mockClient}
+
+	mockClient.On("FetchLambdaFunctionDetail", mock.Anything, "orders").Return(&models.LambdaFunctionDetail{
+		FunctionName: "orders",
+		EventSources: []models.LambdaEventSourceInfo{{SourceType: "sqs", State: "Enabled"}},
+	}, nil)
+
+	detail, err := app.GetLambdaFunctionDetail("orders")
+	assert.NoError(t, err)
+	assert.Len(t, detail.EventSources, 1)
+	mockClient.AssertExpectations(t)
+}
+
+func TestAppGetSQSQueues(t *testing.T) {
+	mockClient := new(MockAWSClient)
+	app := &App{awsClient: mockClient}
+
+	mockClient.On("FetchSQSQueues", mock.Anything).Return([]models.SQSQueueInfo{
+		{QueueName: "orders", Messages: 12, DeadLetterQueue: "orders-dlq"},
+		{QueueName: "orders-dlq", SourceQueues: []string{"orders"}},
+	}, nil)
+
+	queues, err := app.GetSQSQueues()
+	assert.NoError(t, err)
+	assert.Len(t, queues, 2)
+	assert.Equal(t, "orders-dlq", queues[0].DeadLetterQueue)
+	mockClient.AssertExpectations(t)
+}
+
+func TestAppGetEKSClusterDetail(t *testing.T) {
+	mockClient := new(MockAWSClient)
+	app := &App{awsClient: mockClient}
+
+	mockClient.On("FetchEKSClusterDetail", mock.Anything, "prod").Return(&models.EKSClusterDetail{
+		ClusterName: "prod",
+		NodeGroups:  []models.EKSNodeGroupInfo{{Name: "workers", DesiredSize: 3}},
+	}, nil)
+
+	detail, err := app.GetEKSClusterDetail("prod")
+	assert.NoError(t, err)
+	assert.Len(t, detail.NodeGroups, 1)
+	mockClient.AssertExpectations(t)
+}
+
+func TestAppGetECRImageUsage(t *testing.T) {
+	mockClient := new(MockAWSClient)
+	app := &App{awsClient: mockClient}
+
+	mockClient.On("FetchECRImageUsage", mock.Anything).Return(&models.ECRImageUsageReport{
+		Usages: []models.ECRImageUsage{{Service: "api", Repository: "api", SeverityCounts: map[string]int32{"CRITICAL": 2}}},
+	}, nil)
+
+	report, err := app.GetECRImageUsage()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), report.Usages[0].SeverityCounts["CRITICAL"])
+	mockClient.AssertExpectations(t)
+}
+
+func TestAppGetExpiringCertificates(t *testing.T) {
+	mockClient := new(MockAWSClient)
+	app := &App{awsClient: mockClient}
+
+	mockClient.On("FetchExpiringCertificates", mock.Anything, 30).Return([]models.SecurityFinding{
+		{Title: "Certificate expiring soon", ResourceID: "example.com (arn:cert)"},
+	}, nil)
+
+	findings, err := app.GetExpiringCertificates(30)
+	assert.NoError(t, err)
+	assert.Len(t, findings, 1)
+	mockClient.AssertExpectations(t)
+}
+
+func TestAppGetLambdaInvokers(t *testing.T) {
+	mockClient := new(MockAWSClient)
+	app := &App{awsClient: mockClient}
+
+	functionARN := "arn:aws:lambda:eu-west-1:123456789012:function:orders"
+	mockClient.On("FetchLambdaInvokers", mock.Anything, functionARN).Return([]models.LambdaInvokerInfo{
+		{SourceType: "apigateway", SourceID: "rest1", SourceName: "orders-api", Detail: "POST /orders"},
+	}, nil)
+
+	invokers, err := app.GetLambdaInvokers(functionARN)
+	assert.NoError(t, err)
+	assert.Len(t, invokers, 1)
+	assert.Equal(t, "orders-api", invokers[0].SourceName)
+	mockClient.AssertExpectations(t)
+}
+
+func TestAppGetElastiCacheClusters(t *testing.T) {
+	mockClient := new(MockAWSClient)
+	app := &App{awsClient: mockClient}
+
+	mockClient.On("FetchElastiCacheClusters", mock.Anything).Return([]models.ElastiCacheClusterInfo{
+		{ID: "sessions", Engine: "redis", VPCID: "vpc-1", MetricClusterID: "sessions-001"},
+	}, nil)
+
+	clusters, err := app.GetElastiCacheClusters()
+	assert.NoError(t, err)
+	assert.Len(t, clusters, 1)
+	assert.Equal(t, "sessions-001", clusters[0].MetricClusterID)
+	mockClient.AssertExpectations(t)
+}
+
+func TestAppDetectStackDrift(t *testing.T) {
+	mockClient := new(MockAWSClient)
+	app := &App{awsClient: mockClient}
+
+	results := make(chan models.StackDriftResult, 2)
+	emitEvent = func(ctx context.Context, name string, data ...interface{}) {
+		assert.Equal(t, constants.StackDriftEvent, name)
+		results <- data[0].(models.StackDriftResult)
+	}
+	defer func() { emitEvent = runtime.EventsEmit }()
+
+	mockClient.On("StartStackDriftDetection", mock.Anything, "platform").Return("detection-1", nil)
+	mockClient.On("StartStackDriftDetection", mock.Anything, "legacy").Return("detection-2", nil)
+	mockClient.On("WaitForStackDriftDetection", mock.Anything, "detection-1").Return(&models.StackDriftResult{
+		DetectionID: "detection-1", StackName: "platform", DriftStatus: "DRIFTED", DriftedCount: 1,
+	}, nil)
+	mockClient.On("WaitForStackDriftDetection", mock.Anything, "detection-2").Return(nil, errors.New("throttled"))
+
+	detectionID, err := app.DetectStackDrift("platform")
+	assert.NoError(t, err)
+	assert.Equal(t, "detection-1", detectionID)
+	result := <-results
+	assert.Equal(t, "DRIFTED", result.DriftStatus)
+	assert.Empty(t, result.Error)
+
+	_, err = app.DetectStackDrift("legacy")
+	assert.NoError(t, err)
+	result = <-results
+	assert.Equal(t, "legacy", result.StackName)
+	assert.Equal(t, "throttled", result.Error)
+
+	// Logging out while the detection runs must not break the pending wait
+	release := make(chan time.Time)
+	mockClient.On("StartStackDriftDetection", mock.Anything, "network").Return("detection-3", nil)
+	mockClient.On("WaitForStackDriftDetection", mock.Anything, "detection-3").WaitUntil(release).Return(&models.StackDriftResult{
+		DetectionID: "detection-3", StackName: "network", DriftStatus: "IN_SYNC",
+	}, nil)
+
+	_, err = app.DetectStackDrift("network")
+	assert.NoError(t, err)
+	app.awsClient = nil
+	close(release)
+	result = <-results
+	assert.Equal(t, "IN_SYNC", result.DriftStatus)
+}
+
+func TestAppGetIAMHygiene(t *testing.T) {
+	mockClient := new(MockAWSClient)
+	app := &App{awsClient: mockClient}
+
+	req := models.IAMHygieneRequest{AccessKeyMaxAgeDays: 30}
+	mockClient.On("FetchIAMHygiene", mock.MatchedBy(func(ctx context.Context) bool {
+		_, hasDeadline := ctx.Deadline()
+		return hasDeadline
+	}), req).Return(&models.IAMHygieneReport{UsersWithoutMFA: 2}, nil)
+
+	report, err := app.GetIAMHygiene(req)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, report.UsersWithoutMFA)
+	mockClient.AssertExpectations(t)
+}
+
+func TestAppGetPolicyAnalysis(t *testing.T) {
+	mockClient := new(MockAWSClient)
+	app := &App{awsClient: mockClient}
+
+	mockClient.On("FetchPolicyAnalysis", mock.Anything).Return(&models.PolicyAnalysis{
+		Principals: []models.PrincipalPolicyReport{{Name: "bob", Type: "user", Risks: []models.PolicyRisk{{Risk: "iam:PassRole on every role", Severity: "HIGH"}}}},
+	}, nil)
+
+	analysis, err := app.GetPolicyAnalysis()
+	assert.NoError(t, err)
+	assert.Len(t, analysis.Principals, 1)
+	assert.Equal(t, "HIGH", analysis.Principals[0].Risks[0].Severity)
+}
+
+func TestAppGetSecrets(t *testing.T) {
+	mockClient := new(MockAWSClient)
+	app := &App{awsClient: mockClient}
+
+	mockClient.On("FetchSecrets", mock.Anything).Return([]models.SecretInfo{
+		{Name: "legacy/api-key", Stale: true, Issues: []string{"Rotation disabled", "Not accessed in 120 days"}},
+	}, nil)
+
+	secrets, err := app.GetSecrets()
+	assert.NoError(t, err)
+	assert.Len(t, secrets, 1)
+	assert.True(t, secrets[0].Stale)
+}
+
+func TestAppGetFeatureAvailability(t *testing.T) {
+	mockClient := new(MockAWSClient)
+	app := &App{awsClient: mockClient}
+
+	mockClient.On("VerifyPermissions", mock.MatchedBy(func(ctx context.Context) bool {
+		_, hasDeadline := ctx.Deadline()
+		return hasDeadline
+	})).Return([]models.FeaturePermissions{
+		{Feature: constants.FeatureRDS, Label: "RDS instances", Missing: []string{"rds:DescribeDBInstances"}, Permissions: []models.PermissionStatus{
+			{Action: "rds:DescribeDBInstances", Reason: "Implicit Deny"},
+		}},
+		{Feature: constants.FeatureTrustedAdvisor, Label: "Trusted Advisor", Allowed: true, Permissions: []models.PermissionStatus{
+			{Action: "support:DescribeTrustedAdvisorCheckResult", Allowed: true, Reason: "Explicit Allow"},
+		}},
+		{Feature: constants.FeatureVPC, Label: "VPCs", Allowed: true, Permissions: []models.PermissionStatus{
+			{Action: "ec2:DescribeVpcs", Allowed: true, Reason: "Explicit Allow"},
+		}},
+		{Feature: constants.FeatureECR, Label: "ECR repositories", Allowed: true, Degraded: []string{"ecr:GetLifecyclePolicy"}, Permissions: []models.PermissionStatus{
+			{Action: "ecr:DescribeRepositories", Allowed: true, Reason: "Explicit Allow"},
+			{Action: "ecr:GetLifecyclePolicy", Optional: true, Reason: "Implicit Deny"},
+		}},
+	}, nil).Once()
+	mockClient.On("DenyActions", map[string]string{
+		"rds:DescribeDBInstances": constants.PermissionDeniedReason,
+		"ecr:GetLifecyclePolicy":  constants.PermissionDeniedReason,
+	}).Once()
+	mockClient.On("DeniedActions").Return(map[string]string{
+		"rds:DescribeDBInstances":                   constants.PermissionDeniedReason,
+		"support:DescribeTrustedAdvisorCheckResult": "requires a subscription",
+	})
+
+	features, err := app.GetFeatureAvailability()
+	assert.NoError(t, err)
+	availability := make(map[string]models.FeatureAvailability)
+	for _, feature := range features {
+		availability[feature.Feature] = feature
+	}
+	assert.False(t, availability[constants.FeatureRDS].Available)
+	assert.Equal(t, "missing rds:DescribeDBInstances", availability[constants.FeatureRDS].Reason)
+	assert.False(t, availability[constants.FeatureTrustedAdvisor].Available)
+	assert.Equal(t, "support:DescribeTrustedAdvisorCheckResult requires a subscription", availability[constants.FeatureTrustedAdvisor].Reason)
+	assert.True(t, availability[constants.FeatureVPC].Available)
+	assert.True(t, availability[constants.FeatureECR].Available)
+	assert.Equal(t, []string{"ecr:GetLifecyclePolicy"}, availability[constants.FeatureECR].Degraded)
+	assert.Equal(t, "degraded without ecr:GetLifecyclePolicy", availability[constants.FeatureECR].Reason)
+
+	// The simulation is cached, and the denied view fails without calling AWS
+	_, err = app.GetFeatureAvailability()
+	assert.NoError(t, err)
+	instances, err := app.GetRDSInstances()
+	assert.EqualError(t, err, "RDS instances unavailable: missing rds:DescribeDBInstances")
+	assert.Nil(t, instances)
+	mockClient.AssertNotCalled(t, "FetchRDSInstances", mock.Anything)
+	assert.NoError(t, app.featureUnavailable(constants.FeatureECR))
+	mockClient.AssertExpectations(t)
+
+	// New credentials are simulated again
+	app.resetFeatures()
+	assert.Nil(t, app.featureUnavailable(constants.FeatureRDS))
+}
+
+func TestAppGetFeatureAvailability_SimulationFails(t *testing.T) {
+	mockClient := new(MockAWSClient)
+	app := &App{awsClient: mockClient}
+
+	denied := &smithy.GenericAPIError{Code: "AccessDenied", Message: "not authorized to perform iam:SimulatePrincipalPolicy"}
+	mockClient.On("VerifyPermissions", mock.Anything).Return(([]models.FeaturePermissions)(nil), fmt.Errorf("failed to simulate policy: %w", denied)).Once()
+	mockClient.On("DenyActions", map[string]string{}).Once()
+	mockClient.On("DeniedActions").Return(map[string]string{})
+	mockClient.On("FetchVPCs", mock.Anything).Return([]models.VPCInfo{{ID: "vpc-1"}}, nil)
+
+	availability, err := app.GetFeatureAvailability()
+	assert.NoError(t, err)
+	assert.Empty(t, availability)
+
+	// Every view stays available, and the failed simulation is not retried
+	vpcs, err := app.GetVPCs()
+	assert.NoError(t, err)
+	assert.Len(t, vpcs, 1)
+	_, err = app.GetFeatureAvailability()
+	assert.NoError(t, err)
+	mockClient.AssertExpectations(t)
+}
+
+func TestAppGetFeatureAvailability_SimulationRetried(t *testing.T) {
+	mockClient := new(MockAWSClient)
+	app := &App{awsClient: mockClient}
+
+	// A transient failure is not cached, the next call simulates again
+	mockClient.On("VerifyPermissions", mock.Anything).Return(([]models.FeaturePermissions)(nil), errors.New("throttled")).Once()
+	mockClient.On("DeniedActions").Return(map[string]string{})
+
+	availability, err := app.GetFeatureAvailability()
+	assert.NoError(t, err)
+	assert.Empty(t, availability)
+	assert.Nil(t, app.cachedFeatures())
+
+	mockClient.On("VerifyPermissions", mock.Anything).Return([]models.FeaturePermissions{
+		{Feature: constants.FeatureRDS, Label: "RDS instances", Missing: []string{"rds:DescribeDBInstances"}},
+	}, nil).Once()
+	mockClient.On("DenyActions", map[string]string{"rds:DescribeDBInstances": constants.PermissionDeniedReason}).Once()
+
+	availability, err = app.GetFeatureAvailability()
+	assert.NoError(t, err)
+	assert.Len(t, availability, 1)
+	assert.Error(t, app.featureUnavailable(constants.FeatureRDS))
+	mockClient.AssertExpectations(t)
 }
 
 func TestAppGetVPCs_Error(t *testing.T) {
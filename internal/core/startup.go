@@ -58,8 +58,8 @@ func (a *App) initializeAWSClient(ctx context.Context, creds *models.AWSCredenti
 	return aws.NewClientWithConfig(ctx, cfg)
 }
 
-// VerifyPermissions checks if the current user has the required permissions
-func (a *App) VerifyPermissions() ([]models.PermissionStatus, error) {
+// VerifyPermissions checks the permissions of the current user, grouped by feature
+func (a *App) VerifyPermissions() ([]models.FeaturePermissions, error) {
 	if a.awsClient == nil {
 		return nil, nil
 	}
@@ -2,12 +2,24 @@ package core
 
 import (
 	"context"
+	"sync"
+
+	"aws-terminal-sdk-v1/internal/models"
 )
 
 // App struct
 type App struct {
 	ctx       context.Context
 	awsClient AWSClient
+
+	// Running log tails, cancelled by StopLogTail
+	logTailsMu sync.Mutex
+	logTails   map[string]context.CancelFunc
+
+	// Simulated permissions of the current client, nil until simulated
+	simulateMu sync.Mutex
+	featuresMu sync.Mutex
+	features   []models.FeaturePermissions
 }
 
 // NewApp creates a new App application struct
@@ -0,0 +1,140 @@
+package models
+
+import (
+	"sort"
+
+	cwTypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+)
+
+// AlarmInfo represents a CloudWatch metric or composite alarm
+type AlarmInfo struct {
+	Name           string `json:"name"`
+	ARN            string `json:"arn"`
+	Type           string `json:"type"` // "metric" or "composite"
+	Description    string `json:"description"`
+	State          string `json:"state"` // OK, ALARM, INSUFFICIENT_DATA
+	StateReason    string `json:"state_reason"`
+	StateUpdatedAt string `json:"state_updated_at"`
+
+	// Metric alarms
+	Namespace          string            `json:"namespace"`
+	MetricName         string            `json:"metric_name"`
+	Statistic          string            `json:"statistic"`
+	Dimensions         map[string]string `json:"dimensions"`
+	ComparisonOperator string            `json:"comparison_operator"`
+	Threshold          float64           `json:"threshold"`
+	Period             int32             `json:"period"`
+	EvaluationPeriods  int32             `json:"evaluation_periods"`
+	DatapointsToAlarm  int32             `json:"datapoints_to_alarm"`
+	TreatMissingData   string            `json:"treat_missing_data"`
+	// Metric math alarms reference several metrics, one dimension set per metric
+	MetricDimensions []map[string]string `json:"metric_dimensions"`
+
+	// Composite alarms
+	AlarmRule string `json:"alarm_rule"`
+
+	ActionsEnabled          bool     `json:"actions_enabled"`
+	AlarmActions            []string `json:"alarm_actions"`
+	OKActions               []string `json:"ok_actions"`
+	InsufficientDataActions []string `json:"insufficient_data_actions"`
+}
+
+// AlarmHistoryItem represents a state change, configuration update or action of an alarm
+type AlarmHistoryItem struct {
+	AlarmName string `json:"alarm_name"`
+	Timestamp string `json:"timestamp"`
+	Type      string `json:"type"` // StateUpdate, ConfigurationUpdate, Action
+	Summary   string `json:"summary"`
+}
+
+// FromAWSMetricAlarm converts a CloudWatch metric alarm to AlarmInfo
+func FromAWSMetricAlarm(alarm cwTypes.MetricAlarm) AlarmInfo {
+	info := AlarmInfo{
+		Name:                    safeString(alarm.AlarmName),
+		ARN:                     safeString(alarm.AlarmArn),
+		Type:                    "metric",
+		Description:             safeString(alarm.AlarmDescription),
+		State:                   string(alarm.StateValue),
+		StateReason:             safeString(alarm.StateReason),
+		StateUpdatedAt:          safeTime(alarm.StateUpdatedTimestamp),
+		Namespace:               safeString(alarm.Namespace),
+		MetricName:              safeString(alarm.MetricName),
+		Statistic:               string(alarm.Statistic),
+		Dimensions:              fromAWSDimensions(alarm.Dimensions),
+		ComparisonOperator:      string(alarm.ComparisonOperator),
+		Period:                  safeInt32(alarm.Period),
+		EvaluationPeriods:       safeInt32(alarm.EvaluationPeriods),
+		DatapointsToAlarm:       safeInt32(alarm.DatapointsToAlarm),
+		TreatMissingData:        safeString(alarm.TreatMissingData),
+		ActionsEnabled:          safeBool(alarm.ActionsEnabled),
+		AlarmActions:            alarm.AlarmActions,
+		OKActions:               alarm.OKActions,
+		InsufficientDataActions: alarm.InsufficientDataActions,
+	}
+
+	if alarm.Threshold != nil {
+		info.Threshold = *alarm.Threshold
+	}
+	if alarm.ExtendedStatistic != nil {
+		info.Statistic = *alarm.ExtendedStatistic
+	}
+
+	for _, q := range alarm.Metrics {
+		if q.MetricStat == nil || q.MetricStat.Metric == nil {
+			continue
+		}
+		if info.Namespace == "" {
+			info.Namespace = safeString(q.MetricStat.Metric.Namespace)
+		}
+		info.MetricDimensions = append(info.MetricDimensions, fromAWSDimensions(q.MetricStat.Metric.Dimensions))
+	}
+
+	return info
+}
+
+// FromAWSCompositeAlarm converts a CloudWatch composite alarm to AlarmInfo
+func FromAWSCompositeAlarm(alarm cwTypes.CompositeAlarm) AlarmInfo {
+	return AlarmInfo{
+		Name:                    safeString(alarm.AlarmName),
+		ARN:                     safeString(alarm.AlarmArn),
+		Type:                    "composite",
+		Description:             safeString(alarm.AlarmDescription),
+		State:                   string(alarm.StateValue),
+		StateReason:             safeString(alarm.StateReason),
+		StateUpdatedAt:          safeTime(alarm.StateUpdatedTimestamp),
+		AlarmRule:               safeString(alarm.AlarmRule),
+		ActionsEnabled:          safeBool(alarm.ActionsEnabled),
+		AlarmActions:            alarm.AlarmActions,
+		OKActions:               alarm.OKActions,
+		InsufficientDataActions: alarm.InsufficientDataActions,
+	}
+}
+
+// FromAWSAlarmHistoryItem converts a CloudWatch alarm history item to AlarmHistoryItem
+func FromAWSAlarmHistoryItem(item cwTypes.AlarmHistoryItem) AlarmHistoryItem {
+	return AlarmHistoryItem{
+		AlarmName: safeString(item.AlarmName),
+		Timestamp: safeTime(item.Timestamp),
+		Type:      string(item.HistoryItemType),
+		Summary:   safeString(item.HistorySummary),
+	}
+}
+
+// SortAlarmsByState orders alarms in ALARM first, then INSUFFICIENT_DATA, then OK, by name
+func SortAlarmsByState(alarms []AlarmInfo) {
+	rank := map[string]int{"ALARM": 0, "INSUFFICIENT_DATA": 1, "OK": 2}
+	sort.SliceStable(alarms, func(i, j int) bool {
+		if rank[alarms[i].State] != rank[alarms[j].State] {
+			return rank[alarms[i].State] < rank[alarms[j].State]
+		}
+		return alarms[i].Name < alarms[j].Name
+	})
+}
+
+func fromAWSDimensions(dims []cwTypes.Dimension) map[string]string {
+	result := make(map[string]string, len(dims))
+	for _, d := range dims {
+		result[safeString(d.Name)] = safeString(d.Value)
+	}
+	return result
+}
@@ -0,0 +1,307 @@
+package models
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	apigwTypes "github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+	apigwv2Types "github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
+)
+
+// API protocols
+const (
+	APIProtocolREST      = "REST"
+	APIProtocolHTTP      = "HTTP"
+	APIProtocolWebSocket = "WEBSOCKET"
+)
+
+// APIGatewayAPIInfo represents a REST, HTTP or WebSocket API
+type APIGatewayAPIInfo struct {
+	ID           string
+	Name         string
+	Protocol     string // REST, HTTP or WEBSOCKET
+	EndpointType string // EDGE, REGIONAL or PRIVATE
+	Endpoint     string // Default execute-api endpoint
+	// True when clients must go through a custom domain
+	DefaultEndpointDisabled bool
+	Description             string
+	Version                 string
+	CreatedAt               string
+	Tags                    map[string]string
+}
+
+// FromAWSRestAPI converts an AWS SDK RestApi type to our internal model
+func FromAWSRestAPI(api apigwTypes.RestApi, region string) APIGatewayAPIInfo {
+	info := APIGatewayAPIInfo{
+		ID:                      safeString(api.Id),
+		Name:                    safeString(api.Name),
+		Protocol:                APIProtocolREST,
+		Endpoint:                fmt.Sprintf("https://%s.execute-api.%s.amazonaws.com", safeString(api.Id), region),
+		DefaultEndpointDisabled: api.DisableExecuteApiEndpoint,
+		Description:             safeString(api.Description),
+		Version:                 safeString(api.Version),
+		CreatedAt:               safeTime(api.CreatedDate),
+		Tags:                    api.Tags,
+	}
+	if api.EndpointConfiguration != nil && len(api.EndpointConfiguration.Types) > 0 {
+		info.EndpointType = string(api.EndpointConfiguration.Types[0])
+	}
+	if info.Tags == nil {
+		info.Tags = make(map[string]string)
+	}
+	return info
+}
+
+// FromAWSHTTPAPI converts an AWS SDK Api type of API Gateway v2 to our internal model
+func FromAWSHTTPAPI(api apigwv2Types.Api) APIGatewayAPIInfo {
+	info := APIGatewayAPIInfo{
+		ID:                      safeString(api.ApiId),
+		Name:                    safeString(api.Name),
+		Protocol:                string(api.ProtocolType),
+		EndpointType:            "REGIONAL",
+		Endpoint:                safeString(api.ApiEndpoint),
+		DefaultEndpointDisabled: safeBool(api.DisableExecuteApiEndpoint),
+		Description:             safeString(api.Description),
+		Version:                 safeString(api.Version),
+		CreatedAt:               safeTime(api.CreatedDate),
+		Tags:                    api.Tags,
+	}
+	if info.Tags == nil {
+		info.Tags = make(map[string]string)
+	}
+	return info
+}
+
+// APIGatewayStageInfo is a deployed stage of an API
+type APIGatewayStageInfo struct {
+	Name         string `json:"name"`
+	InvokeURL    string `json:"invoke_url"`
+	DeploymentID string `json:"deployment_id"`
+	Description  string `json:"description"`
+	LastUpdated  string `json:"last_updated"`
+	AutoDeploy   bool   `json:"auto_deploy"` // HTTP and WebSocket APIs only
+	// Default throttling of the stage, zero when the account limits apply
+	ThrottleRate    float64 `json:"throttle_rate"`
+	ThrottleBurst   int32   `json:"throttle_burst"`
+	TracingEnabled  bool    `json:"tracing_enabled"` // REST APIs only
+	AccessLogTarget string  `json:"access_log_target"`
+	WebACLARN       string  `json:"web_acl_arn"` // REST APIs only
+}
+
+// FromAWSRestStage converts an AWS SDK Stage type of a REST API to our internal model
+func FromAWSRestStage(stage apigwTypes.Stage, endpoint string) APIGatewayStageInfo {
+	info := APIGatewayStageInfo{
+		Name:           safeString(stage.StageName),
+		InvokeURL:      endpoint + "/" + safeString(stage.StageName),
+		DeploymentID:   safeString(stage.DeploymentId),
+		Description:    safeString(stage.Description),
+		LastUpdated:    safeTime(stage.LastUpdatedDate),
+		TracingEnabled: stage.TracingEnabled,
+		WebACLARN:      safeString(stage.WebAclArn),
+	}
+	if stage.AccessLogSettings != nil {
+		info.AccessLogTarget = safeString(stage.AccessLogSettings.DestinationArn)
+	}
+	// */* holds the settings applying to every method of the stage
+	if settings, ok := stage.MethodSettings["*/*"]; ok {
+		info.ThrottleRate = settings.ThrottlingRateLimit
+		info.ThrottleBurst = settings.ThrottlingBurstLimit
+	}
+	return info
+}
+
+// FromAWSHTTPStage converts an AWS SDK Stage type of API Gateway v2 to our internal model
+func FromAWSHTTPStage(stage apigwv2Types.Stage, endpoint string) APIGatewayStageInfo {
+	info := APIGatewayStageInfo{
+		Name:         safeString(stage.StageName),
+		InvokeURL:    endpoint,
+		DeploymentID: safeString(stage.DeploymentId),
+		Description:  safeString(stage.Description),
+		LastUpdated:  safeTime(stage.LastUpdatedDate),
+		AutoDeploy:   safeBool(stage.AutoDeploy),
+	}
+	if info.Name != "$default" {
+		info.InvokeURL = endpoint + "/" + info.Name
+	}
+	if stage.AccessLogSettings != nil {
+		info.AccessLogTarget = safeString(stage.AccessLogSettings.DestinationArn)
+	}
+	if settings := stage.DefaultRouteSettings; settings != nil {
+		if settings.ThrottlingRateLimit != nil {
+			info.ThrottleRate = *settings.ThrottlingRateLimit
+		}
+		info.ThrottleBurst = safeInt32(settings.ThrottlingBurstLimit)
+	}
+	return info
+}
+
+// APIGatewayRouteInfo is a method of a REST API resource, or a route of an HTTP or
+// WebSocket API, with the backend it is integrated with
+type APIGatewayRouteInfo struct {
+	Route             string `json:"route"` // GET /users/{id}, $default, $connect...
+	Authorization     string `json:"authorization"`
+	APIKeyRequired    bool   `json:"api_key_required"`
+	IntegrationType   string `json:"integration_type"` // AWS_PROXY, AWS, HTTP_PROXY, HTTP, MOCK
+	IntegrationTarget string `json:"integration_target"`
+	// Backends resolved from the integration, empty when it targets something else
+	LambdaARN       string `json:"lambda_arn"`
+	StateMachineARN string `json:"state_machine_arn"`
+}
+
+// FromAWSRestMethod converts an AWS SDK Method type of a REST API resource to our internal model
+func FromAWSRestMethod(path string, method apigwTypes.Method) APIGatewayRouteInfo {
+	info := APIGatewayRouteInfo{
+		Route:          safeString(method.HttpMethod) + " " + path,
+		Authorization:  safeString(method.AuthorizationType),
+		APIKeyRequired: safeBool(method.ApiKeyRequired),
+	}
+
+	if integration := method.MethodIntegration; integration != nil {
+		info.IntegrationType = string(integration.Type)
+		info.IntegrationTarget = safeString(integration.Uri)
+		if arns := ExtractLambdaARNs(info.IntegrationTarget); len(arns) > 0 {
+			info.LambdaARN = arns[0]
+		}
+		// StartExecution integrations name the state machine in their request template
+		if strings.Contains(info.IntegrationTarget, ":states:") {
+			for _, template := range integration.RequestTemplates {
+				if arn := stateMachineARNPattern.FindString(template); arn != "" {
+					info.StateMachineARN = arn
+					break
+				}
+			}
+		}
+	}
+
+	return info
+}
+
+// FromAWSHTTPRoute converts an AWS SDK Route type of API Gateway v2 and the integration
+// it targets, when found, to our internal model
+func FromAWSHTTPRoute(route apigwv2Types.Route, integration *apigwv2Types.Integration) APIGatewayRouteInfo {
+	info := APIGatewayRouteInfo{
+		Route:          safeString(route.RouteKey),
+		Authorization:  string(route.AuthorizationType),
+		APIKeyRequired: safeBool(route.ApiKeyRequired),
+	}
+
+	if integration != nil {
+		info.IntegrationType = string(integration.IntegrationType)
+		info.IntegrationTarget = safeString(integration.IntegrationUri)
+		if arns := ExtractLambdaARNs(info.IntegrationTarget); len(arns) > 0 {
+			info.LambdaARN = arns[0]
+		}
+		if strings.HasPrefix(safeString(integration.IntegrationSubtype), "StepFunctions-") {
+			info.IntegrationTarget = safeString(integration.IntegrationSubtype)
+			info.StateMachineARN = stateMachineARNPattern.FindString(integration.RequestParameters["StateMachineArn"])
+		}
+	}
+
+	return info
+}
+
+// APIGatewayAPIDetail holds the stages and routes of an API
+type APIGatewayAPIDetail struct {
+	APIID    string                `json:"api_id"`
+	Protocol string                `json:"protocol"`
+	Stages   []APIGatewayStageInfo `json:"stages"`
+	Routes   []APIGatewayRouteInfo `json:"routes"`
+	// Calls that failed, the other sections are still filled
+	Errors []string `json:"errors"`
+}
+
+// APIGatewayDomainInfo represents a custom domain name and the API stages mapped to it
+type APIGatewayDomainInfo struct {
+	DomainName     string
+	EndpointType   string // EDGE or REGIONAL
+	CertificateARN string
+	// Host name to point the DNS record at: a CloudFront or regional API Gateway domain
+	TargetDomainName string
+	SecurityPolicy   string
+	Mappings         []APIGatewayMappingInfo
+}
+
+// APIGatewayMappingInfo maps a base path of a custom domain to an API stage
+type APIGatewayMappingInfo struct {
+	APIID    string
+	Stage    string
+	BasePath string // Empty for the root of the domain
+}
+
+// FromAWSRestDomainName converts an AWS SDK DomainName type of API Gateway to our internal model
+func FromAWSRestDomainName(domain apigwTypes.DomainName) APIGatewayDomainInfo {
+	info := APIGatewayDomainInfo{
+		DomainName:       safeString(domain.DomainName),
+		EndpointType:     "EDGE",
+		CertificateARN:   safeString(domain.CertificateArn),
+		TargetDomainName: safeString(domain.DistributionDomainName),
+		SecurityPolicy:   string(domain.SecurityPolicy),
+		Mappings:         make([]APIGatewayMappingInfo, 0),
+	}
+	if domain.RegionalDomainName != nil {
+		info.EndpointType = "REGIONAL"
+		info.CertificateARN = safeString(domain.RegionalCertificateArn)
+		info.TargetDomainName = safeString(domain.RegionalDomainName)
+	}
+	return info
+}
+
+// FromAWSHTTPDomainName converts an AWS SDK DomainName type of API Gateway v2 to our internal model
+func FromAWSHTTPDomainName(domain apigwv2Types.DomainName) APIGatewayDomainInfo {
+	info := APIGatewayDomainInfo{
+		DomainName: safeString(domain.DomainName),
+		Mappings:   make([]APIGatewayMappingInfo, 0),
+	}
+	if len(domain.DomainNameConfigurations) > 0 {
+		config := domain.DomainNameConfigurations[0]
+		info.EndpointType = string(config.EndpointType)
+		info.CertificateARN = safeString(config.CertificateArn)
+		info.TargetDomainName = safeString(config.ApiGatewayDomainName)
+		info.SecurityPolicy = string(config.SecurityPolicy)
+	}
+	return info
+}
+
+// LambdaInvokerInfo is an API route or a state machine invoking a Lambda function
+type LambdaInvokerInfo struct {
+	SourceType string `json:"source_type"` // apigateway or stepfunctions
+	SourceID   string `json:"source_id"`   // API ID or state machine ARN
+	SourceName string `json:"source_name"`
+	Detail     string `json:"detail"` // Route of the API
+	// Alias or version invoked, empty for the unqualified function
+	Qualifier string `json:"qualifier"`
+}
+
+var (
+	// lambdaARNPattern matches function ARNs, also when embedded in an API Gateway
+	// integration URI, with an optional alias or version qualifier
+	lambdaARNPattern       = regexp.MustCompile(`arn:aws[a-z-]*:lambda:[a-z0-9-]+:\d{12}:function:[A-Za-z0-9_-]+(?::[A-Za-z0-9_$-]+)?`)
+	stateMachineARNPattern = regexp.MustCompile(`arn:aws[a-z-]*:states:[a-z0-9-]+:\d{12}:stateMachine:[A-Za-z0-9_-]+`)
+)
+
+// ExtractLambdaARNs returns the distinct Lambda function ARNs found in a text, such as an
+// integration URI or a state machine definition, in sorted order
+func ExtractLambdaARNs(text string) []string {
+	seen := make(map[string]bool)
+	arns := make([]string, 0)
+	for _, arn := range lambdaARNPattern.FindAllString(text, -1) {
+		if !seen[arn] {
+			seen[arn] = true
+			arns = append(arns, arn)
+		}
+	}
+	sort.Strings(arns)
+	return arns
+}
+
+// SplitLambdaARN splits a function ARN into its unqualified ARN and its alias or version
+func SplitLambdaARN(arn string) (function, qualifier string) {
+	// arn:aws:lambda:<region>:<account>:function:<name>[:<qualifier>]
+	parts := strings.SplitN(arn, ":", 8)
+	if len(parts) == 8 {
+		return strings.Join(parts[:7], ":"), parts[7]
+	}
+	return arn, ""
+}
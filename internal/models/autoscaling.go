@@ -0,0 +1,248 @@
+package models
+
+import (
+	"strings"
+
+	asgTypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// AutoScalingGroupInfo represents an EC2 Auto Scaling group
+type AutoScalingGroupInfo struct {
+	Name                    string
+	ARN                     string
+	Status                  string // Empty unless the group is being deleted
+	MinSize                 int32
+	MaxSize                 int32
+	DesiredCapacity         int32
+	HealthCheckType         string
+	HealthCheckGracePeriod  int32
+	AvailabilityZones       []string
+	SubnetIDs               []string
+	LaunchTemplateID        string
+	LaunchTemplateName      string
+	LaunchTemplateVersion   string // Version number, $Latest or $Default
+	LaunchConfigurationName string
+	MixedInstancesPolicy    bool
+	TargetGroupARNs         []string
+	LoadBalancerNames       []string // Classic load balancers
+	SuspendedProcesses      []string
+	Instances               []AutoScalingInstanceInfo
+	Policies                []ScalingPolicyInfo
+	CreatedAt               string
+	Tags                    map[string]string
+}
+
+// AutoScalingInstanceInfo is an instance managed by an Auto Scaling group
+type AutoScalingInstanceInfo struct {
+	InstanceID            string
+	InstanceType          string
+	AvailabilityZone      string
+	LifecycleState        string // Pending, InService, Terminating, Standby...
+	HealthStatus          string // Healthy or Unhealthy
+	LaunchTemplateVersion string
+	ProtectedFromScaleIn  bool
+}
+
+// ScalingPolicyInfo represents a scaling policy of an Auto Scaling group
+type ScalingPolicyInfo struct {
+	Name              string
+	ARN               string
+	PolicyType        string // SimpleScaling, StepScaling, TargetTrackingScaling, PredictiveScaling
+	Enabled           bool
+	AdjustmentType    string
+	ScalingAdjustment int32
+	TargetValue       float64 // Target tracking policies
+	MetricType        string  // Predefined metric of target tracking policies
+	AlarmNames        []string
+}
+
+// ScalingActivityInfo is a scaling activity (launch, terminate...) of an Auto Scaling group
+type ScalingActivityInfo struct {
+	ID            string `json:"id"`
+	Description   string `json:"description"`
+	Cause         string `json:"cause"`
+	StatusCode    string `json:"status_code"`
+	StatusMessage string `json:"status_message"`
+	Progress      int32  `json:"progress"`
+	StartTime     string `json:"start_time"`
+	EndTime       string `json:"end_time"`
+}
+
+// LaunchTemplateInfo represents an EC2 launch template
+type LaunchTemplateInfo struct {
+	ID             string
+	Name           string
+	DefaultVersion int64
+	LatestVersion  int64
+	CreatedBy      string
+	CreatedAt      string
+	Tags           map[string]string
+}
+
+// LaunchTemplateVersionInfo summarizes one version of a launch template.
+// User data is only flagged, never returned, as it often holds secrets.
+type LaunchTemplateVersionInfo struct {
+	TemplateID         string
+	Number             int64
+	Description        string
+	IsDefault          bool
+	CreatedBy          string
+	CreatedAt          string
+	ImageID            string
+	InstanceType       string
+	KeyName            string
+	SecurityGroupIDs   []string
+	IAMInstanceProfile string
+	HasUserData        bool
+}
+
+// FromAWSAutoScalingGroup converts an AWS SDK AutoScalingGroup type to our internal model
+func FromAWSAutoScalingGroup(group asgTypes.AutoScalingGroup) AutoScalingGroupInfo {
+	info := AutoScalingGroupInfo{
+		Name:                    safeString(group.AutoScalingGroupName),
+		ARN:                     safeString(group.AutoScalingGroupARN),
+		Status:                  safeString(group.Status),
+		MinSize:                 safeInt32(group.MinSize),
+		MaxSize:                 safeInt32(group.MaxSize),
+		DesiredCapacity:         safeInt32(group.DesiredCapacity),
+		HealthCheckType:         safeString(group.HealthCheckType),
+		HealthCheckGracePeriod:  safeInt32(group.HealthCheckGracePeriod),
+		AvailabilityZones:       group.AvailabilityZones,
+		LaunchConfigurationName: safeString(group.LaunchConfigurationName),
+		MixedInstancesPolicy:    group.MixedInstancesPolicy != nil,
+		TargetGroupARNs:         group.TargetGroupARNs,
+		LoadBalancerNames:       group.LoadBalancerNames,
+		CreatedAt:               safeTime(group.CreatedTime),
+		Tags:                    make(map[string]string),
+	}
+
+	if zones := safeString(group.VPCZoneIdentifier); zones != "" {
+		info.SubnetIDs = strings.Split(zones, ",")
+	}
+
+	launchTemplate := group.LaunchTemplate
+	if launchTemplate == nil && group.MixedInstancesPolicy != nil && group.MixedInstancesPolicy.LaunchTemplate != nil {
+		launchTemplate = group.MixedInstancesPolicy.LaunchTemplate.LaunchTemplateSpecification
+	}
+	if launchTemplate != nil {
+		info.LaunchTemplateID = safeString(launchTemplate.LaunchTemplateId)
+		info.LaunchTemplateName = safeString(launchTemplate.LaunchTemplateName)
+		info.LaunchTemplateVersion = safeString(launchTemplate.Version)
+	}
+
+	for _, process := range group.SuspendedProcesses {
+		info.SuspendedProcesses = append(info.SuspendedProcesses, safeString(process.ProcessName))
+	}
+
+	for _, instance := range group.Instances {
+		instanceInfo := AutoScalingInstanceInfo{
+			InstanceID:           safeString(instance.InstanceId),
+			InstanceType:         safeString(instance.InstanceType),
+			AvailabilityZone:     safeString(instance.AvailabilityZone),
+			LifecycleState:       string(instance.LifecycleState),
+			HealthStatus:         safeString(instance.HealthStatus),
+			ProtectedFromScaleIn: safeBool(instance.ProtectedFromScaleIn),
+		}
+		if instance.LaunchTemplate != nil {
+			instanceInfo.LaunchTemplateVersion = safeString(instance.LaunchTemplate.Version)
+		}
+		info.Instances = append(info.Instances, instanceInfo)
+	}
+
+	for _, tag := range group.Tags {
+		if tag.Key != nil && tag.Value != nil {
+			info.Tags[*tag.Key] = *tag.Value
+		}
+	}
+
+	return info
+}
+
+// FromAWSScalingPolicy converts an AWS SDK ScalingPolicy type to our internal model
+func FromAWSScalingPolicy(policy asgTypes.ScalingPolicy) ScalingPolicyInfo {
+	info := ScalingPolicyInfo{
+		Name:              safeString(policy.PolicyName),
+		ARN:               safeString(policy.PolicyARN),
+		PolicyType:        safeString(policy.PolicyType),
+		Enabled:           policy.Enabled == nil || *policy.Enabled,
+		AdjustmentType:    safeString(policy.AdjustmentType),
+		ScalingAdjustment: safeInt32(policy.ScalingAdjustment),
+	}
+
+	if tt := policy.TargetTrackingConfiguration; tt != nil {
+		if tt.TargetValue != nil {
+			info.TargetValue = *tt.TargetValue
+		}
+		if tt.PredefinedMetricSpecification != nil {
+			info.MetricType = string(tt.PredefinedMetricSpecification.PredefinedMetricType)
+		} else if tt.CustomizedMetricSpecification != nil {
+			info.MetricType = safeString(tt.CustomizedMetricSpecification.MetricName)
+		}
+	}
+
+	for _, alarm := range policy.Alarms {
+		info.AlarmNames = append(info.AlarmNames, safeString(alarm.AlarmName))
+	}
+
+	return info
+}
+
+// FromAWSScalingActivity converts an AWS SDK Activity type to our internal model
+func FromAWSScalingActivity(activity asgTypes.Activity) ScalingActivityInfo {
+	return ScalingActivityInfo{
+		ID:            safeString(activity.ActivityId),
+		Description:   safeString(activity.Description),
+		Cause:         safeString(activity.Cause),
+		StatusCode:    string(activity.StatusCode),
+		StatusMessage: safeString(activity.StatusMessage),
+		Progress:      safeInt32(activity.Progress),
+		StartTime:     safeTime(activity.StartTime),
+		EndTime:       safeTime(activity.EndTime),
+	}
+}
+
+// FromAWSLaunchTemplate converts an AWS SDK LaunchTemplate type to our internal model
+func FromAWSLaunchTemplate(lt types.LaunchTemplate) LaunchTemplateInfo {
+	info := LaunchTemplateInfo{
+		ID:        safeString(lt.LaunchTemplateId),
+		Name:      safeString(lt.LaunchTemplateName),
+		CreatedBy: safeString(lt.CreatedBy),
+		CreatedAt: safeTime(lt.CreateTime),
+		Tags:      fromEC2Tags(lt.Tags),
+	}
+	if lt.DefaultVersionNumber != nil {
+		info.DefaultVersion = *lt.DefaultVersionNumber
+	}
+	if lt.LatestVersionNumber != nil {
+		info.LatestVersion = *lt.LatestVersionNumber
+	}
+	return info
+}
+
+// FromAWSLaunchTemplateVersion converts an AWS SDK LaunchTemplateVersion type to our internal model
+func FromAWSLaunchTemplateVersion(version types.LaunchTemplateVersion) LaunchTemplateVersionInfo {
+	info := LaunchTemplateVersionInfo{
+		TemplateID:  safeString(version.LaunchTemplateId),
+		Description: safeString(version.VersionDescription),
+		IsDefault:   safeBool(version.DefaultVersion),
+		CreatedBy:   safeString(version.CreatedBy),
+		CreatedAt:   safeTime(version.CreateTime),
+	}
+	if version.VersionNumber != nil {
+		info.Number = *version.VersionNumber
+	}
+
+	if data := version.LaunchTemplateData; data != nil {
+		info.ImageID = safeString(data.ImageId)
+		info.InstanceType = string(data.InstanceType)
+		info.KeyName = safeString(data.KeyName)
+		info.SecurityGroupIDs = data.SecurityGroupIds
+		info.HasUserData = safeString(data.UserData) != ""
+		if data.IamInstanceProfile != nil {
+			info.IAMInstanceProfile = firstString(data.IamInstanceProfile.Arn, data.IamInstanceProfile.Name)
+		}
+	}
+
+	return info
+}
@@ -0,0 +1,181 @@
+package models
+
+import (
+	"fmt"
+
+	cfnTypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+)
+
+// Tags CloudFormation puts on the resources it creates
+const (
+	CloudFormationStackNameTag = "aws:cloudformation:stack-name"
+	CloudFormationStackIDTag   = "aws:cloudformation:stack-id"
+	CloudFormationLogicalIDTag = "aws:cloudformation:logical-id"
+)
+
+// CloudFormationStackInfo represents a CloudFormation stack
+type CloudFormationStackInfo struct {
+	StackName    string
+	StackID      string
+	Status       string
+	StatusReason string
+	// DRIFTED, IN_SYNC, UNKNOWN or NOT_CHECKED, as of the last drift detection
+	DriftStatus           string
+	LastDriftCheck        string
+	Description           string
+	TerminationProtection bool
+	RoleARN               string
+	ParentID              string // Set for nested stacks
+	RootID                string
+	NestedStacks          []string // Names of the stacks nested directly in this one
+	Outputs               []CloudFormationOutputInfo
+	// NoEcho parameters are returned masked by CloudFormation
+	Parameters map[string]string
+	CreatedAt  string
+	UpdatedAt  string
+	Tags       map[string]string
+}
+
+// CloudFormationOutputInfo is an output of a stack
+type CloudFormationOutputInfo struct {
+	Key         string
+	Value       string
+	Description string
+	ExportName  string
+}
+
+// FromAWSStack converts an AWS SDK Stack type to our internal model.
+// Nested stacks are resolved over the whole list and set by the caller.
+func FromAWSStack(stack cfnTypes.Stack) CloudFormationStackInfo {
+	info := CloudFormationStackInfo{
+		StackName:             safeString(stack.StackName),
+		StackID:               safeString(stack.StackId),
+		Status:                string(stack.StackStatus),
+		StatusReason:          safeString(stack.StackStatusReason),
+		DriftStatus:           string(cfnTypes.StackDriftStatusNotChecked),
+		Description:           safeString(stack.Description),
+		TerminationProtection: safeBool(stack.EnableTerminationProtection),
+		RoleARN:               safeString(stack.RoleARN),
+		ParentID:              safeString(stack.ParentId),
+		RootID:                safeString(stack.RootId),
+		NestedStacks:          make([]string, 0),
+		Outputs:               make([]CloudFormationOutputInfo, 0, len(stack.Outputs)),
+		Parameters:            make(map[string]string, len(stack.Parameters)),
+		CreatedAt:             safeTime(stack.CreationTime),
+		UpdatedAt:             safeTime(stack.LastUpdatedTime),
+		Tags:                  make(map[string]string, len(stack.Tags)),
+	}
+	if drift := stack.DriftInformation; drift != nil {
+		info.DriftStatus = string(drift.StackDriftStatus)
+		info.LastDriftCheck = safeTime(drift.LastCheckTimestamp)
+	}
+	for _, output := range stack.Outputs {
+		info.Outputs = append(info.Outputs, CloudFormationOutputInfo{
+			Key:         safeString(output.OutputKey),
+			Value:       safeString(output.OutputValue),
+			Description: safeString(output.Description),
+			ExportName:  safeString(output.ExportName),
+		})
+	}
+	for _, param := range stack.Parameters {
+		value := safeString(param.ParameterValue)
+		// SSM parameter types show the resolved value next to the parameter name
+		if param.ResolvedValue != nil {
+			value = fmt.Sprintf("%s (%s)", value, safeString(param.ResolvedValue))
+		}
+		info.Parameters[safeString(param.ParameterKey)] = value
+	}
+	for _, tag := range stack.Tags {
+		info.Tags[safeString(tag.Key)] = safeString(tag.Value)
+	}
+	return info
+}
+
+// CloudFormationResourceInfo is a resource of a stack
+type CloudFormationResourceInfo struct {
+	LogicalID    string `json:"logical_id"`
+	PhysicalID   string `json:"physical_id"`
+	Type         string `json:"type"`
+	Status       string `json:"status"`
+	StatusReason string `json:"status_reason"`
+	// IN_SYNC, MODIFIED, DELETED or NOT_CHECKED, as of the last drift detection
+	DriftStatus string `json:"drift_status"`
+	UpdatedAt   string `json:"updated_at"`
+}
+
+// FromAWSStackResource converts an AWS SDK StackResourceSummary type to our internal model
+func FromAWSStackResource(resource cfnTypes.StackResourceSummary) CloudFormationResourceInfo {
+	info := CloudFormationResourceInfo{
+		LogicalID:    safeString(resource.LogicalResourceId),
+		PhysicalID:   safeString(resource.PhysicalResourceId),
+		Type:         safeString(resource.ResourceType),
+		Status:       string(resource.ResourceStatus),
+		StatusReason: safeString(resource.ResourceStatusReason),
+		DriftStatus:  string(cfnTypes.StackResourceDriftStatusNotChecked),
+		UpdatedAt:    safeTime(resource.LastUpdatedTimestamp),
+	}
+	if resource.DriftInformation != nil {
+		info.DriftStatus = string(resource.DriftInformation.StackResourceDriftStatus)
+	}
+	return info
+}
+
+// StackOwnerInfo tells which stack created a resource
+type StackOwnerInfo struct {
+	StackName    string `json:"stack_name"`
+	StackID      string `json:"stack_id"`
+	LogicalID    string `json:"logical_id"`
+	ResourceType string `json:"resource_type"`
+}
+
+// StackOwnershipIndex maps the physical IDs of the stack resources to their stack. The
+// frontend looks inventory items up by their identifiers, after their
+// aws:cloudformation:stack-name tag.
+type StackOwnershipIndex struct {
+	ByPhysicalID map[string]StackOwnerInfo `json:"by_physical_id"`
+	// Stack IDs by stack name, completing the owners found by tag
+	StackIDs map[string]string `json:"stack_ids"`
+	Errors   []string          `json:"errors"`
+}
+
+// StackDriftResult is the outcome of a drift detection on a stack
+type StackDriftResult struct {
+	DetectionID string `json:"detection_id"`
+	StackName   string `json:"stack_name"`
+	StackID     string `json:"stack_id"`
+	// DETECTION_IN_PROGRESS, DETECTION_COMPLETE or DETECTION_FAILED. Failed detections
+	// may still report the resources that could be checked.
+	Status       string                   `json:"status"`
+	StatusReason string                   `json:"status_reason"`
+	DriftStatus  string                   `json:"drift_status"` // DRIFTED, IN_SYNC or UNKNOWN
+	DriftedCount int32                    `json:"drifted_count"`
+	CheckedAt    string                   `json:"checked_at"`
+	Drifts       []StackResourceDriftInfo `json:"drifts"`
+	// Set when the detection could not be started or polled
+	Error string `json:"error,omitempty"`
+}
+
+// StackResourceDriftInfo is a resource that differs from its template
+type StackResourceDriftInfo struct {
+	LogicalID   string   `json:"logical_id"`
+	PhysicalID  string   `json:"physical_id"`
+	Type        string   `json:"type"`
+	DriftStatus string   `json:"drift_status"` // MODIFIED or DELETED
+	Differences []string `json:"differences"`
+}
+
+// FromAWSStackResourceDrift converts an AWS SDK StackResourceDrift type to our internal model
+func FromAWSStackResourceDrift(drift cfnTypes.StackResourceDrift) StackResourceDriftInfo {
+	info := StackResourceDriftInfo{
+		LogicalID:   safeString(drift.LogicalResourceId),
+		PhysicalID:  safeString(drift.PhysicalResourceId),
+		Type:        safeString(drift.ResourceType),
+		DriftStatus: string(drift.StackResourceDriftStatus),
+		Differences: make([]string, 0, len(drift.PropertyDifferences)),
+	}
+	for _, diff := range drift.PropertyDifferences {
+		info.Differences = append(info.Differences, fmt.Sprintf("%s %s: %s → %s",
+			safeString(diff.PropertyPath), diff.DifferenceType, safeString(diff.ExpectedValue), safeString(diff.ActualValue)))
+	}
+	return info
+}
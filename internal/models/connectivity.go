@@ -0,0 +1,348 @@
+package models
+
+import (
+	"strings"
+
+	"aws-terminal-sdk-v1/internal/constants"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// Route target types, derived from the target ID set on a route
+const (
+	RouteTargetLocal             = "local"
+	RouteTargetInternetGateway   = "internet-gateway"
+	RouteTargetEgressOnlyGateway = "egress-only-internet-gateway"
+	RouteTargetVPNGateway        = "vpn-gateway"
+	RouteTargetVPCEndpoint       = "vpc-endpoint"
+	RouteTargetNATGateway        = "nat-gateway"
+	RouteTargetTransitGateway    = "transit-gateway"
+	RouteTargetPeering           = "vpc-peering"
+	RouteTargetNetworkInterface  = "network-interface"
+	RouteTargetInstance          = "instance"
+	RouteTargetOther             = "other"
+)
+
+// RouteInfo is a single route of a route table
+type RouteInfo struct {
+	Destination string // CIDR block or prefix list ID
+	TargetType  string // One of the RouteTarget* constants
+	TargetID    string
+	State       string // active or blackhole
+	Origin      string
+}
+
+// InternetGatewayInfo represents an internet gateway and the VPC it is attached to
+type InternetGatewayInfo struct {
+	ID      string
+	Name    string
+	VPCID   string // Empty when detached
+	State   string // Attachment state
+	OwnerID string
+}
+
+// VPCEndpointInfo represents a gateway, interface or Gateway Load Balancer VPC endpoint
+type VPCEndpointInfo struct {
+	ID                  string
+	Name                string
+	ServiceName         string
+	Type                string // Gateway, Interface, GatewayLoadBalancer
+	State               string
+	VPCID               string
+	SubnetIDs           []string // Interface endpoints
+	RouteTableIDs       []string // Gateway endpoints
+	SecurityGroupIDs    []string
+	NetworkInterfaceIDs []string
+	PrivateDNSEnabled   bool
+}
+
+// VPCPeeringInfo represents a VPC peering connection between a requester and an accepter VPC
+type VPCPeeringInfo struct {
+	ID               string
+	Name             string
+	Status           string
+	RequesterVPCID   string
+	RequesterOwnerID string
+	RequesterRegion  string
+	RequesterCIDR    string
+	AccepterVPCID    string
+	AccepterOwnerID  string
+	AccepterRegion   string
+	AccepterCIDR     string
+}
+
+// TransitGatewayAttachmentInfo represents the attachment of a VPC, VPN or peering to a transit gateway
+type TransitGatewayAttachmentInfo struct {
+	ID               string
+	Name             string
+	TransitGatewayID string
+	ResourceType     string // vpc, vpn, direct-connect-gateway, peering...
+	ResourceID       string
+	ResourceOwnerID  string
+	VPCID            string // Set for VPC attachments
+	State            string
+	RouteTableID     string // Associated transit gateway route table
+}
+
+// TransitGatewayRouteTableInfo represents a transit gateway route table and its routes
+type TransitGatewayRouteTableInfo struct {
+	ID                 string
+	Name               string
+	TransitGatewayID   string
+	State              string
+	DefaultAssociation bool
+	DefaultPropagation bool
+	Routes             []TransitGatewayRouteInfo
+}
+
+// TransitGatewayRouteInfo is a single route of a transit gateway route table
+type TransitGatewayRouteInfo struct {
+	Destination   string
+	Type          string // static or propagated
+	State         string
+	AttachmentIDs []string
+	ResourceIDs   []string // VPC, VPN... behind the attachments
+}
+
+// VPNConnectionInfo represents a site-to-site VPN connection
+type VPNConnectionInfo struct {
+	ID                string
+	Name              string
+	State             string
+	Type              string
+	CustomerGatewayID string
+	VPNGatewayID      string
+	TransitGatewayID  string
+	VPCID             string // VPC of the virtual private gateway
+	Tunnels           []VPNTunnelInfo
+}
+
+// VPNTunnelInfo is the status of one VPN tunnel
+type VPNTunnelInfo struct {
+	OutsideIP        string
+	Status           string
+	StatusMessage    string
+	LastStatusChange string
+}
+
+// VPCConnectivity gathers every resource connecting VPCs to each other, to the internet and on-premises
+type VPCConnectivity struct {
+	InternetGateways          []InternetGatewayInfo          `json:"internet_gateways"`
+	Endpoints                 []VPCEndpointInfo              `json:"endpoints"`
+	Peerings                  []VPCPeeringInfo               `json:"peerings"`
+	TransitGatewayAttachments []TransitGatewayAttachmentInfo `json:"transit_gateway_attachments"`
+	TransitGatewayRouteTables []TransitGatewayRouteTableInfo `json:"transit_gateway_route_tables"`
+	VPNConnections            []VPNConnectionInfo            `json:"vpn_connections"`
+	// Sources that could not be fetched, e.g. for missing permissions
+	Errors []string `json:"errors"`
+}
+
+// FromAWSRoute converts an AWS SDK Route type to our internal model
+func FromAWSRoute(route types.Route) RouteInfo {
+	info := RouteInfo{
+		Destination: firstString(route.DestinationCidrBlock, route.DestinationIpv6CidrBlock, route.DestinationPrefixListId),
+		State:       string(route.State),
+		Origin:      string(route.Origin),
+	}
+
+	gatewayID := safeString(route.GatewayId)
+	switch {
+	case gatewayID == "local":
+		info.TargetType, info.TargetID = RouteTargetLocal, gatewayID
+	case strings.HasPrefix(gatewayID, "igw-"):
+		info.TargetType, info.TargetID = RouteTargetInternetGateway, gatewayID
+	case strings.HasPrefix(gatewayID, "vgw-"):
+		info.TargetType, info.TargetID = RouteTargetVPNGateway, gatewayID
+	case strings.HasPrefix(gatewayID, "vpce-"):
+		info.TargetType, info.TargetID = RouteTargetVPCEndpoint, gatewayID
+	case route.NatGatewayId != nil:
+		info.TargetType, info.TargetID = RouteTargetNATGateway, *route.NatGatewayId
+	case route.TransitGatewayId != nil:
+		info.TargetType, info.TargetID = RouteTargetTransitGateway, *route.TransitGatewayId
+	case route.VpcPeeringConnectionId != nil:
+		info.TargetType, info.TargetID = RouteTargetPeering, *route.VpcPeeringConnectionId
+	case route.EgressOnlyInternetGatewayId != nil:
+		info.TargetType, info.TargetID = RouteTargetEgressOnlyGateway, *route.EgressOnlyInternetGatewayId
+	case route.InstanceId != nil:
+		info.TargetType, info.TargetID = RouteTargetInstance, *route.InstanceId
+	case route.NetworkInterfaceId != nil:
+		info.TargetType, info.TargetID = RouteTargetNetworkInterface, *route.NetworkInterfaceId
+	default:
+		info.TargetType = RouteTargetOther
+		info.TargetID = firstString(route.GatewayId, route.CarrierGatewayId, route.LocalGatewayId, route.CoreNetworkArn)
+	}
+
+	return info
+}
+
+// FromAWSInternetGateway converts an AWS SDK InternetGateway type to our internal model
+func FromAWSInternetGateway(igw types.InternetGateway) InternetGatewayInfo {
+	info := InternetGatewayInfo{
+		ID:      safeString(igw.InternetGatewayId),
+		Name:    nameTag(igw.Tags),
+		OwnerID: safeString(igw.OwnerId),
+		State:   "detached",
+	}
+
+	// An internet gateway is attached to at most one VPC
+	if len(igw.Attachments) > 0 {
+		info.VPCID = safeString(igw.Attachments[0].VpcId)
+		info.State = string(igw.Attachments[0].State)
+	}
+
+	return info
+}
+
+// FromAWSVPCEndpoint converts an AWS SDK VpcEndpoint type to our internal model
+func FromAWSVPCEndpoint(endpoint types.VpcEndpoint) VPCEndpointInfo {
+	info := VPCEndpointInfo{
+		ID:                  safeString(endpoint.VpcEndpointId),
+		Name:                nameTag(endpoint.Tags),
+		ServiceName:         safeString(endpoint.ServiceName),
+		Type:                string(endpoint.VpcEndpointType),
+		State:               string(endpoint.State),
+		VPCID:               safeString(endpoint.VpcId),
+		SubnetIDs:           endpoint.SubnetIds,
+		RouteTableIDs:       endpoint.RouteTableIds,
+		NetworkInterfaceIDs: endpoint.NetworkInterfaceIds,
+		PrivateDNSEnabled:   safeBool(endpoint.PrivateDnsEnabled),
+	}
+
+	for _, group := range endpoint.Groups {
+		info.SecurityGroupIDs = append(info.SecurityGroupIDs, safeString(group.GroupId))
+	}
+
+	return info
+}
+
+// FromAWSVPCPeering converts an AWS SDK VpcPeeringConnection type to our internal model
+func FromAWSVPCPeering(peering types.VpcPeeringConnection) VPCPeeringInfo {
+	info := VPCPeeringInfo{
+		ID:   safeString(peering.VpcPeeringConnectionId),
+		Name: nameTag(peering.Tags),
+	}
+
+	if peering.Status != nil {
+		info.Status = string(peering.Status.Code)
+	}
+	if req := peering.RequesterVpcInfo; req != nil {
+		info.RequesterVPCID = safeString(req.VpcId)
+		info.RequesterOwnerID = safeString(req.OwnerId)
+		info.RequesterRegion = safeString(req.Region)
+		info.RequesterCIDR = safeString(req.CidrBlock)
+	}
+	if acc := peering.AccepterVpcInfo; acc != nil {
+		info.AccepterVPCID = safeString(acc.VpcId)
+		info.AccepterOwnerID = safeString(acc.OwnerId)
+		info.AccepterRegion = safeString(acc.Region)
+		info.AccepterCIDR = safeString(acc.CidrBlock)
+	}
+
+	return info
+}
+
+// PeerVPCID returns the VPC on the other side of the peering, or "" if vpcID is not part of it
+func (p VPCPeeringInfo) PeerVPCID(vpcID string) string {
+	switch vpcID {
+	case p.RequesterVPCID:
+		return p.AccepterVPCID
+	case p.AccepterVPCID:
+		return p.RequesterVPCID
+	}
+	return ""
+}
+
+// FromAWSTransitGatewayAttachment converts an AWS SDK TransitGatewayAttachment type to our internal model
+func FromAWSTransitGatewayAttachment(attachment types.TransitGatewayAttachment) TransitGatewayAttachmentInfo {
+	info := TransitGatewayAttachmentInfo{
+		ID:               safeString(attachment.TransitGatewayAttachmentId),
+		Name:             nameTag(attachment.Tags),
+		TransitGatewayID: safeString(attachment.TransitGatewayId),
+		ResourceType:     string(attachment.ResourceType),
+		ResourceID:       safeString(attachment.ResourceId),
+		ResourceOwnerID:  safeString(attachment.ResourceOwnerId),
+		State:            string(attachment.State),
+	}
+
+	if attachment.ResourceType == types.TransitGatewayAttachmentResourceTypeVpc {
+		info.VPCID = info.ResourceID
+	}
+	if attachment.Association != nil {
+		info.RouteTableID = safeString(attachment.Association.TransitGatewayRouteTableId)
+	}
+
+	return info
+}
+
+// FromAWSTransitGatewayRouteTable converts an AWS SDK TransitGatewayRouteTable type to our internal model
+func FromAWSTransitGatewayRouteTable(rt types.TransitGatewayRouteTable) TransitGatewayRouteTableInfo {
+	return TransitGatewayRouteTableInfo{
+		ID:                 safeString(rt.TransitGatewayRouteTableId),
+		Name:               nameTag(rt.Tags),
+		TransitGatewayID:   safeString(rt.TransitGatewayId),
+		State:              string(rt.State),
+		DefaultAssociation: safeBool(rt.DefaultAssociationRouteTable),
+		DefaultPropagation: safeBool(rt.DefaultPropagationRouteTable),
+	}
+}
+
+// FromAWSTransitGatewayRoute converts an AWS SDK TransitGatewayRoute type to our internal model
+func FromAWSTransitGatewayRoute(route types.TransitGatewayRoute) TransitGatewayRouteInfo {
+	info := TransitGatewayRouteInfo{
+		Destination: firstString(route.DestinationCidrBlock, route.PrefixListId),
+		Type:        string(route.Type),
+		State:       string(route.State),
+	}
+
+	for _, attachment := range route.TransitGatewayAttachments {
+		info.AttachmentIDs = append(info.AttachmentIDs, safeString(attachment.TransitGatewayAttachmentId))
+		info.ResourceIDs = append(info.ResourceIDs, safeString(attachment.ResourceId))
+	}
+
+	return info
+}
+
+// FromAWSVPNConnection converts an AWS SDK VpnConnection type to our internal model.
+// VPCID is resolved separately from the virtual private gateway attachments.
+func FromAWSVPNConnection(vpn types.VpnConnection) VPNConnectionInfo {
+	info := VPNConnectionInfo{
+		ID:                safeString(vpn.VpnConnectionId),
+		Name:              nameTag(vpn.Tags),
+		State:             string(vpn.State),
+		Type:              string(vpn.Type),
+		CustomerGatewayID: safeString(vpn.CustomerGatewayId),
+		VPNGatewayID:      safeString(vpn.VpnGatewayId),
+		TransitGatewayID:  safeString(vpn.TransitGatewayId),
+	}
+
+	for _, tunnel := range vpn.VgwTelemetry {
+		info.Tunnels = append(info.Tunnels, VPNTunnelInfo{
+			OutsideIP:        safeString(tunnel.OutsideIpAddress),
+			Status:           string(tunnel.Status),
+			StatusMessage:    safeString(tunnel.StatusMessage),
+			LastStatusChange: safeTime(tunnel.LastStatusChange),
+		})
+	}
+
+	return info
+}
+
+// nameTag returns the value of the Name tag, or "" if there is none
+func nameTag(tags []types.Tag) string {
+	for _, tag := range tags {
+		if tag.Key != nil && *tag.Key == constants.TagName {
+			return safeString(tag.Value)
+		}
+	}
+	return ""
+}
+
+// firstString returns the first non-empty string pointer value
+func firstString(values ...*string) string {
+	for _, v := range values {
+		if v != nil && *v != "" {
+			return *v
+		}
+	}
+	return ""
+}
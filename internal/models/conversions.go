@@ -45,6 +45,10 @@ func FromAWSRouteTable(rt types.RouteTable) RouteTableInfo {
 		Subnets: len(rt.Associations),
 	}
 
+	for _, route := range rt.Routes {
+		rtInfo.RouteEntries = append(rtInfo.RouteEntries, FromAWSRoute(route))
+	}
+
 	// Check if main route table and extract subnet IDs
 	for _, assoc := range rt.Associations {
 		if assoc.Main != nil && *assoc.Main {
@@ -110,6 +114,13 @@ func FromAWSLoadBalancer(lb elbv2Types.LoadBalancer) LoadBalancerInfo {
 	// Extract AZs
 	for _, az := range lb.AvailabilityZones {
 		lbInfo.AvailabilityZones = append(lbInfo.AvailabilityZones, safeString(az.ZoneName))
+		for _, addr := range az.LoadBalancerAddresses {
+			for _, ip := range []*string{addr.IpAddress, addr.PrivateIPv4Address, addr.IPv6Address} {
+				if ip != nil {
+					lbInfo.Addresses = append(lbInfo.Addresses, *ip)
+				}
+			}
+		}
 	}
 
 	return lbInfo
@@ -0,0 +1,338 @@
+package models
+
+import (
+	"strings"
+
+	elasticacheTypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+	kafkaTypes "github.com/aws/aws-sdk-go-v2/service/kafka/types"
+	opensearchTypes "github.com/aws/aws-sdk-go-v2/service/opensearch/types"
+)
+
+// ElastiCacheClusterInfo represents a Redis or Valkey replication group, or a cache cluster
+// outside of any replication group such as a Memcached cluster
+type ElastiCacheClusterInfo struct {
+	ID                string // Replication group or cache cluster ID
+	ARN               string
+	Description       string
+	Engine            string // redis, valkey or memcached
+	EngineVersion     string
+	NodeType          string
+	NodeCount         int
+	ShardCount        int    // Node groups of a replication group, zero for standalone clusters
+	ClusterMode       string // enabled, disabled or compatible
+	Status            string
+	ReplicationGroup  bool
+	MultiAZ           bool
+	AutomaticFailover bool
+	TransitEncryption bool
+	AtRestEncryption  bool
+	AuthTokenEnabled  bool
+	Endpoint          string // Configuration or primary endpoint
+	Port              int32
+	SubnetGroup       string
+	VPCID             string
+	SubnetIDs         []string
+	SecurityGroupIDs  []string
+	// Cache cluster whose CloudWatch metrics stand for the whole group: the primary node
+	MetricClusterID string
+	CreatedAt       string
+}
+
+// FromAWSReplicationGroup converts an AWS SDK ReplicationGroup type to our internal model.
+// The engine version, subnet group and security groups are only known to the member
+// clusters and are set by the caller.
+func FromAWSReplicationGroup(group elasticacheTypes.ReplicationGroup) ElastiCacheClusterInfo {
+	info := ElastiCacheClusterInfo{
+		ID:                safeString(group.ReplicationGroupId),
+		ARN:               safeString(group.ARN),
+		Description:       safeString(group.Description),
+		Engine:            safeString(group.Engine),
+		NodeType:          safeString(group.CacheNodeType),
+		NodeCount:         len(group.MemberClusters),
+		ShardCount:        len(group.NodeGroups),
+		ClusterMode:       string(group.ClusterMode),
+		Status:            safeString(group.Status),
+		ReplicationGroup:  true,
+		MultiAZ:           group.MultiAZ == elasticacheTypes.MultiAZStatusEnabled,
+		AutomaticFailover: group.AutomaticFailover == elasticacheTypes.AutomaticFailoverStatusEnabled,
+		TransitEncryption: safeBool(group.TransitEncryptionEnabled),
+		AtRestEncryption:  safeBool(group.AtRestEncryptionEnabled),
+		AuthTokenEnabled:  safeBool(group.AuthTokenEnabled),
+		SubnetIDs:         make([]string, 0),
+		SecurityGroupIDs:  make([]string, 0),
+		CreatedAt:         safeTime(group.ReplicationGroupCreateTime),
+	}
+	if info.ClusterMode == "" && safeBool(group.ClusterEnabled) {
+		info.ClusterMode = string(elasticacheTypes.ClusterModeEnabled)
+	}
+
+	endpoint := group.ConfigurationEndpoint
+	for _, nodeGroup := range group.NodeGroups {
+		if endpoint == nil {
+			endpoint = nodeGroup.PrimaryEndpoint
+		}
+		for _, member := range nodeGroup.NodeGroupMembers {
+			if safeString(member.CurrentRole) == "primary" && info.MetricClusterID == "" {
+				info.MetricClusterID = safeString(member.CacheClusterId)
+			}
+		}
+	}
+	if endpoint != nil {
+		info.Endpoint = safeString(endpoint.Address)
+		info.Port = safeInt32(endpoint.Port)
+	}
+	// Cluster mode groups have no single primary
+	if info.MetricClusterID == "" && len(group.MemberClusters) > 0 {
+		info.MetricClusterID = group.MemberClusters[0]
+	}
+	return info
+}
+
+// FromAWSCacheCluster converts an AWS SDK CacheCluster type that belongs to no replication
+// group to our internal model. The VPC and subnets come from its subnet group.
+func FromAWSCacheCluster(cluster elasticacheTypes.CacheCluster) ElastiCacheClusterInfo {
+	info := ElastiCacheClusterInfo{
+		ID:                safeString(cluster.CacheClusterId),
+		ARN:               safeString(cluster.ARN),
+		Engine:            safeString(cluster.Engine),
+		EngineVersion:     safeString(cluster.EngineVersion),
+		NodeType:          safeString(cluster.CacheNodeType),
+		NodeCount:         int(safeInt32(cluster.NumCacheNodes)),
+		Status:            safeString(cluster.CacheClusterStatus),
+		TransitEncryption: safeBool(cluster.TransitEncryptionEnabled),
+		AtRestEncryption:  safeBool(cluster.AtRestEncryptionEnabled),
+		AuthTokenEnabled:  safeBool(cluster.AuthTokenEnabled),
+		SubnetGroup:       safeString(cluster.CacheSubnetGroupName),
+		SubnetIDs:         make([]string, 0),
+		SecurityGroupIDs:  cacheClusterSecurityGroups(cluster),
+		MetricClusterID:   safeString(cluster.CacheClusterId),
+		CreatedAt:         safeTime(cluster.CacheClusterCreateTime),
+	}
+
+	endpoint := cluster.ConfigurationEndpoint
+	if endpoint == nil && len(cluster.CacheNodes) > 0 {
+		endpoint = cluster.CacheNodes[0].Endpoint
+	}
+	if endpoint != nil {
+		info.Endpoint = safeString(endpoint.Address)
+		info.Port = safeInt32(endpoint.Port)
+	}
+	return info
+}
+
+// AddMemberCluster completes a replication group with the settings of one of its clusters
+func (e *ElastiCacheClusterInfo) AddMemberCluster(cluster elasticacheTypes.CacheCluster) {
+	if e.EngineVersion == "" {
+		e.EngineVersion = safeString(cluster.EngineVersion)
+	}
+	if e.SubnetGroup == "" {
+		e.SubnetGroup = safeString(cluster.CacheSubnetGroupName)
+	}
+	for _, id := range cacheClusterSecurityGroups(cluster) {
+		if !containsString(e.SecurityGroupIDs, id) {
+			e.SecurityGroupIDs = append(e.SecurityGroupIDs, id)
+		}
+	}
+}
+
+// SetSubnetGroup places the cluster in the VPC and subnets of its subnet group
+func (e *ElastiCacheClusterInfo) SetSubnetGroup(group elasticacheTypes.CacheSubnetGroup) {
+	e.VPCID = safeString(group.VpcId)
+	e.SubnetIDs = make([]string, 0, len(group.Subnets))
+	for _, subnet := range group.Subnets {
+		e.SubnetIDs = append(e.SubnetIDs, safeString(subnet.SubnetIdentifier))
+	}
+}
+
+func cacheClusterSecurityGroups(cluster elasticacheTypes.CacheCluster) []string {
+	ids := make([]string, 0, len(cluster.SecurityGroups))
+	for _, sg := range cluster.SecurityGroups {
+		ids = append(ids, safeString(sg.SecurityGroupId))
+	}
+	return ids
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// OpenSearchDomainInfo represents an OpenSearch Service domain
+type OpenSearchDomainInfo struct {
+	DomainName             string
+	ARN                    string
+	EngineVersion          string // e.g. OpenSearch_2.11 or Elasticsearch_7.10
+	Status                 string // Active, Processing or Deleting
+	InstanceType           string
+	InstanceCount          int32
+	DedicatedMasterEnabled bool
+	DedicatedMasterType    string
+	DedicatedMasterCount   int32
+	WarmCount              int32
+	ZoneAwareness          bool
+	VolumeType             string
+	VolumeSize             int32 // GiB per data node, zero without EBS
+	TransitEncryption      bool  // Node-to-node encryption
+	AtRestEncryption       bool
+	EnforceHTTPS           bool
+	Endpoint               string
+	// Empty for domains with a public endpoint
+	VPCID            string
+	SubnetIDs        []string
+	SecurityGroupIDs []string
+}
+
+// FromAWSOpenSearchDomain converts an AWS SDK DomainStatus type to our internal model
+func FromAWSOpenSearchDomain(domain opensearchTypes.DomainStatus) OpenSearchDomainInfo {
+	info := OpenSearchDomainInfo{
+		DomainName:       safeString(domain.DomainName),
+		ARN:              safeString(domain.ARN),
+		EngineVersion:    safeString(domain.EngineVersion),
+		Status:           "Active",
+		Endpoint:         safeString(domain.Endpoint),
+		SubnetIDs:        make([]string, 0),
+		SecurityGroupIDs: make([]string, 0),
+	}
+	switch {
+	case safeBool(domain.Deleted):
+		info.Status = "Deleting"
+	case safeBool(domain.Processing) || safeBool(domain.UpgradeProcessing):
+		info.Status = "Processing"
+	}
+
+	if cfg := domain.ClusterConfig; cfg != nil {
+		info.InstanceType = string(cfg.InstanceType)
+		info.InstanceCount = safeInt32(cfg.InstanceCount)
+		info.DedicatedMasterEnabled = safeBool(cfg.DedicatedMasterEnabled)
+		if info.DedicatedMasterEnabled {
+			info.DedicatedMasterType = string(cfg.DedicatedMasterType)
+			info.DedicatedMasterCount = safeInt32(cfg.DedicatedMasterCount)
+		}
+		if safeBool(cfg.WarmEnabled) {
+			info.WarmCount = safeInt32(cfg.WarmCount)
+		}
+		info.ZoneAwareness = safeBool(cfg.ZoneAwarenessEnabled)
+	}
+	if ebs := domain.EBSOptions; ebs != nil && safeBool(ebs.EBSEnabled) {
+		info.VolumeType = string(ebs.VolumeType)
+		info.VolumeSize = safeInt32(ebs.VolumeSize)
+	}
+	if domain.NodeToNodeEncryptionOptions != nil {
+		info.TransitEncryption = safeBool(domain.NodeToNodeEncryptionOptions.Enabled)
+	}
+	if domain.EncryptionAtRestOptions != nil {
+		info.AtRestEncryption = safeBool(domain.EncryptionAtRestOptions.Enabled)
+	}
+	if domain.DomainEndpointOptions != nil {
+		info.EnforceHTTPS = safeBool(domain.DomainEndpointOptions.EnforceHTTPS)
+	}
+	if vpc := domain.VPCOptions; vpc != nil {
+		info.VPCID = safeString(vpc.VPCId)
+		info.SubnetIDs = vpc.SubnetIds
+		info.SecurityGroupIDs = vpc.SecurityGroupIds
+		// VPC domains only have the "vpc" endpoint
+		if info.Endpoint == "" {
+			info.Endpoint = domain.Endpoints["vpc"]
+		}
+	}
+	return info
+}
+
+// MSKClusterInfo represents a provisioned or serverless MSK cluster
+type MSKClusterInfo struct {
+	Name         string
+	ARN          string
+	Type         string // PROVISIONED or SERVERLESS
+	State        string
+	KafkaVersion string // Empty for serverless clusters
+	InstanceType string
+	BrokerCount  int32
+	VolumeSize   int32 // GiB per broker
+	// Encryption between clients and brokers: TLS, TLS_PLAINTEXT or PLAINTEXT
+	ClientBrokerEncryption string
+	TransitEncryption      bool // TLS only, both from clients and between brokers
+	AtRestEncryption       bool // Always on, with an AWS managed key unless KMSKeyID is set
+	KMSKeyID               string
+	EnhancedMonitoring     string
+	VPCID                  string // Resolved from the subnets by the caller
+	SubnetIDs              []string
+	SecurityGroupIDs       []string
+	CreatedAt              string
+	Tags                   map[string]string
+}
+
+// FromAWSMSKCluster converts an AWS SDK kafka Cluster type to our internal model
+func FromAWSMSKCluster(cluster kafkaTypes.Cluster) MSKClusterInfo {
+	info := MSKClusterInfo{
+		Name:                   safeString(cluster.ClusterName),
+		ARN:                    safeString(cluster.ClusterArn),
+		Type:                   string(cluster.ClusterType),
+		State:                  string(cluster.State),
+		ClientBrokerEncryption: string(kafkaTypes.ClientBrokerTls),
+		TransitEncryption:      true,
+		AtRestEncryption:       true,
+		SubnetIDs:              make([]string, 0),
+		SecurityGroupIDs:       make([]string, 0),
+		CreatedAt:              safeTime(cluster.CreationTime),
+		Tags:                   cluster.Tags,
+	}
+	if info.Tags == nil {
+		info.Tags = make(map[string]string)
+	}
+
+	if p := cluster.Provisioned; p != nil {
+		info.BrokerCount = safeInt32(p.NumberOfBrokerNodes)
+		info.EnhancedMonitoring = string(p.EnhancedMonitoring)
+		if p.CurrentBrokerSoftwareInfo != nil {
+			info.KafkaVersion = safeString(p.CurrentBrokerSoftwareInfo.KafkaVersion)
+		}
+		if nodes := p.BrokerNodeGroupInfo; nodes != nil {
+			info.InstanceType = safeString(nodes.InstanceType)
+			info.SubnetIDs = nodes.ClientSubnets
+			info.SecurityGroupIDs = nodes.SecurityGroups
+			if nodes.StorageInfo != nil && nodes.StorageInfo.EbsStorageInfo != nil {
+				info.VolumeSize = safeInt32(nodes.StorageInfo.EbsStorageInfo.VolumeSize)
+			}
+		}
+		if enc := p.EncryptionInfo; enc != nil {
+			if enc.EncryptionAtRest != nil {
+				info.KMSKeyID = safeString(enc.EncryptionAtRest.DataVolumeKMSKeyId)
+			}
+			if transit := enc.EncryptionInTransit; transit != nil {
+				if transit.ClientBroker != "" {
+					info.ClientBrokerEncryption = string(transit.ClientBroker)
+				}
+				// In-cluster encryption defaults to on when not specified
+				inCluster := transit.InCluster == nil || *transit.InCluster
+				info.TransitEncryption = inCluster && transit.ClientBroker != kafkaTypes.ClientBrokerTlsPlaintext &&
+					transit.ClientBroker != kafkaTypes.ClientBrokerPlaintext
+			}
+		}
+	}
+	// Serverless clusters are always encrypted and may span several VPCs, the first is shown
+	if s := cluster.Serverless; s != nil && len(s.VpcConfigs) > 0 {
+		info.SubnetIDs = s.VpcConfigs[0].SubnetIds
+		info.SecurityGroupIDs = s.VpcConfigs[0].SecurityGroupIds
+	}
+	if info.SubnetIDs == nil {
+		info.SubnetIDs = make([]string, 0)
+	}
+	if info.SecurityGroupIDs == nil {
+		info.SecurityGroupIDs = make([]string, 0)
+	}
+	return info
+}
+
+// OpenSearchDomainFromARN returns the account and the domain name of a domain ARN,
+// arn:aws:es:<region>:<account>:domain/<name>
+func OpenSearchDomainFromARN(arn string) (account, name string) {
+	parts := strings.SplitN(arn, ":", 6)
+	if len(parts) != 6 || !strings.HasPrefix(parts[5], "domain/") {
+		return "", ""
+	}
+	return parts[4], strings.TrimPrefix(parts[5], "domain/")
+}
@@ -0,0 +1,128 @@
+package models
+
+import (
+	dynamodbTypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// DynamoDBTableInfo represents a DynamoDB table
+type DynamoDBTableInfo struct {
+	TableName          string
+	ARN                string
+	Status             string
+	BillingMode        string // PROVISIONED or PAY_PER_REQUEST
+	ReadCapacity       int64  // Provisioned mode only
+	WriteCapacity      int64
+	ItemCount          int64 // Updated by DynamoDB about every six hours
+	SizeBytes          int64
+	PartitionKey       string
+	SortKey            string
+	TableClass         string
+	GlobalIndexes      []DynamoDBIndexInfo
+	LocalIndexCount    int
+	PITREnabled        bool
+	TTLStatus          string // ENABLED, DISABLED, ENABLING, DISABLING
+	TTLAttribute       string
+	StreamEnabled      bool
+	StreamViewType     string
+	StreamARN          string
+	ReplicaRegions     []string // Global table replicas
+	Encryption         string   // AWS owned key when empty, otherwise KMS
+	DeletionProtection bool
+	CreatedAt          string
+}
+
+// DynamoDBIndexInfo is a global secondary index of a table
+type DynamoDBIndexInfo struct {
+	Name          string
+	Status        string
+	PartitionKey  string
+	SortKey       string
+	Projection    string // ALL, KEYS_ONLY, INCLUDE
+	ItemCount     int64
+	SizeBytes     int64
+	ReadCapacity  int64
+	WriteCapacity int64
+}
+
+// FromAWSDynamoDBTable converts an AWS SDK TableDescription type to our internal model.
+// PITR and TTL come from separate calls and are set by the caller.
+func FromAWSDynamoDBTable(table dynamodbTypes.TableDescription) DynamoDBTableInfo {
+	info := DynamoDBTableInfo{
+		TableName:          safeString(table.TableName),
+		ARN:                safeString(table.TableArn),
+		Status:             string(table.TableStatus),
+		BillingMode:        string(dynamodbTypes.BillingModeProvisioned),
+		ItemCount:          safeInt64(table.ItemCount),
+		SizeBytes:          safeInt64(table.TableSizeBytes),
+		LocalIndexCount:    len(table.LocalSecondaryIndexes),
+		StreamARN:          safeString(table.LatestStreamArn),
+		DeletionProtection: safeBool(table.DeletionProtectionEnabled),
+		CreatedAt:          safeTime(table.CreationDateTime),
+	}
+
+	// Tables created before billing modes existed have no summary and are provisioned
+	if table.BillingModeSummary != nil && table.BillingModeSummary.BillingMode != "" {
+		info.BillingMode = string(table.BillingModeSummary.BillingMode)
+	}
+	if pt := table.ProvisionedThroughput; pt != nil {
+		info.ReadCapacity = safeInt64(pt.ReadCapacityUnits)
+		info.WriteCapacity = safeInt64(pt.WriteCapacityUnits)
+	}
+	info.PartitionKey, info.SortKey = dynamoDBKeys(table.KeySchema)
+	if table.TableClassSummary != nil {
+		info.TableClass = string(table.TableClassSummary.TableClass)
+	}
+	if spec := table.StreamSpecification; spec != nil {
+		info.StreamEnabled = safeBool(spec.StreamEnabled)
+		info.StreamViewType = string(spec.StreamViewType)
+	}
+	if sse := table.SSEDescription; sse != nil {
+		info.Encryption = string(sse.SSEType)
+	}
+
+	for _, gsi := range table.GlobalSecondaryIndexes {
+		index := DynamoDBIndexInfo{
+			Name:      safeString(gsi.IndexName),
+			Status:    string(gsi.IndexStatus),
+			ItemCount: safeInt64(gsi.ItemCount),
+			SizeBytes: safeInt64(gsi.IndexSizeBytes),
+		}
+		index.PartitionKey, index.SortKey = dynamoDBKeys(gsi.KeySchema)
+		if gsi.Projection != nil {
+			index.Projection = string(gsi.Projection.ProjectionType)
+		}
+		if pt := gsi.ProvisionedThroughput; pt != nil {
+			index.ReadCapacity = safeInt64(pt.ReadCapacityUnits)
+			index.WriteCapacity = safeInt64(pt.WriteCapacityUnits)
+		}
+		info.GlobalIndexes = append(info.GlobalIndexes, index)
+	}
+
+	for _, replica := range table.Replicas {
+		info.ReplicaRegions = append(info.ReplicaRegions, safeString(replica.RegionName))
+	}
+
+	return info
+}
+
+// dynamoDBKeys returns the partition (HASH) and sort (RANGE) key attributes of a key schema
+func dynamoDBKeys(schema []dynamodbTypes.KeySchemaElement) (string, string) {
+	var partition, sort string
+	for _, key := range schema {
+		switch key.KeyType {
+		case dynamodbTypes.KeyTypeHash:
+			partition = safeString(key.AttributeName)
+		case dynamodbTypes.KeyTypeRange:
+			sort = safeString(key.AttributeName)
+		}
+	}
+	return partition, sort
+}
+
+// safeInt64 safely dereferences an int64 pointer
+func safeInt64(i *int64) int64 {
+	if i == nil {
+		return 0
+	}
+	return *i
+}
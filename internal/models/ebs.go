@@ -0,0 +1,185 @@
+package models
+
+import (
+	"regexp"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"aws-terminal-sdk-v1/internal/constants"
+)
+
+// amiReference matches the AMI named in the description of snapshots created by CreateImage
+var amiReference = regexp.MustCompile(`ami-[0-9a-f]+`)
+
+// copiedSnapshotVolumeID is the placeholder volume of the snapshots created by CopySnapshot
+const copiedSnapshotVolumeID = "vol-ffffffff"
+
+// EBSVolumeInfo represents an EBS volume
+type EBSVolumeInfo struct {
+	ID               string
+	Name             string
+	SizeGiB          int32
+	VolumeType       string
+	IOPS             int32
+	Throughput       int32 // MB/s, gp3 only
+	Encrypted        bool
+	KMSKeyID         string
+	State            string
+	AvailabilityZone string
+	CreateTime       string
+	SnapshotID       string
+	Attachments      []EBSAttachmentInfo
+	Tags             map[string]string
+	Unattached       bool
+	MonthlyCost      float64
+}
+
+// EBSAttachmentInfo represents the attachment of a volume to an instance
+type EBSAttachmentInfo struct {
+	InstanceID          string
+	Device              string
+	State               string
+	DeleteOnTermination bool
+}
+
+// EBSSnapshotInfo represents an EBS snapshot owned by the account
+type EBSSnapshotInfo struct {
+	ID            string
+	Name          string
+	Description   string
+	VolumeID      string
+	VolumeSizeGiB int32
+	State         string
+	Progress      string
+	Encrypted     bool
+	KMSKeyID      string
+	StartTime     string
+	StorageTier   string
+	Tags          map[string]string
+	// AMIs currently registered from this snapshot
+	ImageIDs []string
+	// Copies, such as cross-region or DR copies, have no source volume
+	Copy bool
+	// Orphaned snapshots have lost their source volume or AMI
+	Orphaned     bool
+	OrphanReason string
+	// Upper bound: snapshots are incremental, billed blocks are often fewer
+	MonthlyCost float64
+}
+
+// EBSStorageReport summarizes volumes, snapshots and their estimated monthly cost
+type EBSStorageReport struct {
+	Volumes               []EBSVolumeInfo   `json:"volumes"`
+	Snapshots             []EBSSnapshotInfo `json:"snapshots"`
+	UnattachedVolumes     int               `json:"unattached_volumes"`
+	OrphanedSnapshots     int               `json:"orphaned_snapshots"`
+	VolumeMonthlyCost     float64           `json:"volume_monthly_cost"`
+	SnapshotMonthlyCost   float64           `json:"snapshot_monthly_cost"`
+	UnattachedMonthlyCost float64           `json:"unattached_monthly_cost"`
+	OrphanedMonthlyCost   float64           `json:"orphaned_monthly_cost"`
+}
+
+// FromAWSVolume converts an AWS SDK Volume type to our internal model
+func FromAWSVolume(volume types.Volume) EBSVolumeInfo {
+	info := EBSVolumeInfo{
+		ID:               safeString(volume.VolumeId),
+		SizeGiB:          safeInt32(volume.Size),
+		VolumeType:       string(volume.VolumeType),
+		IOPS:             safeInt32(volume.Iops),
+		Throughput:       safeInt32(volume.Throughput),
+		Encrypted:        safeBool(volume.Encrypted),
+		KMSKeyID:         safeString(volume.KmsKeyId),
+		State:            string(volume.State),
+		AvailabilityZone: safeString(volume.AvailabilityZone),
+		CreateTime:       safeTime(volume.CreateTime),
+		SnapshotID:       safeString(volume.SnapshotId),
+		Tags:             fromEC2Tags(volume.Tags),
+	}
+	info.Name = info.Tags[constants.TagName]
+
+	for _, att := range volume.Attachments {
+		info.Attachments = append(info.Attachments, EBSAttachmentInfo{
+			InstanceID:          safeString(att.InstanceId),
+			Device:              safeString(att.Device),
+			State:               string(att.State),
+			DeleteOnTermination: safeBool(att.DeleteOnTermination),
+		})
+	}
+
+	info.Unattached = volume.State == types.VolumeStateAvailable
+	info.MonthlyCost = EstimateVolumeMonthlyCost(info.VolumeType, info.SizeGiB, info.IOPS, info.Throughput)
+
+	return info
+}
+
+// FromAWSSnapshot converts an AWS SDK Snapshot type to our internal model
+func FromAWSSnapshot(snapshot types.Snapshot) EBSSnapshotInfo {
+	info := EBSSnapshotInfo{
+		ID:            safeString(snapshot.SnapshotId),
+		Description:   safeString(snapshot.Description),
+		VolumeID:      safeString(snapshot.VolumeId),
+		VolumeSizeGiB: safeInt32(snapshot.VolumeSize),
+		State:         string(snapshot.State),
+		Progress:      safeString(snapshot.Progress),
+		Encrypted:     safeBool(snapshot.Encrypted),
+		KMSKeyID:      safeString(snapshot.KmsKeyId),
+		StartTime:     safeTime(snapshot.StartTime),
+		StorageTier:   string(snapshot.StorageTier),
+		Tags:          fromEC2Tags(snapshot.Tags),
+	}
+	info.Name = info.Tags[constants.TagName]
+	info.Copy = info.VolumeID == copiedSnapshotVolumeID
+
+	price := constants.EBSPriceSnapshotPerGB
+	if snapshot.StorageTier == types.StorageTierArchive {
+		price = constants.EBSPriceSnapshotArchivePerGB
+	}
+	info.MonthlyCost = float64(info.VolumeSizeGiB) * price
+
+	return info
+}
+
+// SourceImageID returns the AMI named in a CreateImage snapshot description, if any
+func (s EBSSnapshotInfo) SourceImageID() string {
+	return amiReference.FindString(s.Description)
+}
+
+// EstimateVolumeMonthlyCost estimates the monthly storage and provisioned performance cost of a volume
+func EstimateVolumeMonthlyCost(volumeType string, sizeGiB, iops, throughput int32) float64 {
+	size := float64(sizeGiB)
+
+	switch types.VolumeType(volumeType) {
+	case types.VolumeTypeGp2:
+		return size * constants.EBSPriceGp2PerGB
+	case types.VolumeTypeGp3:
+		cost := size * constants.EBSPriceGp3PerGB
+		if iops > constants.EBSGp3BaselineIOPS {
+			cost += float64(iops-constants.EBSGp3BaselineIOPS) * constants.EBSPriceGp3PerIOPS
+		}
+		if throughput > constants.EBSGp3BaselineThroughput {
+			cost += float64(throughput-constants.EBSGp3BaselineThroughput) * constants.EBSPriceGp3PerMBps
+		}
+		return cost
+	case types.VolumeTypeIo1:
+		return size*constants.EBSPriceIo1PerGB + float64(iops)*constants.EBSPriceProvisionedIOPS
+	case types.VolumeTypeIo2:
+		return size*constants.EBSPriceIo2PerGB + float64(iops)*constants.EBSPriceProvisionedIOPS
+	case types.VolumeTypeSt1:
+		return size * constants.EBSPriceSt1PerGB
+	case types.VolumeTypeSc1:
+		return size * constants.EBSPriceSc1PerGB
+	default:
+		return size * constants.EBSPriceStandardPerGB
+	}
+}
+
+// fromEC2Tags converts EC2 tags to a map
+func fromEC2Tags(tags []types.Tag) map[string]string {
+	result := make(map[string]string, len(tags))
+	for _, tag := range tags {
+		if tag.Key != nil && tag.Value != nil {
+			result[*tag.Key] = *tag.Value
+		}
+	}
+	return result
+}
@@ -0,0 +1,85 @@
+package models
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// InstanceStatusInfo holds the status checks and scheduled events of an EC2 instance
+type InstanceStatusInfo struct {
+	InstanceID       string              `json:"instance_id"`
+	State            string              `json:"state"`
+	AvailabilityZone string              `json:"availability_zone"`
+	SystemStatus     string              `json:"system_status"`   // ok, impaired, insufficient-data, not-applicable, initializing
+	InstanceStatus   string              `json:"instance_status"` // Same values as SystemStatus
+	EBSStatus        string              `json:"ebs_status"`      // Attached EBS status check
+	SystemChecks     []StatusCheckInfo   `json:"system_checks"`
+	InstanceChecks   []StatusCheckInfo   `json:"instance_checks"`
+	ScheduledEvents  []InstanceEventInfo `json:"scheduled_events"`
+}
+
+// StatusCheckInfo is a single reachability status check
+type StatusCheckInfo struct {
+	Name          string `json:"name"`
+	Status        string `json:"status"` // passed, failed, insufficient-data, initializing
+	ImpairedSince string `json:"impaired_since"`
+}
+
+// InstanceEventInfo is a scheduled maintenance event (reboot, retirement...)
+type InstanceEventInfo struct {
+	ID          string `json:"id"`
+	Code        string `json:"code"` // instance-reboot, system-reboot, system-maintenance, instance-retirement, instance-stop
+	Description string `json:"description"`
+	NotBefore   string `json:"not_before"`
+	NotAfter    string `json:"not_after"`
+	Deadline    string `json:"deadline"`
+}
+
+// FromAWSInstanceStatus converts an AWS SDK InstanceStatus type to our internal model
+func FromAWSInstanceStatus(status types.InstanceStatus) InstanceStatusInfo {
+	info := InstanceStatusInfo{
+		InstanceID:       safeString(status.InstanceId),
+		AvailabilityZone: safeString(status.AvailabilityZone),
+		ScheduledEvents:  make([]InstanceEventInfo, 0, len(status.Events)),
+	}
+
+	if status.InstanceState != nil {
+		info.State = string(status.InstanceState.Name)
+	}
+	if status.SystemStatus != nil {
+		info.SystemStatus = string(status.SystemStatus.Status)
+		info.SystemChecks = fromAWSStatusDetails(status.SystemStatus.Details)
+	}
+	if status.InstanceStatus != nil {
+		info.InstanceStatus = string(status.InstanceStatus.Status)
+		info.InstanceChecks = fromAWSStatusDetails(status.InstanceStatus.Details)
+	}
+	if status.AttachedEbsStatus != nil {
+		info.EBSStatus = string(status.AttachedEbsStatus.Status)
+	}
+
+	for _, event := range status.Events {
+		info.ScheduledEvents = append(info.ScheduledEvents, InstanceEventInfo{
+			ID:          safeString(event.InstanceEventId),
+			Code:        string(event.Code),
+			Description: safeString(event.Description),
+			NotBefore:   safeTime(event.NotBefore),
+			NotAfter:    safeTime(event.NotAfter),
+			Deadline:    safeTime(event.NotBeforeDeadline),
+		})
+	}
+
+	return info
+}
+
+// fromAWSStatusDetails converts the details of a status summary
+func fromAWSStatusDetails(details []types.InstanceStatusDetails) []StatusCheckInfo {
+	checks := make([]StatusCheckInfo, 0, len(details))
+	for _, d := range details {
+		checks = append(checks, StatusCheckInfo{
+			Name:          string(d.Name),
+			Status:        string(d.Status),
+			ImpairedSince: safeTime(d.ImpairedSince),
+		})
+	}
+	return checks
+}
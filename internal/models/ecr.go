@@ -0,0 +1,200 @@
+package models
+
+import (
+	"regexp"
+	"strings"
+
+	ecrTypes "github.com/aws/aws-sdk-go-v2/service/ecr/types"
+)
+
+// ECRRepositoryInfo represents an ECR repository
+type ECRRepositoryInfo struct {
+	Name            string
+	ARN             string
+	URI             string
+	RegistryID      string
+	TagImmutability bool
+	ScanOnPush      bool
+	LifecyclePolicy bool
+	Encryption      string // AES256 or KMS
+	ImageCount      int
+	TotalSizeBytes  int64
+	LastPushedAt    string
+	// Severity counts summed over the scanned images of the repository
+	SeverityCounts map[string]int32
+	CreatedAt      string
+}
+
+// FromAWSECRRepository converts an AWS SDK Repository type to our internal model.
+// Image figures and the lifecycle policy come from separate calls and are set by the caller.
+func FromAWSECRRepository(repo ecrTypes.Repository) ECRRepositoryInfo {
+	info := ECRRepositoryInfo{
+		Name:            safeString(repo.RepositoryName),
+		ARN:             safeString(repo.RepositoryArn),
+		URI:             safeString(repo.RepositoryUri),
+		RegistryID:      safeString(repo.RegistryId),
+		TagImmutability: repo.ImageTagMutability == ecrTypes.ImageTagMutabilityImmutable,
+		SeverityCounts:  make(map[string]int32),
+		CreatedAt:       safeTime(repo.CreatedAt),
+	}
+
+	if repo.ImageScanningConfiguration != nil {
+		info.ScanOnPush = repo.ImageScanningConfiguration.ScanOnPush
+	}
+	if repo.EncryptionConfiguration != nil {
+		info.Encryption = string(repo.EncryptionConfiguration.EncryptionType)
+	}
+
+	return info
+}
+
+// AddImage accounts an image of the repository in its totals
+func (r *ECRRepositoryInfo) AddImage(image ECRImageInfo) {
+	r.ImageCount++
+	r.TotalSizeBytes += image.SizeBytes
+	if image.PushedAt > r.LastPushedAt {
+		r.LastPushedAt = image.PushedAt
+	}
+	for severity, count := range image.SeverityCounts {
+		r.SeverityCounts[severity] += count
+	}
+}
+
+// ECRImageInfo represents an image of an ECR repository
+type ECRImageInfo struct {
+	Repository     string           `json:"repository"`
+	Digest         string           `json:"digest"`
+	Tags           []string         `json:"tags"`
+	PushedAt       string           `json:"pushed_at"`
+	LastPulledAt   string           `json:"last_pulled_at"`
+	SizeBytes      int64            `json:"size_bytes"`
+	ScanStatus     string           `json:"scan_status"` // Empty when the image was never scanned
+	ScanCompleted  string           `json:"scan_completed"`
+	SeverityCounts map[string]int32 `json:"severity_counts"` // CRITICAL, HIGH, MEDIUM, LOW, INFORMATIONAL, UNDEFINED
+}
+
+// FromAWSECRImage converts an AWS SDK ImageDetail type to our internal model
+func FromAWSECRImage(image ecrTypes.ImageDetail) ECRImageInfo {
+	info := ECRImageInfo{
+		Repository:     safeString(image.RepositoryName),
+		Digest:         safeString(image.ImageDigest),
+		Tags:           image.ImageTags,
+		PushedAt:       safeTime(image.ImagePushedAt),
+		LastPulledAt:   safeTime(image.LastRecordedPullTime),
+		SizeBytes:      safeInt64(image.ImageSizeInBytes),
+		SeverityCounts: make(map[string]int32),
+	}
+	if info.Tags == nil {
+		info.Tags = make([]string, 0)
+	}
+
+	if image.ImageScanStatus != nil {
+		info.ScanStatus = string(image.ImageScanStatus.Status)
+	}
+	if summary := image.ImageScanFindingsSummary; summary != nil {
+		info.ScanCompleted = safeTime(summary.ImageScanCompletedAt)
+		for severity, count := range summary.FindingSeverityCounts {
+			info.SeverityCounts[severity] = count
+		}
+	}
+
+	return info
+}
+
+// ECRScanFinding is a vulnerability reported by basic or enhanced image scanning
+type ECRScanFinding struct {
+	Name        string `json:"name"` // CVE identifier
+	Severity    string `json:"severity"`
+	Package     string `json:"package"` // name and version of the vulnerable package
+	FixedIn     string `json:"fixed_in"`
+	Description string `json:"description"`
+	URI         string `json:"uri"`
+}
+
+// FromAWSECRScanFinding converts an AWS SDK ImageScanFinding type of basic scanning to our internal model
+func FromAWSECRScanFinding(finding ecrTypes.ImageScanFinding) ECRScanFinding {
+	info := ECRScanFinding{
+		Name:        safeString(finding.Name),
+		Severity:    string(finding.Severity),
+		Description: safeString(finding.Description),
+		URI:         safeString(finding.Uri),
+	}
+
+	var name, version string
+	for _, attr := range finding.Attributes {
+		switch safeString(attr.Key) {
+		case "package_name":
+			name = safeString(attr.Value)
+		case "package_version":
+			version = safeString(attr.Value)
+		}
+	}
+	info.Package = strings.TrimSpace(name + " " + version)
+
+	return info
+}
+
+// FromAWSECREnhancedFinding converts an AWS SDK EnhancedImageScanFinding type of enhanced
+// (Amazon Inspector) scanning to our internal model
+func FromAWSECREnhancedFinding(finding ecrTypes.EnhancedImageScanFinding) ECRScanFinding {
+	info := ECRScanFinding{
+		Name:        safeString(finding.Title),
+		Severity:    safeString(finding.Severity),
+		Description: safeString(finding.Description),
+	}
+
+	if details := finding.PackageVulnerabilityDetails; details != nil {
+		info.Name = firstString(details.VulnerabilityId, finding.Title)
+		info.URI = safeString(details.SourceUrl)
+		if len(details.VulnerablePackages) > 0 {
+			pkg := details.VulnerablePackages[0]
+			info.Package = strings.TrimSpace(safeString(pkg.Name) + " " + safeString(pkg.Version))
+			info.FixedIn = safeString(pkg.FixedInVersion)
+		}
+	}
+
+	return info
+}
+
+// ECRImageUsage links a container of an ECS service to the ECR image it runs
+type ECRImageUsage struct {
+	Cluster        string           `json:"cluster"`
+	Service        string           `json:"service"`
+	TaskDefinition string           `json:"task_definition"`
+	Container      string           `json:"container"`
+	Image          string           `json:"image"`
+	RegistryID     string           `json:"registry_id"`
+	Repository     string           `json:"repository"`
+	Tag            string           `json:"tag"`
+	Digest         string           `json:"digest"`
+	ScanStatus     string           `json:"scan_status"`
+	SeverityCounts map[string]int32 `json:"severity_counts"`
+	// True when the image is missing from the repository, e.g. expired by a lifecycle policy
+	NotFound bool `json:"not_found"`
+}
+
+// ECRImageUsageReport lists the ECR images run by the ECS services of the account
+type ECRImageUsageReport struct {
+	Usages []ECRImageUsage `json:"usages"`
+	// Services, task definitions or repositories that could not be read
+	Errors []string `json:"errors"`
+}
+
+// ecrImagePattern matches private ECR image references:
+// <registry>.dkr.ecr.<region>.amazonaws.com[.cn]/<repository>[:tag][@digest]
+var ecrImagePattern = regexp.MustCompile(`^(\d{12})\.dkr\.ecr(?:-fips)?\.([a-z0-9-]+)\.amazonaws\.com(?:\.cn)?/([^:@]+)(?::([^@]+))?(?:@(sha256:[0-9a-f]+))?$`)
+
+// ParseECRImage splits an ECR image reference into its registry, region, repository,
+// tag and digest. ok is false for images hosted outside ECR, e.g. on Docker Hub.
+// A reference without tag nor digest points to the latest tag.
+func ParseECRImage(image string) (registry, region, repository, tag, digest string, ok bool) {
+	m := ecrImagePattern.FindStringSubmatch(image)
+	if m == nil {
+		return "", "", "", "", "", false
+	}
+	registry, region, repository, tag, digest = m[1], m[2], m[3], m[4], m[5]
+	if tag == "" && digest == "" {
+		tag = "latest"
+	}
+	return registry, region, repository, tag, digest, true
+}
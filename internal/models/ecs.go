@@ -0,0 +1,284 @@
+package models
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	ecsTypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+)
+
+// redactedValue replaces the values that may contain credentials
+const redactedValue = "<redacted>"
+
+// safeLogOptions are the log driver options shown with their value. The others, such as
+// splunk-token, may carry credentials.
+var safeLogOptions = map[string]bool{
+	"awslogs-group":         true,
+	"awslogs-region":        true,
+	"awslogs-stream-prefix": true,
+}
+
+// ECSServiceInfo represents an ECS service running inside a cluster
+type ECSServiceInfo struct {
+	ServiceName    string
+	ServiceArn     string
+	ClusterArn     string
+	Status         string
+	LaunchType     string
+	TaskDefinition string
+	DesiredCount   int32
+	RunningCount   int32
+	PendingCount   int32
+	RolloutState   string // Rollout state of the PRIMARY deployment
+	CreatedAt      string
+	Deployments    []ECSDeploymentInfo
+	LoadBalancers  []ECSLoadBalancerInfo
+}
+
+// ECSDeploymentInfo represents a single deployment of an ECS service
+type ECSDeploymentInfo struct {
+	ID                 string
+	Status             string // PRIMARY, ACTIVE or INACTIVE
+	TaskDefinition     string
+	LaunchType         string
+	DesiredCount       int32
+	RunningCount       int32
+	PendingCount       int32
+	FailedTasks        int32
+	RolloutState       string
+	RolloutStateReason string
+	CreatedAt          string
+	UpdatedAt          string
+}
+
+// ECSLoadBalancerInfo links an ECS service container to a load balancer target group
+type ECSLoadBalancerInfo struct {
+	TargetGroupArn   string
+	LoadBalancerName string
+	ContainerName    string
+	ContainerPort    int32
+}
+
+// ECSTaskInfo represents a running or recently stopped ECS task
+type ECSTaskInfo struct {
+	TaskArn           string
+	TaskDefinitionArn string
+	Family            string
+	Revision          string
+	Group             string
+	LastStatus        string
+	DesiredStatus     string
+	HealthStatus      string
+	LaunchType        string
+	AvailabilityZone  string
+	CPU               string
+	Memory            string
+	StartedAt         string
+	StoppedAt         string
+	StopCode          string
+	StoppedReason     string
+	Containers        []ECSContainerInfo
+}
+
+// ECSContainerInfo represents the runtime state of a container inside a task
+type ECSContainerInfo struct {
+	Name         string
+	Image        string
+	ImageDigest  string
+	LastStatus   string
+	HealthStatus string
+	ExitCode     *int32
+	Reason       string
+}
+
+// ECSTaskDefinitionInfo represents a registered ECS task definition revision
+type ECSTaskDefinitionInfo struct {
+	Arn                     string
+	Family                  string
+	Revision                int32
+	Status                  string
+	CPU                     string
+	Memory                  string
+	NetworkMode             string
+	TaskRoleArn             string
+	ExecutionRoleArn        string
+	RequiresCompatibilities []string
+	Containers              []ECSContainerDefinitionInfo
+}
+
+// ECSContainerDefinitionInfo represents a container within a task definition.
+// Only the names of environment variables and secrets are kept, never their values.
+type ECSContainerDefinitionInfo struct {
+	Name              string
+	Image             string
+	CPU               int32
+	Memory            int32
+	MemoryReservation int32
+	Essential         bool
+	PortMappings      []string // e.g. "8080/tcp"
+	EnvironmentVars   []string
+	Secrets           []string
+	LogDriver         string
+	LogOptions        map[string]string // Values outside safeLogOptions are redacted
+	// CloudWatch Logs destination of the awslogs driver
+	LogGroup        string
+	LogStreamPrefix string
+}
+
+// FromAWSECSService converts an AWS SDK ECS Service type to our internal model
+func FromAWSECSService(service ecsTypes.Service) ECSServiceInfo {
+	serviceInfo := ECSServiceInfo{
+		ServiceName:    safeString(service.ServiceName),
+		ServiceArn:     safeString(service.ServiceArn),
+		ClusterArn:     safeString(service.ClusterArn),
+		Status:         safeString(service.Status),
+		LaunchType:     string(service.LaunchType),
+		TaskDefinition: safeString(service.TaskDefinition),
+		DesiredCount:   service.DesiredCount,
+		RunningCount:   service.RunningCount,
+		PendingCount:   service.PendingCount,
+		CreatedAt:      safeTime(service.CreatedAt),
+	}
+
+	for _, d := range service.Deployments {
+		deployment := ECSDeploymentInfo{
+			ID:                 safeString(d.Id),
+			Status:             safeString(d.Status),
+			TaskDefinition:     safeString(d.TaskDefinition),
+			LaunchType:         string(d.LaunchType),
+			DesiredCount:       d.DesiredCount,
+			RunningCount:       d.RunningCount,
+			PendingCount:       d.PendingCount,
+			FailedTasks:        d.FailedTasks,
+			RolloutState:       string(d.RolloutState),
+			RolloutStateReason: safeString(d.RolloutStateReason),
+			CreatedAt:          safeTime(d.CreatedAt),
+			UpdatedAt:          safeTime(d.UpdatedAt),
+		}
+		if deployment.Status == "PRIMARY" {
+			serviceInfo.RolloutState = deployment.RolloutState
+		}
+		serviceInfo.Deployments = append(serviceInfo.Deployments, deployment)
+	}
+
+	for _, lb := range service.LoadBalancers {
+		serviceInfo.LoadBalancers = append(serviceInfo.LoadBalancers, ECSLoadBalancerInfo{
+			TargetGroupArn:   safeString(lb.TargetGroupArn),
+			LoadBalancerName: safeString(lb.LoadBalancerName),
+			ContainerName:    safeString(lb.ContainerName),
+			ContainerPort:    safeInt32(lb.ContainerPort),
+		})
+	}
+
+	return serviceInfo
+}
+
+// FromAWSECSTask converts an AWS SDK ECS Task type to our internal model
+func FromAWSECSTask(task ecsTypes.Task) ECSTaskInfo {
+	taskInfo := ECSTaskInfo{
+		TaskArn:           safeString(task.TaskArn),
+		TaskDefinitionArn: safeString(task.TaskDefinitionArn),
+		Group:             safeString(task.Group),
+		LastStatus:        safeString(task.LastStatus),
+		DesiredStatus:     safeString(task.DesiredStatus),
+		HealthStatus:      string(task.HealthStatus),
+		LaunchType:        string(task.LaunchType),
+		AvailabilityZone:  safeString(task.AvailabilityZone),
+		CPU:               safeString(task.Cpu),
+		Memory:            safeString(task.Memory),
+		StartedAt:         safeTime(task.StartedAt),
+		StoppedAt:         safeTime(task.StoppedAt),
+		StopCode:          string(task.StopCode),
+		StoppedReason:     safeString(task.StoppedReason),
+	}
+
+	// Task definition ARNs end with "task-definition/<family>:<revision>"
+	taskInfo.Family, taskInfo.Revision = parseTaskDefinitionArn(taskInfo.TaskDefinitionArn)
+
+	for _, c := range task.Containers {
+		taskInfo.Containers = append(taskInfo.Containers, ECSContainerInfo{
+			Name:         safeString(c.Name),
+			Image:        safeString(c.Image),
+			ImageDigest:  safeString(c.ImageDigest),
+			LastStatus:   safeString(c.LastStatus),
+			HealthStatus: string(c.HealthStatus),
+			ExitCode:     c.ExitCode,
+			Reason:       safeString(c.Reason),
+		})
+	}
+
+	return taskInfo
+}
+
+// FromAWSECSTaskDefinition converts an AWS SDK ECS TaskDefinition type to our internal model
+func FromAWSECSTaskDefinition(td ecsTypes.TaskDefinition) ECSTaskDefinitionInfo {
+	tdInfo := ECSTaskDefinitionInfo{
+		Arn:              safeString(td.TaskDefinitionArn),
+		Family:           safeString(td.Family),
+		Revision:         td.Revision,
+		Status:           string(td.Status),
+		CPU:              safeString(td.Cpu),
+		Memory:           safeString(td.Memory),
+		NetworkMode:      string(td.NetworkMode),
+		TaskRoleArn:      safeString(td.TaskRoleArn),
+		ExecutionRoleArn: safeString(td.ExecutionRoleArn),
+	}
+
+	for _, c := range td.RequiresCompatibilities {
+		tdInfo.RequiresCompatibilities = append(tdInfo.RequiresCompatibilities, string(c))
+	}
+
+	for _, cd := range td.ContainerDefinitions {
+		container := ECSContainerDefinitionInfo{
+			Name:              safeString(cd.Name),
+			Image:             safeString(cd.Image),
+			CPU:               cd.Cpu,
+			Memory:            safeInt32(cd.Memory),
+			MemoryReservation: safeInt32(cd.MemoryReservation),
+			Essential:         safeBool(cd.Essential),
+		}
+
+		for _, pm := range cd.PortMappings {
+			container.PortMappings = append(container.PortMappings, fmt.Sprintf("%d/%s", safeInt32(pm.ContainerPort), pm.Protocol))
+		}
+
+		// Environment values may contain credentials, keep names only
+		for _, env := range cd.Environment {
+			container.EnvironmentVars = append(container.EnvironmentVars, safeString(env.Name))
+		}
+		sort.Strings(container.EnvironmentVars)
+
+		for _, secret := range cd.Secrets {
+			container.Secrets = append(container.Secrets, safeString(secret.Name))
+		}
+
+		if cd.LogConfiguration != nil {
+			container.LogDriver = string(cd.LogConfiguration.LogDriver)
+			container.LogOptions = make(map[string]string, len(cd.LogConfiguration.Options))
+			for name, value := range cd.LogConfiguration.Options {
+				if !safeLogOptions[name] {
+					value = redactedValue
+				}
+				container.LogOptions[name] = value
+			}
+			if cd.LogConfiguration.LogDriver == ecsTypes.LogDriverAwslogs {
+				container.LogGroup = cd.LogConfiguration.Options["awslogs-group"]
+				container.LogStreamPrefix = cd.LogConfiguration.Options["awslogs-stream-prefix"]
+			}
+		}
+
+		tdInfo.Containers = append(tdInfo.Containers, container)
+	}
+
+	return tdInfo
+}
+
+// parseTaskDefinitionArn splits a task definition ARN into its family and revision
+func parseTaskDefinitionArn(arn string) (string, string) {
+	name := arn[strings.LastIndex(arn, "/")+1:]
+	if idx := strings.LastIndex(name, ":"); idx >= 0 {
+		return name[:idx], name[idx+1:]
+	}
+	return name, ""
+}
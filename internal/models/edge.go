@@ -0,0 +1,219 @@
+package models
+
+import (
+	"math"
+	"strings"
+	"time"
+
+	acmTypes "github.com/aws/aws-sdk-go-v2/service/acm/types"
+	cloudfrontTypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	route53Types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+)
+
+// CloudFrontDistributionInfo represents a CloudFront distribution
+type CloudFrontDistributionInfo struct {
+	ID                     string
+	ARN                    string
+	DomainName             string // dxxxxxxxx.cloudfront.net
+	Aliases                []string
+	Status                 string
+	Enabled                bool
+	Origins                []CloudFrontOriginInfo
+	PriceClass             string
+	WebACLID               string // Empty when no WAF web ACL is attached
+	CertificateARN         string // ACM certificate, empty with the default CloudFront certificate
+	MinimumProtocolVersion string
+	HTTPVersion            string
+	IPv6Enabled            bool
+	Comment                string
+	LastModified           string
+}
+
+// CloudFrontOriginInfo is an origin of a distribution
+type CloudFrontOriginInfo struct {
+	ID         string
+	DomainName string
+	Path       string
+	Type       string // s3, custom or vpc
+	// Origin access control or legacy origin access identity protecting an S3 origin
+	AccessControl bool
+}
+
+// FromAWSCloudFrontDistribution converts an AWS SDK DistributionSummary type to our internal model
+func FromAWSCloudFrontDistribution(dist cloudfrontTypes.DistributionSummary) CloudFrontDistributionInfo {
+	info := CloudFrontDistributionInfo{
+		ID:           safeString(dist.Id),
+		ARN:          safeString(dist.ARN),
+		DomainName:   safeString(dist.DomainName),
+		Aliases:      make([]string, 0),
+		Status:       safeString(dist.Status),
+		Enabled:      safeBool(dist.Enabled),
+		Origins:      make([]CloudFrontOriginInfo, 0),
+		PriceClass:   string(dist.PriceClass),
+		WebACLID:     safeString(dist.WebACLId),
+		HTTPVersion:  string(dist.HttpVersion),
+		IPv6Enabled:  safeBool(dist.IsIPV6Enabled),
+		Comment:      safeString(dist.Comment),
+		LastModified: safeTime(dist.LastModifiedTime),
+	}
+
+	if dist.Aliases != nil {
+		info.Aliases = append(info.Aliases, dist.Aliases.Items...)
+	}
+	if cert := dist.ViewerCertificate; cert != nil {
+		info.CertificateARN = safeString(cert.ACMCertificateArn)
+		info.MinimumProtocolVersion = string(cert.MinimumProtocolVersion)
+	}
+	if dist.Origins != nil {
+		for _, origin := range dist.Origins.Items {
+			o := CloudFrontOriginInfo{
+				ID:            safeString(origin.Id),
+				DomainName:    safeString(origin.DomainName),
+				Path:          safeString(origin.OriginPath),
+				Type:          "custom",
+				AccessControl: safeString(origin.OriginAccessControlId) != "",
+			}
+			switch {
+			case origin.S3OriginConfig != nil:
+				o.Type = "s3"
+				o.AccessControl = o.AccessControl || safeString(origin.S3OriginConfig.OriginAccessIdentity) != ""
+			case origin.VpcOriginConfig != nil:
+				o.Type = "vpc"
+			}
+			info.Origins = append(info.Origins, o)
+		}
+	}
+
+	return info
+}
+
+// Route53HostedZoneInfo represents a Route 53 hosted zone
+type Route53HostedZoneInfo struct {
+	ID          string // Without the /hostedzone/ prefix
+	Name        string
+	Private     bool
+	RecordCount int64
+	Comment     string
+}
+
+// FromAWSRoute53HostedZone converts an AWS SDK HostedZone type to our internal model
+func FromAWSRoute53HostedZone(zone route53Types.HostedZone) Route53HostedZoneInfo {
+	info := Route53HostedZoneInfo{
+		ID:          strings.TrimPrefix(safeString(zone.Id), "/hostedzone/"),
+		Name:        safeString(zone.Name),
+		RecordCount: safeInt64(zone.ResourceRecordSetCount),
+	}
+	if zone.Config != nil {
+		info.Private = zone.Config.PrivateZone
+		info.Comment = safeString(zone.Config.Comment)
+	}
+	return info
+}
+
+// Route53RecordInfo is a record set of a hosted zone
+type Route53RecordInfo struct {
+	Name   string   `json:"name"`
+	Type   string   `json:"type"`
+	TTL    int64    `json:"ttl"`    // Zero for alias records
+	Values []string `json:"values"` // Empty for alias records
+	// Alias records only: DNS name of the AWS resource the record points at
+	AliasTarget string `json:"alias_target"`
+	// Routing policy: simple, weighted, latency, failover, geolocation or multivalue
+	Routing       string `json:"routing"`
+	SetIdentifier string `json:"set_identifier"`
+	HealthCheckID string `json:"health_check_id"`
+}
+
+// FromAWSRoute53Record converts an AWS SDK ResourceRecordSet type to our internal model.
+// Names are unescaped and the trailing dot is dropped.
+func FromAWSRoute53Record(record route53Types.ResourceRecordSet) Route53RecordInfo {
+	info := Route53RecordInfo{
+		Name:          NormalizeDNSName(safeString(record.Name)),
+		Type:          string(record.Type),
+		TTL:           safeInt64(record.TTL),
+		Values:        make([]string, 0, len(record.ResourceRecords)),
+		SetIdentifier: safeString(record.SetIdentifier),
+		HealthCheckID: safeString(record.HealthCheckId),
+		Routing:       "simple",
+	}
+
+	for _, rr := range record.ResourceRecords {
+		info.Values = append(info.Values, safeString(rr.Value))
+	}
+	if record.AliasTarget != nil {
+		info.AliasTarget = NormalizeDNSName(safeString(record.AliasTarget.DNSName))
+	}
+
+	switch {
+	case record.Weight != nil:
+		info.Routing = "weighted"
+	case record.Region != "":
+		info.Routing = "latency"
+	case record.Failover != "":
+		info.Routing = "failover"
+	case record.GeoLocation != nil || record.GeoProximityLocation != nil:
+		info.Routing = "geolocation"
+	case safeBool(record.MultiValueAnswer):
+		info.Routing = "multivalue"
+	}
+
+	return info
+}
+
+// NormalizeDNSName lowercases a DNS name, drops its trailing dot and the dualstack.
+// prefix Route 53 puts in front of load balancer alias targets, and unescapes the
+// wildcard label
+func NormalizeDNSName(name string) string {
+	name = strings.ToLower(strings.TrimSuffix(name, "."))
+	name = strings.TrimPrefix(name, "dualstack.")
+	return strings.ReplaceAll(name, `\052`, "*")
+}
+
+// ACMCertificateInfo represents an ACM certificate
+type ACMCertificateInfo struct {
+	ARN                     string
+	DomainName              string
+	SubjectAlternativeNames []string
+	Status                  string // PENDING_VALIDATION, ISSUED, EXPIRED, REVOKED, FAILED...
+	Type                    string // AMAZON_ISSUED, IMPORTED or PRIVATE
+	KeyAlgorithm            string
+	Issuer                  string
+	NotBefore               string
+	NotAfter                string
+	// Whole days until NotAfter, negative once expired. Zero when the certificate has no expiry yet.
+	DaysToExpiry       int
+	RenewalEligibility string // ELIGIBLE when ACM renews the certificate automatically
+	RenewalStatus      string
+	InUseBy            []string
+	Region             string
+}
+
+// FromAWSACMCertificate converts an AWS SDK CertificateDetail type to our internal model
+func FromAWSACMCertificate(cert acmTypes.CertificateDetail, region string, now time.Time) ACMCertificateInfo {
+	info := ACMCertificateInfo{
+		ARN:                     safeString(cert.CertificateArn),
+		DomainName:              safeString(cert.DomainName),
+		SubjectAlternativeNames: cert.SubjectAlternativeNames,
+		Status:                  string(cert.Status),
+		Type:                    string(cert.Type),
+		KeyAlgorithm:            string(cert.KeyAlgorithm),
+		Issuer:                  safeString(cert.Issuer),
+		NotBefore:               safeTime(cert.NotBefore),
+		NotAfter:                safeTime(cert.NotAfter),
+		RenewalEligibility:      string(cert.RenewalEligibility),
+		InUseBy:                 cert.InUseBy,
+		Region:                  region,
+	}
+	if info.InUseBy == nil {
+		info.InUseBy = make([]string, 0)
+	}
+
+	if cert.NotAfter != nil {
+		info.DaysToExpiry = int(math.Floor(cert.NotAfter.Sub(now).Hours() / 24))
+	}
+	if cert.RenewalSummary != nil {
+		info.RenewalStatus = string(cert.RenewalSummary.RenewalStatus)
+	}
+
+	return info
+}
@@ -0,0 +1,312 @@
+package models
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	eksTypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+)
+
+// eksVersionCalendar holds the end of standard and extended support (YYYY-MM-DD) of the
+// Kubernetes versions on EKS, as published in the Amazon EKS Kubernetes release calendar.
+// Versions released before extended support existed have no extended date.
+// Keep it in sync with the AWS documentation.
+var eksVersionCalendar = map[string][2]string{
+	"1.21": {"2023-02-16", ""},
+	"1.22": {"2023-06-04", ""},
+	"1.23": {"2023-10-11", "2024-10-11"},
+	"1.24": {"2024-01-31", "2025-01-31"},
+	"1.25": {"2024-05-01", "2025-05-01"},
+	"1.26": {"2024-06-11", "2025-06-11"},
+	"1.27": {"2024-07-24", "2025-07-24"},
+	"1.28": {"2024-11-26", "2025-11-26"},
+	"1.29": {"2025-03-23", "2026-03-23"},
+	"1.30": {"2025-07-23", "2026-07-23"},
+	"1.31": {"2025-11-26", "2026-11-26"},
+	"1.32": {"2026-03-23", "2027-03-23"},
+	"1.33": {"2026-07-29", "2027-07-29"},
+	"1.34": {"2026-12-02", "2027-12-02"},
+}
+
+// EKS support phases of a Kubernetes version
+const (
+	EKSSupportStandard    = "standard"
+	EKSSupportExtended    = "extended"
+	EKSSupportUnsupported = "unsupported"
+)
+
+// EKSVersionSupport is an entry of the bundled EKS release calendar
+type EKSVersionSupport struct {
+	Version            string `json:"version"`
+	StandardSupportEnd string `json:"standard_support_end"`
+	ExtendedSupportEnd string `json:"extended_support_end"` // Empty when the version never had extended support
+	// standard, extended or unsupported at the time of the lookup, empty for versions missing from the calendar
+	Status string `json:"status"`
+}
+
+// LookupEKSVersionSupport returns the support dates of a Kubernetes version and the
+// support phase it is in at the given time
+func LookupEKSVersionSupport(version string, now time.Time) EKSVersionSupport {
+	support := EKSVersionSupport{Version: version}
+	dates, ok := eksVersionCalendar[version]
+	if !ok {
+		return support
+	}
+
+	support.StandardSupportEnd, support.ExtendedSupportEnd = dates[0], dates[1]
+	today := now.Format("2006-01-02")
+	switch {
+	case today < support.StandardSupportEnd:
+		support.Status = EKSSupportStandard
+	case support.ExtendedSupportEnd != "" && today < support.ExtendedSupportEnd:
+		support.Status = EKSSupportExtended
+	default:
+		support.Status = EKSSupportUnsupported
+	}
+	return support
+}
+
+// EKSVersionCalendar returns the bundled release calendar, newest version first
+func EKSVersionCalendar(now time.Time) []EKSVersionSupport {
+	calendar := make([]EKSVersionSupport, 0, len(eksVersionCalendar))
+	for version := range eksVersionCalendar {
+		calendar = append(calendar, LookupEKSVersionSupport(version, now))
+	}
+	sort.Slice(calendar, func(i, j int) bool {
+		return eksMinorVersion(calendar[i].Version) > eksMinorVersion(calendar[j].Version)
+	})
+	return calendar
+}
+
+func eksMinorVersion(version string) int {
+	_, minor, _ := strings.Cut(version, ".")
+	n, _ := strconv.Atoi(minor)
+	return n
+}
+
+// EKSClusterInfo represents an EKS cluster
+type EKSClusterInfo struct {
+	Name                   string
+	ARN                    string
+	Status                 string
+	Version                string
+	PlatformVersion        string
+	Endpoint               string
+	EndpointPublicAccess   bool
+	EndpointPrivateAccess  bool
+	PublicAccessCIDRs      []string
+	VPCID                  string
+	SubnetIDs              []string
+	SecurityGroupIDs       []string
+	ClusterSecurityGroupID string
+	EnabledLogTypes        []string // api, audit, authenticator, controllerManager, scheduler
+	SecretsEncryption      bool     // Envelope encryption of Kubernetes secrets with KMS
+	SupportType            string   // STANDARD auto-upgrades at the end of standard support, EXTENDED does not
+	StandardSupportEnd     string
+	ExtendedSupportEnd     string
+	SupportStatus          string // standard, extended or unsupported, empty when the version is not in the calendar
+	CreatedAt              string
+	Tags                   map[string]string
+}
+
+// FromAWSEKSCluster converts an AWS SDK Cluster type to our internal model,
+// placing its version on the bundled release calendar at the given time
+func FromAWSEKSCluster(cluster eksTypes.Cluster, now time.Time) EKSClusterInfo {
+	info := EKSClusterInfo{
+		Name:              safeString(cluster.Name),
+		ARN:               safeString(cluster.Arn),
+		Status:            string(cluster.Status),
+		Version:           safeString(cluster.Version),
+		PlatformVersion:   safeString(cluster.PlatformVersion),
+		Endpoint:          safeString(cluster.Endpoint),
+		EnabledLogTypes:   make([]string, 0),
+		SecretsEncryption: len(cluster.EncryptionConfig) > 0,
+		CreatedAt:         safeTime(cluster.CreatedAt),
+		Tags:              cluster.Tags,
+	}
+
+	if vpc := cluster.ResourcesVpcConfig; vpc != nil {
+		info.EndpointPublicAccess = vpc.EndpointPublicAccess
+		info.EndpointPrivateAccess = vpc.EndpointPrivateAccess
+		info.PublicAccessCIDRs = vpc.PublicAccessCidrs
+		info.VPCID = safeString(vpc.VpcId)
+		info.SubnetIDs = vpc.SubnetIds
+		info.SecurityGroupIDs = vpc.SecurityGroupIds
+		info.ClusterSecurityGroupID = safeString(vpc.ClusterSecurityGroupId)
+	}
+
+	if cluster.Logging != nil {
+		for _, setup := range cluster.Logging.ClusterLogging {
+			if !safeBool(setup.Enabled) {
+				continue
+			}
+			for _, logType := range setup.Types {
+				info.EnabledLogTypes = append(info.EnabledLogTypes, string(logType))
+			}
+		}
+	}
+
+	if cluster.UpgradePolicy != nil {
+		info.SupportType = string(cluster.UpgradePolicy.SupportType)
+	}
+
+	support := LookupEKSVersionSupport(info.Version, now)
+	info.StandardSupportEnd = support.StandardSupportEnd
+	info.ExtendedSupportEnd = support.ExtendedSupportEnd
+	info.SupportStatus = support.Status
+
+	return info
+}
+
+// EKSClusterDetail holds the compute, add-ons and network footprint of a cluster
+type EKSClusterDetail struct {
+	ClusterName     string                  `json:"cluster_name"`
+	NodeGroups      []EKSNodeGroupInfo      `json:"node_groups"`
+	FargateProfiles []EKSFargateProfileInfo `json:"fargate_profiles"`
+	AddOns          []EKSAddonInfo          `json:"add_ons"`
+	// One entry per subnet used by the control plane, a node group or a Fargate profile
+	Footprint []EKSSubnetFootprint `json:"footprint"`
+	ENICount  int                  `json:"eni_count"`
+	// Calls that failed, the other sections are still filled
+	Errors []string `json:"errors"`
+}
+
+// EKSNodeGroupInfo is a managed node group of a cluster
+type EKSNodeGroupInfo struct {
+	Name              string   `json:"name"`
+	Status            string   `json:"status"`
+	Version           string   `json:"version"`
+	ReleaseVersion    string   `json:"release_version"`
+	AMIType           string   `json:"ami_type"`
+	CapacityType      string   `json:"capacity_type"` // ON_DEMAND or SPOT
+	InstanceTypes     []string `json:"instance_types"`
+	MinSize           int32    `json:"min_size"`
+	MaxSize           int32    `json:"max_size"`
+	DesiredSize       int32    `json:"desired_size"`
+	SubnetIDs         []string `json:"subnet_ids"`
+	AutoScalingGroups []string `json:"auto_scaling_groups"`
+	LaunchTemplate    string   `json:"launch_template"` // name:version
+	HealthIssues      []string `json:"health_issues"`
+}
+
+// FromAWSEKSNodeGroup converts an AWS SDK Nodegroup type to our internal model
+func FromAWSEKSNodeGroup(group eksTypes.Nodegroup) EKSNodeGroupInfo {
+	info := EKSNodeGroupInfo{
+		Name:              safeString(group.NodegroupName),
+		Status:            string(group.Status),
+		Version:           safeString(group.Version),
+		ReleaseVersion:    safeString(group.ReleaseVersion),
+		AMIType:           string(group.AmiType),
+		CapacityType:      string(group.CapacityType),
+		InstanceTypes:     group.InstanceTypes,
+		SubnetIDs:         group.Subnets,
+		AutoScalingGroups: make([]string, 0),
+		HealthIssues:      make([]string, 0),
+	}
+
+	if scaling := group.ScalingConfig; scaling != nil {
+		info.MinSize = safeInt32(scaling.MinSize)
+		info.MaxSize = safeInt32(scaling.MaxSize)
+		info.DesiredSize = safeInt32(scaling.DesiredSize)
+	}
+	if group.Resources != nil {
+		for _, asg := range group.Resources.AutoScalingGroups {
+			info.AutoScalingGroups = append(info.AutoScalingGroups, safeString(asg.Name))
+		}
+	}
+	if lt := group.LaunchTemplate; lt != nil {
+		info.LaunchTemplate = fmt.Sprintf("%s:%s", firstString(lt.Name, lt.Id), safeString(lt.Version))
+	}
+	if group.Health != nil {
+		for _, issue := range group.Health.Issues {
+			info.HealthIssues = append(info.HealthIssues, fmt.Sprintf("%s: %s", issue.Code, safeString(issue.Message)))
+		}
+	}
+
+	return info
+}
+
+// EKSFargateProfileInfo is a Fargate profile of a cluster
+type EKSFargateProfileInfo struct {
+	Name                string   `json:"name"`
+	Status              string   `json:"status"`
+	PodExecutionRoleARN string   `json:"pod_execution_role_arn"`
+	SubnetIDs           []string `json:"subnet_ids"`
+	Selectors           []string `json:"selectors"` // namespace, followed by the label selector if any
+}
+
+// FromAWSEKSFargateProfile converts an AWS SDK FargateProfile type to our internal model
+func FromAWSEKSFargateProfile(profile eksTypes.FargateProfile) EKSFargateProfileInfo {
+	info := EKSFargateProfileInfo{
+		Name:                safeString(profile.FargateProfileName),
+		Status:              string(profile.Status),
+		PodExecutionRoleARN: safeString(profile.PodExecutionRoleArn),
+		SubnetIDs:           profile.Subnets,
+		Selectors:           make([]string, 0, len(profile.Selectors)),
+	}
+
+	for _, selector := range profile.Selectors {
+		labels := make([]string, 0, len(selector.Labels))
+		for key, value := range selector.Labels {
+			labels = append(labels, key+"="+value)
+		}
+		sort.Strings(labels)
+
+		entry := safeString(selector.Namespace)
+		if len(labels) > 0 {
+			entry += " {" + strings.Join(labels, ", ") + "}"
+		}
+		info.Selectors = append(info.Selectors, entry)
+	}
+
+	return info
+}
+
+// EKSAddonInfo is an EKS managed add-on installed on a cluster
+type EKSAddonInfo struct {
+	Name         string   `json:"name"`
+	Version      string   `json:"version"`
+	Status       string   `json:"status"`
+	HealthIssues []string `json:"health_issues"`
+}
+
+// FromAWSEKSAddon converts an AWS SDK Addon type to our internal model
+func FromAWSEKSAddon(addon eksTypes.Addon) EKSAddonInfo {
+	info := EKSAddonInfo{
+		Name:         safeString(addon.AddonName),
+		Version:      safeString(addon.AddonVersion),
+		Status:       string(addon.Status),
+		HealthIssues: make([]string, 0),
+	}
+
+	if addon.Health != nil {
+		for _, issue := range addon.Health.Issues {
+			info.HealthIssues = append(info.HealthIssues, fmt.Sprintf("%s: %s", issue.Code, safeString(issue.Message)))
+		}
+	}
+
+	return info
+}
+
+// EKSSubnetFootprint is the network usage of a cluster in one subnet
+type EKSSubnetFootprint struct {
+	SubnetID         string   `json:"subnet_id"`
+	AvailabilityZone string   `json:"availability_zone"`
+	UsedBy           []string `json:"used_by"` // control-plane, nodegroup/<name>, fargate/<name>
+	ClusterENIs      int      `json:"cluster_enis"`
+	TotalENIs        int      `json:"total_enis"`
+	AvailableIPs     int32    `json:"available_ips"`
+}
+
+// IsEKSClusterENI reports whether an interface was created for a cluster: the control
+// plane interfaces carry the cluster name in their description, the interfaces attached
+// by the VPC CNI plugin are tagged with it
+func IsEKSClusterENI(eni NetworkInterfaceInfo, cluster string) bool {
+	if eni.Description == "Amazon EKS "+cluster {
+		return true
+	}
+	return eni.Tags["cluster.k8s.amazonaws.com/name"] == cluster || eni.Tags["eks:cluster-name"] == cluster
+}
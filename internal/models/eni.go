@@ -0,0 +1,123 @@
+package models
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// NetworkInterfaceInfo represents an elastic network interface (ENI)
+type NetworkInterfaceInfo struct {
+	ID               string
+	Description      string
+	InterfaceType    string // interface, nat_gateway, lambda, network_load_balancer, vpc_endpoint...
+	Status           string
+	RequesterID      string
+	RequesterManaged bool
+	// Service that created the interface: EC2, Lambda, ELB, NAT Gateway, RDS, EKS, ECS...
+	Requester        string
+	InstanceID       string
+	AttachmentStatus string
+	PrivateIPs       []string
+	PublicIPs        []string
+	SecurityGroupIDs []string
+	SubnetID         string
+	VPCID            string
+	AvailabilityZone string
+	MacAddress       string
+	Tags             map[string]string
+}
+
+// IPOwner is a resource holding a looked up IP address
+type IPOwner struct {
+	ResourceType string `json:"resource_type"` // eni, eip, ec2, load-balancer, nat-gateway
+	ResourceID   string `json:"resource_id"`
+	Name         string `json:"name"`
+	Requester    string `json:"requester"`
+	Detail       string `json:"detail"`
+}
+
+// IPLookupResult lists every resource found holding an IP address
+type IPLookupResult struct {
+	IP     string    `json:"ip"`
+	Owners []IPOwner `json:"owners"`
+	// Sources that could not be searched, e.g. for missing permissions
+	Errors []string `json:"errors"`
+}
+
+// FromAWSNetworkInterface converts an AWS SDK NetworkInterface type to our internal model
+func FromAWSNetworkInterface(eni types.NetworkInterface) NetworkInterfaceInfo {
+	info := NetworkInterfaceInfo{
+		ID:               safeString(eni.NetworkInterfaceId),
+		Description:      safeString(eni.Description),
+		InterfaceType:    string(eni.InterfaceType),
+		Status:           string(eni.Status),
+		RequesterID:      safeString(eni.RequesterId),
+		RequesterManaged: safeBool(eni.RequesterManaged),
+		SubnetID:         safeString(eni.SubnetId),
+		VPCID:            safeString(eni.VpcId),
+		AvailabilityZone: safeString(eni.AvailabilityZone),
+		MacAddress:       safeString(eni.MacAddress),
+		Tags:             fromEC2Tags(eni.TagSet),
+	}
+
+	if eni.Attachment != nil {
+		info.InstanceID = safeString(eni.Attachment.InstanceId)
+		info.AttachmentStatus = string(eni.Attachment.Status)
+	}
+
+	for _, addr := range eni.PrivateIpAddresses {
+		info.PrivateIPs = append(info.PrivateIPs, safeString(addr.PrivateIpAddress))
+		if addr.Association != nil && addr.Association.PublicIp != nil {
+			info.PublicIPs = append(info.PublicIPs, *addr.Association.PublicIp)
+		}
+	}
+	for _, addr := range eni.Ipv6Addresses {
+		info.PrivateIPs = append(info.PrivateIPs, safeString(addr.Ipv6Address))
+	}
+	if len(info.PublicIPs) == 0 && eni.Association != nil && eni.Association.PublicIp != nil {
+		info.PublicIPs = append(info.PublicIPs, *eni.Association.PublicIp)
+	}
+
+	for _, group := range eni.Groups {
+		info.SecurityGroupIDs = append(info.SecurityGroupIDs, safeString(group.GroupId))
+	}
+
+	info.Requester = classifyENIRequester(info)
+	return info
+}
+
+// classifyENIRequester infers the service owning an interface from its type,
+// requester and the description AWS services give the interfaces they create
+func classifyENIRequester(eni NetworkInterfaceInfo) string {
+	desc := eni.Description
+
+	switch {
+	case eni.InterfaceType == string(types.NetworkInterfaceTypeNatGateway) || strings.HasPrefix(desc, "Interface for NAT Gateway"):
+		return "NAT Gateway"
+	case eni.InterfaceType == string(types.NetworkInterfaceTypeLambda) || strings.HasPrefix(desc, "AWS Lambda VPC ENI"):
+		return "Lambda"
+	case eni.InterfaceType == string(types.NetworkInterfaceTypeNetworkLoadBalancer) || strings.HasPrefix(desc, "ELB "):
+		return "ELB"
+	case eni.InterfaceType == string(types.NetworkInterfaceTypeVpcEndpoint) || strings.HasPrefix(desc, "VPC Endpoint Interface"):
+		return "VPC Endpoint"
+	case eni.InterfaceType == string(types.NetworkInterfaceTypeTransitGateway):
+		return "Transit Gateway"
+	case strings.HasPrefix(desc, "RDSNetworkInterface") || eni.RequesterID == "amazon-rds":
+		return "RDS"
+	case strings.HasPrefix(desc, "Amazon EKS"):
+		return "EKS"
+	case strings.HasPrefix(desc, "arn:aws:ecs:") || strings.Contains(desc, "ecs-task"):
+		return "ECS"
+	case strings.HasPrefix(desc, "ElastiCache"):
+		return "ElastiCache"
+	case strings.HasPrefix(desc, "EFS mount target"):
+		return "EFS"
+	case eni.InstanceID != "":
+		return "EC2"
+	case eni.RequesterManaged:
+		return eni.RequesterID
+	default:
+		return "Unattached"
+	}
+}
@@ -0,0 +1,153 @@
+package models
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// FlowLogInfo represents a VPC flow log attached to a VPC, subnet or network interface
+type FlowLogInfo struct {
+	ID              string
+	Name            string
+	ResourceID      string
+	ResourceType    string // VPC, Subnet, NetworkInterface, TransitGateway...
+	TrafficType     string // ACCEPT, REJECT or ALL
+	DestinationType string // cloud-watch-logs, s3, kinesis-data-firehose
+	Destination     string
+	LogGroup        string // Set when delivered to CloudWatch Logs
+	LogFormat       string
+	Status          string
+	DeliverStatus   string
+	DeliverError    string
+	CreatedAt       string
+}
+
+// FlowLogTalker is a source/destination pair and the traffic between them
+type FlowLogTalker struct {
+	Source      string `json:"source"`
+	Destination string `json:"destination"`
+	Bytes       int64  `json:"bytes"`
+	Packets     int64  `json:"packets"`
+}
+
+// FlowLogRejected is a rejected source/destination/port combination
+type FlowLogRejected struct {
+	Source      string `json:"source"`
+	Destination string `json:"destination"`
+	Port        string `json:"port"`
+	Protocol    string `json:"protocol"`
+	Flows       int64  `json:"flows"`
+}
+
+// FlowLogPortBytes is the traffic sent to a destination port
+type FlowLogPortBytes struct {
+	Port     string `json:"port"`
+	Protocol string `json:"protocol"`
+	Bytes    int64  `json:"bytes"`
+}
+
+// FlowLogSummary is the top-talkers analysis of a VPC flow log over a time window
+type FlowLogSummary struct {
+	VPCID       string             `json:"vpc_id"`
+	FlowLogID   string             `json:"flow_log_id"`
+	LogGroup    string             `json:"log_group"`
+	Start       string             `json:"start"` // RFC3339
+	End         string             `json:"end"`   // RFC3339
+	TopTalkers  []FlowLogTalker    `json:"top_talkers"`
+	Rejected    []FlowLogRejected  `json:"rejected"`
+	BytesByPort []FlowLogPortBytes `json:"bytes_by_port"`
+	// Queries that failed, the other sections are still filled
+	Errors []string `json:"errors"`
+}
+
+// FromAWSFlowLog converts an AWS SDK FlowLog type to our internal model
+func FromAWSFlowLog(fl types.FlowLog) FlowLogInfo {
+	return FlowLogInfo{
+		ID:              safeString(fl.FlowLogId),
+		Name:            nameTag(fl.Tags),
+		ResourceID:      safeString(fl.ResourceId),
+		ResourceType:    FlowLogResourceType(safeString(fl.ResourceId)),
+		TrafficType:     string(fl.TrafficType),
+		DestinationType: string(fl.LogDestinationType),
+		Destination:     safeString(fl.LogDestination),
+		LogGroup:        safeString(fl.LogGroupName),
+		LogFormat:       safeString(fl.LogFormat),
+		Status:          safeString(fl.FlowLogStatus),
+		DeliverStatus:   safeString(fl.DeliverLogsStatus),
+		DeliverError:    safeString(fl.DeliverLogsErrorMessage),
+		CreatedAt:       safeTime(fl.CreationTime),
+	}
+}
+
+// FlowLogResourceType derives the kind of resource a flow log is attached to from its ID
+func FlowLogResourceType(resourceID string) string {
+	switch {
+	case strings.HasPrefix(resourceID, "vpc-"):
+		return "VPC"
+	case strings.HasPrefix(resourceID, "subnet-"):
+		return "Subnet"
+	case strings.HasPrefix(resourceID, "eni-"):
+		return "NetworkInterface"
+	case strings.HasPrefix(resourceID, "tgw-attach-"):
+		return "TransitGatewayAttachment"
+	case strings.HasPrefix(resourceID, "tgw-"):
+		return "TransitGateway"
+	}
+	return "Unknown"
+}
+
+// ProtocolName maps an IANA protocol number from a flow log record to its name
+func ProtocolName(number string) string {
+	switch number {
+	case "1":
+		return "ICMP"
+	case "6":
+		return "TCP"
+	case "17":
+		return "UDP"
+	case "58":
+		return "ICMPv6"
+	}
+	return number
+}
+
+// FlowLogTalkerFromRow builds a FlowLogTalker from a Logs Insights result row
+func FlowLogTalkerFromRow(row map[string]string) FlowLogTalker {
+	return FlowLogTalker{
+		Source:      row["srcAddr"],
+		Destination: row["dstAddr"],
+		Bytes:       parseCount(row["bytes"]),
+		Packets:     parseCount(row["packets"]),
+	}
+}
+
+// FlowLogRejectedFromRow builds a FlowLogRejected from a Logs Insights result row
+func FlowLogRejectedFromRow(row map[string]string) FlowLogRejected {
+	return FlowLogRejected{
+		Source:      row["srcAddr"],
+		Destination: row["dstAddr"],
+		Port:        row["dstPort"],
+		Protocol:    ProtocolName(row["protocol"]),
+		Flows:       parseCount(row["flows"]),
+	}
+}
+
+// FlowLogPortBytesFromRow builds a FlowLogPortBytes from a Logs Insights result row
+func FlowLogPortBytesFromRow(row map[string]string) FlowLogPortBytes {
+	return FlowLogPortBytes{
+		Port:     row["dstPort"],
+		Protocol: ProtocolName(row["protocol"]),
+		Bytes:    parseCount(row["bytes"]),
+	}
+}
+
+// parseCount parses a Logs Insights aggregate, which may be rendered as a float
+func parseCount(value string) int64 {
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0
+	}
+	return int64(f)
+}
@@ -0,0 +1,224 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	iamTypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+
+	"aws-terminal-sdk-v1/internal/constants"
+)
+
+// CredentialReportRootUser is the user name of the root account in the credential report
+const CredentialReportRootUser = "<root_account>"
+
+// IAMHygieneRequest sets the thresholds of the IAM hygiene report, in days. Zero values
+// use the defaults from constants.
+type IAMHygieneRequest struct {
+	AccessKeyMaxAgeDays int `json:"access_key_max_age_days"`
+	AccessKeyUnusedDays int `json:"access_key_unused_days"`
+	ConsoleInactiveDays int `json:"console_inactive_days"`
+	RoleUnusedDays      int `json:"role_unused_days"`
+}
+
+// WithDefaults returns the request with its unset thresholds set to the defaults
+func (r IAMHygieneRequest) WithDefaults() IAMHygieneRequest {
+	if r.AccessKeyMaxAgeDays <= 0 {
+		r.AccessKeyMaxAgeDays = constants.IAMAccessKeyMaxAgeDays
+	}
+	if r.AccessKeyUnusedDays <= 0 {
+		r.AccessKeyUnusedDays = constants.IAMAccessKeyUnusedDays
+	}
+	if r.ConsoleInactiveDays <= 0 {
+		r.ConsoleInactiveDays = constants.IAMConsoleInactiveDays
+	}
+	if r.RoleUnusedDays <= 0 {
+		r.RoleUnusedDays = constants.IAMRoleUnusedDays
+	}
+	return r
+}
+
+// IAMUserInfo represents an IAM user, or the root account, from the credential report
+type IAMUserInfo struct {
+	UserName            string
+	ARN                 string
+	Root                bool
+	CreatedAt           string
+	AgeDays             int
+	PasswordEnabled     bool
+	PasswordLastUsed    string
+	PasswordLastChanged string
+	DaysSinceLogin      int // -1 when the password was never used
+	MFAActive           bool
+	AccessKeys          []IAMAccessKeyInfo
+	Issues              []string
+}
+
+// IAMAccessKeyInfo is one of the two access key slots of a user
+type IAMAccessKeyInfo struct {
+	Slot            int // 1 or 2
+	Active          bool
+	LastRotated     string
+	AgeDays         int
+	LastUsed        string
+	LastUsedService string
+	DaysSinceUse    int // -1 when the key was never used
+}
+
+// IAMUserFromCredentialReport converts a row of the credential report, keyed by
+// column name, to our internal model
+func IAMUserFromCredentialReport(row map[string]string) IAMUserInfo {
+	created := parseReportTime(row["user_creation_time"])
+	lastLogin := parseReportTime(row["password_last_used"])
+	user := IAMUserInfo{
+		UserName:            row["user"],
+		ARN:                 row["arn"],
+		Root:                row["user"] == CredentialReportRootUser,
+		CreatedAt:           safeTime(created),
+		AgeDays:             ageDays(created),
+		PasswordEnabled:     row["password_enabled"] == "true",
+		PasswordLastUsed:    safeTime(lastLogin),
+		PasswordLastChanged: safeTime(parseReportTime(row["password_last_changed"])),
+		DaysSinceLogin:      daysSince(lastLogin),
+		MFAActive:           row["mfa_active"] == "true",
+		AccessKeys:          make([]IAMAccessKeyInfo, 0, 2),
+		Issues:              make([]string, 0),
+	}
+	// The root account reports password_enabled as not_supported, it can always sign in
+	if user.Root {
+		user.PasswordEnabled = true
+	}
+
+	for slot := 1; slot <= 2; slot++ {
+		prefix := "access_key_" + strconv.Itoa(slot) + "_"
+		rotated := parseReportTime(row[prefix+"last_rotated"])
+		// Slots that never held a key have no rotation date
+		if rotated == nil {
+			continue
+		}
+		lastUsed := parseReportTime(row[prefix+"last_used_date"])
+		service := row[prefix+"last_used_service"]
+		if service == "N/A" {
+			service = ""
+		}
+		user.AccessKeys = append(user.AccessKeys, IAMAccessKeyInfo{
+			Slot:            slot,
+			Active:          row[prefix+"active"] == "true",
+			LastRotated:     safeTime(rotated),
+			AgeDays:         ageDays(rotated),
+			LastUsed:        safeTime(lastUsed),
+			LastUsedService: service,
+			DaysSinceUse:    daysSince(lastUsed),
+		})
+	}
+	return user
+}
+
+// IAMRoleInfo represents an IAM role with its last use
+type IAMRoleInfo struct {
+	RoleName       string
+	ARN            string
+	Path           string
+	Description    string
+	CreatedAt      string
+	AgeDays        int
+	LastUsed       string
+	LastUsedRegion string
+	DaysSinceUse   int  // -1 when the role was never used within the IAM tracking period
+	ServiceLinked  bool // Managed by an AWS service, not flagged
+	Issues         []string
+}
+
+// FromAWSRole converts an AWS SDK Role type, as returned by GetRole, to our internal model
+func FromAWSRole(role iamTypes.Role) IAMRoleInfo {
+	info := IAMRoleInfo{
+		RoleName:      safeString(role.RoleName),
+		ARN:           safeString(role.Arn),
+		Path:          safeString(role.Path),
+		Description:   safeString(role.Description),
+		CreatedAt:     safeTime(role.CreateDate),
+		AgeDays:       ageDays(role.CreateDate),
+		DaysSinceUse:  -1,
+		ServiceLinked: strings.HasPrefix(safeString(role.Path), "/aws-service-role/"),
+		Issues:        make([]string, 0),
+	}
+	if lastUsed := role.RoleLastUsed; lastUsed != nil {
+		info.LastUsed = safeTime(lastUsed.LastUsedDate)
+		info.LastUsedRegion = safeString(lastUsed.Region)
+		info.DaysSinceUse = daysSince(lastUsed.LastUsedDate)
+	}
+	return info
+}
+
+// PasswordPolicyGaps lists where the account password policy falls short of the CIS
+// recommendations; policy is nil when the account has none
+func PasswordPolicyGaps(policy *iamTypes.PasswordPolicy) []string {
+	if policy == nil {
+		return []string{"No password policy set, IAM defaults apply"}
+	}
+
+	gaps := make([]string, 0)
+	if length := safeInt32(policy.MinimumPasswordLength); length < constants.PasswordPolicyMinLength {
+		gaps = append(gaps, fmt.Sprintf("Minimum length is %d, below %d", length, constants.PasswordPolicyMinLength))
+	}
+	if !policy.RequireUppercaseCharacters {
+		gaps = append(gaps, "Uppercase letters not required")
+	}
+	if !policy.RequireLowercaseCharacters {
+		gaps = append(gaps, "Lowercase letters not required")
+	}
+	if !policy.RequireNumbers {
+		gaps = append(gaps, "Numbers not required")
+	}
+	if !policy.RequireSymbols {
+		gaps = append(gaps, "Symbols not required")
+	}
+	if maxAge := safeInt32(policy.MaxPasswordAge); !policy.ExpirePasswords || maxAge == 0 {
+		gaps = append(gaps, "Passwords never expire")
+	} else if maxAge > constants.PasswordPolicyMaxAgeDays {
+		gaps = append(gaps, fmt.Sprintf("Passwords expire after %d days, above %d", maxAge, constants.PasswordPolicyMaxAgeDays))
+	}
+	if reuse := safeInt32(policy.PasswordReusePrevention); reuse == 0 {
+		gaps = append(gaps, "Password reuse not prevented")
+	} else if reuse < constants.PasswordPolicyReusePrevented {
+		gaps = append(gaps, fmt.Sprintf("Only the last %d passwords cannot be reused, below %d", reuse, constants.PasswordPolicyReusePrevented))
+	}
+	return gaps
+}
+
+// IAMHygieneReport gathers the users, roles and password policy issues of the account
+type IAMHygieneReport struct {
+	// When the credential report the users come from was generated, IAM caches it for 4 hours
+	GeneratedAt          string            `json:"generated_at"`
+	Thresholds           IAMHygieneRequest `json:"thresholds"`
+	Users                []IAMUserInfo     `json:"users"`
+	Roles                []IAMRoleInfo     `json:"roles"`
+	PasswordPolicyGaps   []string          `json:"password_policy_gaps"`
+	UsersWithoutMFA      int               `json:"users_without_mfa"`
+	OldAccessKeys        int               `json:"old_access_keys"`
+	UnusedAccessKeys     int               `json:"unused_access_keys"`
+	InactiveConsoleUsers int               `json:"inactive_console_users"`
+	UnusedRoles          int               `json:"unused_roles"`
+	Findings             []SecurityFinding `json:"findings"`
+	Errors               []string          `json:"errors"`
+}
+
+// parseReportTime parses a credential report date; N/A, no_information and
+// not_supported all give nil
+func parseReportTime(value string) *time.Time {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil
+	}
+	return &t
+}
+
+// daysSince is ageDays, but -1 for a nil time, telling never from today
+func daysSince(t *time.Time) int {
+	if t == nil {
+		return -1
+	}
+	return ageDays(t)
+}
@@ -0,0 +1,330 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+)
+
+// PolicyDocument is a parsed IAM identity policy
+type PolicyDocument struct {
+	Version    string
+	Statements []PolicyStatement
+}
+
+// PolicyStatement is a statement of a policy, with its single or list values flattened
+type PolicyStatement struct {
+	Sid          string
+	Effect       string
+	Action       []string
+	NotAction    []string
+	Resource     []string
+	NotResource  []string
+	Principal    []string // Of resource policies, such as key policies
+	HasCondition bool
+	JSON         string // The statement as written, reported with the risks it carries
+}
+
+// policyDocumentJSON is the policy grammar: Statement is either a statement or a list
+type policyDocumentJSON struct {
+	Version   string          `json:"Version"`
+	Statement json.RawMessage `json:"Statement"`
+}
+
+type policyStatementJSON struct {
+	Sid         string          `json:"Sid"`
+	Effect      string          `json:"Effect"`
+	Action      json.RawMessage `json:"Action"`
+	NotAction   json.RawMessage `json:"NotAction"`
+	Resource    json.RawMessage `json:"Resource"`
+	NotResource json.RawMessage `json:"NotResource"`
+	Principal   json.RawMessage `json:"Principal"`
+	Condition   json.RawMessage `json:"Condition"`
+}
+
+// ParsePolicyDocument parses an IAM policy document, URL-encoded as IAM returns it or not
+func ParsePolicyDocument(document string) (*PolicyDocument, error) {
+	if decoded, err := url.QueryUnescape(document); err == nil && !strings.HasPrefix(strings.TrimSpace(document), "{") {
+		document = decoded
+	}
+
+	var doc policyDocumentJSON
+	if err := json.Unmarshal([]byte(document), &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse policy document: %w", err)
+	}
+
+	raws := make([]json.RawMessage, 0)
+	if err := json.Unmarshal(doc.Statement, &raws); err != nil {
+		raws = []json.RawMessage{doc.Statement}
+	}
+
+	parsed := &PolicyDocument{Version: doc.Version, Statements: make([]PolicyStatement, 0, len(raws))}
+	for _, raw := range raws {
+		var stmt policyStatementJSON
+		if err := json.Unmarshal(raw, &stmt); err != nil {
+			return nil, fmt.Errorf("failed to parse policy statement: %w", err)
+		}
+
+		compact := new(bytes.Buffer)
+		if err := json.Compact(compact, raw); err != nil {
+			compact.Write(raw)
+		}
+		parsed.Statements = append(parsed.Statements, PolicyStatement{
+			Sid:          stmt.Sid,
+			Effect:       stmt.Effect,
+			Action:       policyValues(stmt.Action),
+			NotAction:    policyValues(stmt.NotAction),
+			Resource:     policyValues(stmt.Resource),
+			NotResource:  policyValues(stmt.NotResource),
+			Principal:    policyValues(stmt.Principal),
+			HasCondition: len(stmt.Condition) > 0 && string(stmt.Condition) != "null",
+			JSON:         compact.String(),
+		})
+	}
+	return parsed, nil
+}
+
+// Allows tells whether the statement allows action, a service:Action name. Deny
+// statements allow nothing.
+func (s PolicyStatement) Allows(action string) bool {
+	if s.Effect != "Allow" {
+		return false
+	}
+	if len(s.NotAction) > 0 {
+		return !matchesAny(s.NotAction, action)
+	}
+	return matchesAny(s.Action, action)
+}
+
+// AnyResource tells whether the statement applies to every resource
+func (s PolicyStatement) AnyResource() bool {
+	return len(s.NotResource) > 0 || containsString(s.Resource, "*")
+}
+
+// wildcardResource tells whether the statement applies to resources matched by a wildcard
+func (s PolicyStatement) wildcardResource() bool {
+	if len(s.NotResource) > 0 {
+		return true
+	}
+	for _, resource := range s.Resource {
+		if strings.Contains(resource, "*") {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesAny matches an action against IAM action patterns, case-insensitively with
+// the * and ? wildcards
+func matchesAny(patterns []string, action string) bool {
+	action = strings.ToLower(action)
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(strings.ToLower(pattern), action); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// sensitiveServices grant access to credentials, keys, secrets or data when allowed
+// on every resource
+var sensitiveServices = []string{"iam", "sts", "kms", "secretsmanager", "ssm", "s3", "organizations"}
+
+// privilegeEscalations are the action sets that let a principal grant itself more
+// permissions, directly or through a role it can pass to a service
+var privilegeEscalations = []struct {
+	risk    string
+	actions []string
+}{
+	{"Can create a new default version of a managed policy", []string{"iam:CreatePolicyVersion"}},
+	{"Can switch a managed policy to another version", []string{"iam:SetDefaultPolicyVersion"}},
+	{"Can create access keys for other users", []string{"iam:CreateAccessKey"}},
+	{"Can set the console password of other users", []string{"iam:CreateLoginProfile"}},
+	{"Can change the console password of other users", []string{"iam:UpdateLoginProfile"}},
+	{"Can attach managed policies to users", []string{"iam:AttachUserPolicy"}},
+	{"Can attach managed policies to groups", []string{"iam:AttachGroupPolicy"}},
+	{"Can attach managed policies to roles", []string{"iam:AttachRolePolicy"}},
+	{"Can write inline policies of users", []string{"iam:PutUserPolicy"}},
+	{"Can write inline policies of groups", []string{"iam:PutGroupPolicy"}},
+	{"Can write inline policies of roles", []string{"iam:PutRolePolicy"}},
+	{"Can add users to groups", []string{"iam:AddUserToGroup"}},
+	{"Can rewrite a role trust policy and assume the role", []string{"iam:UpdateAssumeRolePolicy", "sts:AssumeRole"}},
+	{"Can pass a role to a new EC2 instance", []string{"iam:PassRole", "ec2:RunInstances"}},
+	{"Can pass a role to a new Lambda function and invoke it", []string{"iam:PassRole", "lambda:CreateFunction", "lambda:InvokeFunction"}},
+	{"Can pass a role to a new Lambda function triggered by an event source", []string{"iam:PassRole", "lambda:CreateFunction", "lambda:CreateEventSourceMapping"}},
+	{"Can replace the code of Lambda functions", []string{"lambda:UpdateFunctionCode"}},
+	{"Can pass a role to a new Glue development endpoint", []string{"iam:PassRole", "glue:CreateDevEndpoint"}},
+	{"Can pass a role to a new CloudFormation stack", []string{"iam:PassRole", "cloudformation:CreateStack"}},
+	{"Can pass a role to a new Data Pipeline", []string{"iam:PassRole", "datapipeline:CreatePipeline", "datapipeline:PutPipelineDefinition"}},
+}
+
+// PrincipalPolicy is a policy in effect for a principal
+type PrincipalPolicy struct {
+	Name     string
+	ARN      string // Empty for inline policies
+	Via      string // "attached", "inline", or "group <name>" for the policies of a group
+	Document *PolicyDocument
+}
+
+// PolicyRisk is a risky permission found in the policies of a principal
+type PolicyRisk struct {
+	Risk     string `json:"risk"`
+	Severity string `json:"severity"` // CRITICAL, HIGH or MEDIUM
+	Policy   string `json:"policy"`   // Policies joined with ", " for combinations
+	Via      string `json:"via"`
+	// The offending statements, one JSON statement per line for combinations
+	Statement string `json:"statement"`
+	// The statement has conditions, which may narrow the grant; they are not evaluated
+	Conditional bool `json:"conditional"`
+}
+
+// grantedStatement is a statement with the policy it comes from
+type grantedStatement struct {
+	policy    PrincipalPolicy
+	statement PolicyStatement
+}
+
+// AnalyzePrincipalPolicies flags full administrator access, Allow statements with
+// NotAction, iam:PassRole on every role, sensitive services allowed on every resource
+// and privilege escalation combinations over all the policies of a principal. Deny
+// statements and conditions are not evaluated.
+func AnalyzePrincipalPolicies(policies []PrincipalPolicy) []PolicyRisk {
+	risks := make([]PolicyRisk, 0)
+	add := func(risk, severity string, g grantedStatement) {
+		risks = append(risks, PolicyRisk{
+			Risk:        risk,
+			Severity:    severity,
+			Policy:      g.policy.Name,
+			Via:         g.policy.Via,
+			Statement:   g.statement.JSON,
+			Conditional: g.statement.HasCondition,
+		})
+	}
+
+	admin := false
+	allows := make([]grantedStatement, 0)
+	for _, policy := range policies {
+		if policy.Document == nil {
+			continue
+		}
+		for _, stmt := range policy.Document.Statements {
+			if stmt.Effect != "Allow" {
+				continue
+			}
+			g := grantedStatement{policy: policy, statement: stmt}
+			allows = append(allows, g)
+
+			if (containsString(stmt.Action, "*") || containsString(stmt.Action, "*:*")) && stmt.AnyResource() {
+				add("Full administrator access (*:*)", "CRITICAL", g)
+				admin = true
+				continue
+			}
+			if len(stmt.NotAction) > 0 {
+				add(fmt.Sprintf("Allow with NotAction grants every action but %s", strings.Join(stmt.NotAction, ", ")), "HIGH", g)
+			}
+			if stmt.Allows("iam:PassRole") && stmt.AnyResource() {
+				add("iam:PassRole on every role", "HIGH", g)
+			}
+			if services := sensitiveWildcards(stmt); len(services) > 0 {
+				add(fmt.Sprintf("%s actions allowed on every resource", strings.Join(services, ", ")), "MEDIUM", g)
+			}
+		}
+	}
+
+	// Every combination is reachable with full access, which is already reported
+	if admin {
+		return risks
+	}
+
+	for _, escalation := range privilegeEscalations {
+		granting := make([]grantedStatement, 0, len(escalation.actions))
+		for _, action := range escalation.actions {
+			found := false
+			for _, g := range allows {
+				if g.statement.Allows(action) && g.statement.wildcardResource() {
+					granting = append(granting, g)
+					found = true
+					break
+				}
+			}
+			if !found {
+				break
+			}
+		}
+		if len(granting) < len(escalation.actions) {
+			continue
+		}
+
+		risks = append(risks, combinedRisk(escalation.risk, granting))
+	}
+	return risks
+}
+
+// combinedRisk reports a privilege escalation with the distinct statements granting it
+func combinedRisk(risk string, granting []grantedStatement) PolicyRisk {
+	combined := PolicyRisk{Risk: risk, Severity: "HIGH"}
+	policies := make([]string, 0, len(granting))
+	via := make([]string, 0, len(granting))
+	statements := make([]string, 0, len(granting))
+	for _, g := range granting {
+		if !containsString(statements, g.statement.JSON) {
+			statements = append(statements, g.statement.JSON)
+			combined.Conditional = combined.Conditional || g.statement.HasCondition
+		}
+		if !containsString(policies, g.policy.Name) {
+			policies = append(policies, g.policy.Name)
+		}
+		if !containsString(via, g.policy.Via) {
+			via = append(via, g.policy.Via)
+		}
+	}
+	combined.Policy = strings.Join(policies, ", ")
+	combined.Via = strings.Join(via, ", ")
+	combined.Statement = strings.Join(statements, "\n")
+	return combined
+}
+
+// sensitiveWildcards returns the sensitive services a statement allows on every
+// resource, leaving out the List and Describe actions that only work on *
+func sensitiveWildcards(stmt PolicyStatement) []string {
+	if !stmt.AnyResource() || len(stmt.NotAction) > 0 {
+		return nil
+	}
+
+	services := make([]string, 0)
+	for _, action := range stmt.Action {
+		service, name, found := strings.Cut(strings.ToLower(action), ":")
+		if !found || !containsString(sensitiveServices, service) || containsString(services, service) {
+			continue
+		}
+		if !strings.ContainsAny(name, "*?") && (strings.HasPrefix(name, "list") || strings.HasPrefix(name, "describe")) {
+			continue
+		}
+		services = append(services, service)
+	}
+	sort.Strings(services)
+	return services
+}
+
+// PrincipalPolicyReport holds the risks found in the policies of a user, group or role
+type PrincipalPolicyReport struct {
+	Name     string       `json:"name"`
+	ARN      string       `json:"arn"`
+	Type     string       `json:"type"`     // user, group or role
+	Policies []string     `json:"policies"` // Names of the policies in effect
+	Risks    []PolicyRisk `json:"risks"`
+}
+
+// PolicyAnalysis is the outcome of the analysis of every identity policy of the account
+type PolicyAnalysis struct {
+	// Only the principals with risks are listed
+	Principals         []PrincipalPolicyReport `json:"principals"`
+	PrincipalsAnalyzed int                     `json:"principals_analyzed"`
+	PoliciesAnalyzed   int                     `json:"policies_analyzed"`
+	Findings           []SecurityFinding       `json:"findings"`
+	Errors             []string                `json:"errors"`
+}
@@ -0,0 +1,268 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	lambdaTypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+)
+
+// lambdaRuntimeDeprecations holds the deprecation date (YYYY-MM-DD) of the Lambda runtimes,
+// as published in the AWS Lambda runtime deprecation policy. Runtimes without a
+// scheduled date are absent. Keep it in sync with the AWS documentation.
+var lambdaRuntimeDeprecations = map[string]string{
+	"nodejs":         "2016-10-31",
+	"nodejs4.3":      "2020-03-05",
+	"nodejs4.3-edge": "2019-04-30",
+	"nodejs6.10":     "2019-08-12",
+	"nodejs8.10":     "2020-03-06",
+	"nodejs10.x":     "2021-07-30",
+	"nodejs12.x":     "2023-03-31",
+	"nodejs14.x":     "2023-12-04",
+	"nodejs16.x":     "2024-06-12",
+	"nodejs18.x":     "2025-09-01",
+	"nodejs20.x":     "2026-04-30",
+	"nodejs22.x":     "2027-04-30",
+	"python2.7":      "2021-07-15",
+	"python3.6":      "2022-07-18",
+	"python3.7":      "2023-12-04",
+	"python3.8":      "2024-10-14",
+	"python3.9":      "2025-12-15",
+	"python3.10":     "2026-06-30",
+	"python3.11":     "2026-06-30",
+	"python3.12":     "2028-10-31",
+	"python3.13":     "2029-06-30",
+	"ruby2.5":        "2021-07-30",
+	"ruby2.7":        "2023-12-07",
+	"ruby3.2":        "2026-03-31",
+	"ruby3.3":        "2026-03-31",
+	"java8":          "2024-01-08",
+	"java8.al2":      "2026-06-30",
+	"java11":         "2026-06-30",
+	"java17":         "2026-06-30",
+	"java21":         "2029-06-30",
+	"go1.x":          "2024-01-08",
+	"provided":       "2024-01-08",
+	"provided.al2":   "2026-06-30",
+	"dotnetcore1.0":  "2019-07-30",
+	"dotnetcore2.0":  "2019-05-30",
+	"dotnetcore2.1":  "2022-01-05",
+	"dotnetcore3.1":  "2023-04-03",
+	"dotnet5.0":      "2022-05-10",
+	"dotnet6":        "2024-12-20",
+	"dotnet7":        "2024-05-14",
+	"dotnet8":        "2026-11-10",
+}
+
+// LambdaRuntimeDeprecation returns the deprecation date of a runtime and whether it has
+// passed at the given time. The date is empty for runtimes without a scheduled deprecation.
+func LambdaRuntimeDeprecation(runtime string, now time.Time) (string, bool) {
+	date, ok := lambdaRuntimeDeprecations[runtime]
+	if !ok {
+		return "", false
+	}
+	return date, now.Format("2006-01-02") >= date
+}
+
+// LambdaFunctionDetail holds the per-function configuration that needs extra API calls
+type LambdaFunctionDetail struct {
+	FunctionName string `json:"function_name"`
+	// Nil when the function draws from the unreserved account pool
+	ReservedConcurrency    *int32                         `json:"reserved_concurrency"`
+	ProvisionedConcurrency []LambdaProvisionedConcurrency `json:"provisioned_concurrency"`
+	Aliases                []LambdaAliasInfo              `json:"aliases"`
+	Versions               []LambdaVersionInfo            `json:"versions"`
+	EventSources           []LambdaEventSourceInfo        `json:"event_sources"`
+	Triggers               []LambdaTriggerInfo            `json:"triggers"`
+	// Calls that failed, the other sections are still filled
+	Errors []string `json:"errors"`
+}
+
+// LambdaProvisionedConcurrency is the provisioned concurrency of an alias or version
+type LambdaProvisionedConcurrency struct {
+	Qualifier string `json:"qualifier"`
+	Requested int32  `json:"requested"`
+	Allocated int32  `json:"allocated"`
+	Available int32  `json:"available"`
+	Status    string `json:"status"` // IN_PROGRESS, READY, FAILED
+	Reason    string `json:"reason"`
+}
+
+// LambdaAliasInfo is an alias and the versions it routes to
+type LambdaAliasInfo struct {
+	Name        string `json:"name"`
+	Version     string `json:"version"`
+	Description string `json:"description"`
+	// Weighted routing to an additional version, for canary deployments
+	AdditionalVersionWeights map[string]float64 `json:"additional_version_weights"`
+}
+
+// LambdaVersionInfo is a published version of a function
+type LambdaVersionInfo struct {
+	Version      string `json:"version"`
+	Description  string `json:"description"`
+	Runtime      string `json:"runtime"`
+	CodeSha256   string `json:"code_sha256"`
+	LastModified string `json:"last_modified"`
+}
+
+// LambdaEventSourceInfo is an event source mapping polling a queue or stream for the function
+type LambdaEventSourceInfo struct {
+	UUID           string `json:"uuid"`
+	SourceARN      string `json:"source_arn"`
+	SourceType     string `json:"source_type"` // sqs, kinesis, dynamodb, kafka, mq...
+	State          string `json:"state"`
+	BatchSize      int32  `json:"batch_size"`
+	LastResult     string `json:"last_result"`
+	StateReason    string `json:"state_reason"`
+	LastModified   string `json:"last_modified"`
+	MaximumRetries int32  `json:"maximum_retries"`
+}
+
+// LambdaTriggerInfo is a statement of the function resource policy allowing a service or
+// account to invoke it
+type LambdaTriggerInfo struct {
+	Sid       string `json:"sid"`
+	Principal string `json:"principal"` // Service (s3.amazonaws.com) or account
+	Action    string `json:"action"`
+	SourceARN string `json:"source_arn"`
+	Account   string `json:"account"`
+}
+
+// FromAWSProvisionedConcurrency converts an AWS SDK ProvisionedConcurrencyConfigListItem to our internal model
+func FromAWSProvisionedConcurrency(config lambdaTypes.ProvisionedConcurrencyConfigListItem) LambdaProvisionedConcurrency {
+	arn := safeString(config.FunctionArn)
+	return LambdaProvisionedConcurrency{
+		Qualifier: arn[strings.LastIndex(arn, ":")+1:],
+		Requested: safeInt32(config.RequestedProvisionedConcurrentExecutions),
+		Allocated: safeInt32(config.AllocatedProvisionedConcurrentExecutions),
+		Available: safeInt32(config.AvailableProvisionedConcurrentExecutions),
+		Status:    string(config.Status),
+		Reason:    safeString(config.StatusReason),
+	}
+}
+
+// FromAWSLambdaAlias converts an AWS SDK AliasConfiguration type to our internal model
+func FromAWSLambdaAlias(alias lambdaTypes.AliasConfiguration) LambdaAliasInfo {
+	info := LambdaAliasInfo{
+		Name:        safeString(alias.Name),
+		Version:     safeString(alias.FunctionVersion),
+		Description: safeString(alias.Description),
+	}
+	if alias.RoutingConfig != nil {
+		info.AdditionalVersionWeights = alias.RoutingConfig.AdditionalVersionWeights
+	}
+	return info
+}
+
+// FromAWSLambdaVersion converts the configuration of a published version to our internal model
+func FromAWSLambdaVersion(fn lambdaTypes.FunctionConfiguration) LambdaVersionInfo {
+	return LambdaVersionInfo{
+		Version:      safeString(fn.Version),
+		Description:  safeString(fn.Description),
+		Runtime:      string(fn.Runtime),
+		CodeSha256:   safeString(fn.CodeSha256),
+		LastModified: safeString(fn.LastModified),
+	}
+}
+
+// FromAWSEventSourceMapping converts an AWS SDK EventSourceMappingConfiguration type to our internal model
+func FromAWSEventSourceMapping(mapping lambdaTypes.EventSourceMappingConfiguration) LambdaEventSourceInfo {
+	sourceARN := safeString(mapping.EventSourceArn)
+	return LambdaEventSourceInfo{
+		UUID:           safeString(mapping.UUID),
+		SourceARN:      sourceARN,
+		SourceType:     arnService(sourceARN),
+		State:          safeString(mapping.State),
+		BatchSize:      safeInt32(mapping.BatchSize),
+		LastResult:     safeString(mapping.LastProcessingResult),
+		StateReason:    safeString(mapping.StateTransitionReason),
+		LastModified:   safeTime(mapping.LastModified),
+		MaximumRetries: safeInt32(mapping.MaximumRetryAttempts),
+	}
+}
+
+// arnService returns the service part of an ARN (arn:partition:service:...)
+func arnService(arn string) string {
+	parts := strings.SplitN(arn, ":", 4)
+	if len(parts) < 3 {
+		return ""
+	}
+	return parts[2]
+}
+
+// lambdaPolicy is the subset of a function resource policy needed to list its triggers
+type lambdaPolicy struct {
+	Statement []struct {
+		Sid       string                            `json:"Sid"`
+		Effect    string                            `json:"Effect"`
+		Principal json.RawMessage                   `json:"Principal"`
+		Action    json.RawMessage                   `json:"Action"`
+		Condition map[string]map[string]interface{} `json:"Condition"`
+	} `json:"Statement"`
+}
+
+// ParseLambdaPolicyTriggers lists the Allow statements of a function resource policy
+func ParseLambdaPolicyTriggers(policy string) ([]LambdaTriggerInfo, error) {
+	var doc lambdaPolicy
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse function policy: %w", err)
+	}
+
+	triggers := make([]LambdaTriggerInfo, 0, len(doc.Statement))
+	for _, stmt := range doc.Statement {
+		if stmt.Effect != "Allow" {
+			continue
+		}
+
+		trigger := LambdaTriggerInfo{
+			Sid:       stmt.Sid,
+			Principal: strings.Join(policyValues(stmt.Principal), ", "),
+			Action:    strings.Join(policyValues(stmt.Action), ", "),
+		}
+		for _, operator := range stmt.Condition {
+			for key, value := range operator {
+				switch strings.ToLower(key) {
+				case "aws:sourcearn":
+					trigger.SourceARN = fmt.Sprint(value)
+				case "aws:sourceaccount":
+					trigger.Account = fmt.Sprint(value)
+				}
+			}
+		}
+		triggers = append(triggers, trigger)
+	}
+
+	return triggers, nil
+}
+
+// policyValues flattens a policy element that is either a string, a list of strings or
+// a map of lists such as {"Service": "s3.amazonaws.com"}
+func policyValues(raw json.RawMessage) []string {
+	if len(raw) == 0 {
+		return nil
+	}
+
+	var single string
+	if err := json.Unmarshal(raw, &single); err == nil {
+		return []string{single}
+	}
+	var list []string
+	if err := json.Unmarshal(raw, &list); err == nil {
+		return list
+	}
+
+	var byType map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &byType); err != nil {
+		return nil
+	}
+	values := make([]string, 0, len(byType))
+	for _, v := range byType {
+		values = append(values, policyValues(v)...)
+	}
+	sort.Strings(values)
+	return values
+}
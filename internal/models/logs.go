@@ -0,0 +1,120 @@
+package models
+
+import (
+	"time"
+
+	logsTypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+
+	"aws-terminal-sdk-v1/internal/constants"
+)
+
+// LogGroupInfo represents a CloudWatch Logs log group
+type LogGroupInfo struct {
+	Name            string `json:"name"`
+	ARN             string `json:"arn"`
+	CreatedAt       string `json:"created_at"`
+	RetentionInDays int32  `json:"retention_in_days"` // 0 means never expire
+	StoredBytes     int64  `json:"stored_bytes"`
+	KMSKeyID        string `json:"kms_key_id"`
+	LogGroupClass   string `json:"log_group_class"`
+}
+
+// LogStreamInfo represents a log stream of a log group
+type LogStreamInfo struct {
+	Name           string `json:"name"`
+	CreatedAt      string `json:"created_at"`
+	FirstEventAt   string `json:"first_event_at"`
+	LastEventAt    string `json:"last_event_at"`
+	LastIngestedAt string `json:"last_ingested_at"`
+}
+
+// LogEvent represents a single log line
+type LogEvent struct {
+	ID        string `json:"id"`
+	Stream    string `json:"stream"`
+	Timestamp int64  `json:"timestamp"` // Milliseconds since epoch
+	Time      string `json:"time"`
+	Message   string `json:"message"`
+}
+
+// LogTailRequest selects the events to live-tail
+type LogTailRequest struct {
+	LogGroup      string `json:"log_group"`
+	LogStream     string `json:"log_stream"`     // Optional, tails the whole group when empty
+	FilterPattern string `json:"filter_pattern"` // CloudWatch Logs filter pattern syntax
+}
+
+// LogTailBatch is emitted to the frontend for each batch of new tailed events
+type LogTailBatch struct {
+	TailID string     `json:"tail_id"`
+	Events []LogEvent `json:"events"`
+	Error  string     `json:"error"`
+}
+
+// LogsInsightsRequest is a Logs Insights query over one or more log groups
+type LogsInsightsRequest struct {
+	LogGroups []string `json:"log_groups"`
+	Query     string   `json:"query"`
+	Start     string   `json:"start"` // RFC3339, defaults to one hour before End
+	End       string   `json:"end"`   // RFC3339, defaults to now
+	Limit     int32    `json:"limit"`
+}
+
+// LogsInsightsResult holds the rows of a Logs Insights query, one field map per row
+type LogsInsightsResult struct {
+	QueryID        string              `json:"query_id"`
+	Status         string              `json:"status"` // Scheduled, Running, Complete, Failed, Cancelled, Timeout
+	Rows           []map[string]string `json:"rows"`
+	RecordsMatched float64             `json:"records_matched"`
+	RecordsScanned float64             `json:"records_scanned"`
+	BytesScanned   float64             `json:"bytes_scanned"`
+}
+
+// FromAWSLogGroup converts a CloudWatch Logs log group to LogGroupInfo
+func FromAWSLogGroup(group logsTypes.LogGroup) LogGroupInfo {
+	info := LogGroupInfo{
+		Name:            safeString(group.LogGroupName),
+		ARN:             safeString(group.Arn),
+		CreatedAt:       safeMillis(group.CreationTime),
+		RetentionInDays: safeInt32(group.RetentionInDays),
+		KMSKeyID:        safeString(group.KmsKeyId),
+		LogGroupClass:   string(group.LogGroupClass),
+	}
+	if group.StoredBytes != nil {
+		info.StoredBytes = *group.StoredBytes
+	}
+	return info
+}
+
+// FromAWSLogStream converts a CloudWatch Logs log stream to LogStreamInfo
+func FromAWSLogStream(stream logsTypes.LogStream) LogStreamInfo {
+	return LogStreamInfo{
+		Name:           safeString(stream.LogStreamName),
+		CreatedAt:      safeMillis(stream.CreationTime),
+		FirstEventAt:   safeMillis(stream.FirstEventTimestamp),
+		LastEventAt:    safeMillis(stream.LastEventTimestamp),
+		LastIngestedAt: safeMillis(stream.LastIngestionTime),
+	}
+}
+
+// FromAWSFilteredLogEvent converts a filtered log event to LogEvent
+func FromAWSFilteredLogEvent(event logsTypes.FilteredLogEvent) LogEvent {
+	e := LogEvent{
+		ID:      safeString(event.EventId),
+		Stream:  safeString(event.LogStreamName),
+		Time:    safeMillis(event.Timestamp),
+		Message: safeString(event.Message),
+	}
+	if event.Timestamp != nil {
+		e.Timestamp = *event.Timestamp
+	}
+	return e
+}
+
+// safeMillis formats an epoch milliseconds pointer, returning an empty string for nil
+func safeMillis(ms *int64) string {
+	if ms == nil {
+		return ""
+	}
+	return time.UnixMilli(*ms).Format(constants.DateTimeFormat)
+}
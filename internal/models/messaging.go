@@ -0,0 +1,152 @@
+package models
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	snsTypes "github.com/aws/aws-sdk-go-v2/service/sns/types"
+
+	"aws-terminal-sdk-v1/internal/constants"
+)
+
+// SQSQueueInfo represents an SQS queue
+type SQSQueueInfo struct {
+	QueueName         string
+	QueueURL          string
+	ARN               string
+	FIFO              bool
+	Messages          int64 // Visible messages, the queue depth
+	MessagesInFlight  int64 // Received but not yet deleted
+	MessagesDelayed   int64
+	OldestMessageAge  int64  // Seconds, from CloudWatch; -1 when unavailable
+	VisibilityTimeout int64  // Seconds
+	RetentionPeriod   int64  // Seconds
+	DeadLetterQueue   string // ARN of the queue receiving failed messages
+	MaxReceiveCount   int64
+	SourceQueues      []string // ARNs of the queues using this one as dead-letter queue
+	Encryption        string   // SSE-SQS, SSE-KMS or empty
+	KMSKeyID          string
+	CreatedAt         string
+}
+
+// SNSTopicInfo represents an SNS topic and its subscriptions
+type SNSTopicInfo struct {
+	TopicName               string
+	ARN                     string
+	DisplayName             string
+	FIFO                    bool
+	KMSKeyID                string
+	SubscriptionsConfirmed  int64
+	SubscriptionsPending    int64
+	SubscriptionsByProtocol map[string]int // sqs, lambda, email, https...
+	Subscriptions           []SNSSubscriptionInfo
+}
+
+// SNSSubscriptionInfo is a subscription of an SNS topic
+type SNSSubscriptionInfo struct {
+	ARN                 string
+	Protocol            string
+	Endpoint            string
+	PendingConfirmation bool
+}
+
+// sqsRedrivePolicy is the JSON RedrivePolicy attribute of a queue
+type sqsRedrivePolicy struct {
+	DeadLetterTargetArn string          `json:"deadLetterTargetArn"`
+	MaxReceiveCount     json.RawMessage `json:"maxReceiveCount"` // A number or a string depending on how it was set
+}
+
+// FromSQSQueueAttributes builds an SQSQueueInfo from the attributes returned by GetQueueAttributes
+func FromSQSQueueAttributes(queueURL string, attributes map[string]string) SQSQueueInfo {
+	info := SQSQueueInfo{
+		QueueName:         queueURL[strings.LastIndex(queueURL, "/")+1:],
+		QueueURL:          queueURL,
+		ARN:               attributes["QueueArn"],
+		FIFO:              attributes["FifoQueue"] == "true",
+		Messages:          parseAttributeInt(attributes["ApproximateNumberOfMessages"]),
+		MessagesInFlight:  parseAttributeInt(attributes["ApproximateNumberOfMessagesNotVisible"]),
+		MessagesDelayed:   parseAttributeInt(attributes["ApproximateNumberOfMessagesDelayed"]),
+		OldestMessageAge:  -1,
+		VisibilityTimeout: parseAttributeInt(attributes["VisibilityTimeout"]),
+		RetentionPeriod:   parseAttributeInt(attributes["MessageRetentionPeriod"]),
+		KMSKeyID:          attributes["KmsMasterKeyId"],
+	}
+
+	switch {
+	case info.KMSKeyID != "":
+		info.Encryption = "SSE-KMS"
+	case attributes["SqsManagedSseEnabled"] == "true":
+		info.Encryption = "SSE-SQS"
+	}
+
+	if created := parseAttributeInt(attributes["CreatedTimestamp"]); created > 0 {
+		info.CreatedAt = time.Unix(created, 0).Format(constants.DateTimeFormat)
+	}
+
+	if raw := attributes["RedrivePolicy"]; raw != "" {
+		var policy sqsRedrivePolicy
+		if err := json.Unmarshal([]byte(raw), &policy); err == nil {
+			info.DeadLetterQueue = policy.DeadLetterTargetArn
+			info.MaxReceiveCount = parseAttributeInt(strings.Trim(string(policy.MaxReceiveCount), `"`))
+		}
+	}
+
+	return info
+}
+
+// LinkDeadLetterQueues fills SourceQueues of the queues used as dead-letter queue by others
+func LinkDeadLetterQueues(queues []SQSQueueInfo) {
+	byARN := make(map[string]int, len(queues))
+	for i, q := range queues {
+		byARN[q.ARN] = i
+	}
+	for _, q := range queues {
+		if i, ok := byARN[q.DeadLetterQueue]; ok && q.DeadLetterQueue != "" {
+			queues[i].SourceQueues = append(queues[i].SourceQueues, q.ARN)
+		}
+	}
+}
+
+// FromSNSTopicAttributes builds an SNSTopicInfo from the attributes returned by GetTopicAttributes
+func FromSNSTopicAttributes(topicARN string, attributes map[string]string) SNSTopicInfo {
+	return SNSTopicInfo{
+		TopicName:               topicARN[strings.LastIndex(topicARN, ":")+1:],
+		ARN:                     topicARN,
+		DisplayName:             attributes["DisplayName"],
+		FIFO:                    attributes["FifoTopic"] == "true",
+		KMSKeyID:                attributes["KmsMasterKeyId"],
+		SubscriptionsConfirmed:  parseAttributeInt(attributes["SubscriptionsConfirmed"]),
+		SubscriptionsPending:    parseAttributeInt(attributes["SubscriptionsPending"]),
+		SubscriptionsByProtocol: make(map[string]int),
+	}
+}
+
+// AddSubscriptions attaches subscriptions of the topic and counts them by protocol
+func (t *SNSTopicInfo) AddSubscriptions(subscriptions []snsTypes.Subscription) {
+	for _, sub := range subscriptions {
+		arn := safeString(sub.SubscriptionArn)
+		info := SNSSubscriptionInfo{
+			ARN:                 arn,
+			Protocol:            safeString(sub.Protocol),
+			Endpoint:            safeString(sub.Endpoint),
+			PendingConfirmation: arn == "PendingConfirmation",
+		}
+		t.Subscriptions = append(t.Subscriptions, info)
+		t.SubscriptionsByProtocol[info.Protocol]++
+	}
+	sort.Slice(t.Subscriptions, func(i, j int) bool {
+		return t.Subscriptions[i].Protocol < t.Subscriptions[j].Protocol
+	})
+}
+
+// parseAttributeInt parses a numeric SQS or SNS attribute, 0 when absent
+func parseAttributeInt(value string) int64 {
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return n
+}
@@ -0,0 +1,34 @@
+package models
+
+// MetricQuery describes a single CloudWatch metric statistic or metric math expression
+type MetricQuery struct {
+	ID         string            `json:"id"` // Must start with a lowercase letter
+	Label      string            `json:"label"`
+	Namespace  string            `json:"namespace"`
+	MetricName string            `json:"metric_name"`
+	Dimensions map[string]string `json:"dimensions"`
+	Stat       string            `json:"stat"` // Average, Sum, Maximum, Minimum, p50, p90, p99...
+	Period     int32             `json:"period"`
+	Expression string            `json:"expression"` // Metric math, e.g. "errors_sum/invocations_sum*100"
+	Hidden     bool              `json:"hidden"`     // Only used as input to an expression
+}
+
+// MetricExpression is a metric math expression over the metrics of a MetricRequest
+type MetricExpression struct {
+	ID         string `json:"id"`
+	Label      string `json:"label"`
+	Expression string `json:"expression"`
+}
+
+// MetricRequest is a custom metrics query for a single resource.
+// Metric IDs are "<metric>_<stat>" in lowercase (e.g. "targetresponsetime_p99")
+// so expressions can reference them.
+type MetricRequest struct {
+	ResourceType string             `json:"resource_type"`
+	ResourceID   string             `json:"resource_id"`
+	Start        string             `json:"start"`  // RFC3339, defaults to 24 hours before End
+	End          string             `json:"end"`    // RFC3339, defaults to now
+	Period       int32              `json:"period"` // Seconds, derived from the window when 0
+	Stats        []string           `json:"stats"`  // Defaults to each metric's catalog statistic
+	Expressions  []MetricExpression `json:"expressions"`
+}
@@ -1,6 +1,11 @@
 package models
 
 import (
+	"sort"
+	"time"
+
+	"aws-terminal-sdk-v1/internal/constants"
+
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	ecsTypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
 	lambdaTypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
@@ -72,18 +77,65 @@ func FromAWSEC2Instance(instance types.Instance) EC2InstanceInfo {
 		KeyName:          safeString(instance.KeyName),
 		Architecture:     string(instance.Architecture),
 		Platform:         Platform,
+		Tags:             fromEC2Tags(instance.Tags),
+		ImageID:          safeString(instance.ImageId),
+		Lifecycle:        "on-demand",
+		EBSOptimized:     safeBool(instance.EbsOptimized),
+		RootDeviceName:   safeString(instance.RootDeviceName),
+	}
+
+	// Lifecycle is only set for non on-demand instances
+	if instance.InstanceLifecycle != "" {
+		instanceInfo.Lifecycle = string(instance.InstanceLifecycle)
 	}
 
 	// Extract Security Groups
 	for _, sg := range instance.SecurityGroups {
 		instanceInfo.SecurityGroups = append(instanceInfo.SecurityGroups, safeString(sg.GroupName))
+		instanceInfo.SecurityGroupIDs = append(instanceInfo.SecurityGroupIDs, safeString(sg.GroupId))
 	}
 
-	// Extract Name from tags
+	if instance.IamInstanceProfile != nil {
+		instanceInfo.IAMInstanceProfile = safeString(instance.IamInstanceProfile.Arn)
+	}
+	if opts := instance.MetadataOptions; opts != nil {
+		instanceInfo.IMDSv2Required = opts.HttpTokens == types.HttpTokensStateRequired
+		instanceInfo.IMDSEnabled = opts.HttpEndpoint != types.InstanceMetadataEndpointStateDisabled
+		instanceInfo.IMDSHopLimit = safeInt32(opts.HttpPutResponseHopLimit)
+	}
+	if instance.Monitoring != nil {
+		instanceInfo.Monitoring = string(instance.Monitoring.State)
+	}
+	if placement := instance.Placement; placement != nil {
+		instanceInfo.AvailabilityZone = safeString(placement.AvailabilityZone)
+		instanceInfo.Tenancy = string(placement.Tenancy)
+		instanceInfo.PlacementGroup = safeString(placement.GroupName)
+	}
+	if instance.StateReason != nil {
+		instanceInfo.StateReason = safeString(instance.StateReason.Message)
+	}
+
+	for _, bd := range instance.BlockDeviceMappings {
+		device := EC2BlockDeviceInfo{DeviceName: safeString(bd.DeviceName)}
+		if bd.Ebs != nil {
+			device.VolumeID = safeString(bd.Ebs.VolumeId)
+			device.Status = string(bd.Ebs.Status)
+			device.DeleteOnTermination = safeBool(bd.Ebs.DeleteOnTermination)
+			device.AttachTime = safeTime(bd.Ebs.AttachTime)
+		}
+		instanceInfo.BlockDevices = append(instanceInfo.BlockDevices, device)
+	}
+
+	// Extract Name and Auto Scaling group from tags
 	for _, tag := range instance.Tags {
-		if tag.Key != nil && *tag.Key == "Name" {
+		if tag.Key == nil {
+			continue
+		}
+		switch *tag.Key {
+		case constants.TagName:
 			instanceInfo.Name = safeString(tag.Value)
-			break
+		case constants.TagAutoScalingGroup:
+			instanceInfo.AutoScalingGroup = safeString(tag.Value)
 		}
 	}
 
@@ -193,19 +245,34 @@ func FromAWSElasticIP(addr types.Address) ElasticIPInfo {
 
 // LambdaFunctionInfo represents an AWS Lambda Function
 type LambdaFunctionInfo struct {
-	FunctionName string
-	Runtime      string
-	MemorySize   int32
-	LastModified string
-	Handler      string
-	Description  string
-	Arn          string
-	State        string
+	FunctionName     string
+	Runtime          string
+	MemorySize       int32
+	LastModified     string
+	Handler          string
+	Description      string
+	Arn              string
+	State            string
+	LogGroup         string
+	Timeout          int32 // Seconds
+	Architecture     string
+	PackageType      string // Zip or Image
+	CodeSize         int64
+	Role             string
+	Layers           []string // Layer version ARNs
+	VPCID            string
+	SubnetIDs        []string
+	SecurityGroupIDs []string
+	// Names of the environment variables, values are never returned
+	EnvVarNames []string
+	// Deprecation date of the runtime, empty when none is scheduled
+	RuntimeDeprecationDate string
+	DeprecatedRuntime      bool
 }
 
 // FromAWSLambdaFunction converts an AWS SDK Lambda Function type to our internal model
 func FromAWSLambdaFunction(fn lambdaTypes.FunctionConfiguration) LambdaFunctionInfo {
-	return LambdaFunctionInfo{
+	info := LambdaFunctionInfo{
 		FunctionName: safeString(fn.FunctionName),
 		Runtime:      string(fn.Runtime),
 		MemorySize:   safeInt32(fn.MemorySize),
@@ -214,7 +281,38 @@ func FromAWSLambdaFunction(fn lambdaTypes.FunctionConfiguration) LambdaFunctionI
 		Description:  safeString(fn.Description),
 		Arn:          safeString(fn.FunctionArn),
 		State:        string(fn.State),
+		LogGroup:     "/aws/lambda/" + safeString(fn.FunctionName),
+		Timeout:      safeInt32(fn.Timeout),
+		PackageType:  string(fn.PackageType),
+		CodeSize:     fn.CodeSize,
+		Role:         safeString(fn.Role),
+	}
+
+	// Functions can log to a custom group instead of the default one
+	if fn.LoggingConfig != nil && fn.LoggingConfig.LogGroup != nil {
+		info.LogGroup = *fn.LoggingConfig.LogGroup
+	}
+
+	if len(fn.Architectures) > 0 {
+		info.Architecture = string(fn.Architectures[0])
+	}
+	for _, layer := range fn.Layers {
+		info.Layers = append(info.Layers, safeString(layer.Arn))
+	}
+	if vpc := fn.VpcConfig; vpc != nil {
+		info.VPCID = safeString(vpc.VpcId)
+		info.SubnetIDs = vpc.SubnetIds
+		info.SecurityGroupIDs = vpc.SecurityGroupIds
 	}
+	if fn.Environment != nil {
+		for name := range fn.Environment.Variables {
+			info.EnvVarNames = append(info.EnvVarNames, name)
+		}
+		sort.Strings(info.EnvVarNames)
+	}
+	info.RuntimeDeprecationDate, info.DeprecatedRuntime = LambdaRuntimeDeprecation(info.Runtime, time.Now())
+
+	return info
 }
 
 // safeInt32 safely dereferences an int32 pointer
@@ -225,6 +323,14 @@ func safeInt32(i *int32) int32 {
 	return *i
 }
 
+// safeTime safely formats a time pointer, returning an empty string for nil
+func safeTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(constants.DateTimeFormat)
+}
+
 // RDSInstanceInfo represents an AWS RDS Instance
 type RDSInstanceInfo struct {
 	DBInstanceIdentifier string
@@ -239,6 +345,7 @@ type RDSInstanceInfo struct {
 	MultiAZ              bool
 	PubliclyAccessible   bool
 	MasterUsername       string
+	DBClusterIdentifier  string // Set for Aurora and Multi-AZ DB cluster members
 }
 
 // FromAWSRDSInstance converts an AWS SDK RDS Instance type to our internal model
@@ -266,6 +373,7 @@ func FromAWSRDSInstance(db rdsTypes.DBInstance) RDSInstanceInfo {
 		MultiAZ:              safeBool(db.MultiAZ),
 		PubliclyAccessible:   safeBool(db.PubliclyAccessible),
 		MasterUsername:       safeString(db.MasterUsername),
+		DBClusterIdentifier:  safeString(db.DBClusterIdentifier),
 	}
 }
 
@@ -277,9 +385,10 @@ type AWSCredentials struct {
 
 // MetricData represents a single metric's time series data
 type MetricData struct {
+	ID     string    `json:"id"`
 	Label  string    `json:"label"`
 	Values []float64 `json:"values"`
-	Times  []string  `json:"times"`
+	Times  []string  `json:"times"` // RFC3339, newest first
 }
 
 // ResourceMetrics represents a collection of metrics for a resource
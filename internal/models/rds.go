@@ -0,0 +1,266 @@
+package models
+
+import (
+	"strings"
+	"time"
+
+	rdsTypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+)
+
+// RDSClusterInfo represents an Aurora or Multi-AZ DB cluster
+type RDSClusterInfo struct {
+	DBClusterIdentifier   string
+	ARN                   string
+	Engine                string
+	EngineVersion         string
+	EngineMode            string // provisioned, serverless (v1), parallelquery, global, multimaster
+	Status                string
+	Endpoint              string // Writer endpoint
+	ReaderEndpoint        string
+	CustomEndpoints       []string
+	Port                  int32
+	MultiAZ               bool
+	WriterInstance        string
+	Members               []RDSClusterMemberInfo
+	ServerlessV2MinACU    float64 // Zero unless Serverless v2 capacity is configured
+	ServerlessV2MaxACU    float64
+	BacktrackWindow       int64 // Seconds, zero when backtracking is disabled
+	EarliestBacktrackTime string
+	BackupRetentionPeriod int32
+	ParameterGroup        string
+	SubnetGroup           string
+	StorageEncrypted      bool
+	DeletionProtection    bool
+	CreatedAt             string
+	Tags                  map[string]string
+}
+
+// RDSClusterMemberInfo is a DB instance of a cluster
+type RDSClusterMemberInfo struct {
+	DBInstanceIdentifier string
+	IsWriter             bool
+	PromotionTier        int32
+	ParameterGroupStatus string // in-sync, pending-reboot, applying
+}
+
+// RDSSnapshotInfo represents a DB instance or DB cluster snapshot
+type RDSSnapshotInfo struct {
+	Identifier       string
+	ARN              string
+	SourceIdentifier string // DB instance or DB cluster the snapshot was taken from
+	IsCluster        bool
+	Type             string // automated, manual, shared, public, awsbackup
+	Status           string
+	Engine           string
+	EngineVersion    string
+	AllocatedStorage int32
+	Encrypted        bool
+	CreatedAt        string
+	AgeDays          int
+}
+
+// RDSParameterGroupInfo is a DB or DB cluster parameter group and the parameters
+// changed from the engine defaults
+type RDSParameterGroupInfo struct {
+	Name               string
+	Family             string
+	Description        string
+	IsCluster          bool
+	IsDefault          bool // Default groups cannot be modified
+	ModifiedParameters []RDSParameterInfo
+}
+
+// RDSParameterInfo is a parameter set by the user in a parameter group
+type RDSParameterInfo struct {
+	Name        string
+	Value       string
+	ApplyType   string // static or dynamic
+	ApplyMethod string // immediate or pending-reboot
+}
+
+// RDSPendingMaintenanceInfo is a maintenance action pending on a DB instance or cluster
+type RDSPendingMaintenanceInfo struct {
+	ResourceARN      string `json:"resource_arn"`
+	Action           string `json:"action"` // system-update, db-upgrade, hardware-maintenance, ca-certificate-rotation...
+	Description      string `json:"description"`
+	AutoAppliedAfter string `json:"auto_applied_after"`
+	ForcedApplyDate  string `json:"forced_apply_date"`
+	CurrentApplyDate string `json:"current_apply_date"`
+	OptInStatus      string `json:"opt_in_status"`
+}
+
+// RDSEventInfo is an event emitted by RDS for a DB instance, cluster, snapshot or parameter group
+type RDSEventInfo struct {
+	SourceIdentifier string   `json:"source_identifier"`
+	SourceType       string   `json:"source_type"`
+	Message          string   `json:"message"`
+	Categories       []string `json:"categories"`
+	Date             string   `json:"date"`
+}
+
+// FromAWSDBCluster converts an AWS SDK DBCluster type to our internal model
+func FromAWSDBCluster(cluster rdsTypes.DBCluster) RDSClusterInfo {
+	info := RDSClusterInfo{
+		DBClusterIdentifier:   safeString(cluster.DBClusterIdentifier),
+		ARN:                   safeString(cluster.DBClusterArn),
+		Engine:                safeString(cluster.Engine),
+		EngineVersion:         safeString(cluster.EngineVersion),
+		EngineMode:            safeString(cluster.EngineMode),
+		Status:                safeString(cluster.Status),
+		Endpoint:              safeString(cluster.Endpoint),
+		ReaderEndpoint:        safeString(cluster.ReaderEndpoint),
+		CustomEndpoints:       cluster.CustomEndpoints,
+		Port:                  safeInt32(cluster.Port),
+		MultiAZ:               safeBool(cluster.MultiAZ),
+		EarliestBacktrackTime: safeTime(cluster.EarliestBacktrackTime),
+		BackupRetentionPeriod: safeInt32(cluster.BackupRetentionPeriod),
+		ParameterGroup:        safeString(cluster.DBClusterParameterGroup),
+		SubnetGroup:           safeString(cluster.DBSubnetGroup),
+		StorageEncrypted:      safeBool(cluster.StorageEncrypted),
+		DeletionProtection:    safeBool(cluster.DeletionProtection),
+		CreatedAt:             safeTime(cluster.ClusterCreateTime),
+		Tags:                  make(map[string]string),
+	}
+
+	if cluster.BacktrackWindow != nil {
+		info.BacktrackWindow = *cluster.BacktrackWindow
+	}
+	if sv2 := cluster.ServerlessV2ScalingConfiguration; sv2 != nil {
+		if sv2.MinCapacity != nil {
+			info.ServerlessV2MinACU = *sv2.MinCapacity
+		}
+		if sv2.MaxCapacity != nil {
+			info.ServerlessV2MaxACU = *sv2.MaxCapacity
+		}
+	}
+
+	for _, member := range cluster.DBClusterMembers {
+		memberInfo := RDSClusterMemberInfo{
+			DBInstanceIdentifier: safeString(member.DBInstanceIdentifier),
+			IsWriter:             safeBool(member.IsClusterWriter),
+			PromotionTier:        safeInt32(member.PromotionTier),
+			ParameterGroupStatus: safeString(member.DBClusterParameterGroupStatus),
+		}
+		if memberInfo.IsWriter {
+			info.WriterInstance = memberInfo.DBInstanceIdentifier
+		}
+		info.Members = append(info.Members, memberInfo)
+	}
+
+	for _, tag := range cluster.TagList {
+		if tag.Key != nil && tag.Value != nil {
+			info.Tags[*tag.Key] = *tag.Value
+		}
+	}
+
+	return info
+}
+
+// FromAWSDBSnapshot converts an AWS SDK DBSnapshot type to our internal model
+func FromAWSDBSnapshot(snapshot rdsTypes.DBSnapshot) RDSSnapshotInfo {
+	return RDSSnapshotInfo{
+		Identifier:       safeString(snapshot.DBSnapshotIdentifier),
+		ARN:              safeString(snapshot.DBSnapshotArn),
+		SourceIdentifier: safeString(snapshot.DBInstanceIdentifier),
+		Type:             safeString(snapshot.SnapshotType),
+		Status:           safeString(snapshot.Status),
+		Engine:           safeString(snapshot.Engine),
+		EngineVersion:    safeString(snapshot.EngineVersion),
+		AllocatedStorage: safeInt32(snapshot.AllocatedStorage),
+		Encrypted:        safeBool(snapshot.Encrypted),
+		CreatedAt:        safeTime(snapshot.SnapshotCreateTime),
+		AgeDays:          ageDays(snapshot.SnapshotCreateTime),
+	}
+}
+
+// FromAWSDBClusterSnapshot converts an AWS SDK DBClusterSnapshot type to our internal model
+func FromAWSDBClusterSnapshot(snapshot rdsTypes.DBClusterSnapshot) RDSSnapshotInfo {
+	return RDSSnapshotInfo{
+		Identifier:       safeString(snapshot.DBClusterSnapshotIdentifier),
+		ARN:              safeString(snapshot.DBClusterSnapshotArn),
+		SourceIdentifier: safeString(snapshot.DBClusterIdentifier),
+		IsCluster:        true,
+		Type:             safeString(snapshot.SnapshotType),
+		Status:           safeString(snapshot.Status),
+		Engine:           safeString(snapshot.Engine),
+		EngineVersion:    safeString(snapshot.EngineVersion),
+		AllocatedStorage: safeInt32(snapshot.AllocatedStorage),
+		Encrypted:        safeBool(snapshot.StorageEncrypted),
+		CreatedAt:        safeTime(snapshot.SnapshotCreateTime),
+		AgeDays:          ageDays(snapshot.SnapshotCreateTime),
+	}
+}
+
+// FromAWSDBParameterGroup converts an AWS SDK DBParameterGroup type to our internal model
+func FromAWSDBParameterGroup(group rdsTypes.DBParameterGroup) RDSParameterGroupInfo {
+	name := safeString(group.DBParameterGroupName)
+	return RDSParameterGroupInfo{
+		Name:        name,
+		Family:      safeString(group.DBParameterGroupFamily),
+		Description: safeString(group.Description),
+		IsDefault:   IsDefaultParameterGroup(name),
+	}
+}
+
+// FromAWSDBClusterParameterGroup converts an AWS SDK DBClusterParameterGroup type to our internal model
+func FromAWSDBClusterParameterGroup(group rdsTypes.DBClusterParameterGroup) RDSParameterGroupInfo {
+	name := safeString(group.DBClusterParameterGroupName)
+	return RDSParameterGroupInfo{
+		Name:        name,
+		Family:      safeString(group.DBParameterGroupFamily),
+		Description: safeString(group.Description),
+		IsCluster:   true,
+		IsDefault:   IsDefaultParameterGroup(name),
+	}
+}
+
+// FromAWSParameter converts an AWS SDK Parameter type to our internal model
+func FromAWSParameter(param rdsTypes.Parameter) RDSParameterInfo {
+	return RDSParameterInfo{
+		Name:        safeString(param.ParameterName),
+		Value:       safeString(param.ParameterValue),
+		ApplyType:   safeString(param.ApplyType),
+		ApplyMethod: string(param.ApplyMethod),
+	}
+}
+
+// IsDefaultParameterGroup reports whether a parameter group is one created by RDS
+func IsDefaultParameterGroup(name string) bool {
+	return strings.HasPrefix(name, "default.")
+}
+
+// FromAWSPendingMaintenance converts the pending actions of a resource to our internal model
+func FromAWSPendingMaintenance(resource rdsTypes.ResourcePendingMaintenanceActions) []RDSPendingMaintenanceInfo {
+	actions := make([]RDSPendingMaintenanceInfo, 0, len(resource.PendingMaintenanceActionDetails))
+	for _, action := range resource.PendingMaintenanceActionDetails {
+		actions = append(actions, RDSPendingMaintenanceInfo{
+			ResourceARN:      safeString(resource.ResourceIdentifier),
+			Action:           safeString(action.Action),
+			Description:      safeString(action.Description),
+			AutoAppliedAfter: safeTime(action.AutoAppliedAfterDate),
+			ForcedApplyDate:  safeTime(action.ForcedApplyDate),
+			CurrentApplyDate: safeTime(action.CurrentApplyDate),
+			OptInStatus:      safeString(action.OptInStatus),
+		})
+	}
+	return actions
+}
+
+// FromAWSRDSEvent converts an AWS SDK Event type to our internal model
+func FromAWSRDSEvent(event rdsTypes.Event) RDSEventInfo {
+	return RDSEventInfo{
+		SourceIdentifier: safeString(event.SourceIdentifier),
+		SourceType:       string(event.SourceType),
+		Message:          safeString(event.Message),
+		Categories:       event.EventCategories,
+		Date:             safeTime(event.Date),
+	}
+}
+
+// ageDays returns the number of whole days elapsed since t
+func ageDays(t *time.Time) int {
+	if t == nil {
+		return 0
+	}
+	return int(time.Since(*t).Hours() / 24)
+}
@@ -0,0 +1,213 @@
+package models
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	kmsTypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	secretsTypes "github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+
+	"aws-terminal-sdk-v1/internal/constants"
+)
+
+// DefaultSecretsManagerKey is the AWS managed key encrypting the secrets without a KMS key
+const DefaultSecretsManagerKey = "aws/secretsmanager"
+
+// KMSKeyInfo represents a customer-managed KMS key
+type KMSKeyInfo struct {
+	KeyID              string
+	ARN                string
+	Description        string
+	Aliases            []string
+	State              string // Enabled, Disabled, PendingDeletion...
+	KeySpec            string // SYMMETRIC_DEFAULT, RSA_2048, HMAC_256...
+	KeyUsage           string // ENCRYPT_DECRYPT, SIGN_VERIFY or GENERATE_VERIFY_MAC
+	Origin             string // AWS_KMS, EXTERNAL (imported material) or AWS_CLOUDHSM
+	MultiRegion        bool
+	CreatedAt          string
+	RotationSupported  bool // Only symmetric keys with KMS generated material rotate
+	RotationEnabled    bool
+	RotationUnknown    bool // The rotation status could not be read
+	RotationPeriodDays int32
+	NextRotation       string
+	PendingDeletion    bool
+	DeletionDate       string
+	PolicyPrincipals   []string // Principals the key policy allows, "*" for anyone
+	Issues             []string
+}
+
+// FromAWSKeyMetadata converts the metadata of a key, as returned by DescribeKey, to our
+// internal model
+func FromAWSKeyMetadata(key kmsTypes.KeyMetadata) KMSKeyInfo {
+	return KMSKeyInfo{
+		KeyID:             safeString(key.KeyId),
+		ARN:               safeString(key.Arn),
+		Description:       safeString(key.Description),
+		Aliases:           make([]string, 0),
+		State:             string(key.KeyState),
+		KeySpec:           string(key.KeySpec),
+		KeyUsage:          string(key.KeyUsage),
+		Origin:            string(key.Origin),
+		MultiRegion:       safeBool(key.MultiRegion),
+		CreatedAt:         safeTime(key.CreationDate),
+		RotationSupported: key.KeySpec == kmsTypes.KeySpecSymmetricDefault && key.Origin == kmsTypes.OriginTypeAwsKms,
+		PendingDeletion:   key.KeyState == kmsTypes.KeyStatePendingDeletion,
+		DeletionDate:      safeTime(key.DeletionDate),
+		PolicyPrincipals:  make([]string, 0),
+		Issues:            make([]string, 0),
+	}
+}
+
+// SetKeyPolicy lists the principals the Allow statements of the key policy grant, and
+// flags the statements allowing anyone without a condition
+func (k *KMSKeyInfo) SetKeyPolicy(policy string) error {
+	doc, err := ParsePolicyDocument(policy)
+	if err != nil {
+		return err
+	}
+
+	for _, stmt := range doc.Statements {
+		if stmt.Effect != "Allow" {
+			continue
+		}
+		for _, principal := range stmt.Principal {
+			if !containsString(k.PolicyPrincipals, principal) {
+				k.PolicyPrincipals = append(k.PolicyPrincipals, principal)
+			}
+			if principal == "*" && !stmt.HasCondition {
+				k.Issues = append(k.Issues, "Key policy allows any principal without a condition")
+			}
+		}
+	}
+	sort.Strings(k.PolicyPrincipals)
+	return nil
+}
+
+// SetRotation sets the automatic rotation status of the key, as returned by GetKeyRotationStatus
+func (k *KMSKeyInfo) SetRotation(enabled bool, periodDays *int32, next *time.Time) {
+	k.RotationEnabled = enabled
+	k.RotationPeriodDays = safeInt32(periodDays)
+	k.NextRotation = safeTime(next)
+}
+
+// FlagKeyIssues flags the keys scheduled for deletion and those that could rotate but do
+// not. Keys whose rotation status could not be read are not flagged.
+func (k *KMSKeyInfo) FlagKeyIssues() {
+	if k.PendingDeletion {
+		k.Issues = append(k.Issues, fmt.Sprintf("Pending deletion on %s", k.DeletionDate))
+	} else if k.RotationSupported && !k.RotationUnknown && !k.RotationEnabled {
+		k.Issues = append(k.Issues, "Automatic rotation disabled")
+	}
+}
+
+// SecretInfo represents the metadata of a Secrets Manager secret. The secret value is
+// never read.
+type SecretInfo struct {
+	Name             string
+	ARN              string
+	Description      string
+	KMSKeyID         string // DefaultSecretsManagerKey when the secret uses the AWS managed key
+	OwningService    string // Set for secrets managed by another service, such as RDS
+	RotationEnabled  bool
+	RotationLambda   string
+	RotationSchedule string // Such as "every 30 days" or a schedule expression
+	LastRotated      string
+	NextRotation     string
+	LastChanged      string
+	LastAccessed     string // Secrets Manager records the day only
+	DaysSinceAccess  int    // -1 when the secret was never accessed
+	CreatedAt        string
+	PrimaryRegion    string // Set for replicated secrets, the region of the primary
+	Replicas         []SecretReplicaInfo
+	Stale            bool
+	Issues           []string
+}
+
+// SecretReplicaInfo is a replica of a secret in another region
+type SecretReplicaInfo struct {
+	Region        string
+	Status        string // InSync, Failed or InProgress
+	StatusMessage string
+	KMSKeyID      string
+	LastAccessed  string
+}
+
+// FromAWSSecret converts an AWS SDK SecretListEntry type to our internal model
+func FromAWSSecret(secret secretsTypes.SecretListEntry) SecretInfo {
+	info := SecretInfo{
+		Name:            safeString(secret.Name),
+		ARN:             safeString(secret.ARN),
+		Description:     safeString(secret.Description),
+		KMSKeyID:        safeString(secret.KmsKeyId),
+		OwningService:   safeString(secret.OwningService),
+		RotationEnabled: safeBool(secret.RotationEnabled),
+		RotationLambda:  safeString(secret.RotationLambdaARN),
+		LastRotated:     safeTime(secret.LastRotatedDate),
+		NextRotation:    safeTime(secret.NextRotationDate),
+		LastChanged:     safeTime(secret.LastChangedDate),
+		LastAccessed:    safeDate(secret.LastAccessedDate),
+		DaysSinceAccess: daysSince(secret.LastAccessedDate),
+		CreatedAt:       safeTime(secret.CreatedDate),
+		PrimaryRegion:   safeString(secret.PrimaryRegion),
+		Replicas:        make([]SecretReplicaInfo, 0),
+		Issues:          make([]string, 0),
+	}
+	if info.KMSKeyID == "" {
+		info.KMSKeyID = DefaultSecretsManagerKey
+	}
+
+	if rules := secret.RotationRules; rules != nil {
+		if expression := safeString(rules.ScheduleExpression); expression != "" {
+			info.RotationSchedule = expression
+		} else if days := safeInt64(rules.AutomaticallyAfterDays); days > 0 {
+			info.RotationSchedule = fmt.Sprintf("every %d days", days)
+		}
+	}
+
+	flagSecretIssues(&info, secret.NextRotationDate, secret.CreatedDate)
+	return info
+}
+
+// flagSecretIssues flags the secrets without rotation, past their rotation date, or not
+// accessed for constants.SecretUnusedDays
+func flagSecretIssues(info *SecretInfo, nextRotation, created *time.Time) {
+	if !info.RotationEnabled {
+		info.Issues = append(info.Issues, "Rotation disabled")
+	} else if nextRotation != nil && nextRotation.Before(time.Now()) {
+		info.Issues = append(info.Issues, fmt.Sprintf("Rotation overdue since %s", info.NextRotation))
+	}
+
+	switch {
+	case info.DaysSinceAccess > constants.SecretUnusedDays:
+		info.Stale = true
+		info.Issues = append(info.Issues, fmt.Sprintf("Not accessed in %d days", info.DaysSinceAccess))
+	case info.DaysSinceAccess < 0 && ageDays(created) > constants.SecretUnusedDays:
+		info.Stale = true
+		info.Issues = append(info.Issues, "Never accessed")
+	}
+}
+
+// AddReplicas attaches the replication status of a secret, as returned by DescribeSecret
+func (s *SecretInfo) AddReplicas(replicas []secretsTypes.ReplicationStatusType) {
+	for _, replica := range replicas {
+		s.Replicas = append(s.Replicas, SecretReplicaInfo{
+			Region:        safeString(replica.Region),
+			Status:        string(replica.Status),
+			StatusMessage: safeString(replica.StatusMessage),
+			KMSKeyID:      safeString(replica.KmsKeyId),
+			LastAccessed:  safeDate(replica.LastAccessedDate),
+		})
+	}
+	sort.Slice(s.Replicas, func(i, j int) bool {
+		return s.Replicas[i].Region < s.Replicas[j].Region
+	})
+}
+
+// safeDate formats the day of a time, empty for nil
+func safeDate(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(constants.DateFormat)
+}
@@ -0,0 +1,47 @@
+package models
+
+import (
+	sfnTypes "github.com/aws/aws-sdk-go-v2/service/sfn/types"
+)
+
+// StateMachineInfo represents a Step Functions state machine
+type StateMachineInfo struct {
+	ARN            string
+	Name           string
+	Type           string // STANDARD or EXPRESS
+	Status         string
+	RoleARN        string
+	LoggingLevel   string
+	TracingEnabled bool
+	CreatedAt      string
+	// Lambda functions named in the definition
+	LambdaARNs []string
+	// Status counts over the most recent executions: RUNNING, SUCCEEDED, FAILED, TIMED_OUT, ABORTED.
+	// Empty for express state machines, whose executions are not listed.
+	ExecutionCounts map[string]int
+	// Number of executions the counts were taken from
+	SampledExecutions int
+	LastExecution     string
+}
+
+// FromAWSStateMachine converts an AWS SDK StateMachineListItem type to our internal model.
+// The definition and executions come from separate calls and are set by the caller.
+func FromAWSStateMachine(sm sfnTypes.StateMachineListItem) StateMachineInfo {
+	return StateMachineInfo{
+		ARN:             safeString(sm.StateMachineArn),
+		Name:            safeString(sm.Name),
+		Type:            string(sm.Type),
+		CreatedAt:       safeTime(sm.CreationDate),
+		LambdaARNs:      make([]string, 0),
+		ExecutionCounts: make(map[string]int),
+	}
+}
+
+// AddExecution accounts a recent execution in the status counts
+func (s *StateMachineInfo) AddExecution(execution sfnTypes.ExecutionListItem) {
+	s.SampledExecutions++
+	s.ExecutionCounts[string(execution.Status)]++
+	if started := safeTime(execution.StartDate); started > s.LastExecution {
+		s.LastExecution = started
+	}
+}
@@ -9,9 +9,31 @@ type ConfigurationInfo struct {
 }
 
 type PermissionStatus struct {
-	Action  string
-	Allowed bool
-	Reason  string
+	Action   string
+	Allowed  bool
+	Unknown  bool // The simulation lacked context values, such as aws:SourceIp, to decide
+	Optional bool // The feature works, degraded, without the action
+	Reason   string
+}
+
+// FeaturePermissions holds the simulated permissions of the IAM actions of a feature
+type FeaturePermissions struct {
+	Feature     string
+	Label       string
+	Allowed     bool     // Every required action of the feature is allowed
+	Missing     []string // Required actions that are denied
+	Degraded    []string // Optional actions that are denied
+	Permissions []PermissionStatus
+}
+
+// FeatureAvailability tells whether a feature can be used with the current credentials
+type FeatureAvailability struct {
+	Feature   string   `json:"feature"`
+	Label     string   `json:"label"`
+	Available bool     `json:"available"`
+	Missing   []string `json:"missing"`  // Actions denied by policy or failing for lack of a subscription
+	Degraded  []string `json:"degraded"` // Optional actions likewise unavailable, the feature works without them
+	Reason    string   `json:"reason"`   // Such as "missing rds:DescribeDBInstances"
 }
 
 // NAT Gateway Info
@@ -28,13 +50,14 @@ type NATGatewayInfo struct {
 
 // Route Table Info
 type RouteTableInfo struct {
-	ID        string
-	Name      string
-	VPCID     string
-	IsMain    bool
-	Routes    int
-	Subnets   int
-	SubnetIDs []string
+	ID           string
+	Name         string
+	VPCID        string
+	IsMain       bool
+	Routes       int
+	Subnets      int
+	SubnetIDs    []string
+	RouteEntries []RouteInfo
 }
 
 // S3 Bucket Info
@@ -70,6 +93,7 @@ type LoadBalancerInfo struct {
 	State             string
 	VPCID             string
 	AvailabilityZones []string
+	Addresses         []string // Static IPs of network load balancers
 }
 
 type SecurityGroupInfo struct {
@@ -115,6 +139,33 @@ type EC2InstanceInfo struct {
 	KeyName          string
 	Platform         string
 	Architecture     string
+	AutoScalingGroup string // From the aws:autoscaling:groupName tag
+
+	SecurityGroupIDs   []string
+	Tags               map[string]string
+	ImageID            string
+	Lifecycle          string // on-demand, spot, scheduled or capacity-block
+	IAMInstanceProfile string // Instance profile ARN
+	IMDSv2Required     bool   // HttpTokens set to required
+	IMDSEnabled        bool
+	IMDSHopLimit       int32
+	Monitoring         string // disabled, enabled (detailed) or pending
+	AvailabilityZone   string
+	Tenancy            string
+	PlacementGroup     string
+	EBSOptimized       bool
+	RootDeviceName     string
+	BlockDevices       []EC2BlockDeviceInfo
+	StateReason        string // Why the instance was stopped or terminated
+}
+
+// EC2BlockDeviceInfo is an EBS volume attached to an instance
+type EC2BlockDeviceInfo struct {
+	DeviceName          string
+	VolumeID            string
+	Status              string
+	DeleteOnTermination bool
+	AttachTime          string
 }
 
 // VPCInfo represents a VPC with its essential information
@@ -162,6 +213,7 @@ type AccountHomeInfo struct {
 	CostMonthToDate float64 `json:"cost_month_to_date"`
 	CostLastMonth   float64 `json:"cost_last_month"`
 	Currency        string  `json:"currency"`
+	CostsEnabled    bool    `json:"costs_enabled"` // Cost Explorer is allowed and enabled
 
 	// Quotas/Limits summary
 	VPCLimit      int `json:"vpc_limit"`
@@ -185,4 +237,8 @@ type AccountHomeInfo struct {
 	PotentialSavings     float64                        `json:"potential_savings"`
 	Recommendations      []TrustedAdvisorRecommendation `json:"recommendations"`
 	TopFindings          []SecurityFinding              `json:"top_findings"`
+
+	// CloudWatch alarms currently in ALARM state
+	AlarmsEnabled bool        `json:"alarms_enabled"`
+	AlarmsInAlarm []AlarmInfo `json:"alarms_in_alarm"`
 }